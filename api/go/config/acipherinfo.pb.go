@@ -306,6 +306,19 @@ type EncryptionBlock struct {
 	CellularNetUsername string `protobuf:"bytes,6,opt,name=cellular_net_username,json=cellularNetUsername,proto3" json:"cellular_net_username,omitempty"`
 	// Password for cellular network.
 	CellularNetPassword string `protobuf:"bytes,7,opt,name=cellular_net_password,json=cellularNetPassword,proto3" json:"cellular_net_password,omitempty"`
+	// PIN code used to unlock SIM card.
+	CellularSimPin string `protobuf:"bytes,8,opt,name=cellular_sim_pin,json=cellularSimPin,proto3" json:"cellular_sim_pin,omitempty"`
+	// Username used for 802.1X authentication of a wired port (PEAP).
+	PortAuthUsername string `protobuf:"bytes,9,opt,name=port_auth_username,json=portAuthUsername,proto3" json:"port_auth_username,omitempty"`
+	// Password used for 802.1X authentication of a wired port (PEAP).
+	PortAuthPassword string `protobuf:"bytes,10,opt,name=port_auth_password,json=portAuthPassword,proto3" json:"port_auth_password,omitempty"`
+	// Username used to authenticate with a network proxy.
+	// For NTLM it may include the domain in the form DOMAIN\user.
+	ProxyUsername string `protobuf:"bytes,11,opt,name=proxy_username,json=proxyUsername,proto3" json:"proxy_username,omitempty"`
+	// Password used to authenticate with a network proxy.
+	ProxyPassword string `protobuf:"bytes,12,opt,name=proxy_password,json=proxyPassword,proto3" json:"proxy_password,omitempty"`
+	// Secrets of an application instance (see AppInstanceConfig.secrets).
+	AppSecrets []*AppSecret `protobuf:"bytes,13,rep,name=app_secrets,json=appSecrets,proto3" json:"app_secrets,omitempty"`
 }
 
 func (x *EncryptionBlock) Reset() {
@@ -389,6 +402,119 @@ func (x *EncryptionBlock) GetCellularNetPassword() string {
 	return ""
 }
 
+func (x *EncryptionBlock) GetCellularSimPin() string {
+	if x != nil {
+		return x.CellularSimPin
+	}
+	return ""
+}
+
+func (x *EncryptionBlock) GetPortAuthUsername() string {
+	if x != nil {
+		return x.PortAuthUsername
+	}
+	return ""
+}
+
+func (x *EncryptionBlock) GetPortAuthPassword() string {
+	if x != nil {
+		return x.PortAuthPassword
+	}
+	return ""
+}
+
+func (x *EncryptionBlock) GetProxyUsername() string {
+	if x != nil {
+		return x.ProxyUsername
+	}
+	return ""
+}
+
+func (x *EncryptionBlock) GetProxyPassword() string {
+	if x != nil {
+		return x.ProxyPassword
+	}
+	return ""
+}
+
+func (x *EncryptionBlock) GetAppSecrets() []*AppSecret {
+	if x != nil {
+		return x.AppSecrets
+	}
+	return nil
+}
+
+// AppSecret is a named secret delivered to an application instance.
+// Containers find it in a file named after the secret under /run/secrets,
+// VMs fetch it from the meta-data server.
+type AppSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the secret. Allowed characters are letters, digits, '.', '_'
+	// and '-'; the name must not start with '.'.
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// If set, the secret is also set in this environment variable of
+	// containers. Unlike files, environment variables are only updated when
+	// the application instance is restarted.
+	EnvName string `protobuf:"bytes,3,opt,name=env_name,json=envName,proto3" json:"env_name,omitempty"`
+}
+
+func (x *AppSecret) Reset() {
+	*x = AppSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_acipherinfo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSecret) ProtoMessage() {}
+
+func (x *AppSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_config_acipherinfo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSecret.ProtoReflect.Descriptor instead.
+func (*AppSecret) Descriptor() ([]byte, []int) {
+	return file_config_acipherinfo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppSecret) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *AppSecret) GetEnvName() string {
+	if x != nil {
+		return x.EnvName
+	}
+	return ""
+}
+
 var File_config_acipherinfo_proto protoreflect.FileDescriptor
 
 var file_config_acipherinfo_proto_rawDesc = []byte{
@@ -431,7 +557,7 @@ var file_config_acipherinfo_proto_rawDesc = []byte{
 	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65,
 	0x78, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
 	0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
-	0xab, 0x02, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0xc2, 0x04, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
 	0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x73, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x73, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
 	0x1e, 0x0a, 0x0a, 0x64, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
@@ -449,18 +575,41 @@ var file_config_acipherinfo_proto_rawDesc = []byte{
 	0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x65, 0x6c,
 	0x6c, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
 	0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c,
-	0x61, 0x72, 0x4e, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a, 0x2f, 0x0a,
-	0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45, 0x41, 0x5f, 0x45, 0x43, 0x44, 0x48, 0x10, 0x01, 0x2a, 0x33,
-	0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x53, 0x41, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x46,
-	0x42, 0x10, 0x01, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65,
-	0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x72, 0x4e, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a,
+	0x10, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x69, 0x6d, 0x5f, 0x70, 0x69,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61,
+	0x72, 0x53, 0x69, 0x6d, 0x50, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x41, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
+	0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x2a, 0x2f, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4b,
+	0x45, 0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x45, 0x41,
+	0x5f, 0x45, 0x43, 0x44, 0x48, 0x10, 0x01, 0x2a, 0x33, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x41, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x5f, 0x41,
+	0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x5f, 0x43, 0x46, 0x42, 0x10, 0x01, 0x42, 0x3d, 0x0a, 0x15,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -476,24 +625,26 @@ func file_config_acipherinfo_proto_rawDescGZIP() []byte {
 }
 
 var file_config_acipherinfo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_config_acipherinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_acipherinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_config_acipherinfo_proto_goTypes = []interface{}{
 	(KeyExchangeScheme)(0),       // 0: org.lfedge.eve.config.KeyExchangeScheme
 	(EncryptionScheme)(0),        // 1: org.lfedge.eve.config.EncryptionScheme
 	(*CipherContext)(nil),        // 2: org.lfedge.eve.config.CipherContext
 	(*CipherBlock)(nil),          // 3: org.lfedge.eve.config.CipherBlock
 	(*EncryptionBlock)(nil),      // 4: org.lfedge.eve.config.EncryptionBlock
-	(evecommon.HashAlgorithm)(0), // 5: org.lfedge.eve.common.HashAlgorithm
+	(*AppSecret)(nil),            // 5: org.lfedge.eve.config.AppSecret
+	(evecommon.HashAlgorithm)(0), // 6: org.lfedge.eve.common.HashAlgorithm
 }
 var file_config_acipherinfo_proto_depIdxs = []int32{
-	5, // 0: org.lfedge.eve.config.CipherContext.hashScheme:type_name -> org.lfedge.eve.common.HashAlgorithm
+	6, // 0: org.lfedge.eve.config.CipherContext.hashScheme:type_name -> org.lfedge.eve.common.HashAlgorithm
 	0, // 1: org.lfedge.eve.config.CipherContext.keyExchangeScheme:type_name -> org.lfedge.eve.config.KeyExchangeScheme
 	1, // 2: org.lfedge.eve.config.CipherContext.encryptionScheme:type_name -> org.lfedge.eve.config.EncryptionScheme
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 3: org.lfedge.eve.config.EncryptionBlock.app_secrets:type_name -> org.lfedge.eve.config.AppSecret
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_config_acipherinfo_proto_init() }
@@ -538,6 +689,18 @@ func file_config_acipherinfo_proto_init() {
 				return nil
 			}
 		}
+		file_config_acipherinfo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -545,7 +708,7 @@ func file_config_acipherinfo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_acipherinfo_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
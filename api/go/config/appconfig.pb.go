@@ -31,6 +31,15 @@ const (
 	MetaDataType_MetaDataNone           MetaDataType = 1 // Do not provide metadata
 	MetaDataType_MetaDataOpenStack      MetaDataType = 2
 	MetaDataType_MetaDataDriveMultipart MetaDataType = 3 // Process multipart MIME for application
+	// Ignition config (JSON) of e.g. Fedora CoreOS or Flatcar in the user data.
+	// Passed to VMs run by KVM in the firmware config (fw_cfg) and served by
+	// the meta-data server at /openstack/latest/user_data
+	MetaDataType_MetaDataIgnition MetaDataType = 4
+	// NoCloud ISO image (volume label "cidata") which always contains
+	// the meta-data and user-data files
+	MetaDataType_MetaDataNoCloud MetaDataType = 5
+	// OpenStack ConfigDrive v2 ISO image (volume label "config-2")
+	MetaDataType_MetaDataConfigDrive MetaDataType = 6
 )
 
 // Enum value maps for MetaDataType.
@@ -40,12 +49,18 @@ var (
 		1: "MetaDataNone",
 		2: "MetaDataOpenStack",
 		3: "MetaDataDriveMultipart",
+		4: "MetaDataIgnition",
+		5: "MetaDataNoCloud",
+		6: "MetaDataConfigDrive",
 	}
 	MetaDataType_value = map[string]int32{
 		"MetaDataDrive":          0,
 		"MetaDataNone":           1,
 		"MetaDataOpenStack":      2,
 		"MetaDataDriveMultipart": 3,
+		"MetaDataIgnition":       4,
+		"MetaDataNoCloud":        5,
+		"MetaDataConfigDrive":    6,
 	}
 )
 
@@ -123,6 +138,300 @@ func (SnapshotType) EnumDescriptor() ([]byte, []int) {
 	return file_config_appconfig_proto_rawDescGZIP(), []int{1}
 }
 
+// Method used by a health probe to check an application instance
+type AppProbeType int32
+
+const (
+	AppProbeType_APP_PROBE_TYPE_UNSPECIFIED AppProbeType = 0
+	// HTTP GET request, probe succeeds if the response status code is 2xx or 3xx
+	AppProbeType_APP_PROBE_TYPE_HTTP AppProbeType = 1
+	// TCP connect, probe succeeds if the connection is established
+	AppProbeType_APP_PROBE_TYPE_TCP AppProbeType = 2
+	// Command executed inside the application container, probe succeeds
+	// if the command exits with zero status. Only supported for container
+	// applications deployed without a hypervisor.
+	AppProbeType_APP_PROBE_TYPE_EXEC AppProbeType = 3
+	// guest-ping command sent to the qemu-guest-agent running inside VM.
+	// Only supported for VMs with qemu-guest-agent installed.
+	AppProbeType_APP_PROBE_TYPE_GUEST_AGENT AppProbeType = 4
+)
+
+// Enum value maps for AppProbeType.
+var (
+	AppProbeType_name = map[int32]string{
+		0: "APP_PROBE_TYPE_UNSPECIFIED",
+		1: "APP_PROBE_TYPE_HTTP",
+		2: "APP_PROBE_TYPE_TCP",
+		3: "APP_PROBE_TYPE_EXEC",
+		4: "APP_PROBE_TYPE_GUEST_AGENT",
+	}
+	AppProbeType_value = map[string]int32{
+		"APP_PROBE_TYPE_UNSPECIFIED": 0,
+		"APP_PROBE_TYPE_HTTP":        1,
+		"APP_PROBE_TYPE_TCP":         2,
+		"APP_PROBE_TYPE_EXEC":        3,
+		"APP_PROBE_TYPE_GUEST_AGENT": 4,
+	}
+)
+
+func (x AppProbeType) Enum() *AppProbeType {
+	p := new(AppProbeType)
+	*p = x
+	return p
+}
+
+func (x AppProbeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppProbeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[2].Descriptor()
+}
+
+func (AppProbeType) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[2]
+}
+
+func (x AppProbeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppProbeType.Descriptor instead.
+func (AppProbeType) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+}
+
+// Action taken when the liveness probe of an application instance fails
+type AppHealthAction int32
+
+const (
+	// Only report the probe failure
+	AppHealthAction_APP_HEALTH_ACTION_NONE AppHealthAction = 0
+	// Restart the application instance
+	AppHealthAction_APP_HEALTH_ACTION_RESTART AppHealthAction = 1
+	// Purge the application instance, i.e. re-create its volumes from
+	// the original content and restart it
+	AppHealthAction_APP_HEALTH_ACTION_PURGE AppHealthAction = 2
+	// Roll back the application instance to the most recent snapshot
+	// (see SnapshotConfig). If there is no snapshot available, the application
+	// instance is restarted instead.
+	AppHealthAction_APP_HEALTH_ACTION_ROLLBACK AppHealthAction = 3
+)
+
+// Enum value maps for AppHealthAction.
+var (
+	AppHealthAction_name = map[int32]string{
+		0: "APP_HEALTH_ACTION_NONE",
+		1: "APP_HEALTH_ACTION_RESTART",
+		2: "APP_HEALTH_ACTION_PURGE",
+		3: "APP_HEALTH_ACTION_ROLLBACK",
+	}
+	AppHealthAction_value = map[string]int32{
+		"APP_HEALTH_ACTION_NONE":     0,
+		"APP_HEALTH_ACTION_RESTART":  1,
+		"APP_HEALTH_ACTION_PURGE":    2,
+		"APP_HEALTH_ACTION_ROLLBACK": 3,
+	}
+)
+
+func (x AppHealthAction) Enum() *AppHealthAction {
+	p := new(AppHealthAction)
+	*p = x
+	return p
+}
+
+func (x AppHealthAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppHealthAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[3].Descriptor()
+}
+
+func (AppHealthAction) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[3]
+}
+
+func (x AppHealthAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppHealthAction.Descriptor instead.
+func (AppHealthAction) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+}
+
+// Condition that an application instance required by another application
+// instance must satisfy before the dependent application instance is started
+type AppDependencyCondition int32
+
+const (
+	// Treated as APP_DEPENDENCY_CONDITION_READY
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_UNSPECIFIED AppDependencyCondition = 0
+	// Required application instance is running
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_STARTED AppDependencyCondition = 1
+	// Required application instance is running and its readiness probe
+	// (see AppHealthConfig) succeeds. Same as STARTED if the required
+	// application instance has no readiness probe.
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_READY AppDependencyCondition = 2
+)
+
+// Enum value maps for AppDependencyCondition.
+var (
+	AppDependencyCondition_name = map[int32]string{
+		0: "APP_DEPENDENCY_CONDITION_UNSPECIFIED",
+		1: "APP_DEPENDENCY_CONDITION_STARTED",
+		2: "APP_DEPENDENCY_CONDITION_READY",
+	}
+	AppDependencyCondition_value = map[string]int32{
+		"APP_DEPENDENCY_CONDITION_UNSPECIFIED": 0,
+		"APP_DEPENDENCY_CONDITION_STARTED":     1,
+		"APP_DEPENDENCY_CONDITION_READY":       2,
+	}
+)
+
+func (x AppDependencyCondition) Enum() *AppDependencyCondition {
+	p := new(AppDependencyCondition)
+	*p = x
+	return p
+}
+
+func (x AppDependencyCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppDependencyCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[4].Descriptor()
+}
+
+func (AppDependencyCondition) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[4]
+}
+
+func (x AppDependencyCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppDependencyCondition.Descriptor instead.
+func (AppDependencyCondition) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+}
+
+// Role of an additional container of a container application instance
+type AppContainerType int32
+
+const (
+	// Treated as APP_CONTAINER_TYPE_SIDECAR
+	AppContainerType_APP_CONTAINER_TYPE_UNSPECIFIED AppContainerType = 0
+	// Runs alongside the main container for the whole life of the
+	// application instance
+	AppContainerType_APP_CONTAINER_TYPE_SIDECAR AppContainerType = 1
+	// Runs to completion before the main container is started. Init containers
+	// are run one after another in the order they are listed.
+	AppContainerType_APP_CONTAINER_TYPE_INIT AppContainerType = 2
+)
+
+// Enum value maps for AppContainerType.
+var (
+	AppContainerType_name = map[int32]string{
+		0: "APP_CONTAINER_TYPE_UNSPECIFIED",
+		1: "APP_CONTAINER_TYPE_SIDECAR",
+		2: "APP_CONTAINER_TYPE_INIT",
+	}
+	AppContainerType_value = map[string]int32{
+		"APP_CONTAINER_TYPE_UNSPECIFIED": 0,
+		"APP_CONTAINER_TYPE_SIDECAR":     1,
+		"APP_CONTAINER_TYPE_INIT":        2,
+	}
+)
+
+func (x AppContainerType) Enum() *AppContainerType {
+	p := new(AppContainerType)
+	*p = x
+	return p
+}
+
+func (x AppContainerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppContainerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[5].Descriptor()
+}
+
+func (AppContainerType) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[5]
+}
+
+func (x AppContainerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppContainerType.Descriptor instead.
+func (AppContainerType) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{5}
+}
+
+// When to restart an additional container which has exited
+type AppContainerRestartPolicy int32
+
+const (
+	// Treated as APP_CONTAINER_RESTART_POLICY_ALWAYS for sidecars and as
+	// APP_CONTAINER_RESTART_POLICY_ON_FAILURE for init containers
+	AppContainerRestartPolicy_APP_CONTAINER_RESTART_POLICY_UNSPECIFIED AppContainerRestartPolicy = 0
+	// Restart whenever the container exits. Same as ON_FAILURE for
+	// init containers.
+	AppContainerRestartPolicy_APP_CONTAINER_RESTART_POLICY_ALWAYS AppContainerRestartPolicy = 1
+	// Restart only if the container exits with a non-zero status
+	AppContainerRestartPolicy_APP_CONTAINER_RESTART_POLICY_ON_FAILURE AppContainerRestartPolicy = 2
+	// Never restart. An init container which fails prevents the application
+	// instance from starting.
+	AppContainerRestartPolicy_APP_CONTAINER_RESTART_POLICY_NEVER AppContainerRestartPolicy = 3
+)
+
+// Enum value maps for AppContainerRestartPolicy.
+var (
+	AppContainerRestartPolicy_name = map[int32]string{
+		0: "APP_CONTAINER_RESTART_POLICY_UNSPECIFIED",
+		1: "APP_CONTAINER_RESTART_POLICY_ALWAYS",
+		2: "APP_CONTAINER_RESTART_POLICY_ON_FAILURE",
+		3: "APP_CONTAINER_RESTART_POLICY_NEVER",
+	}
+	AppContainerRestartPolicy_value = map[string]int32{
+		"APP_CONTAINER_RESTART_POLICY_UNSPECIFIED": 0,
+		"APP_CONTAINER_RESTART_POLICY_ALWAYS":      1,
+		"APP_CONTAINER_RESTART_POLICY_ON_FAILURE":  2,
+		"APP_CONTAINER_RESTART_POLICY_NEVER":       3,
+	}
+)
+
+func (x AppContainerRestartPolicy) Enum() *AppContainerRestartPolicy {
+	p := new(AppContainerRestartPolicy)
+	*p = x
+	return p
+}
+
+func (x AppContainerRestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppContainerRestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[6].Descriptor()
+}
+
+func (AppContainerRestartPolicy) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[6]
+}
+
+func (x AppContainerRestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppContainerRestartPolicy.Descriptor instead.
+func (AppContainerRestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{6}
+}
+
 type InstanceOpsCmd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -351,6 +660,396 @@ func (x *SnapshotConfig) GetSnapshots() []*SnapshotDesc {
 	return nil
 }
 
+// Health probe of an Application Instance
+type AppProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type AppProbeType `protobuf:"varint,1,opt,name=type,proto3,enum=org.lfedge.eve.config.AppProbeType" json:"type,omitempty"`
+	// TCP port of the application to probe (HTTP and TCP probes)
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// URL path requested by HTTP probe, "/" if empty
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Use HTTPS instead of HTTP. Server certificate is not verified.
+	Https bool `protobuf:"varint,4,opt,name=https,proto3" json:"https,omitempty"`
+	// Command with arguments to execute inside the container (EXEC probe)
+	Command []string `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+	// Index of the application network adapter (in AppInstanceConfig.interfaces)
+	// with the IP address used by HTTP and TCP probes.
+	AdapterIndex uint32 `protobuf:"varint,6,opt,name=adapter_index,json=adapterIndex,proto3" json:"adapter_index,omitempty"`
+	// Number of seconds after the application instance has started before
+	// the probe is run for the first time.
+	InitialDelaySeconds uint32 `protobuf:"varint,7,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
+	// How often to run the probe. Default is 10 seconds.
+	PeriodSeconds uint32 `protobuf:"varint,8,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// Probe timeout. Default is 1 second.
+	TimeoutSeconds uint32 `protobuf:"varint,9,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// Number of consecutive failures for the probe to be considered failed
+	// after having succeeded. Default is 3.
+	FailureThreshold uint32 `protobuf:"varint,10,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// Number of consecutive successes for the probe to be considered successful
+	// after having failed. Default is 1.
+	SuccessThreshold uint32 `protobuf:"varint,11,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
+}
+
+func (x *AppProbe) Reset() {
+	*x = AppProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppProbe) ProtoMessage() {}
+
+func (x *AppProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppProbe.ProtoReflect.Descriptor instead.
+func (*AppProbe) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppProbe) GetType() AppProbeType {
+	if x != nil {
+		return x.Type
+	}
+	return AppProbeType_APP_PROBE_TYPE_UNSPECIFIED
+}
+
+func (x *AppProbe) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AppProbe) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AppProbe) GetHttps() bool {
+	if x != nil {
+		return x.Https
+	}
+	return false
+}
+
+func (x *AppProbe) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *AppProbe) GetAdapterIndex() uint32 {
+	if x != nil {
+		return x.AdapterIndex
+	}
+	return 0
+}
+
+func (x *AppProbe) GetInitialDelaySeconds() uint32 {
+	if x != nil {
+		return x.InitialDelaySeconds
+	}
+	return 0
+}
+
+func (x *AppProbe) GetPeriodSeconds() uint32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *AppProbe) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *AppProbe) GetFailureThreshold() uint32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *AppProbe) GetSuccessThreshold() uint32 {
+	if x != nil {
+		return x.SuccessThreshold
+	}
+	return 0
+}
+
+// Health checking of an Application Instance
+type AppHealthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Liveness probe determines whether the application is working.
+	// If the probe fails, the configured action is taken.
+	Liveness *AppProbe `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	// Readiness probe determines whether the application is ready to serve.
+	// The result is only reported, no action is taken when it fails.
+	Readiness *AppProbe `protobuf:"bytes,2,opt,name=readiness,proto3" json:"readiness,omitempty"`
+	// Action taken when the liveness probe fails
+	Action AppHealthAction `protobuf:"varint,3,opt,name=action,proto3,enum=org.lfedge.eve.config.AppHealthAction" json:"action,omitempty"`
+	// After the action is taken, EVE waits at least backoff_initial_seconds
+	// (default 10) before the action may be taken again. The backoff is doubled
+	// with every subsequent action, up to backoff_max_seconds (default 300).
+	// The backoff is reset once the application has been live for
+	// backoff_max_seconds.
+	BackoffInitialSeconds uint32 `protobuf:"varint,4,opt,name=backoff_initial_seconds,json=backoffInitialSeconds,proto3" json:"backoff_initial_seconds,omitempty"`
+	BackoffMaxSeconds     uint32 `protobuf:"varint,5,opt,name=backoff_max_seconds,json=backoffMaxSeconds,proto3" json:"backoff_max_seconds,omitempty"`
+}
+
+func (x *AppHealthConfig) Reset() {
+	*x = AppHealthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppHealthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHealthConfig) ProtoMessage() {}
+
+func (x *AppHealthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHealthConfig.ProtoReflect.Descriptor instead.
+func (*AppHealthConfig) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AppHealthConfig) GetLiveness() *AppProbe {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *AppHealthConfig) GetReadiness() *AppProbe {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *AppHealthConfig) GetAction() AppHealthAction {
+	if x != nil {
+		return x.Action
+	}
+	return AppHealthAction_APP_HEALTH_ACTION_NONE
+}
+
+func (x *AppHealthConfig) GetBackoffInitialSeconds() uint32 {
+	if x != nil {
+		return x.BackoffInitialSeconds
+	}
+	return 0
+}
+
+func (x *AppHealthConfig) GetBackoffMaxSeconds() uint32 {
+	if x != nil {
+		return x.BackoffMaxSeconds
+	}
+	return 0
+}
+
+// Dependency of an Application Instance on another Application Instance
+type AppDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the required application instance
+	AppUuid   string                 `protobuf:"bytes,1,opt,name=app_uuid,json=appUuid,proto3" json:"app_uuid,omitempty"`
+	Condition AppDependencyCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=org.lfedge.eve.config.AppDependencyCondition" json:"condition,omitempty"`
+}
+
+func (x *AppDependency) Reset() {
+	*x = AppDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDependency) ProtoMessage() {}
+
+func (x *AppDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDependency.ProtoReflect.Descriptor instead.
+func (*AppDependency) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppDependency) GetAppUuid() string {
+	if x != nil {
+		return x.AppUuid
+	}
+	return ""
+}
+
+func (x *AppDependency) GetCondition() AppDependencyCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return AppDependencyCondition_APP_DEPENDENCY_CONDITION_UNSPECIFIED
+}
+
+// Additional container of a container application instance.
+// All containers of an application instance share the network namespace
+// (and therefore the network adapters), IPC and UTS namespaces and volumes
+// of the main container, which is the container created from the first
+// volume in volumeRefList. Resources (fixedresources) are shared by all
+// containers.
+type AppContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the container, unique within the application instance.
+	// Must consist of lower case alphanumeric characters or '-'.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// UUID of the volume from volumeRefList with the container image.
+	// The volume must not be the first one in volumeRefList and is not
+	// mounted into other containers.
+	VolumeUuid    string                    `protobuf:"bytes,2,opt,name=volume_uuid,json=volumeUuid,proto3" json:"volume_uuid,omitempty"`
+	Type          AppContainerType          `protobuf:"varint,3,opt,name=type,proto3,enum=org.lfedge.eve.config.AppContainerType" json:"type,omitempty"`
+	RestartPolicy AppContainerRestartPolicy `protobuf:"varint,4,opt,name=restart_policy,json=restartPolicy,proto3,enum=org.lfedge.eve.config.AppContainerRestartPolicy" json:"restart_policy,omitempty"`
+	// Overrides ENTRYPOINT and CMD of the image if not empty
+	Command []string `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+	// Environment variables set in addition to those from the image
+	// and from userData
+	Environment map[string]string `protobuf:"bytes,6,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AppContainer) Reset() {
+	*x = AppContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppContainer) ProtoMessage() {}
+
+func (x *AppContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppContainer.ProtoReflect.Descriptor instead.
+func (*AppContainer) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AppContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppContainer) GetVolumeUuid() string {
+	if x != nil {
+		return x.VolumeUuid
+	}
+	return ""
+}
+
+func (x *AppContainer) GetType() AppContainerType {
+	if x != nil {
+		return x.Type
+	}
+	return AppContainerType_APP_CONTAINER_TYPE_UNSPECIFIED
+}
+
+func (x *AppContainer) GetRestartPolicy() AppContainerRestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return AppContainerRestartPolicy_APP_CONTAINER_RESTART_POLICY_UNSPECIFIED
+}
+
+func (x *AppContainer) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *AppContainer) GetEnvironment() map[string]string {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
 // The complete configuration for an Application Instance
 // When changing key fields such as the drives/volumeRefs or the number
 // of interfaces, the controller is required to issue a purge command i.e.,
@@ -448,12 +1147,36 @@ type AppInstanceConfig struct {
 	CloudInitVersion uint32 `protobuf:"varint,21,opt,name=cloud_init_version,json=cloudInitVersion,proto3" json:"cloud_init_version,omitempty"`
 	// SnapshotConfig is used to track the snapshots of the app instance
 	Snapshot *SnapshotConfig `protobuf:"bytes,22,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Health probes and the action taken when the application is not live
+	Health *AppHealthConfig `protobuf:"bytes,23,opt,name=health,proto3" json:"health,omitempty"`
+	// Application instances which must satisfy the given condition before this
+	// application instance is started (and restarted). When application
+	// instances are stopped (deactivated), dependent application instances
+	// are stopped before the application instances they depend on.
+	// Application instances in a dependency cycle are never started and
+	// reported with an error.
+	Dependencies []*AppDependency `protobuf:"bytes,24,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Application instances with a non-zero start_group are started only after
+	// all activated application instances with a lower non-zero start_group
+	// are ready (as if they were listed in dependencies with the condition
+	// APP_DEPENDENCY_CONDITION_READY). 0 means no start group.
+	StartGroup uint32 `protobuf:"varint,25,opt,name=start_group,json=startGroup,proto3" json:"start_group,omitempty"`
+	// Additional containers (sidecars and init containers) of a container
+	// application instance. Only supported for application instances running
+	// without hypervisor (virtualization mode NOHYPER).
+	Containers []*AppContainer `protobuf:"bytes,26,rep,name=containers,proto3" json:"containers,omitempty"`
+	// Encrypted secrets of the application instance, an EncryptionBlock
+	// with app_secrets set. Secrets can be changed (rotated) without
+	// restarting the application instance.
+	Secrets *CipherBlock `protobuf:"bytes,27,opt,name=secrets,proto3" json:"secrets,omitempty"`
+	// Prometheus endpoint of the application instance scraped by EVE
+	Prometheus *AppPrometheusConfig `protobuf:"bytes,28,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
 }
 
 func (x *AppInstanceConfig) Reset() {
 	*x = AppInstanceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[3]
+		mi := &file_config_appconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -466,7 +1189,7 @@ func (x *AppInstanceConfig) String() string {
 func (*AppInstanceConfig) ProtoMessage() {}
 
 func (x *AppInstanceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[3]
+	mi := &file_config_appconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +1202,7 @@ func (x *AppInstanceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppInstanceConfig.ProtoReflect.Descriptor instead.
 func (*AppInstanceConfig) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+	return file_config_appconfig_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AppInstanceConfig) GetUuidandversion() *UUIDandVersion {
@@ -622,6 +1345,122 @@ func (x *AppInstanceConfig) GetSnapshot() *SnapshotConfig {
 	return nil
 }
 
+func (x *AppInstanceConfig) GetHealth() *AppHealthConfig {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetDependencies() []*AppDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetStartGroup() uint32 {
+	if x != nil {
+		return x.StartGroup
+	}
+	return 0
+}
+
+func (x *AppInstanceConfig) GetContainers() []*AppContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetSecrets() *CipherBlock {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetPrometheus() *AppPrometheusConfig {
+	if x != nil {
+		return x.Prometheus
+	}
+	return nil
+}
+
+// AppPrometheusConfig declares a Prometheus endpoint (text exposition
+// format) of an application instance. EVE scrapes the endpoint over
+// the network instance at the metric interval and reports the samples
+// of the allowed metrics in appMetric.custom of the device metrics.
+// The endpoint is reached on the IP address allocated by EVE to the
+// first network adapter of the application instance which has one,
+// i.e. connected to a local network instance.
+type AppPrometheusConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TCP port of the endpoint; 0 disables scraping
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// HTTP path of the endpoint; "/metrics" if empty
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Names of the metrics to report. A name ending with '*' matches all
+	// metrics with the given prefix. Must not be empty.
+	Allowlist []string `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (x *AppPrometheusConfig) Reset() {
+	*x = AppPrometheusConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppPrometheusConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppPrometheusConfig) ProtoMessage() {}
+
+func (x *AppPrometheusConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppPrometheusConfig.ProtoReflect.Descriptor instead.
+func (*AppPrometheusConfig) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AppPrometheusConfig) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AppPrometheusConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AppPrometheusConfig) GetAllowlist() []string {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
 // Reference to a Volume specified separately in the API
 // If a volume is purged (re-created from scratch) it will either have a new
 // UUID or a new generationCount
@@ -640,7 +1479,7 @@ type VolumeRef struct {
 func (x *VolumeRef) Reset() {
 	*x = VolumeRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[4]
+		mi := &file_config_appconfig_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +1492,7 @@ func (x *VolumeRef) String() string {
 func (*VolumeRef) ProtoMessage() {}
 
 func (x *VolumeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[4]
+	mi := &file_config_appconfig_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +1505,7 @@ func (x *VolumeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeRef.ProtoReflect.Descriptor instead.
 func (*VolumeRef) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+	return file_config_appconfig_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VolumeRef) GetUuid() string {
@@ -727,7 +1566,85 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
 	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x65,
-	0x73, 0x63, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0xca, 0x08,
+	0x73, 0x63, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x9e, 0x03,
+	0x0a, 0x08, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xb5,
+	0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x3d, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x3e,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x17, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x15, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x78, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x77, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x4b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70,
+	0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8b, 0x03, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x56, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x3e, 0x0a,
+	0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x0b,
 	0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65,
 	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
@@ -796,29 +1713,110 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x68, 0x6f, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
 	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
-	0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44,
-	0x69, 0x72, 0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x0c, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4e,
-	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4e, 0x41,
-	0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d,
-	0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d,
+	0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65,
+	0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74,
+	0x68, 0x65, 0x75, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x65, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x2a, 0xaa, 0x01, 0x0a, 0x0c, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74,
+	0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x49, 0x67,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x10, 0x05, 0x12, 0x17, 0x0a,
+	0x13, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x10, 0x06, 0x2a, 0x4b, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
+	0x4f, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x2a, 0x98, 0x01, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x43, 0x50, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f,
+	0x42, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x10, 0x03, 0x12, 0x1e,
+	0x0a, 0x1a, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x89,
+	0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x50, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x41, 0x50, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x50, 0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x55, 0x52, 0x47, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50,
+	0x50, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x2a, 0x8c, 0x01, 0x0a, 0x16, 0x41,
+	0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
+	0x1e, 0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x02, 0x2a, 0xc7,
+	0x01, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x28,
+	0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x50,
+	0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59,
+	0x53, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
+	0x12, 0x26, 0x0a, 0x22, 0x41, 0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66,
+	0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -833,43 +1831,67 @@ func file_config_appconfig_proto_rawDescGZIP() []byte {
 	return file_config_appconfig_proto_rawDescData
 }
 
-var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_config_appconfig_proto_goTypes = []interface{}{
-	(MetaDataType)(0),         // 0: org.lfedge.eve.config.MetaDataType
-	(SnapshotType)(0),         // 1: org.lfedge.eve.config.SnapshotType
-	(*InstanceOpsCmd)(nil),    // 2: org.lfedge.eve.config.InstanceOpsCmd
-	(*SnapshotDesc)(nil),      // 3: org.lfedge.eve.config.SnapshotDesc
-	(*SnapshotConfig)(nil),    // 4: org.lfedge.eve.config.SnapshotConfig
-	(*AppInstanceConfig)(nil), // 5: org.lfedge.eve.config.AppInstanceConfig
-	(*VolumeRef)(nil),         // 6: org.lfedge.eve.config.VolumeRef
-	(*UUIDandVersion)(nil),    // 7: org.lfedge.eve.config.UUIDandVersion
-	(*VmConfig)(nil),          // 8: org.lfedge.eve.config.VmConfig
-	(*Drive)(nil),             // 9: org.lfedge.eve.config.Drive
-	(*NetworkAdapter)(nil),    // 10: org.lfedge.eve.config.NetworkAdapter
-	(*Adapter)(nil),           // 11: org.lfedge.eve.config.Adapter
-	(*CipherBlock)(nil),       // 12: org.lfedge.eve.config.CipherBlock
+	(MetaDataType)(0),              // 0: org.lfedge.eve.config.MetaDataType
+	(SnapshotType)(0),              // 1: org.lfedge.eve.config.SnapshotType
+	(AppProbeType)(0),              // 2: org.lfedge.eve.config.AppProbeType
+	(AppHealthAction)(0),           // 3: org.lfedge.eve.config.AppHealthAction
+	(AppDependencyCondition)(0),    // 4: org.lfedge.eve.config.AppDependencyCondition
+	(AppContainerType)(0),          // 5: org.lfedge.eve.config.AppContainerType
+	(AppContainerRestartPolicy)(0), // 6: org.lfedge.eve.config.AppContainerRestartPolicy
+	(*InstanceOpsCmd)(nil),         // 7: org.lfedge.eve.config.InstanceOpsCmd
+	(*SnapshotDesc)(nil),           // 8: org.lfedge.eve.config.SnapshotDesc
+	(*SnapshotConfig)(nil),         // 9: org.lfedge.eve.config.SnapshotConfig
+	(*AppProbe)(nil),               // 10: org.lfedge.eve.config.AppProbe
+	(*AppHealthConfig)(nil),        // 11: org.lfedge.eve.config.AppHealthConfig
+	(*AppDependency)(nil),          // 12: org.lfedge.eve.config.AppDependency
+	(*AppContainer)(nil),           // 13: org.lfedge.eve.config.AppContainer
+	(*AppInstanceConfig)(nil),      // 14: org.lfedge.eve.config.AppInstanceConfig
+	(*AppPrometheusConfig)(nil),    // 15: org.lfedge.eve.config.AppPrometheusConfig
+	(*VolumeRef)(nil),              // 16: org.lfedge.eve.config.VolumeRef
+	nil,                            // 17: org.lfedge.eve.config.AppContainer.EnvironmentEntry
+	(*UUIDandVersion)(nil),         // 18: org.lfedge.eve.config.UUIDandVersion
+	(*VmConfig)(nil),               // 19: org.lfedge.eve.config.VmConfig
+	(*Drive)(nil),                  // 20: org.lfedge.eve.config.Drive
+	(*NetworkAdapter)(nil),         // 21: org.lfedge.eve.config.NetworkAdapter
+	(*Adapter)(nil),                // 22: org.lfedge.eve.config.Adapter
+	(*CipherBlock)(nil),            // 23: org.lfedge.eve.config.CipherBlock
 }
 var file_config_appconfig_proto_depIdxs = []int32{
 	1,  // 0: org.lfedge.eve.config.SnapshotDesc.type:type_name -> org.lfedge.eve.config.SnapshotType
-	2,  // 1: org.lfedge.eve.config.SnapshotConfig.rollback_cmd:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	3,  // 2: org.lfedge.eve.config.SnapshotConfig.snapshots:type_name -> org.lfedge.eve.config.SnapshotDesc
-	7,  // 3: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	8,  // 4: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
-	9,  // 5: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
-	10, // 6: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
-	11, // 7: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
-	2,  // 8: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	2,  // 9: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	12, // 10: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	6,  // 11: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
-	0,  // 12: org.lfedge.eve.config.AppInstanceConfig.metaDataType:type_name -> org.lfedge.eve.config.MetaDataType
-	4,  // 13: org.lfedge.eve.config.AppInstanceConfig.snapshot:type_name -> org.lfedge.eve.config.SnapshotConfig
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	7,  // 1: org.lfedge.eve.config.SnapshotConfig.rollback_cmd:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	8,  // 2: org.lfedge.eve.config.SnapshotConfig.snapshots:type_name -> org.lfedge.eve.config.SnapshotDesc
+	2,  // 3: org.lfedge.eve.config.AppProbe.type:type_name -> org.lfedge.eve.config.AppProbeType
+	10, // 4: org.lfedge.eve.config.AppHealthConfig.liveness:type_name -> org.lfedge.eve.config.AppProbe
+	10, // 5: org.lfedge.eve.config.AppHealthConfig.readiness:type_name -> org.lfedge.eve.config.AppProbe
+	3,  // 6: org.lfedge.eve.config.AppHealthConfig.action:type_name -> org.lfedge.eve.config.AppHealthAction
+	4,  // 7: org.lfedge.eve.config.AppDependency.condition:type_name -> org.lfedge.eve.config.AppDependencyCondition
+	5,  // 8: org.lfedge.eve.config.AppContainer.type:type_name -> org.lfedge.eve.config.AppContainerType
+	6,  // 9: org.lfedge.eve.config.AppContainer.restart_policy:type_name -> org.lfedge.eve.config.AppContainerRestartPolicy
+	17, // 10: org.lfedge.eve.config.AppContainer.environment:type_name -> org.lfedge.eve.config.AppContainer.EnvironmentEntry
+	18, // 11: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	19, // 12: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
+	20, // 13: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
+	21, // 14: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
+	22, // 15: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
+	7,  // 16: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	7,  // 17: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	23, // 18: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	16, // 19: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
+	0,  // 20: org.lfedge.eve.config.AppInstanceConfig.metaDataType:type_name -> org.lfedge.eve.config.MetaDataType
+	9,  // 21: org.lfedge.eve.config.AppInstanceConfig.snapshot:type_name -> org.lfedge.eve.config.SnapshotConfig
+	11, // 22: org.lfedge.eve.config.AppInstanceConfig.health:type_name -> org.lfedge.eve.config.AppHealthConfig
+	12, // 23: org.lfedge.eve.config.AppInstanceConfig.dependencies:type_name -> org.lfedge.eve.config.AppDependency
+	13, // 24: org.lfedge.eve.config.AppInstanceConfig.containers:type_name -> org.lfedge.eve.config.AppContainer
+	23, // 25: org.lfedge.eve.config.AppInstanceConfig.secrets:type_name -> org.lfedge.eve.config.CipherBlock
+	15, // 26: org.lfedge.eve.config.AppInstanceConfig.prometheus:type_name -> org.lfedge.eve.config.AppPrometheusConfig
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_config_appconfig_proto_init() }
@@ -920,7 +1942,7 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 		file_config_appconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppInstanceConfig); i {
+			switch v := v.(*AppProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -932,6 +1954,66 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 		file_config_appconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppHealthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppPrometheusConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeRef); i {
 			case 0:
 				return &v.state
@@ -949,8 +2031,8 @@ func file_config_appconfig_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_appconfig_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
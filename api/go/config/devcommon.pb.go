@@ -339,6 +339,103 @@ func (x *EthVF) GetVlanId() uint32 {
 	return 0
 }
 
+// On-demand collection of network diagnostics (aka netdump).
+// EVE repeatedly sends HTTP(S) requests to the target for the given duration,
+// recording network trace (DNS queries, TCP connections, TLS handshakes,
+// HTTP requests) together with packet captures taken on the selected
+// interfaces. The result is published as a netdump archive (tar.gz) which
+// is then uploaded into the given datastore.
+type NetDumpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique request ID (e.g. UUID). EVE runs each request only once.
+	// The ID is also used to name the uploaded archive and to report
+	// the request status (see ZInfoNetDump).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Target endpoint, either as <host>:<port> or as HTTP(S) URL.
+	// With host:port, HTTPS is used unless the port is 80.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// For how long to repeat requests and capture packets.
+	// If not set (zero), the default of 30 seconds is used.
+	// Maximum allowed duration is 10 minutes.
+	DurationSeconds uint32 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Logical labels of device ports to send requests from and to capture
+	// packets on. If empty, all management ports are used.
+	Interfaces []string `protobuf:"bytes,4,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// UUID of the datastore to upload the netdump archive into.
+	// If empty, the archive is only stored locally under /persist/netdump.
+	DatastoreId string `protobuf:"bytes,5,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id,omitempty"`
+}
+
+func (x *NetDumpRequest) Reset() {
+	*x = NetDumpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_devcommon_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetDumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetDumpRequest) ProtoMessage() {}
+
+func (x *NetDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_devcommon_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetDumpRequest.ProtoReflect.Descriptor instead.
+func (*NetDumpRequest) Descriptor() ([]byte, []int) {
+	return file_config_devcommon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NetDumpRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetDumpRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NetDumpRequest) GetDurationSeconds() uint32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *NetDumpRequest) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *NetDumpRequest) GetDatastoreId() string {
+	if x != nil {
+		return x.DatastoreId
+	}
+	return ""
+}
+
 var File_config_devcommon_proto protoreflect.FileDescriptor
 
 var file_config_devcommon_proto_rawDesc = []byte{
@@ -374,11 +471,22 @@ var file_config_devcommon_proto_rawDesc = []byte{
 	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61,
 	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e,
-	0x49, 0x64, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f,
-	0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x42, 0x3d, 0x0a, 0x15, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -393,17 +501,18 @@ func file_config_devcommon_proto_rawDescGZIP() []byte {
 	return file_config_devcommon_proto_rawDescData
 }
 
-var file_config_devcommon_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_devcommon_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_config_devcommon_proto_goTypes = []interface{}{
 	(*UUIDandVersion)(nil),   // 0: org.lfedge.eve.config.UUIDandVersion
 	(*DeviceOpsCmd)(nil),     // 1: org.lfedge.eve.config.DeviceOpsCmd
 	(*ConfigItem)(nil),       // 2: org.lfedge.eve.config.ConfigItem
 	(*Adapter)(nil),          // 3: org.lfedge.eve.config.Adapter
 	(*EthVF)(nil),            // 4: org.lfedge.eve.config.EthVF
-	(evecommon.PhyIoType)(0), // 5: org.lfedge.eve.common.PhyIoType
+	(*NetDumpRequest)(nil),   // 5: org.lfedge.eve.config.NetDumpRequest
+	(evecommon.PhyIoType)(0), // 6: org.lfedge.eve.common.PhyIoType
 }
 var file_config_devcommon_proto_depIdxs = []int32{
-	5, // 0: org.lfedge.eve.config.Adapter.type:type_name -> org.lfedge.eve.common.PhyIoType
+	6, // 0: org.lfedge.eve.config.Adapter.type:type_name -> org.lfedge.eve.common.PhyIoType
 	4, // 1: org.lfedge.eve.config.Adapter.eth_vf:type_name -> org.lfedge.eve.config.EthVF
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
@@ -478,6 +587,18 @@ func file_config_devcommon_proto_init() {
 				return nil
 			}
 		}
+		file_config_devcommon_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetDumpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -485,7 +606,7 @@ func file_config_devcommon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_devcommon_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
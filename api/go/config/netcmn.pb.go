@@ -78,6 +78,65 @@ func (ProxyProto) EnumDescriptor() ([]byte, []int) {
 	return file_config_netcmn_proto_rawDescGZIP(), []int{0}
 }
 
+// Authentication scheme used with HTTP/HTTPS proxies.
+type ProxyAuthMethod int32
+
+const (
+	// Use the strongest scheme offered by the proxy in the Proxy-Authenticate
+	// header (Negotiate, then NTLM, then Basic).
+	ProxyAuthMethod_PROXY_AUTH_METHOD_AUTO ProxyAuthMethod = 0
+	// Username and password sent in clear (base64 encoded).
+	ProxyAuthMethod_PROXY_AUTH_METHOD_BASIC ProxyAuthMethod = 1
+	// NTLMv2 challenge-response. Username can be given as DOMAIN\user.
+	ProxyAuthMethod_PROXY_AUTH_METHOD_NTLM ProxyAuthMethod = 2
+	// SPNEGO. EVE does not support Kerberos, therefore NTLM tokens are sent
+	// inside the Negotiate scheme (accepted by most Negotiate-enabled proxies).
+	ProxyAuthMethod_PROXY_AUTH_METHOD_NEGOTIATE ProxyAuthMethod = 3
+)
+
+// Enum value maps for ProxyAuthMethod.
+var (
+	ProxyAuthMethod_name = map[int32]string{
+		0: "PROXY_AUTH_METHOD_AUTO",
+		1: "PROXY_AUTH_METHOD_BASIC",
+		2: "PROXY_AUTH_METHOD_NTLM",
+		3: "PROXY_AUTH_METHOD_NEGOTIATE",
+	}
+	ProxyAuthMethod_value = map[string]int32{
+		"PROXY_AUTH_METHOD_AUTO":      0,
+		"PROXY_AUTH_METHOD_BASIC":     1,
+		"PROXY_AUTH_METHOD_NTLM":      2,
+		"PROXY_AUTH_METHOD_NEGOTIATE": 3,
+	}
+)
+
+func (x ProxyAuthMethod) Enum() *ProxyAuthMethod {
+	p := new(ProxyAuthMethod)
+	*p = x
+	return p
+}
+
+func (x ProxyAuthMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyAuthMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netcmn_proto_enumTypes[1].Descriptor()
+}
+
+func (ProxyAuthMethod) Type() protoreflect.EnumType {
+	return &file_config_netcmn_proto_enumTypes[1]
+}
+
+func (x ProxyAuthMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyAuthMethod.Descriptor instead.
+func (ProxyAuthMethod) EnumDescriptor() ([]byte, []int) {
+	return file_config_netcmn_proto_rawDescGZIP(), []int{1}
+}
+
 type DHCPType int32
 
 const (
@@ -117,11 +176,11 @@ func (x DHCPType) String() string {
 }
 
 func (DHCPType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_netcmn_proto_enumTypes[1].Descriptor()
+	return file_config_netcmn_proto_enumTypes[2].Descriptor()
 }
 
 func (DHCPType) Type() protoreflect.EnumType {
-	return &file_config_netcmn_proto_enumTypes[1]
+	return &file_config_netcmn_proto_enumTypes[2]
 }
 
 func (x DHCPType) Number() protoreflect.EnumNumber {
@@ -130,7 +189,7 @@ func (x DHCPType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DHCPType.Descriptor instead.
 func (DHCPType) EnumDescriptor() ([]byte, []int) {
-	return file_config_netcmn_proto_rawDescGZIP(), []int{1}
+	return file_config_netcmn_proto_rawDescGZIP(), []int{2}
 }
 
 type NetworkType int32
@@ -184,11 +243,11 @@ func (x NetworkType) String() string {
 }
 
 func (NetworkType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_netcmn_proto_enumTypes[2].Descriptor()
+	return file_config_netcmn_proto_enumTypes[3].Descriptor()
 }
 
 func (NetworkType) Type() protoreflect.EnumType {
-	return &file_config_netcmn_proto_enumTypes[2]
+	return &file_config_netcmn_proto_enumTypes[3]
 }
 
 func (x NetworkType) Number() protoreflect.EnumNumber {
@@ -197,7 +256,7 @@ func (x NetworkType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkType.Descriptor instead.
 func (NetworkType) EnumDescriptor() ([]byte, []int) {
-	return file_config_netcmn_proto_rawDescGZIP(), []int{2}
+	return file_config_netcmn_proto_rawDescGZIP(), []int{3}
 }
 
 type WirelessType int32
@@ -233,11 +292,11 @@ func (x WirelessType) String() string {
 }
 
 func (WirelessType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_netcmn_proto_enumTypes[3].Descriptor()
+	return file_config_netcmn_proto_enumTypes[4].Descriptor()
 }
 
 func (WirelessType) Type() protoreflect.EnumType {
-	return &file_config_netcmn_proto_enumTypes[3]
+	return &file_config_netcmn_proto_enumTypes[4]
 }
 
 func (x WirelessType) Number() protoreflect.EnumNumber {
@@ -246,7 +305,7 @@ func (x WirelessType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WirelessType.Descriptor instead.
 func (WirelessType) EnumDescriptor() ([]byte, []int) {
-	return file_config_netcmn_proto_rawDescGZIP(), []int{3}
+	return file_config_netcmn_proto_rawDescGZIP(), []int{4}
 }
 
 type WiFiKeyScheme int32
@@ -282,11 +341,11 @@ func (x WiFiKeyScheme) String() string {
 }
 
 func (WiFiKeyScheme) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_netcmn_proto_enumTypes[4].Descriptor()
+	return file_config_netcmn_proto_enumTypes[5].Descriptor()
 }
 
 func (WiFiKeyScheme) Type() protoreflect.EnumType {
-	return &file_config_netcmn_proto_enumTypes[4]
+	return &file_config_netcmn_proto_enumTypes[5]
 }
 
 func (x WiFiKeyScheme) Number() protoreflect.EnumNumber {
@@ -295,7 +354,7 @@ func (x WiFiKeyScheme) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WiFiKeyScheme.Descriptor instead.
 func (WiFiKeyScheme) EnumDescriptor() ([]byte, []int) {
-	return file_config_netcmn_proto_rawDescGZIP(), []int{4}
+	return file_config_netcmn_proto_rawDescGZIP(), []int{5}
 }
 
 type IpRange struct {
@@ -436,6 +495,12 @@ type ProxyConfig struct {
 	// this may be needed either in explicit (has ProxyServer items), automatic
 	// (networkProxyEnable) or transparent (network layer not aware of proxy)
 	ProxyCertPEM [][]byte `protobuf:"bytes,6,rep,name=proxyCertPEM,proto3" json:"proxyCertPEM,omitempty"`
+	// Authentication scheme to use with proxies (both explicitly configured
+	// and returned by PAC/WPAD). Used only if cipher_data is set.
+	AuthMethod ProxyAuthMethod `protobuf:"varint,7,opt,name=auth_method,json=authMethod,proto3,enum=org.lfedge.eve.config.ProxyAuthMethod" json:"auth_method,omitempty"`
+	// Encrypted proxy credentials
+	// (EncryptionBlock.proxy_username and EncryptionBlock.proxy_password).
+	CipherData *CipherBlock `protobuf:"bytes,8,opt,name=cipher_data,json=cipherData,proto3" json:"cipher_data,omitempty"`
 }
 
 func (x *ProxyConfig) Reset() {
@@ -512,6 +577,20 @@ func (x *ProxyConfig) GetProxyCertPEM() [][]byte {
 	return nil
 }
 
+func (x *ProxyConfig) GetAuthMethod() ProxyAuthMethod {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ProxyAuthMethod_PROXY_AUTH_METHOD_AUTO
+}
+
+func (x *ProxyConfig) GetCipherData() *CipherBlock {
+	if x != nil {
+		return x.CipherData
+	}
+	return nil
+}
+
 // deprecated use ZnetStaticDNSEntry
 type ZedServer struct {
 	state         protoimpl.MessageState
@@ -728,87 +807,106 @@ var File_config_netcmn_proto protoreflect.FileDescriptor
 var file_config_netcmn_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x65, 0x74, 0x63, 0x6d, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x31, 0x0a, 0x07,
-	0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
-	0x72, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x37,
-	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x52, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x18, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x07, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x72, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x91, 0x03,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a,
 	0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x65,
-	0x72, 0x74, 0x50, 0x45, 0x4d, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x43, 0x65, 0x72, 0x74, 0x50, 0x45, 0x4d, 0x22, 0x39, 0x0a, 0x09, 0x5a, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x45, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x12, 0x5a, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x63, 0x44, 0x4e, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0xe9, 0x01, 0x0a, 0x06, 0x69, 0x70, 0x73, 0x70, 0x65, 0x63, 0x12, 0x33, 0x0a, 0x04, 0x64,
-	0x68, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x65, 0x72, 0x74, 0x50, 0x45, 0x4d, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x65, 0x72, 0x74,
+	0x50, 0x45, 0x4d, 0x12, 0x47, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x43, 0x0a, 0x0b,
+	0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x39, 0x0a, 0x09, 0x5a, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x45, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x12,
+	0x5a, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x44, 0x4e, 0x53, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x06, 0x69, 0x70, 0x73,
+	0x70, 0x65, 0x63, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x68, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
+	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x64, 0x68, 0x63, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x74, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6e, 0x74, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
 	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x68, 0x63, 0x70,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x74,
-	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x74, 0x70, 0x12, 0x10, 0x0a, 0x03,
-	0x64, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x3c,
-	0x0a, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2a, 0x5f, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52,
-	0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52,
-	0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
-	0x52, 0x4f, 0x58, 0x59, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
-	0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x46, 0x54, 0x50, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x50,
-	0x52, 0x4f, 0x58, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x3e, 0x0a,
-	0x08, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43,
-	0x50, 0x4e, 0x6f, 0x6f, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x63, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
-	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x2a, 0x83, 0x01,
-	0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a,
-	0x0f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x54, 0x59, 0x50, 0x45, 0x4e, 0x4f, 0x4f, 0x50,
-	0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x34, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x36,
-	0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x34, 0x10, 0x18,
-	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x36, 0x10, 0x1a, 0x12, 0x0d,
-	0x0a, 0x09, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x45, 0x49, 0x44, 0x10, 0x0e, 0x12, 0x0a, 0x0a,
-	0x06, 0x56, 0x34, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x36, 0x4f,
-	0x6e, 0x6c, 0x79, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x75, 0x61, 0x6c, 0x56, 0x34, 0x56,
-	0x36, 0x10, 0x09, 0x2a, 0x34, 0x0a, 0x0c, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x69, 0x46, 0x69, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43,
-	0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x0d, 0x57, 0x69, 0x46,
-	0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50,
-	0x41, 0x50, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50, 0x41, 0x45, 0x41, 0x50,
-	0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f,
-	0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x2a, 0x5f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50,
+	0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x53, 0x4f, 0x43,
+	0x4b, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x46, 0x54,
+	0x50, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x87, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
+	0x58, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x54, 0x4c, 0x4d, 0x10, 0x02, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a,
+	0x3e, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6f, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6e,
+	0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x2a,
+	0x83, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x13, 0x0a, 0x0f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x54, 0x59, 0x50, 0x45, 0x4e, 0x4f,
+	0x4f, 0x50, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x34, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02,
+	0x56, 0x36, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x34,
+	0x10, 0x18, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x36, 0x10, 0x1a,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x45, 0x49, 0x44, 0x10, 0x0e, 0x12,
+	0x0a, 0x0a, 0x06, 0x56, 0x34, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x56,
+	0x36, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x75, 0x61, 0x6c, 0x56,
+	0x34, 0x56, 0x36, 0x10, 0x09, 0x2a, 0x34, 0x0a, 0x0c, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x4f, 0x4f,
+	0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x69, 0x46, 0x69, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x0d, 0x57,
+	0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x57, 0x50, 0x41, 0x50, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50, 0x41, 0x45,
+	0x41, 0x50, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67,
+	0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -823,31 +921,35 @@ func file_config_netcmn_proto_rawDescGZIP() []byte {
 	return file_config_netcmn_proto_rawDescData
 }
 
-var file_config_netcmn_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_config_netcmn_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_config_netcmn_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_config_netcmn_proto_goTypes = []interface{}{
 	(ProxyProto)(0),            // 0: org.lfedge.eve.config.proxyProto
-	(DHCPType)(0),              // 1: org.lfedge.eve.config.DHCPType
-	(NetworkType)(0),           // 2: org.lfedge.eve.config.NetworkType
-	(WirelessType)(0),          // 3: org.lfedge.eve.config.WirelessType
-	(WiFiKeyScheme)(0),         // 4: org.lfedge.eve.config.WiFiKeyScheme
-	(*IpRange)(nil),            // 5: org.lfedge.eve.config.ipRange
-	(*ProxyServer)(nil),        // 6: org.lfedge.eve.config.ProxyServer
-	(*ProxyConfig)(nil),        // 7: org.lfedge.eve.config.ProxyConfig
-	(*ZedServer)(nil),          // 8: org.lfedge.eve.config.ZedServer
-	(*ZnetStaticDNSEntry)(nil), // 9: org.lfedge.eve.config.ZnetStaticDNSEntry
-	(*Ipspec)(nil),             // 10: org.lfedge.eve.config.ipspec
+	(ProxyAuthMethod)(0),       // 1: org.lfedge.eve.config.ProxyAuthMethod
+	(DHCPType)(0),              // 2: org.lfedge.eve.config.DHCPType
+	(NetworkType)(0),           // 3: org.lfedge.eve.config.NetworkType
+	(WirelessType)(0),          // 4: org.lfedge.eve.config.WirelessType
+	(WiFiKeyScheme)(0),         // 5: org.lfedge.eve.config.WiFiKeyScheme
+	(*IpRange)(nil),            // 6: org.lfedge.eve.config.ipRange
+	(*ProxyServer)(nil),        // 7: org.lfedge.eve.config.ProxyServer
+	(*ProxyConfig)(nil),        // 8: org.lfedge.eve.config.ProxyConfig
+	(*ZedServer)(nil),          // 9: org.lfedge.eve.config.ZedServer
+	(*ZnetStaticDNSEntry)(nil), // 10: org.lfedge.eve.config.ZnetStaticDNSEntry
+	(*Ipspec)(nil),             // 11: org.lfedge.eve.config.ipspec
+	(*CipherBlock)(nil),        // 12: org.lfedge.eve.config.CipherBlock
 }
 var file_config_netcmn_proto_depIdxs = []int32{
-	0, // 0: org.lfedge.eve.config.ProxyServer.proto:type_name -> org.lfedge.eve.config.proxyProto
-	6, // 1: org.lfedge.eve.config.ProxyConfig.proxies:type_name -> org.lfedge.eve.config.ProxyServer
-	1, // 2: org.lfedge.eve.config.ipspec.dhcp:type_name -> org.lfedge.eve.config.DHCPType
-	5, // 3: org.lfedge.eve.config.ipspec.dhcpRange:type_name -> org.lfedge.eve.config.ipRange
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: org.lfedge.eve.config.ProxyServer.proto:type_name -> org.lfedge.eve.config.proxyProto
+	7,  // 1: org.lfedge.eve.config.ProxyConfig.proxies:type_name -> org.lfedge.eve.config.ProxyServer
+	1,  // 2: org.lfedge.eve.config.ProxyConfig.auth_method:type_name -> org.lfedge.eve.config.ProxyAuthMethod
+	12, // 3: org.lfedge.eve.config.ProxyConfig.cipher_data:type_name -> org.lfedge.eve.config.CipherBlock
+	2,  // 4: org.lfedge.eve.config.ipspec.dhcp:type_name -> org.lfedge.eve.config.DHCPType
+	6,  // 5: org.lfedge.eve.config.ipspec.dhcpRange:type_name -> org.lfedge.eve.config.ipRange
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_config_netcmn_proto_init() }
@@ -855,6 +957,7 @@ func file_config_netcmn_proto_init() {
 	if File_config_netcmn_proto != nil {
 		return
 	}
+	file_config_acipherinfo_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_config_netcmn_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IpRange); i {
@@ -934,7 +1037,7 @@ func file_config_netcmn_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netcmn_proto_rawDesc,
-			NumEnums:      5,
+			NumEnums:      6,
 			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -24,6 +24,114 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EAP method used for 802.1X port authentication.
+type EAPMethod int32
+
+const (
+	EAPMethod_EAP_METHOD_UNSPECIFIED EAPMethod = 0
+	// EAP-TLS using the device certificate and the device key
+	// (which is kept inside the TPM if the device has one).
+	EAPMethod_EAP_METHOD_TLS EAPMethod = 1
+	// PEAP with MSCHAPv2 as the inner authentication method.
+	// Username and password are delivered inside cipher_data.
+	EAPMethod_EAP_METHOD_PEAP EAPMethod = 2
+)
+
+// Enum value maps for EAPMethod.
+var (
+	EAPMethod_name = map[int32]string{
+		0: "EAP_METHOD_UNSPECIFIED",
+		1: "EAP_METHOD_TLS",
+		2: "EAP_METHOD_PEAP",
+	}
+	EAPMethod_value = map[string]int32{
+		"EAP_METHOD_UNSPECIFIED": 0,
+		"EAP_METHOD_TLS":         1,
+		"EAP_METHOD_PEAP":        2,
+	}
+)
+
+func (x EAPMethod) Enum() *EAPMethod {
+	p := new(EAPMethod)
+	*p = x
+	return p
+}
+
+func (x EAPMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAPMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netconfig_proto_enumTypes[0].Descriptor()
+}
+
+func (EAPMethod) Type() protoreflect.EnumType {
+	return &file_config_netconfig_proto_enumTypes[0]
+}
+
+func (x EAPMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EAPMethod.Descriptor instead.
+func (EAPMethod) EnumDescriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{0}
+}
+
+type CellularIPType int32
+
+const (
+	// Unspecified, IPv4 is used.
+	CellularIPType_CELLULAR_IP_TYPE_UNSPECIFIED CellularIPType = 0
+	CellularIPType_CELLULAR_IP_TYPE_IPV4        CellularIPType = 1
+	CellularIPType_CELLULAR_IP_TYPE_IPV6        CellularIPType = 2
+	// Dual-stack bearer.
+	CellularIPType_CELLULAR_IP_TYPE_IPV4_AND_IPV6 CellularIPType = 3
+)
+
+// Enum value maps for CellularIPType.
+var (
+	CellularIPType_name = map[int32]string{
+		0: "CELLULAR_IP_TYPE_UNSPECIFIED",
+		1: "CELLULAR_IP_TYPE_IPV4",
+		2: "CELLULAR_IP_TYPE_IPV6",
+		3: "CELLULAR_IP_TYPE_IPV4_AND_IPV6",
+	}
+	CellularIPType_value = map[string]int32{
+		"CELLULAR_IP_TYPE_UNSPECIFIED":   0,
+		"CELLULAR_IP_TYPE_IPV4":          1,
+		"CELLULAR_IP_TYPE_IPV6":          2,
+		"CELLULAR_IP_TYPE_IPV4_AND_IPV6": 3,
+	}
+)
+
+func (x CellularIPType) Enum() *CellularIPType {
+	p := new(CellularIPType)
+	*p = x
+	return p
+}
+
+func (x CellularIPType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CellularIPType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netconfig_proto_enumTypes[1].Descriptor()
+}
+
+func (CellularIPType) Type() protoreflect.EnumType {
+	return &file_config_netconfig_proto_enumTypes[1]
+}
+
+func (x CellularIPType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CellularIPType.Descriptor instead.
+func (CellularIPType) EnumDescriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{1}
+}
+
 type CellularAuthProtocol int32
 
 const (
@@ -64,11 +172,11 @@ func (x CellularAuthProtocol) String() string {
 }
 
 func (CellularAuthProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_netconfig_proto_enumTypes[0].Descriptor()
+	return file_config_netconfig_proto_enumTypes[2].Descriptor()
 }
 
 func (CellularAuthProtocol) Type() protoreflect.EnumType {
-	return &file_config_netconfig_proto_enumTypes[0]
+	return &file_config_netconfig_proto_enumTypes[2]
 }
 
 func (x CellularAuthProtocol) Number() protoreflect.EnumNumber {
@@ -77,7 +185,112 @@ func (x CellularAuthProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CellularAuthProtocol.Descriptor instead.
 func (CellularAuthProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{0}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{2}
+}
+
+// Security (authentication and key management) used by WiFi access point.
+type WifiAccessPointSecurity int32
+
+const (
+	WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_UNSPECIFIED WifiAccessPointSecurity = 0 // WPA2-PSK is used
+	WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_WPA2_PSK    WifiAccessPointSecurity = 1
+	WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_WPA3_SAE    WifiAccessPointSecurity = 2
+	// WPA2-PSK and WPA3-SAE are both enabled, allowing older clients
+	// without WPA3 support to connect.
+	WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_WPA2_WPA3 WifiAccessPointSecurity = 3
+)
+
+// Enum value maps for WifiAccessPointSecurity.
+var (
+	WifiAccessPointSecurity_name = map[int32]string{
+		0: "WIFI_ACCESS_POINT_SECURITY_UNSPECIFIED",
+		1: "WIFI_ACCESS_POINT_SECURITY_WPA2_PSK",
+		2: "WIFI_ACCESS_POINT_SECURITY_WPA3_SAE",
+		3: "WIFI_ACCESS_POINT_SECURITY_WPA2_WPA3",
+	}
+	WifiAccessPointSecurity_value = map[string]int32{
+		"WIFI_ACCESS_POINT_SECURITY_UNSPECIFIED": 0,
+		"WIFI_ACCESS_POINT_SECURITY_WPA2_PSK":    1,
+		"WIFI_ACCESS_POINT_SECURITY_WPA3_SAE":    2,
+		"WIFI_ACCESS_POINT_SECURITY_WPA2_WPA3":   3,
+	}
+)
+
+func (x WifiAccessPointSecurity) Enum() *WifiAccessPointSecurity {
+	p := new(WifiAccessPointSecurity)
+	*p = x
+	return p
+}
+
+func (x WifiAccessPointSecurity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WifiAccessPointSecurity) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netconfig_proto_enumTypes[3].Descriptor()
+}
+
+func (WifiAccessPointSecurity) Type() protoreflect.EnumType {
+	return &file_config_netconfig_proto_enumTypes[3]
+}
+
+func (x WifiAccessPointSecurity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WifiAccessPointSecurity.Descriptor instead.
+func (WifiAccessPointSecurity) EnumDescriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{3}
+}
+
+// Frequency band used by WiFi access point.
+type WifiBand int32
+
+const (
+	WifiBand_WIFI_BAND_UNSPECIFIED WifiBand = 0 // 2.4GHz is used
+	WifiBand_WIFI_BAND_2_4GHZ      WifiBand = 1
+	WifiBand_WIFI_BAND_5GHZ        WifiBand = 2
+)
+
+// Enum value maps for WifiBand.
+var (
+	WifiBand_name = map[int32]string{
+		0: "WIFI_BAND_UNSPECIFIED",
+		1: "WIFI_BAND_2_4GHZ",
+		2: "WIFI_BAND_5GHZ",
+	}
+	WifiBand_value = map[string]int32{
+		"WIFI_BAND_UNSPECIFIED": 0,
+		"WIFI_BAND_2_4GHZ":      1,
+		"WIFI_BAND_5GHZ":        2,
+	}
+)
+
+func (x WifiBand) Enum() *WifiBand {
+	p := new(WifiBand)
+	*p = x
+	return p
+}
+
+func (x WifiBand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WifiBand) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netconfig_proto_enumTypes[4].Descriptor()
+}
+
+func (WifiBand) Type() protoreflect.EnumType {
+	return &file_config_netconfig_proto_enumTypes[4]
+}
+
+func (x WifiBand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WifiBand.Descriptor instead.
+func (WifiBand) EnumDescriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
 }
 
 type NetworkConfig struct {
@@ -94,6 +307,8 @@ type NetworkConfig struct {
 	EntProxy *ProxyConfig `protobuf:"bytes,8,opt,name=entProxy,proto3" json:"entProxy,omitempty"`
 	// wireless specification
 	Wireless *WirelessConfig `protobuf:"bytes,10,opt,name=wireless,proto3" json:"wireless,omitempty"`
+	// IEEE 802.1X port authentication (wired ports only)
+	PortAuth *PortAuthConfig `protobuf:"bytes,11,opt,name=port_auth,json=portAuth,proto3" json:"port_auth,omitempty"`
 }
 
 func (x *NetworkConfig) Reset() {
@@ -170,6 +385,114 @@ func (x *NetworkConfig) GetWireless() *WirelessConfig {
 	return nil
 }
 
+func (x *NetworkConfig) GetPortAuth() *PortAuthConfig {
+	if x != nil {
+		return x.PortAuth
+	}
+	return nil
+}
+
+// PortAuthConfig enables IEEE 802.1X (port-based network access control)
+// authentication of a wired Ethernet port. EVE runs wpa_supplicant with
+// the wired driver on the port and reports the authentication state
+// in DevicePort.port_auth.
+type PortAuthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enable 802.1X authentication of the port.
+	Enabled   bool      `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	EapMethod EAPMethod `protobuf:"varint,2,opt,name=eap_method,json=eapMethod,proto3,enum=org.lfedge.eve.config.EAPMethod" json:"eap_method,omitempty"`
+	// Identity presented to the authenticator. With EAP-TLS it defaults
+	// to the common name of the device certificate. With PEAP it is used
+	// as the outer (anonymous) identity, if defined.
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// PEM-encoded CA certificate(s) used to verify the authentication server.
+	// If empty, the server certificate is not verified.
+	CaCertPem string `protobuf:"bytes,4,opt,name=ca_cert_pem,json=caCertPem,proto3" json:"ca_cert_pem,omitempty"`
+	// Optional domain name suffix that the authentication server certificate
+	// must match.
+	ServerDomainSuffix string `protobuf:"bytes,5,opt,name=server_domain_suffix,json=serverDomainSuffix,proto3" json:"server_domain_suffix,omitempty"`
+	// Encrypted PEAP username and password
+	// (EncryptionBlock.port_auth_username and port_auth_password).
+	CipherData *CipherBlock `protobuf:"bytes,6,opt,name=cipher_data,json=cipherData,proto3" json:"cipher_data,omitempty"`
+}
+
+func (x *PortAuthConfig) Reset() {
+	*x = PortAuthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortAuthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortAuthConfig) ProtoMessage() {}
+
+func (x *PortAuthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortAuthConfig.ProtoReflect.Descriptor instead.
+func (*PortAuthConfig) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PortAuthConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PortAuthConfig) GetEapMethod() EAPMethod {
+	if x != nil {
+		return x.EapMethod
+	}
+	return EAPMethod_EAP_METHOD_UNSPECIFIED
+}
+
+func (x *PortAuthConfig) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *PortAuthConfig) GetCaCertPem() string {
+	if x != nil {
+		return x.CaCertPem
+	}
+	return ""
+}
+
+func (x *PortAuthConfig) GetServerDomainSuffix() string {
+	if x != nil {
+		return x.ServerDomainSuffix
+	}
+	return ""
+}
+
+func (x *PortAuthConfig) GetCipherData() *CipherBlock {
+	if x != nil {
+		return x.CipherData
+	}
+	return nil
+}
+
 type NetworkAdapter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -194,12 +517,16 @@ type NetworkAdapter struct {
 	// valid vlan id range: 2 - 4093
 	// vlan id 1 is implicitly used by linux bridges
 	AccessVlanId uint32 `protobuf:"varint,41,opt,name=access_vlan_id,json=accessVlanId,proto3" json:"access_vlan_id,omitempty"`
+	// Traffic shaping and QoS applied to traffic of this application interface.
+	// Not supported for application interfaces with direct access to a physical
+	// network adapter.
+	Qos *AdapterQoS `protobuf:"bytes,42,opt,name=qos,proto3" json:"qos,omitempty"`
 }
 
 func (x *NetworkAdapter) Reset() {
 	*x = NetworkAdapter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[1]
+		mi := &file_config_netconfig_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -212,7 +539,7 @@ func (x *NetworkAdapter) String() string {
 func (*NetworkAdapter) ProtoMessage() {}
 
 func (x *NetworkAdapter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[1]
+	mi := &file_config_netconfig_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +552,7 @@ func (x *NetworkAdapter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkAdapter.ProtoReflect.Descriptor instead.
 func (*NetworkAdapter) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{1}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *NetworkAdapter) GetName() string {
@@ -305,6 +632,158 @@ func (x *NetworkAdapter) GetAccessVlanId() uint32 {
 	return 0
 }
 
+func (x *NetworkAdapter) GetQos() *AdapterQoS {
+	if x != nil {
+		return x.Qos
+	}
+	return nil
+}
+
+// Traffic shaping and QoS configured for an application interface.
+type AdapterQoS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Shaping of traffic sent by the application.
+	Egress *TrafficShaping `protobuf:"bytes,1,opt,name=egress,proto3" json:"egress,omitempty"`
+	// Shaping of traffic sent to the application.
+	Ingress *TrafficShaping `protobuf:"bytes,2,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	// If enabled, DSCP field of every IP packet sent by the application is set
+	// to the value of "dscp". This allows to prioritize (or de-prioritize)
+	// application traffic in the network beyond the device.
+	MarkDscp bool `protobuf:"varint,3,opt,name=mark_dscp,json=markDscp,proto3" json:"mark_dscp,omitempty"`
+	// DSCP value (0 - 63) to mark application traffic with.
+	Dscp uint32 `protobuf:"varint,4,opt,name=dscp,proto3" json:"dscp,omitempty"`
+}
+
+func (x *AdapterQoS) Reset() {
+	*x = AdapterQoS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdapterQoS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdapterQoS) ProtoMessage() {}
+
+func (x *AdapterQoS) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdapterQoS.ProtoReflect.Descriptor instead.
+func (*AdapterQoS) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdapterQoS) GetEgress() *TrafficShaping {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+func (x *AdapterQoS) GetIngress() *TrafficShaping {
+	if x != nil {
+		return x.Ingress
+	}
+	return nil
+}
+
+func (x *AdapterQoS) GetMarkDscp() bool {
+	if x != nil {
+		return x.MarkDscp
+	}
+	return false
+}
+
+func (x *AdapterQoS) GetDscp() uint32 {
+	if x != nil {
+		return x.Dscp
+	}
+	return 0
+}
+
+// Bandwidth limit for one direction of the application traffic.
+// Shaped traffic is split into two classes with strict priority between them:
+//   - control traffic (ARP, ICMP, DHCP, DNS and packets with DSCP marked as EF,
+//     CS6 or CS7), which is guaranteed 10% of the rate and is always served first
+//   - all other traffic, which can use the remaining bandwidth
+//
+// Excess packets are queued (and dropped by the fq_codel AQM when the queue
+// builds up) instead of being dropped right away as is the case with ACE
+// action "limit".
+type TrafficShaping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum rate in kilobits per second. Zero disables shaping.
+	RateKbps uint64 `protobuf:"varint,1,opt,name=rate_kbps,json=rateKbps,proto3" json:"rate_kbps,omitempty"`
+	// Maximum burst size in bytes. Zero means that a minimal burst suitable
+	// for the given rate is used.
+	BurstBytes uint32 `protobuf:"varint,2,opt,name=burst_bytes,json=burstBytes,proto3" json:"burst_bytes,omitempty"`
+}
+
+func (x *TrafficShaping) Reset() {
+	*x = TrafficShaping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficShaping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficShaping) ProtoMessage() {}
+
+func (x *TrafficShaping) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficShaping.ProtoReflect.Descriptor instead.
+func (*TrafficShaping) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TrafficShaping) GetRateKbps() uint64 {
+	if x != nil {
+		return x.RateKbps
+	}
+	return 0
+}
+
+func (x *TrafficShaping) GetBurstBytes() uint32 {
+	if x != nil {
+		return x.BurstBytes
+	}
+	return 0
+}
+
 type WirelessConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -313,12 +792,17 @@ type WirelessConfig struct {
 	Type        WirelessType      `protobuf:"varint,1,opt,name=type,proto3,enum=org.lfedge.eve.config.WirelessType" json:"type,omitempty"` // either LTE or Wifi
 	CellularCfg []*CellularConfig `protobuf:"bytes,5,rep,name=cellularCfg,proto3" json:"cellularCfg,omitempty"`                            // Cellular config
 	WifiCfg     []*WifiConfig     `protobuf:"bytes,10,rep,name=wifiCfg,proto3" json:"wifiCfg,omitempty"`                                   // Wifi, can be multiple APs on a single wlan, e.g. one for 2.5Ghz, other 5Ghz SSIDs
+	// If defined, the WiFi adapter is operated in the access point mode instead
+	// of connecting to WiFi networks listed in wifiCfg (which is then ignored).
+	// The adapter is expected to be used as the access point port of a local
+	// or a switch network instance (see NetworkInstanceConfig.wifi_access_point).
+	AccessPoint *WifiAccessPointConfig `protobuf:"bytes,15,opt,name=access_point,json=accessPoint,proto3" json:"access_point,omitempty"`
 }
 
 func (x *WirelessConfig) Reset() {
 	*x = WirelessConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[2]
+		mi := &file_config_netconfig_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +815,7 @@ func (x *WirelessConfig) String() string {
 func (*WirelessConfig) ProtoMessage() {}
 
 func (x *WirelessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[2]
+	mi := &file_config_netconfig_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +828,7 @@ func (x *WirelessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WirelessConfig.ProtoReflect.Descriptor instead.
 func (*WirelessConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{2}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WirelessConfig) GetType() WirelessType {
@@ -368,6 +852,13 @@ func (x *WirelessConfig) GetWifiCfg() []*WifiConfig {
 	return nil
 }
 
+func (x *WirelessConfig) GetAccessPoint() *WifiAccessPointConfig {
+	if x != nil {
+		return x.AccessPoint
+	}
+	return nil
+}
+
 type CellularConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -395,12 +886,21 @@ type CellularConfig struct {
 	// 2 - activate the second SIM slot
 	// etc.
 	ActivatedSimSlot uint32 `protobuf:"varint,5,opt,name=activated_sim_slot,json=activatedSimSlot,proto3" json:"activated_sim_slot,omitempty"`
+	// Enable automatic failover between access points (and SIM slots).
+	// Access points are tried in the order as listed in access_points, starting
+	// with the first one (activated_sim_slot is then ignored).
+	// If the connection cannot be established or the connectivity probe
+	// (see CellularConnectivityProbe) keeps failing even after reconnecting,
+	// EVE moves to the next access point, switching to its SIM slot if needed.
+	// After the last access point, EVE continues with the first one.
+	// Requires connectivity probing to be enabled.
+	SimFailover bool `protobuf:"varint,6,opt,name=sim_failover,json=simFailover,proto3" json:"sim_failover,omitempty"`
 }
 
 func (x *CellularConfig) Reset() {
 	*x = CellularConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[3]
+		mi := &file_config_netconfig_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +913,7 @@ func (x *CellularConfig) String() string {
 func (*CellularConfig) ProtoMessage() {}
 
 func (x *CellularConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[3]
+	mi := &file_config_netconfig_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +926,7 @@ func (x *CellularConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularConfig.ProtoReflect.Descriptor instead.
 func (*CellularConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{3}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CellularConfig) GetAPN() string {
@@ -464,6 +964,13 @@ func (x *CellularConfig) GetActivatedSimSlot() uint32 {
 	return 0
 }
 
+func (x *CellularConfig) GetSimFailover() bool {
+	if x != nil {
+		return x.SimFailover
+	}
+	return false
+}
+
 // CellularConnectivityProbe is used to periodically check the connectivity status of a cellular network
 // by probing a remote endpoint.
 // Whenever the probe fails, the cellular connection is automatically restarted. If the probe keeps failing
@@ -493,7 +1000,7 @@ type CellularConnectivityProbe struct {
 func (x *CellularConnectivityProbe) Reset() {
 	*x = CellularConnectivityProbe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[4]
+		mi := &file_config_netconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +1013,7 @@ func (x *CellularConnectivityProbe) String() string {
 func (*CellularConnectivityProbe) ProtoMessage() {}
 
 func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[4]
+	mi := &file_config_netconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +1026,7 @@ func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularConnectivityProbe.ProtoReflect.Descriptor instead.
 func (*CellularConnectivityProbe) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CellularConnectivityProbe) GetDisable() bool {
@@ -554,7 +1061,8 @@ type CellularAccessPoint struct {
 	// Authentication protocol used by the network.
 	AuthProtocol CellularAuthProtocol `protobuf:"varint,3,opt,name=auth_protocol,json=authProtocol,proto3,enum=org.lfedge.eve.config.CellularAuthProtocol" json:"auth_protocol,omitempty"`
 	// Cipher data may contain encrypted user credentials
-	// (inside cellNetUsername and cellNetPassword fields).
+	// (inside cellNetUsername and cellNetPassword fields)
+	// and the SIM card PIN (cellular_sim_pin).
 	CipherData *CipherBlock `protobuf:"bytes,4,opt,name=cipher_data,json=cipherData,proto3" json:"cipher_data,omitempty"`
 	// The set of cellular network operators that modem should preferably try to register
 	// and connect into.
@@ -571,12 +1079,14 @@ type CellularAccessPoint struct {
 	// Not listed technologies will not be tried.
 	// If empty, then modem will select RAT automatically.
 	PreferredRats []evecommon.RadioAccessTechnology `protobuf:"varint,7,rep,packed,name=preferred_rats,json=preferredRats,proto3,enum=org.lfedge.eve.common.RadioAccessTechnology" json:"preferred_rats,omitempty"`
+	// IP version(s) to request for the data bearer (PDP context).
+	IpType CellularIPType `protobuf:"varint,8,opt,name=ip_type,json=ipType,proto3,enum=org.lfedge.eve.config.CellularIPType" json:"ip_type,omitempty"`
 }
 
 func (x *CellularAccessPoint) Reset() {
 	*x = CellularAccessPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[5]
+		mi := &file_config_netconfig_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1099,7 @@ func (x *CellularAccessPoint) String() string {
 func (*CellularAccessPoint) ProtoMessage() {}
 
 func (x *CellularAccessPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[5]
+	mi := &file_config_netconfig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1112,7 @@ func (x *CellularAccessPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularAccessPoint.ProtoReflect.Descriptor instead.
 func (*CellularAccessPoint) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CellularAccessPoint) GetSimSlot() uint32 {
@@ -654,6 +1164,13 @@ func (x *CellularAccessPoint) GetPreferredRats() []evecommon.RadioAccessTechnolo
 	return nil
 }
 
+func (x *CellularAccessPoint) GetIpType() CellularIPType {
+	if x != nil {
+		return x.IpType
+	}
+	return CellularIPType_CELLULAR_IP_TYPE_UNSPECIFIED
+}
+
 type WifiConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -673,7 +1190,7 @@ type WifiConfig struct {
 func (x *WifiConfig) Reset() {
 	*x = WifiConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[6]
+		mi := &file_config_netconfig_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -686,7 +1203,7 @@ func (x *WifiConfig) String() string {
 func (*WifiConfig) ProtoMessage() {}
 
 func (x *WifiConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[6]
+	mi := &file_config_netconfig_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +1216,7 @@ func (x *WifiConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfig.ProtoReflect.Descriptor instead.
 func (*WifiConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{6}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WifiConfig) GetWifiSSID() string {
@@ -751,6 +1268,120 @@ func (x *WifiConfig) GetCipherData() *CipherBlock {
 	return nil
 }
 
+// Configuration for a WiFi adapter operating in the access point mode.
+type WifiAccessPointConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network name announced by the access point.
+	Ssid string `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	// Do not broadcast SSID in beacon frames.
+	HiddenSsid bool                    `protobuf:"varint,2,opt,name=hidden_ssid,json=hiddenSsid,proto3" json:"hidden_ssid,omitempty"`
+	Security   WifiAccessPointSecurity `protobuf:"varint,3,opt,name=security,proto3,enum=org.lfedge.eve.config.WifiAccessPointSecurity" json:"security,omitempty"`
+	// Encrypted pre-shared key (WPA2) or password (WPA3) with access point
+	// credentials. Decrypted EncryptionBlock.wifiPassword is used.
+	CipherData *CipherBlock `protobuf:"bytes,4,opt,name=cipher_data,json=cipherData,proto3" json:"cipher_data,omitempty"`
+	Band       WifiBand     `protobuf:"varint,5,opt,name=band,proto3,enum=org.lfedge.eve.config.WifiBand" json:"band,omitempty"`
+	// Channel number. Zero means default channel for the band
+	// (6 for 2.4GHz, 36 for 5GHz).
+	Channel uint32 `protobuf:"varint,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	// ISO/IEC 3166-1 alpha2 country code, e.g. "US" or "DE".
+	// Determines regulatory limitations for the selected channel.
+	CountryCode string `protobuf:"bytes,7,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	// Maximum number of associated clients. Zero means no limit (beyond
+	// the limit of the hardware/driver).
+	MaxClients uint32 `protobuf:"varint,8,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+}
+
+func (x *WifiAccessPointConfig) Reset() {
+	*x = WifiAccessPointConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WifiAccessPointConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WifiAccessPointConfig) ProtoMessage() {}
+
+func (x *WifiAccessPointConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WifiAccessPointConfig.ProtoReflect.Descriptor instead.
+func (*WifiAccessPointConfig) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WifiAccessPointConfig) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *WifiAccessPointConfig) GetHiddenSsid() bool {
+	if x != nil {
+		return x.HiddenSsid
+	}
+	return false
+}
+
+func (x *WifiAccessPointConfig) GetSecurity() WifiAccessPointSecurity {
+	if x != nil {
+		return x.Security
+	}
+	return WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_UNSPECIFIED
+}
+
+func (x *WifiAccessPointConfig) GetCipherData() *CipherBlock {
+	if x != nil {
+		return x.CipherData
+	}
+	return nil
+}
+
+func (x *WifiAccessPointConfig) GetBand() WifiBand {
+	if x != nil {
+		return x.Band
+	}
+	return WifiBand_WIFI_BAND_UNSPECIFIED
+}
+
+func (x *WifiAccessPointConfig) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *WifiAccessPointConfig) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *WifiAccessPointConfig) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
 type WifiConfigCryptoblock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -763,7 +1394,7 @@ type WifiConfigCryptoblock struct {
 func (x *WifiConfigCryptoblock) Reset() {
 	*x = WifiConfigCryptoblock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[7]
+		mi := &file_config_netconfig_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +1407,7 @@ func (x *WifiConfigCryptoblock) String() string {
 func (*WifiConfigCryptoblock) ProtoMessage() {}
 
 func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[7]
+	mi := &file_config_netconfig_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1420,7 @@ func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfigCryptoblock.ProtoReflect.Descriptor instead.
 func (*WifiConfigCryptoblock) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{6, 0}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *WifiConfigCryptoblock) GetIdentity() string {
@@ -817,7 +1448,7 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x67, 0x2f, 0x66, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x2f, 0x6e, 0x65, 0x74, 0x63, 0x6d, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x65, 0x76, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a, 0x0d, 0x4e,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x03, 0x0a, 0x0d, 0x4e,
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04,
 	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67,
@@ -838,130 +1469,239 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
 	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x6c,
 	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x77, 0x69, 0x72, 0x65, 0x6c,
-	0x65, 0x73, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41,
-	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x45, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x45, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x70, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
-	0x69, 0x73, 0x70, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x65, 0x6d, 0x63, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
-	0x65, 0x6d, 0x63, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x65, 0x6d, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70,
-	0x65, 0x6d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x04,
-	0x61, 0x63, 0x6c, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x72, 0x67,
-	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x41, 0x43, 0x45, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0e,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x29,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x56, 0x6c, 0x61, 0x6e,
-	0x49, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x72, 0x65,
-	0x6c, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x47,
-	0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x66, 0x67, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
-	0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c,
-	0x75, 0x6c, 0x61, 0x72, 0x43, 0x66, 0x67, 0x12, 0x3b, 0x0a, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43,
-	0x66, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x75, 0x74, 0x68, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x72, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x65, 0x61, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
 	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x77, 0x69, 0x66,
-	0x69, 0x43, 0x66, 0x67, 0x22, 0x96, 0x02, 0x0a, 0x0e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x50, 0x4e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x50, 0x4e, 0x12, 0x46, 0x0a, 0x05, 0x70, 0x72, 0x6f,
-	0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4f,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65,
-	0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6d,
-	0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x5a, 0x0a,
-	0x19, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f,
-	0x62, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xfe, 0x02, 0x0a, 0x13, 0x43, 0x65,
-	0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x70, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x12, 0x50,
-	0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65,
-	0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x12, 0x43, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69,
-	0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x64, 0x5f, 0x70, 0x6c, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x6d, 0x6e, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x5f, 0x72, 0x6f, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x52, 0x6f,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0d, 0x70, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x52, 0x61, 0x74, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x0a, 0x57,
-	0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x66,
-	0x69, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x66,
-	0x69, 0x53, 0x53, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x57, 0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x09,
-	0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x2e, 0x45, 0x41, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x09, 0x65, 0x61, 0x70, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x50, 0x65,
+	0x6d, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
 	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69,
-	0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa1, 0x03, 0x0a, 0x0e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x45, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x45, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x69, 0x73, 0x70, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x70, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6d, 0x63, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6d, 0x63, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x65, 0x6d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x70, 0x65, 0x6d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x6b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x43, 0x45, 0x52, 0x04, 0x61, 0x63, 0x6c,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x6c, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x56, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x03, 0x71, 0x6f, 0x73, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x51, 0x6f, 0x53, 0x52, 0x03, 0x71, 0x6f, 0x73, 0x22, 0xbd, 0x01, 0x0a,
+	0x0a, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x51, 0x6f, 0x53, 0x12, 0x3d, 0x0a, 0x06, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x61, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x61, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x72, 0x6b, 0x5f, 0x64, 0x73, 0x63, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6d, 0x61, 0x72, 0x6b, 0x44, 0x73, 0x63, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x63, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x22, 0x4e, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x61, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x62, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa0, 0x02, 0x0a,
+	0x0e, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x43, 0x66, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x66,
+	0x67, 0x12, 0x3b, 0x0a, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x12, 0x4f,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66,
+	0x69, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0xb9, 0x02, 0x0a, 0x0e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x50, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x41, 0x50, 0x4e, 0x12, 0x46, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61,
+	0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x69, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x19, 0x43,
+	0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x13, 0x43, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x69, 0x6d, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x73, 0x69, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x6e, 0x12, 0x50, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x43,
+	0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x70, 0x6c, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x6c, 0x6d, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x5f, 0x72, 0x6f, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x52, 0x6f, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x52, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x49, 0x50, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x92, 0x03, 0x0a, 0x0a, 0x57, 0x69, 0x66,
+	0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53,
+	0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53,
+	0x53, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57,
+	0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x09, 0x6b, 0x65,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a,
-	0xa1, 0x01, 0x0a, 0x14, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x45, 0x4c, 0x4c,
-	0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
-	0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x45, 0x4c,
-	0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x50, 0x41, 0x50, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x45, 0x4c,
-	0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x50, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x45,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x45, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xf0, 0x02,
+	0x0a, 0x15, 0x57, 0x69, 0x66, 0x69, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x73, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x73, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x73, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x73, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x08,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a,
+	0x04, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x42, 0x61, 0x6e, 0x64, 0x52, 0x04, 0x62, 0x61,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2a, 0x50, 0x0a, 0x09, 0x45, 0x41, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a,
+	0x16, 0x45, 0x41, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x41, 0x50,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x41, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x45, 0x41, 0x50,
+	0x10, 0x02, 0x2a, 0x8c, 0x01, 0x0a, 0x0e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x49,
+	0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41,
+	0x52, 0x5f, 0x49, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x45, 0x4c, 0x4c, 0x55,
+	0x4c, 0x41, 0x52, 0x5f, 0x49, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x34,
+	0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x49,
+	0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x12, 0x22, 0x0a,
+	0x1e, 0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x49, 0x50, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x49, 0x50, 0x56, 0x34, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49, 0x50, 0x56, 0x36, 0x10,
+	0x03, 0x2a, 0xa1, 0x01, 0x0a, 0x14, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x45,
 	0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x50, 0x41, 0x50, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x48, 0x41,
-	0x50, 0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65,
-	0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x50, 0x41, 0x50, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x43,
+	0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x48, 0x41, 0x50, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23,
+	0x43, 0x45, 0x4c, 0x4c, 0x55, 0x4c, 0x41, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x50, 0x41, 0x50, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43,
+	0x48, 0x41, 0x50, 0x10, 0x03, 0x2a, 0xc1, 0x01, 0x0a, 0x17, 0x57, 0x69, 0x66, 0x69, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a,
+	0x23, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x4f, 0x49,
+	0x4e, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x50, 0x41, 0x32,
+	0x5f, 0x50, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x55,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x50, 0x41, 0x33, 0x5f, 0x53, 0x41, 0x45, 0x10, 0x02, 0x12,
+	0x28, 0x0a, 0x24, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x50,
+	0x41, 0x32, 0x5f, 0x57, 0x50, 0x41, 0x33, 0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x08, 0x57, 0x69, 0x66,
+	0x69, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x42, 0x41,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x5f, 0x32, 0x5f,
+	0x34, 0x47, 0x48, 0x5a, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x49, 0x46, 0x49, 0x5f, 0x42,
+	0x41, 0x4e, 0x44, 0x5f, 0x35, 0x47, 0x48, 0x5a, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -976,51 +1716,70 @@ func file_config_netconfig_proto_rawDescGZIP() []byte {
 	return file_config_netconfig_proto_rawDescData
 }
 
-var file_config_netconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_config_netconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_config_netconfig_proto_goTypes = []interface{}{
-	(CellularAuthProtocol)(0),            // 0: org.lfedge.eve.config.CellularAuthProtocol
-	(*NetworkConfig)(nil),                // 1: org.lfedge.eve.config.NetworkConfig
-	(*NetworkAdapter)(nil),               // 2: org.lfedge.eve.config.NetworkAdapter
-	(*WirelessConfig)(nil),               // 3: org.lfedge.eve.config.WirelessConfig
-	(*CellularConfig)(nil),               // 4: org.lfedge.eve.config.CellularConfig
-	(*CellularConnectivityProbe)(nil),    // 5: org.lfedge.eve.config.CellularConnectivityProbe
-	(*CellularAccessPoint)(nil),          // 6: org.lfedge.eve.config.CellularAccessPoint
-	(*WifiConfig)(nil),                   // 7: org.lfedge.eve.config.WifiConfig
-	(*WifiConfigCryptoblock)(nil),        // 8: org.lfedge.eve.config.WifiConfig.cryptoblock
-	(NetworkType)(0),                     // 9: org.lfedge.eve.config.NetworkType
-	(*Ipspec)(nil),                       // 10: org.lfedge.eve.config.ipspec
-	(*ZnetStaticDNSEntry)(nil),           // 11: org.lfedge.eve.config.ZnetStaticDNSEntry
-	(*ProxyConfig)(nil),                  // 12: org.lfedge.eve.config.ProxyConfig
-	(*ACE)(nil),                          // 13: org.lfedge.eve.config.ACE
-	(WirelessType)(0),                    // 14: org.lfedge.eve.config.WirelessType
-	(*CipherBlock)(nil),                  // 15: org.lfedge.eve.config.CipherBlock
-	(evecommon.RadioAccessTechnology)(0), // 16: org.lfedge.eve.common.RadioAccessTechnology
-	(WiFiKeyScheme)(0),                   // 17: org.lfedge.eve.config.WiFiKeyScheme
+	(EAPMethod)(0),                       // 0: org.lfedge.eve.config.EAPMethod
+	(CellularIPType)(0),                  // 1: org.lfedge.eve.config.CellularIPType
+	(CellularAuthProtocol)(0),            // 2: org.lfedge.eve.config.CellularAuthProtocol
+	(WifiAccessPointSecurity)(0),         // 3: org.lfedge.eve.config.WifiAccessPointSecurity
+	(WifiBand)(0),                        // 4: org.lfedge.eve.config.WifiBand
+	(*NetworkConfig)(nil),                // 5: org.lfedge.eve.config.NetworkConfig
+	(*PortAuthConfig)(nil),               // 6: org.lfedge.eve.config.PortAuthConfig
+	(*NetworkAdapter)(nil),               // 7: org.lfedge.eve.config.NetworkAdapter
+	(*AdapterQoS)(nil),                   // 8: org.lfedge.eve.config.AdapterQoS
+	(*TrafficShaping)(nil),               // 9: org.lfedge.eve.config.TrafficShaping
+	(*WirelessConfig)(nil),               // 10: org.lfedge.eve.config.WirelessConfig
+	(*CellularConfig)(nil),               // 11: org.lfedge.eve.config.CellularConfig
+	(*CellularConnectivityProbe)(nil),    // 12: org.lfedge.eve.config.CellularConnectivityProbe
+	(*CellularAccessPoint)(nil),          // 13: org.lfedge.eve.config.CellularAccessPoint
+	(*WifiConfig)(nil),                   // 14: org.lfedge.eve.config.WifiConfig
+	(*WifiAccessPointConfig)(nil),        // 15: org.lfedge.eve.config.WifiAccessPointConfig
+	(*WifiConfigCryptoblock)(nil),        // 16: org.lfedge.eve.config.WifiConfig.cryptoblock
+	(NetworkType)(0),                     // 17: org.lfedge.eve.config.NetworkType
+	(*Ipspec)(nil),                       // 18: org.lfedge.eve.config.ipspec
+	(*ZnetStaticDNSEntry)(nil),           // 19: org.lfedge.eve.config.ZnetStaticDNSEntry
+	(*ProxyConfig)(nil),                  // 20: org.lfedge.eve.config.ProxyConfig
+	(*CipherBlock)(nil),                  // 21: org.lfedge.eve.config.CipherBlock
+	(*ACE)(nil),                          // 22: org.lfedge.eve.config.ACE
+	(WirelessType)(0),                    // 23: org.lfedge.eve.config.WirelessType
+	(evecommon.RadioAccessTechnology)(0), // 24: org.lfedge.eve.common.RadioAccessTechnology
+	(WiFiKeyScheme)(0),                   // 25: org.lfedge.eve.config.WiFiKeyScheme
 }
 var file_config_netconfig_proto_depIdxs = []int32{
-	9,  // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
-	10, // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
-	11, // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
-	12, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
-	3,  // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
-	13, // 5: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
-	14, // 6: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
-	4,  // 7: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
-	7,  // 8: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
-	5,  // 9: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
-	6,  // 10: org.lfedge.eve.config.CellularConfig.access_points:type_name -> org.lfedge.eve.config.CellularAccessPoint
-	0,  // 11: org.lfedge.eve.config.CellularAccessPoint.auth_protocol:type_name -> org.lfedge.eve.config.CellularAuthProtocol
-	15, // 12: org.lfedge.eve.config.CellularAccessPoint.cipher_data:type_name -> org.lfedge.eve.config.CipherBlock
-	16, // 13: org.lfedge.eve.config.CellularAccessPoint.preferred_rats:type_name -> org.lfedge.eve.common.RadioAccessTechnology
-	17, // 14: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
-	8,  // 15: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
-	15, // 16: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	17, // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
+	18, // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
+	19, // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
+	20, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
+	10, // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
+	6,  // 5: org.lfedge.eve.config.NetworkConfig.port_auth:type_name -> org.lfedge.eve.config.PortAuthConfig
+	0,  // 6: org.lfedge.eve.config.PortAuthConfig.eap_method:type_name -> org.lfedge.eve.config.EAPMethod
+	21, // 7: org.lfedge.eve.config.PortAuthConfig.cipher_data:type_name -> org.lfedge.eve.config.CipherBlock
+	22, // 8: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
+	8,  // 9: org.lfedge.eve.config.NetworkAdapter.qos:type_name -> org.lfedge.eve.config.AdapterQoS
+	9,  // 10: org.lfedge.eve.config.AdapterQoS.egress:type_name -> org.lfedge.eve.config.TrafficShaping
+	9,  // 11: org.lfedge.eve.config.AdapterQoS.ingress:type_name -> org.lfedge.eve.config.TrafficShaping
+	23, // 12: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
+	11, // 13: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
+	14, // 14: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
+	15, // 15: org.lfedge.eve.config.WirelessConfig.access_point:type_name -> org.lfedge.eve.config.WifiAccessPointConfig
+	12, // 16: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
+	13, // 17: org.lfedge.eve.config.CellularConfig.access_points:type_name -> org.lfedge.eve.config.CellularAccessPoint
+	2,  // 18: org.lfedge.eve.config.CellularAccessPoint.auth_protocol:type_name -> org.lfedge.eve.config.CellularAuthProtocol
+	21, // 19: org.lfedge.eve.config.CellularAccessPoint.cipher_data:type_name -> org.lfedge.eve.config.CipherBlock
+	24, // 20: org.lfedge.eve.config.CellularAccessPoint.preferred_rats:type_name -> org.lfedge.eve.common.RadioAccessTechnology
+	1,  // 21: org.lfedge.eve.config.CellularAccessPoint.ip_type:type_name -> org.lfedge.eve.config.CellularIPType
+	25, // 22: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
+	16, // 23: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
+	21, // 24: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	3,  // 25: org.lfedge.eve.config.WifiAccessPointConfig.security:type_name -> org.lfedge.eve.config.WifiAccessPointSecurity
+	21, // 26: org.lfedge.eve.config.WifiAccessPointConfig.cipher_data:type_name -> org.lfedge.eve.config.CipherBlock
+	4,  // 27: org.lfedge.eve.config.WifiAccessPointConfig.band:type_name -> org.lfedge.eve.config.WifiBand
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_config_netconfig_proto_init() }
@@ -1045,7 +1804,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkAdapter); i {
+			switch v := v.(*PortAuthConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1057,7 +1816,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WirelessConfig); i {
+			switch v := v.(*NetworkAdapter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1069,7 +1828,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularConfig); i {
+			switch v := v.(*AdapterQoS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1081,7 +1840,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularConnectivityProbe); i {
+			switch v := v.(*TrafficShaping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1093,7 +1852,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularAccessPoint); i {
+			switch v := v.(*WirelessConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1105,7 +1864,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WifiConfig); i {
+			switch v := v.(*CellularConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1117,6 +1876,54 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellularConnectivityProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellularAccessPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WifiConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WifiAccessPointConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WifiConfigCryptoblock); i {
 			case 0:
 				return &v.state
@@ -1134,8 +1941,8 @@ func file_config_netconfig_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netconfig_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
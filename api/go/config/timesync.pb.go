@@ -0,0 +1,367 @@
+// Copyright(c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.21.9
+// source: config/timesync.proto
+
+package config
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NTP server to synchronize the device clock with.
+type NTPServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hostname or IP address of the server.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Authenticate the server using Network Time Security (NTS, RFC 8915).
+	// Hostname (not IP address) should be used so that the certificate
+	// presented by the NTS-KE server can be verified.
+	Nts bool `protobuf:"varint,2,opt,name=nts,proto3" json:"nts,omitempty"`
+	// TCP port of the NTS Key Establishment server.
+	// Zero value means the default port 4460.
+	NtsKePort uint32 `protobuf:"varint,3,opt,name=nts_ke_port,json=ntsKePort,proto3" json:"nts_ke_port,omitempty"`
+	// Prefer this server over the others.
+	Prefer bool `protobuf:"varint,4,opt,name=prefer,proto3" json:"prefer,omitempty"`
+}
+
+func (x *NTPServer) Reset() {
+	*x = NTPServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_timesync_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NTPServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NTPServer) ProtoMessage() {}
+
+func (x *NTPServer) ProtoReflect() protoreflect.Message {
+	mi := &file_config_timesync_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NTPServer.ProtoReflect.Descriptor instead.
+func (*NTPServer) Descriptor() ([]byte, []int) {
+	return file_config_timesync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NTPServer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NTPServer) GetNts() bool {
+	if x != nil {
+		return x.Nts
+	}
+	return false
+}
+
+func (x *NTPServer) GetNtsKePort() uint32 {
+	if x != nil {
+		return x.NtsKePort
+	}
+	return 0
+}
+
+func (x *NTPServer) GetPrefer() bool {
+	if x != nil {
+		return x.Prefer
+	}
+	return false
+}
+
+// Precision Time Protocol (IEEE 1588) configuration.
+// PTP is only used on ports with a NIC that has a PTP hardware clock (PHC).
+type PTPConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Logical labels of the device ports to run PTP on.
+	// If empty, PTP is run on all management ports with a PHC.
+	Ports []string `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	// PTP domain number (0-127).
+	Domain uint32 `protobuf:"varint,3,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *PTPConfig) Reset() {
+	*x = PTPConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_timesync_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PTPConfig) ProtoMessage() {}
+
+func (x *PTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_timesync_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PTPConfig.ProtoReflect.Descriptor instead.
+func (*PTPConfig) Descriptor() ([]byte, []int) {
+	return file_config_timesync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PTPConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PTPConfig) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *PTPConfig) GetDomain() uint32 {
+	if x != nil {
+		return x.Domain
+	}
+	return 0
+}
+
+// TimeSyncConfig : configuration of the device clock synchronization.
+// NTP servers received from DHCP or configured for network ports
+// (NetworkConfig.ntpServer) are used in addition to the servers listed here,
+// unless use_only_configured_servers is set.
+type TimeSyncConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NtpServers               []*NTPServer `protobuf:"bytes,1,rep,name=ntp_servers,json=ntpServers,proto3" json:"ntp_servers,omitempty"`
+	UseOnlyConfiguredServers bool         `protobuf:"varint,2,opt,name=use_only_configured_servers,json=useOnlyConfiguredServers,proto3" json:"use_only_configured_servers,omitempty"`
+	Ptp                      *PTPConfig   `protobuf:"bytes,3,opt,name=ptp,proto3" json:"ptp,omitempty"`
+}
+
+func (x *TimeSyncConfig) Reset() {
+	*x = TimeSyncConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_timesync_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSyncConfig) ProtoMessage() {}
+
+func (x *TimeSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_timesync_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSyncConfig.ProtoReflect.Descriptor instead.
+func (*TimeSyncConfig) Descriptor() ([]byte, []int) {
+	return file_config_timesync_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TimeSyncConfig) GetNtpServers() []*NTPServer {
+	if x != nil {
+		return x.NtpServers
+	}
+	return nil
+}
+
+func (x *TimeSyncConfig) GetUseOnlyConfiguredServers() bool {
+	if x != nil {
+		return x.UseOnlyConfiguredServers
+	}
+	return false
+}
+
+func (x *TimeSyncConfig) GetPtp() *PTPConfig {
+	if x != nil {
+		return x.Ptp
+	}
+	return nil
+}
+
+var File_config_timesync_proto protoreflect.FileDescriptor
+
+var file_config_timesync_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x79, 0x6e,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6f,
+	0x0a, 0x09, 0x4e, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x74, 0x73, 0x5f, 0x6b,
+	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x74,
+	0x73, 0x4b, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x22,
+	0x53, 0x0a, 0x09, 0x50, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x74, 0x70, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0a,
+	0x6e, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x75, 0x73,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x75, 0x73, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x03, 0x70, 0x74, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50,
+	0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x70, 0x74, 0x70, 0x42, 0x3d, 0x0a,
+	0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_timesync_proto_rawDescOnce sync.Once
+	file_config_timesync_proto_rawDescData = file_config_timesync_proto_rawDesc
+)
+
+func file_config_timesync_proto_rawDescGZIP() []byte {
+	file_config_timesync_proto_rawDescOnce.Do(func() {
+		file_config_timesync_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_timesync_proto_rawDescData)
+	})
+	return file_config_timesync_proto_rawDescData
+}
+
+var file_config_timesync_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_timesync_proto_goTypes = []interface{}{
+	(*NTPServer)(nil),      // 0: org.lfedge.eve.config.NTPServer
+	(*PTPConfig)(nil),      // 1: org.lfedge.eve.config.PTPConfig
+	(*TimeSyncConfig)(nil), // 2: org.lfedge.eve.config.TimeSyncConfig
+}
+var file_config_timesync_proto_depIdxs = []int32{
+	0, // 0: org.lfedge.eve.config.TimeSyncConfig.ntp_servers:type_name -> org.lfedge.eve.config.NTPServer
+	1, // 1: org.lfedge.eve.config.TimeSyncConfig.ptp:type_name -> org.lfedge.eve.config.PTPConfig
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_config_timesync_proto_init() }
+func file_config_timesync_proto_init() {
+	if File_config_timesync_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_timesync_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NTPServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_timesync_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PTPConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_timesync_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSyncConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_timesync_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_timesync_proto_goTypes,
+		DependencyIndexes: file_config_timesync_proto_depIdxs,
+		MessageInfos:      file_config_timesync_proto_msgTypes,
+	}.Build()
+	File_config_timesync_proto = out.File
+	file_config_timesync_proto_rawDesc = nil
+	file_config_timesync_proto_goTypes = nil
+	file_config_timesync_proto_depIdxs = nil
+}
@@ -85,6 +85,60 @@ func (VmMode) EnumDescriptor() ([]byte, []int) {
 	return file_config_vm_proto_rawDescGZIP(), []int{0}
 }
 
+// Virtual TPM 2.0 device attached to a VM. The TPM is emulated by swtpm
+// running on the device, its state survives reboots of the device and
+// updates of the app instance, and is destroyed when the app instance is
+// purged or deleted. Only supported with KVM on devices with a TPM, which
+// protects the state.
+type VmTpmModel int32
+
+const (
+	VmTpmModel_VM_TPM_MODEL_NONE VmTpmModel = 0 // no virtual TPM
+	VmTpmModel_VM_TPM_MODEL_CRB  VmTpmModel = 1 // Command Response Buffer interface (tpm-crb)
+	VmTpmModel_VM_TPM_MODEL_TIS  VmTpmModel = 2 // TPM Interface Specification (tpm-tis)
+)
+
+// Enum value maps for VmTpmModel.
+var (
+	VmTpmModel_name = map[int32]string{
+		0: "VM_TPM_MODEL_NONE",
+		1: "VM_TPM_MODEL_CRB",
+		2: "VM_TPM_MODEL_TIS",
+	}
+	VmTpmModel_value = map[string]int32{
+		"VM_TPM_MODEL_NONE": 0,
+		"VM_TPM_MODEL_CRB":  1,
+		"VM_TPM_MODEL_TIS":  2,
+	}
+)
+
+func (x VmTpmModel) Enum() *VmTpmModel {
+	p := new(VmTpmModel)
+	*p = x
+	return p
+}
+
+func (x VmTpmModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VmTpmModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_vm_proto_enumTypes[1].Descriptor()
+}
+
+func (VmTpmModel) Type() protoreflect.EnumType {
+	return &file_config_vm_proto_enumTypes[1]
+}
+
+func (x VmTpmModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VmTpmModel.Descriptor instead.
+func (VmTpmModel) EnumDescriptor() ([]byte, []int) {
+	return file_config_vm_proto_rawDescGZIP(), []int{1}
+}
+
 type VmConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -136,6 +190,11 @@ type VmConfig struct {
 	// for one VM instance (guest RAM + VM monitor) is set according to the
 	// following equation: `memory` + `vmm_maxmem`
 	VmmMaxmem uint32 `protobuf:"varint,21,opt,name=vmm_maxmem,json=vmmMaxmem,proto3" json:"vmm_maxmem,omitempty"`
+	// Limits of CPU, disk I/O and number of processes. Changing the limits
+	// requires a restart of the app instance.
+	Limits *VmResourceLimits `protobuf:"bytes,22,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Virtual TPM for the VM. Changing it requires a restart of the app instance.
+	Vtpm VmTpmModel `protobuf:"varint,23,opt,name=vtpm,proto3,enum=org.lfedge.eve.config.VmTpmModel" json:"vtpm,omitempty"`
 }
 
 func (x *VmConfig) Reset() {
@@ -317,12 +376,134 @@ func (x *VmConfig) GetVmmMaxmem() uint32 {
 	return 0
 }
 
+func (x *VmConfig) GetLimits() *VmResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *VmConfig) GetVtpm() VmTpmModel {
+	if x != nil {
+		return x.Vtpm
+	}
+	return VmTpmModel_VM_TPM_MODEL_NONE
+}
+
+// Cgroup limits of an app instance. For container app instances (NOHYPER)
+// they apply to all processes of the app instance, for VMs they apply to the
+// hypervisor processes running the VM: QEMU for KVM, the device model
+// (including disk and network backends) for Xen. With Xen the CPU time
+// of the guest VCPUs is not limited by cpu_max and cpu_weight.
+// Zero values mean no limit or the default.
+type VmResourceLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum CPU bandwidth in thousandths of a CPU, e.g. 1500 for one and a
+	// half CPU (cgroup cpu.max). Overrides the default limit of `vcpus` CPUs.
+	CpuMaxMillicpus uint32 `protobuf:"varint,1,opt,name=cpu_max_millicpus,json=cpuMaxMillicpus,proto3" json:"cpu_max_millicpus,omitempty"`
+	// Relative CPU weight in the range 1-10000, the default is 100
+	// (cgroup cpu.weight).
+	CpuWeight uint32 `protobuf:"varint,2,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight,omitempty"`
+	// Maximum number of processes and threads (cgroup pids.max)
+	PidsMax uint32 `protobuf:"varint,3,opt,name=pids_max,json=pidsMax,proto3" json:"pids_max,omitempty"`
+	// Disk I/O limits applied separately to every block device holding
+	// volumes of the app instance (cgroup io.max).
+	IoReadBps   uint64 `protobuf:"varint,4,opt,name=io_read_bps,json=ioReadBps,proto3" json:"io_read_bps,omitempty"`
+	IoWriteBps  uint64 `protobuf:"varint,5,opt,name=io_write_bps,json=ioWriteBps,proto3" json:"io_write_bps,omitempty"`
+	IoReadIops  uint32 `protobuf:"varint,6,opt,name=io_read_iops,json=ioReadIops,proto3" json:"io_read_iops,omitempty"`
+	IoWriteIops uint32 `protobuf:"varint,7,opt,name=io_write_iops,json=ioWriteIops,proto3" json:"io_write_iops,omitempty"`
+}
+
+func (x *VmResourceLimits) Reset() {
+	*x = VmResourceLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_vm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VmResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmResourceLimits) ProtoMessage() {}
+
+func (x *VmResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_config_vm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmResourceLimits.ProtoReflect.Descriptor instead.
+func (*VmResourceLimits) Descriptor() ([]byte, []int) {
+	return file_config_vm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VmResourceLimits) GetCpuMaxMillicpus() uint32 {
+	if x != nil {
+		return x.CpuMaxMillicpus
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetCpuWeight() uint32 {
+	if x != nil {
+		return x.CpuWeight
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetPidsMax() uint32 {
+	if x != nil {
+		return x.PidsMax
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetIoReadBps() uint64 {
+	if x != nil {
+		return x.IoReadBps
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetIoWriteBps() uint64 {
+	if x != nil {
+		return x.IoWriteBps
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetIoReadIops() uint32 {
+	if x != nil {
+		return x.IoReadIops
+	}
+	return 0
+}
+
+func (x *VmResourceLimits) GetIoWriteIops() uint32 {
+	if x != nil {
+		return x.IoWriteIops
+	}
+	return 0
+}
+
 var File_config_vm_proto protoreflect.FileDescriptor
 
 var file_config_vm_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xed, 0x04, 0x0a, 0x08, 0x56, 0x6d, 0x43,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xe5, 0x05, 0x0a, 0x08, 0x56, 0x6d, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a,
 	0x07, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
@@ -361,16 +542,45 @@ var file_config_vm_proto_rawDesc = []byte{
 	0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x14, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x43, 0x70, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6d, 0x6d,
 	0x5f, 0x6d, 0x61, 0x78, 0x6d, 0x65, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x76,
-	0x6d, 0x6d, 0x4d, 0x61, 0x78, 0x6d, 0x65, 0x6d, 0x2a, 0x47, 0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x50, 0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x56,
-	0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x46, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59,
-	0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10,
-	0x05, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65,
-	0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x6d, 0x4d, 0x61, 0x78, 0x6d, 0x65, 0x6d, 0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x56, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x76, 0x74, 0x70,
+	0x6d, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x56, 0x6d, 0x54, 0x70, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x04, 0x76, 0x74, 0x70, 0x6d,
+	0x22, 0x80, 0x02, 0x0a, 0x10, 0x56, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x63, 0x70, 0x75, 0x4d, 0x61, 0x78, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x70, 0x75, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x69, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x70, 0x69, 0x64, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x1e, 0x0a, 0x0b, 0x69,
+	0x6f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x69, 0x6f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x70, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69,
+	0x6f, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x69, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x20, 0x0a,
+	0x0c, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6f, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6f, 0x70, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x69, 0x6f, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x6f, 0x70, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49,
+	0x6f, 0x70, 0x73, 0x2a, 0x47, 0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a,
+	0x02, 0x50, 0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x56, 0x4d, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4d,
+	0x4c, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x10, 0x04,
+	0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x2a, 0x4f, 0x0a, 0x0a,
+	0x56, 0x6d, 0x54, 0x70, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d,
+	0x5f, 0x54, 0x50, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x4d, 0x5f, 0x54, 0x50, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x4c, 0x5f, 0x43, 0x52, 0x42, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x4d, 0x5f, 0x54, 0x50,
+	0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x54, 0x49, 0x53, 0x10, 0x02, 0x42, 0x3d, 0x0a,
+	0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -385,19 +595,23 @@ func file_config_vm_proto_rawDescGZIP() []byte {
 	return file_config_vm_proto_rawDescData
 }
 
-var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_config_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_config_vm_proto_goTypes = []interface{}{
-	(VmMode)(0),      // 0: org.lfedge.eve.config.VmMode
-	(*VmConfig)(nil), // 1: org.lfedge.eve.config.VmConfig
+	(VmMode)(0),              // 0: org.lfedge.eve.config.VmMode
+	(VmTpmModel)(0),          // 1: org.lfedge.eve.config.VmTpmModel
+	(*VmConfig)(nil),         // 2: org.lfedge.eve.config.VmConfig
+	(*VmResourceLimits)(nil), // 3: org.lfedge.eve.config.VmResourceLimits
 }
 var file_config_vm_proto_depIdxs = []int32{
 	0, // 0: org.lfedge.eve.config.VmConfig.virtualizationMode:type_name -> org.lfedge.eve.config.VmMode
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: org.lfedge.eve.config.VmConfig.limits:type_name -> org.lfedge.eve.config.VmResourceLimits
+	1, // 2: org.lfedge.eve.config.VmConfig.vtpm:type_name -> org.lfedge.eve.config.VmTpmModel
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_config_vm_proto_init() }
@@ -418,14 +632,26 @@ func file_config_vm_proto_init() {
 				return nil
 			}
 		}
+		file_config_vm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VmResourceLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_vm_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
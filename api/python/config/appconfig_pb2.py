@@ -18,7 +18,7 @@ from config import vm_pb2 as config_dot_vm__pb2
 from config import netconfig_pb2 as config_dot_netconfig__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"M\n\x0cSnapshotDesc\x12\n\n\x02id\x18\x01 \x01(\t\x12\x31\n\x04type\x18\x02 \x01(\x0e\x32#.org.lfedge.eve.config.SnapshotType\"\xb5\x01\n\x0eSnapshotConfig\x12\x17\n\x0f\x61\x63tive_snapshot\x18\x01 \x01(\t\x12;\n\x0crollback_cmd\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x15\n\rmax_snapshots\x18\x03 \x01(\r\x12\x36\n\tsnapshots\x18\x04 \x03(\x0b\x32#.org.lfedge.eve.config.SnapshotDesc\"\xc8\x06\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12\x1e\n\x16start_delay_in_seconds\x18\x13 \x01(\r\x12\x0f\n\x07service\x18\x14 \x01(\x08\x12\x1a\n\x12\x63loud_init_version\x18\x15 \x01(\r\x12\x37\n\x08snapshot\x18\x16 \x01(\x0b\x32%.org.lfedge.eve.config.SnapshotConfig\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03*K\n\x0cSnapshotType\x12\x1d\n\x19SNAPSHOT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n\x18SNAPSHOT_TYPE_APP_UPDATE\x10\x01\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"M\n\x0cSnapshotDesc\x12\n\n\x02id\x18\x01 \x01(\t\x12\x31\n\x04type\x18\x02 \x01(\x0e\x32#.org.lfedge.eve.config.SnapshotType\"\xb5\x01\n\x0eSnapshotConfig\x12\x17\n\x0f\x61\x63tive_snapshot\x18\x01 \x01(\t\x12;\n\x0crollback_cmd\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x15\n\rmax_snapshots\x18\x03 \x01(\r\x12\x36\n\tsnapshots\x18\x04 \x03(\x0b\x32#.org.lfedge.eve.config.SnapshotDesc\"\x96\x02\n\x08\x41ppProbe\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.AppProbeType\x12\x0c\n\x04port\x18\x02 \x01(\r\x12\x0c\n\x04path\x18\x03 \x01(\t\x12\r\n\x05https\x18\x04 \x01(\x08\x12\x0f\n\x07\x63ommand\x18\x05 \x03(\t\x12\x15\n\radapter_index\x18\x06 \x01(\r\x12\x1d\n\x15initial_delay_seconds\x18\x07 \x01(\r\x12\x16\n\x0eperiod_seconds\x18\x08 \x01(\r\x12\x17\n\x0ftimeout_seconds\x18\t \x01(\r\x12\x19\n\x11\x66\x61ilure_threshold\x18\n \x01(\r\x12\x19\n\x11success_threshold\x18\x0b \x01(\r\"\xee\x01\n\x0f\x41ppHealthConfig\x12\x31\n\x08liveness\x18\x01 \x01(\x0b\x32\x1f.org.lfedge.eve.config.AppProbe\x12\x32\n\treadiness\x18\x02 \x01(\x0b\x32\x1f.org.lfedge.eve.config.AppProbe\x12\x36\n\x06\x61\x63tion\x18\x03 \x01(\x0e\x32&.org.lfedge.eve.config.AppHealthAction\x12\x1f\n\x17\x62\x61\x63koff_initial_seconds\x18\x04 \x01(\r\x12\x1b\n\x13\x62\x61\x63koff_max_seconds\x18\x05 \x01(\r\"c\n\rAppDependency\x12\x10\n\x08\x61pp_uuid\x18\x01 \x01(\t\x12@\n\tcondition\x18\x02 \x01(\x0e\x32-.org.lfedge.eve.config.AppDependencyCondition\"\xc2\x02\n\x0c\x41ppContainer\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0bvolume_uuid\x18\x02 \x01(\t\x12\x35\n\x04type\x18\x03 \x01(\x0e\x32\'.org.lfedge.eve.config.AppContainerType\x12H\n\x0erestart_policy\x18\x04 \x01(\x0e\x32\x30.org.lfedge.eve.config.AppContainerRestartPolicy\x12\x0f\n\x07\x63ommand\x18\x05 \x03(\t\x12I\n\x0b\x65nvironment\x18\x06 \x03(\x0b\x32\x34.org.lfedge.eve.config.AppContainer.EnvironmentEntry\x1a\x32\n\x10\x45nvironmentEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xff\x08\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12\x1e\n\x16start_delay_in_seconds\x18\x13 \x01(\r\x12\x0f\n\x07service\x18\x14 \x01(\x08\x12\x1a\n\x12\x63loud_init_version\x18\x15 \x01(\r\x12\x37\n\x08snapshot\x18\x16 \x01(\x0b\x32%.org.lfedge.eve.config.SnapshotConfig\x12\x36\n\x06health\x18\x17 \x01(\x0b\x32&.org.lfedge.eve.config.AppHealthConfig\x12:\n\x0c\x64\x65pendencies\x18\x18 \x03(\x0b\x32$.org.lfedge.eve.config.AppDependency\x12\x13\n\x0bstart_group\x18\x19 \x01(\r\x12\x37\n\ncontainers\x18\x1a \x03(\x0b\x32#.org.lfedge.eve.config.AppContainer\x12\x33\n\x07secrets\x18\x1b \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12>\n\nprometheus\x18\x1c \x01(\x0b\x32*.org.lfedge.eve.config.AppPrometheusConfig\"D\n\x13\x41ppPrometheusConfig\x12\x0c\n\x04port\x18\x01 \x01(\r\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x11\n\tallowlist\x18\x03 \x03(\t\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*\xaa\x01\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03\x12\x14\n\x10MetaDataIgnition\x10\x04\x12\x13\n\x0fMetaDataNoCloud\x10\x05\x12\x17\n\x13MetaDataConfigDrive\x10\x06*K\n\x0cSnapshotType\x12\x1d\n\x19SNAPSHOT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n\x18SNAPSHOT_TYPE_APP_UPDATE\x10\x01*\x98\x01\n\x0c\x41ppProbeType\x12\x1e\n\x1a\x41PP_PROBE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n\x13\x41PP_PROBE_TYPE_HTTP\x10\x01\x12\x16\n\x12\x41PP_PROBE_TYPE_TCP\x10\x02\x12\x17\n\x13\x41PP_PROBE_TYPE_EXEC\x10\x03\x12\x1e\n\x1a\x41PP_PROBE_TYPE_GUEST_AGENT\x10\x04*\x89\x01\n\x0f\x41ppHealthAction\x12\x1a\n\x16\x41PP_HEALTH_ACTION_NONE\x10\x00\x12\x1d\n\x19\x41PP_HEALTH_ACTION_RESTART\x10\x01\x12\x1b\n\x17\x41PP_HEALTH_ACTION_PURGE\x10\x02\x12\x1e\n\x1a\x41PP_HEALTH_ACTION_ROLLBACK\x10\x03*\x8c\x01\n\x16\x41ppDependencyCondition\x12(\n$APP_DEPENDENCY_CONDITION_UNSPECIFIED\x10\x00\x12$\n APP_DEPENDENCY_CONDITION_STARTED\x10\x01\x12\"\n\x1e\x41PP_DEPENDENCY_CONDITION_READY\x10\x02*s\n\x10\x41ppContainerType\x12\"\n\x1e\x41PP_CONTAINER_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n\x1a\x41PP_CONTAINER_TYPE_SIDECAR\x10\x01\x12\x1b\n\x17\x41PP_CONTAINER_TYPE_INIT\x10\x02*\xc7\x01\n\x19\x41ppContainerRestartPolicy\x12,\n(APP_CONTAINER_RESTART_POLICY_UNSPECIFIED\x10\x00\x12\'\n#APP_CONTAINER_RESTART_POLICY_ALWAYS\x10\x01\x12+\n\'APP_CONTAINER_RESTART_POLICY_ON_FAILURE\x10\x02\x12&\n\"APP_CONTAINER_RESTART_POLICY_NEVER\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'config.appconfig_pb2', globals())
@@ -26,18 +26,42 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config'
-  _METADATATYPE._serialized_start=1391
-  _METADATATYPE._serialized_end=1493
-  _SNAPSHOTTYPE._serialized_start=1495
-  _SNAPSHOTTYPE._serialized_end=1570
+  _APPCONTAINER_ENVIRONMENTENTRY._options = None
+  _APPCONTAINER_ENVIRONMENTENTRY._serialized_options = b'8\001'
+  _METADATATYPE._serialized_start=2721
+  _METADATATYPE._serialized_end=2891
+  _SNAPSHOTTYPE._serialized_start=2893
+  _SNAPSHOTTYPE._serialized_end=2968
+  _APPPROBETYPE._serialized_start=2971
+  _APPPROBETYPE._serialized_end=3123
+  _APPHEALTHACTION._serialized_start=3126
+  _APPHEALTHACTION._serialized_end=3263
+  _APPDEPENDENCYCONDITION._serialized_start=3266
+  _APPDEPENDENCYCONDITION._serialized_end=3406
+  _APPCONTAINERTYPE._serialized_start=3408
+  _APPCONTAINERTYPE._serialized_end=3523
+  _APPCONTAINERRESTARTPOLICY._serialized_start=3526
+  _APPCONTAINERRESTARTPOLICY._serialized_end=3725
   _INSTANCEOPSCMD._serialized_start=162
   _INSTANCEOPSCMD._serialized_end=212
   _SNAPSHOTDESC._serialized_start=214
   _SNAPSHOTDESC._serialized_end=291
   _SNAPSHOTCONFIG._serialized_start=294
   _SNAPSHOTCONFIG._serialized_end=475
-  _APPINSTANCECONFIG._serialized_start=478
-  _APPINSTANCECONFIG._serialized_end=1318
-  _VOLUMEREF._serialized_start=1320
-  _VOLUMEREF._serialized_end=1389
+  _APPPROBE._serialized_start=478
+  _APPPROBE._serialized_end=756
+  _APPHEALTHCONFIG._serialized_start=759
+  _APPHEALTHCONFIG._serialized_end=997
+  _APPDEPENDENCY._serialized_start=999
+  _APPDEPENDENCY._serialized_end=1098
+  _APPCONTAINER._serialized_start=1101
+  _APPCONTAINER._serialized_end=1423
+  _APPCONTAINER_ENVIRONMENTENTRY._serialized_start=1373
+  _APPCONTAINER_ENVIRONMENTENTRY._serialized_end=1423
+  _APPINSTANCECONFIG._serialized_start=1426
+  _APPINSTANCECONFIG._serialized_end=2577
+  _APPPROMETHEUSCONFIG._serialized_start=2579
+  _APPPROMETHEUSCONFIG._serialized_end=2647
+  _VOLUMEREF._serialized_start=2649
+  _VOLUMEREF._serialized_end=2718
 # @@protoc_insertion_point(module_scope)
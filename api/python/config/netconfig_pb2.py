@@ -17,7 +17,7 @@ from config import netcmn_pb2 as config_dot_netcmn__pb2
 from evecommon import evecommon_pb2 as evecommon_dot_evecommon__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\x1a\x19\x65vecommon/evecommon.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xf9\x01\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"\xd8\x01\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\x12\x41\n\raccess_points\x18\x04 \x03(\x0b\x32*.org.lfedge.eve.config.CellularAccessPoint\x12\x1a\n\x12\x61\x63tivated_sim_slot\x18\x05 \x01(\r\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xa8\x02\n\x13\x43\x65llularAccessPoint\x12\x10\n\x08sim_slot\x18\x01 \x01(\r\x12\x0b\n\x03\x61pn\x18\x02 \x01(\t\x12\x42\n\rauth_protocol\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.config.CellularAuthProtocol\x12\x37\n\x0b\x63ipher_data\x18\x04 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x17\n\x0fpreferred_plmns\x18\x05 \x03(\t\x12\x16\n\x0e\x66orbid_roaming\x18\x06 \x01(\x08\x12\x44\n\x0epreferred_rats\x18\x07 \x03(\x0e\x32,.org.lfedge.eve.common.RadioAccessTechnology\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\t*\xa1\x01\n\x14\x43\x65llularAuthProtocol\x12\x1f\n\x1b\x43\x45LLULAR_AUTH_PROTOCOL_NONE\x10\x00\x12\x1e\n\x1a\x43\x45LLULAR_AUTH_PROTOCOL_PAP\x10\x01\x12\x1f\n\x1b\x43\x45LLULAR_AUTH_PROTOCOL_CHAP\x10\x02\x12\'\n#CELLULAR_AUTH_PROTOCOL_PAP_AND_CHAP\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\x1a\x19\x65vecommon/evecommon.proto\"\xd9\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\x12\x38\n\tport_auth\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.config.PortAuthConfig\"\xd5\x01\n\x0ePortAuthConfig\x12\x0f\n\x07\x65nabled\x18\x01 \x01(\x08\x12\x34\n\neap_method\x18\x02 \x01(\x0e\x32 .org.lfedge.eve.config.EAPMethod\x12\x10\n\x08identity\x18\x03 \x01(\t\x12\x13\n\x0b\x63\x61_cert_pem\x18\x04 \x01(\t\x12\x1c\n\x14server_domain_suffix\x18\x05 \x01(\t\x12\x37\n\x0b\x63ipher_data\x18\x06 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\"\xa9\x02\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\x12.\n\x03qos\x18* \x01(\x0b\x32!.org.lfedge.eve.config.AdapterQoS\"\x9c\x01\n\nAdapterQoS\x12\x35\n\x06\x65gress\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.TrafficShaping\x12\x36\n\x07ingress\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.config.TrafficShaping\x12\x11\n\tmark_dscp\x18\x03 \x01(\x08\x12\x0c\n\x04\x64scp\x18\x04 \x01(\r\"8\n\x0eTrafficShaping\x12\x11\n\trate_kbps\x18\x01 \x01(\x04\x12\x13\n\x0b\x62urst_bytes\x18\x02 \x01(\r\"\xf7\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\x12\x42\n\x0c\x61\x63\x63\x65ss_point\x18\x0f \x01(\x0b\x32,.org.lfedge.eve.config.WifiAccessPointConfig\"\xee\x01\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\x12\x19\n\x11location_tracking\x18\x03 \x01(\x08\x12\x41\n\raccess_points\x18\x04 \x03(\x0b\x32*.org.lfedge.eve.config.CellularAccessPoint\x12\x1a\n\x12\x61\x63tivated_sim_slot\x18\x05 \x01(\r\x12\x14\n\x0csim_failover\x18\x06 \x01(\x08\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xe0\x02\n\x13\x43\x65llularAccessPoint\x12\x10\n\x08sim_slot\x18\x01 \x01(\r\x12\x0b\n\x03\x61pn\x18\x02 \x01(\t\x12\x42\n\rauth_protocol\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.config.CellularAuthProtocol\x12\x37\n\x0b\x63ipher_data\x18\x04 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x17\n\x0fpreferred_plmns\x18\x05 \x03(\t\x12\x16\n\x0e\x66orbid_roaming\x18\x06 \x01(\x08\x12\x44\n\x0epreferred_rats\x18\x07 \x03(\x0e\x32,.org.lfedge.eve.common.RadioAccessTechnology\x12\x36\n\x07ip_type\x18\x08 \x01(\x0e\x32%.org.lfedge.eve.config.CellularIPType\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\t\"\xa0\x02\n\x15WifiAccessPointConfig\x12\x0c\n\x04ssid\x18\x01 \x01(\t\x12\x13\n\x0bhidden_ssid\x18\x02 \x01(\x08\x12@\n\x08security\x18\x03 \x01(\x0e\x32..org.lfedge.eve.config.WifiAccessPointSecurity\x12\x37\n\x0b\x63ipher_data\x18\x04 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12-\n\x04\x62\x61nd\x18\x05 \x01(\x0e\x32\x1f.org.lfedge.eve.config.WifiBand\x12\x0f\n\x07\x63hannel\x18\x06 \x01(\r\x12\x14\n\x0c\x63ountry_code\x18\x07 \x01(\t\x12\x13\n\x0bmax_clients\x18\x08 \x01(\r*P\n\tEAPMethod\x12\x1a\n\x16\x45\x41P_METHOD_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45\x41P_METHOD_TLS\x10\x01\x12\x13\n\x0f\x45\x41P_METHOD_PEAP\x10\x02*\x8c\x01\n\x0e\x43\x65llularIPType\x12 \n\x1c\x43\x45LLULAR_IP_TYPE_UNSPECIFIED\x10\x00\x12\x19\n\x15\x43\x45LLULAR_IP_TYPE_IPV4\x10\x01\x12\x19\n\x15\x43\x45LLULAR_IP_TYPE_IPV6\x10\x02\x12\"\n\x1e\x43\x45LLULAR_IP_TYPE_IPV4_AND_IPV6\x10\x03*\xa1\x01\n\x14\x43\x65llularAuthProtocol\x12\x1f\n\x1b\x43\x45LLULAR_AUTH_PROTOCOL_NONE\x10\x00\x12\x1e\n\x1a\x43\x45LLULAR_AUTH_PROTOCOL_PAP\x10\x01\x12\x1f\n\x1b\x43\x45LLULAR_AUTH_PROTOCOL_CHAP\x10\x02\x12\'\n#CELLULAR_AUTH_PROTOCOL_PAP_AND_CHAP\x10\x03*\xc1\x01\n\x17WifiAccessPointSecurity\x12*\n&WIFI_ACCESS_POINT_SECURITY_UNSPECIFIED\x10\x00\x12\'\n#WIFI_ACCESS_POINT_SECURITY_WPA2_PSK\x10\x01\x12\'\n#WIFI_ACCESS_POINT_SECURITY_WPA3_SAE\x10\x02\x12(\n$WIFI_ACCESS_POINT_SECURITY_WPA2_WPA3\x10\x03*O\n\x08WifiBand\x12\x19\n\x15WIFI_BAND_UNSPECIFIED\x10\x00\x12\x14\n\x10WIFI_BAND_2_4GHZ\x10\x01\x12\x12\n\x0eWIFI_BAND_5GHZ\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'config.netconfig_pb2', globals())
@@ -25,22 +25,38 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config'
-  _CELLULARAUTHPROTOCOL._serialized_start=1766
-  _CELLULARAUTHPROTOCOL._serialized_end=1927
+  _EAPMETHOD._serialized_start=2741
+  _EAPMETHOD._serialized_end=2821
+  _CELLULARIPTYPE._serialized_start=2824
+  _CELLULARIPTYPE._serialized_end=2964
+  _CELLULARAUTHPROTOCOL._serialized_start=2967
+  _CELLULARAUTHPROTOCOL._serialized_end=3128
+  _WIFIACCESSPOINTSECURITY._serialized_start=3131
+  _WIFIACCESSPOINTSECURITY._serialized_end=3324
+  _WIFIBAND._serialized_start=3326
+  _WIFIBAND._serialized_end=3405
   _NETWORKCONFIG._serialized_start=141
-  _NETWORKCONFIG._serialized_end=428
-  _NETWORKADAPTER._serialized_start=431
-  _NETWORKADAPTER._serialized_end=680
-  _WIRELESSCONFIG._serialized_start=683
-  _WIRELESSCONFIG._serialized_end=862
-  _CELLULARCONFIG._serialized_start=865
-  _CELLULARCONFIG._serialized_end=1081
-  _CELLULARCONNECTIVITYPROBE._serialized_start=1083
-  _CELLULARCONNECTIVITYPROBE._serialized_end=1150
-  _CELLULARACCESSPOINT._serialized_start=1153
-  _CELLULARACCESSPOINT._serialized_end=1449
-  _WIFICONFIG._serialized_start=1452
-  _WIFICONFIG._serialized_end=1763
-  _WIFICONFIG_CRYPTOBLOCK._serialized_start=1714
-  _WIFICONFIG_CRYPTOBLOCK._serialized_end=1763
+  _NETWORKCONFIG._serialized_end=486
+  _PORTAUTHCONFIG._serialized_start=489
+  _PORTAUTHCONFIG._serialized_end=702
+  _NETWORKADAPTER._serialized_start=705
+  _NETWORKADAPTER._serialized_end=1002
+  _ADAPTERQOS._serialized_start=1005
+  _ADAPTERQOS._serialized_end=1161
+  _TRAFFICSHAPING._serialized_start=1163
+  _TRAFFICSHAPING._serialized_end=1219
+  _WIRELESSCONFIG._serialized_start=1222
+  _WIRELESSCONFIG._serialized_end=1469
+  _CELLULARCONFIG._serialized_start=1472
+  _CELLULARCONFIG._serialized_end=1710
+  _CELLULARCONNECTIVITYPROBE._serialized_start=1712
+  _CELLULARCONNECTIVITYPROBE._serialized_end=1779
+  _CELLULARACCESSPOINT._serialized_start=1782
+  _CELLULARACCESSPOINT._serialized_end=2134
+  _WIFICONFIG._serialized_start=2137
+  _WIFICONFIG._serialized_end=2448
+  _WIFICONFIG_CRYPTOBLOCK._serialized_start=2399
+  _WIFICONFIG_CRYPTOBLOCK._serialized_end=2448
+  _WIFIACCESSPOINTCONFIG._serialized_start=2451
+  _WIFIACCESSPOINTCONFIG._serialized_end=2739
 # @@protoc_insertion_point(module_scope)
@@ -15,7 +15,7 @@ from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import netcmn_pb2 as config_dot_netcmn__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xb3\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"\xbe\x03\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry*\xb3\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*C\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xad\x01\n\x10\x46lowExportConfig\x12\x0f\n\x07\x65nabled\x18\x01 \x01(\x08\x12;\n\x08protocol\x18\x02 \x01(\x0e\x32).org.lfedge.eve.config.FlowExportProtocol\x12\x11\n\tcollector\x18\x03 \x01(\t\x12\x15\n\rsampling_rate\x18\x04 \x01(\r\x12!\n\x19template_refresh_interval\x18\x05 \x01(\r\"P\n\tDNSFilter\x12\x32\n\x04mode\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.config.DNSFilterMode\x12\x0f\n\x07\x64omains\x18\x02 \x03(\t\"/\n\x0c\x44NSForwarder\x12\x0e\n\x06\x64omain\x18\x01 \x01(\t\x12\x0f\n\x07servers\x18\x02 \x03(\t\"\xb3\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"\xf3\x05\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x39\n\x11wifi_access_point\x18* \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12G\n\x14host_acl_enforcement\x18+ \x01(\x0e\x32).org.lfedge.eve.config.HostACLEnforcement\x12<\n\x0b\x66low_export\x18, \x01(\x0b\x32\'.org.lfedge.eve.config.FlowExportConfig\x12;\n\x0e\x64ns_forwarders\x18- \x03(\x0b\x32#.org.lfedge.eve.config.DNSForwarder\x12\x34\n\ndns_filter\x18. \x01(\x0b\x32 .org.lfedge.eve.config.DNSFilter*\xb3\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*O\n\x12HostACLEnforcement\x12\x1c\n\x18HOST_ACL_ENFORCEMENT_DNS\x10\x00\x12\x1b\n\x17HOST_ACL_ENFORCEMENT_L7\x10\x01*\x7f\n\x12\x46lowExportProtocol\x12$\n FLOW_EXPORT_PROTOCOL_UNSPECIFIED\x10\x00\x12\x1e\n\x1a\x46LOW_EXPORT_PROTOCOL_IPFIX\x10\x01\x12#\n\x1f\x46LOW_EXPORT_PROTOCOL_NETFLOW_V9\x10\x02*g\n\rDNSFilterMode\x12\x18\n\x14\x44NS_FILTER_MODE_NONE\x10\x00\x12\x1d\n\x19\x44NS_FILTER_MODE_BLOCKLIST\x10\x01\x12\x1d\n\x19\x44NS_FILTER_MODE_ALLOWLIST\x10\x02*C\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'config.netinst_pb2', globals())
@@ -23,20 +23,32 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config'
-  _ZNETWORKINSTTYPE._serialized_start=1062
-  _ZNETWORKINSTTYPE._serialized_end=1241
-  _ADDRESSTYPE._serialized_start=1243
-  _ADDRESSTYPE._serialized_end=1330
-  _ZNETWORKOPAQUECONFIGTYPE._serialized_start=1332
-  _ZNETWORKOPAQUECONFIGTYPE._serialized_end=1399
-  _ZCSERVICETYPE._serialized_start=1401
-  _ZCSERVICETYPE._serialized_end=1472
-  _NETWORKINSTANCEOPAQUECONFIG._serialized_start=93
-  _NETWORKINSTANCEOPAQUECONFIG._serialized_end=272
-  _ZCSERVICEPOINT._serialized_start=274
-  _ZCSERVICEPOINT._serialized_end=382
-  _NETWORKINSTANCELISPCONFIG._serialized_start=385
-  _NETWORKINSTANCELISPCONFIG._serialized_end=610
-  _NETWORKINSTANCECONFIG._serialized_start=613
-  _NETWORKINSTANCECONFIG._serialized_end=1059
+  _ZNETWORKINSTTYPE._serialized_start=1678
+  _ZNETWORKINSTTYPE._serialized_end=1857
+  _ADDRESSTYPE._serialized_start=1859
+  _ADDRESSTYPE._serialized_end=1946
+  _HOSTACLENFORCEMENT._serialized_start=1948
+  _HOSTACLENFORCEMENT._serialized_end=2027
+  _FLOWEXPORTPROTOCOL._serialized_start=2029
+  _FLOWEXPORTPROTOCOL._serialized_end=2156
+  _DNSFILTERMODE._serialized_start=2158
+  _DNSFILTERMODE._serialized_end=2261
+  _ZNETWORKOPAQUECONFIGTYPE._serialized_start=2263
+  _ZNETWORKOPAQUECONFIGTYPE._serialized_end=2330
+  _ZCSERVICETYPE._serialized_start=2332
+  _ZCSERVICETYPE._serialized_end=2403
+  _FLOWEXPORTCONFIG._serialized_start=93
+  _FLOWEXPORTCONFIG._serialized_end=266
+  _DNSFILTER._serialized_start=268
+  _DNSFILTER._serialized_end=348
+  _DNSFORWARDER._serialized_start=350
+  _DNSFORWARDER._serialized_end=397
+  _NETWORKINSTANCEOPAQUECONFIG._serialized_start=400
+  _NETWORKINSTANCEOPAQUECONFIG._serialized_end=579
+  _ZCSERVICEPOINT._serialized_start=581
+  _ZCSERVICEPOINT._serialized_end=689
+  _NETWORKINSTANCELISPCONFIG._serialized_start=692
+  _NETWORKINSTANCELISPCONFIG._serialized_end=917
+  _NETWORKINSTANCECONFIG._serialized_start=920
+  _NETWORKINSTANCECONFIG._serialized_end=1675
 # @@protoc_insertion_point(module_scope)
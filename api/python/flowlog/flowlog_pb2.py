@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15\x66lowlog/flowlog.proto\x12\x16org.lfedge.eve.flowlog\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n\x06IpFlow\x12\x0b\n\x03src\x18\x01 \x01(\t\x12\x0f\n\x07srcPort\x18\x02 \x01(\x05\x12\x0c\n\x04\x64\x65st\x18\x03 \x01(\t\x12\x10\n\x08\x64\x65stPort\x18\x04 \x01(\x05\x12\x10\n\x08protocol\x18\x05 \x01(\x05\"O\n\tScopeInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04intf\x18\x02 \x01(\t\x12\x11\n\tlocalIntf\x18\x03 \x01(\t\x12\x13\n\x0bnetInstUUID\x18\x04 \x01(\t\"\xbc\x02\n\nFlowRecord\x12,\n\x04\x66low\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.flowlog.IpFlow\x12\x0f\n\x07inbound\x18\x02 \x01(\x08\x12\r\n\x05\x61\x63lId\x18\x03 \x01(\x05\x12\x0f\n\x07\x61\x63lName\x18\x04 \x01(\t\x12-\n\tstartTime\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07\x65ndTime\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07txBytes\x18\x08 \x01(\x03\x12\x0e\n\x06txPkts\x18\t \x01(\x03\x12\x0f\n\x07rxBytes\x18\n \x01(\x03\x12\x0e\n\x06rxPkts\x18\x0b \x01(\x03\x12\x31\n\x06\x61\x63tion\x18\x0c \x01(\x0e\x32!.org.lfedge.eve.flowlog.ACLAction\"n\n\nDnsRequest\x12\x10\n\x08hostName\x18\x01 \x01(\t\x12\r\n\x05\x61\x64\x64rs\x18\x02 \x03(\t\x12/\n\x0brequestTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63lNum\x18\x04 \x01(\x05\"\xb6\x01\n\x0b\x46lowMessage\x12\r\n\x05\x64\x65vId\x18\x01 \x01(\t\x12\x30\n\x05scope\x18\x02 \x01(\x0b\x32!.org.lfedge.eve.flowlog.ScopeInfo\x12\x31\n\x05\x66lows\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.flowlog.FlowRecord\x12\x33\n\x07\x64nsReqs\x18\x04 \x03(\x0b\x32\".org.lfedge.eve.flowlog.DnsRequest*@\n\tACLAction\x12\x11\n\rActionUnknown\x10\x00\x12\x0e\n\nActionDrop\x10\x01\x12\x10\n\x0c\x41\x63tionAccept\x10\x02\x42?\n\x16org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlogb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15\x66lowlog/flowlog.proto\x12\x16org.lfedge.eve.flowlog\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n\x06IpFlow\x12\x0b\n\x03src\x18\x01 \x01(\t\x12\x0f\n\x07srcPort\x18\x02 \x01(\x05\x12\x0c\n\x04\x64\x65st\x18\x03 \x01(\t\x12\x10\n\x08\x64\x65stPort\x18\x04 \x01(\x05\x12\x10\n\x08protocol\x18\x05 \x01(\x05\"O\n\tScopeInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04intf\x18\x02 \x01(\t\x12\x11\n\tlocalIntf\x18\x03 \x01(\t\x12\x13\n\x0bnetInstUUID\x18\x04 \x01(\t\"\xce\x02\n\nFlowRecord\x12,\n\x04\x66low\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.flowlog.IpFlow\x12\x0f\n\x07inbound\x18\x02 \x01(\x08\x12\r\n\x05\x61\x63lId\x18\x03 \x01(\x05\x12\x0f\n\x07\x61\x63lName\x18\x04 \x01(\t\x12-\n\tstartTime\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07\x65ndTime\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07txBytes\x18\x08 \x01(\x03\x12\x0e\n\x06txPkts\x18\t \x01(\x03\x12\x0f\n\x07rxBytes\x18\n \x01(\x03\x12\x0e\n\x06rxPkts\x18\x0b \x01(\x03\x12\x31\n\x06\x61\x63tion\x18\x0c \x01(\x0e\x32!.org.lfedge.eve.flowlog.ACLAction\x12\x10\n\x08hostname\x18\r \x01(\t\"\x7f\n\nDnsRequest\x12\x10\n\x08hostName\x18\x01 \x01(\t\x12\r\n\x05\x61\x64\x64rs\x18\x02 \x03(\t\x12/\n\x0brequestTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63lNum\x18\x04 \x01(\x05\x12\x0f\n\x07\x62locked\x18\x05 \x01(\x08\"\xb6\x01\n\x0b\x46lowMessage\x12\r\n\x05\x64\x65vId\x18\x01 \x01(\t\x12\x30\n\x05scope\x18\x02 \x01(\x0b\x32!.org.lfedge.eve.flowlog.ScopeInfo\x12\x31\n\x05\x66lows\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.flowlog.FlowRecord\x12\x33\n\x07\x64nsReqs\x18\x04 \x03(\x0b\x32\".org.lfedge.eve.flowlog.DnsRequest*@\n\tACLAction\x12\x11\n\rActionUnknown\x10\x00\x12\x0e\n\nActionDrop\x10\x01\x12\x10\n\x0c\x41\x63tionAccept\x10\x02\x42?\n\x16org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlogb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'flowlog.flowlog_pb2', globals())
@@ -22,16 +22,16 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\026org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlog'
-  _ACLACTION._serialized_start=869
-  _ACLACTION._serialized_end=933
+  _ACLACTION._serialized_start=904
+  _ACLACTION._serialized_end=968
   _IPFLOW._serialized_start=82
   _IPFLOW._serialized_end=170
   _SCOPEINFO._serialized_start=172
   _SCOPEINFO._serialized_end=251
   _FLOWRECORD._serialized_start=254
-  _FLOWRECORD._serialized_end=570
-  _DNSREQUEST._serialized_start=572
-  _DNSREQUEST._serialized_end=682
-  _FLOWMESSAGE._serialized_start=685
-  _FLOWMESSAGE._serialized_end=867
+  _FLOWRECORD._serialized_end=588
+  _DNSREQUEST._serialized_start=590
+  _DNSREQUEST._serialized_end=717
+  _FLOWMESSAGE._serialized_start=720
+  _FLOWMESSAGE._serialized_end=902
 # @@protoc_insertion_point(module_scope)
@@ -20,6 +20,9 @@ type tracedConn struct {
 	log          Logger
 	forResolver  bool
 	withDNSTrace bool
+	// DNS messages are exchanged over a stream and prefixed with two-byte
+	// length field.
+	streamDNS bool
 }
 
 // socketOpTrace is published for every socket read/write operation.
@@ -258,7 +261,11 @@ func (tc *tracedConn) Read(b []byte) (n int, err error) {
 	if err == nil && tc.forResolver && tc.withDNSTrace {
 		// XXX Large DNS reply could be in theory split across multiple reads.
 		//     (when DNS over TCP is used)
-		tc.parseDNSReply(b[:n], returnAt)
+		// With stream transport the Go resolver reads the length prefix first
+		// and the message separately.
+		if !tc.streamDNS || n != 2 {
+			tc.parseDNSReply(b[:n], returnAt)
+		}
 	}
 	return n, err
 }
@@ -279,7 +286,11 @@ func (tc *tracedConn) Write(b []byte) (n int, err error) {
 		connID: tc.connID,
 	})
 	if err == nil && tc.forResolver && tc.withDNSTrace {
-		tc.parseDNSQuery(b[:n], returnAt)
+		msg := b[:n]
+		if tc.streamDNS && len(msg) > 2 && int(msg[0])<<8|int(msg[1]) == len(msg)-2 {
+			msg = msg[2:]
+		}
+		tc.parseDNSQuery(msg, returnAt)
 	}
 	return n, err
 }
@@ -25,6 +25,7 @@ type tracedDialer struct {
 	keepAliveInterval time.Duration
 	withDNSTrace      bool
 	skipNameserver    NameserverSelector
+	dialNameserver    NameserverDialer
 }
 
 // tracedResolver publishes traces from nameserver dialing.
@@ -66,6 +67,7 @@ type resolverDialTrace struct {
 	resolvDial  TraceID
 	parentDial  TraceID
 	nameserver  string
+	protocol    string
 	dialBeginAt Timestamp
 	dialEndAt   Timestamp
 	dialErr     error
@@ -86,7 +88,7 @@ func (resolverCloseTrace) isInternalNetTrace() {}
 
 func newTracedDialer(tracer tracerWithDial, log Logger, sourceIP net.IP,
 	handshakeTimeout, keepAliveInterval time.Duration, withDNSTrace bool,
-	skipNameserver NameserverSelector) *tracedDialer {
+	skipNameserver NameserverSelector, dialNameserver NameserverDialer) *tracedDialer {
 	dialID := IDGenerator()
 	return &tracedDialer{
 		dialID: dialID,
@@ -101,6 +103,7 @@ func newTracedDialer(tracer tracerWithDial, log Logger, sourceIP net.IP,
 		keepAliveInterval: keepAliveInterval,
 		withDNSTrace:      withDNSTrace,
 		skipNameserver:    skipNameserver,
+		dialNameserver:    dialNameserver,
 	}
 }
 
@@ -215,8 +218,18 @@ func (tr *tracedResolver) dial(ctx context.Context, network, address string) (ne
 		nameserver:  address,
 		dialBeginAt: tr.caller.tracer.getRelTimestamp(),
 	}
-	conn, err := netDialer.DialContext(ctx, network, address)
+	var conn net.Conn
+	var err error
+	if tr.caller.dialNameserver != nil {
+		conn, trace.protocol, trace.nameserver, err = tr.caller.dialNameserver(
+			ctx, netDialer.DialContext, network, address)
+	} else {
+		conn, err = netDialer.DialContext(ctx, network, address)
+	}
 	trace.dialEndAt = tr.caller.tracer.getRelTimestamp()
+	if trace.nameserver == "" {
+		trace.nameserver = address
+	}
 	if !stringListContains(tr.triedServers, address) {
 		tr.triedServers = append(tr.triedServers, address)
 	}
@@ -238,6 +251,7 @@ func (tr *tracedResolver) dial(ctx context.Context, network, address string) (ne
 			packetConn: packetConn,
 		}, nil
 	}
+	tracedConn.streamDNS = true
 	return tracedConn, err
 }
 
@@ -44,6 +44,8 @@ type HTTPClient struct {
 	log                  Logger
 	sourceIP             net.IP
 	skipNameserver       NameserverSelector
+	dialNameserver       NameserverDialer
+	dialTunnel           TunnelDialer
 	netProxy             func(req *http.Request) (*url.URL, error)
 	withSockTrace        bool
 	withDNSTrace         bool
@@ -72,6 +74,31 @@ type HTTPClient struct {
 // whether it should be used for name resolution or skipped.
 type NameserverSelector func(ipAddr net.IP, port uint16) (skip bool, reason string)
 
+// DialFunc is a function used to open a network connection.
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// NameserverDialer is a function that opens connection with a nameserver.
+// It is given the address of the nameserver selected by the resolver (from
+// the system-wide DNS configuration) and a (traced) dial function, which should
+// be used to open the underlying network connection.
+// The dialer may decide to query a different nameserver and/or to use
+// an encrypted DNS protocol (e.g. DNS-over-TLS). The returned connection is used
+// to exchange (unencrypted) DNS messages. If the connection does not implement
+// net.PacketConn, DNS messages are prefixed with a two-byte length field
+// (as with DNS over TCP).
+// The function returns the name of the DNS protocol used (empty for plain DNS)
+// and the address of the nameserver that was actually dialed.
+type NameserverDialer func(ctx context.Context, dial DialFunc, network, address string) (
+	conn net.Conn, protocol, nameserver string, err error)
+
+// TunnelDialer is a function that opens connection with the given address
+// through a tunnel, for example HTTP CONNECT tunnel established with a proxy
+// which requires connection-based authentication (not supported by http.Transport).
+// The provided dial function should be used to connect with the tunnel endpoint
+// (this way the connection is traced).
+type TunnelDialer func(ctx context.Context, dial DialFunc, network, address string) (
+	net.Conn, error)
+
 // HTTPClientCfg : configuration for the embedded HTTP client.
 // This is not related to tracing but how the standard HTTP client itself should behave.
 // Normally, HTTP client is configured by customizing the client's Transport
@@ -102,6 +129,17 @@ type HTTPClientCfg struct {
 	// moves to the next one.
 	// Every skipped nameserver is recorded in DialTrace.SkippedNameservers.
 	SkipNameserver NameserverSelector
+	// DialNameserver can be optionally provided to customize how connections
+	// with nameservers are established. This can be used for example to send DNS
+	// queries using an encrypted DNS protocol.
+	// The callback is called for every nameserver which was not skipped
+	// by SkipNameserver.
+	// The protocol and the nameserver returned by the callback are recorded
+	// in ResolverDialTrace.
+	DialNameserver NameserverDialer
+	// DialTunnel can be optionally provided to open all connections through
+	// a tunnel. Proxy should return nil for requests sent through the tunnel.
+	DialTunnel TunnelDialer
 	// Proxy specifies a callback to return an address of a network proxy that
 	// should be used for the given HTTP request.
 	// If Proxy is nil or returns a nil *URL, no proxy is used.
@@ -222,6 +260,8 @@ func NewHTTPClient(config HTTPClientCfg, traceOpts ...TraceOpt) (*HTTPClient, er
 		log:            &nilLogger{},
 		sourceIP:       config.SourceIP,
 		skipNameserver: config.SkipNameserver,
+		dialNameserver: config.DialNameserver,
+		dialTunnel:     config.DialTunnel,
 		netProxy:       config.Proxy,
 		pendingTraces:  lockfree.NewQueue(),
 	}
@@ -731,6 +771,7 @@ func (c *HTTPClient) processPendingTraces(dropAll bool) {
 				DialEndAt:       t.dialEndAt,
 				DialErr:         errToString(t.dialErr),
 				Nameserver:      t.nameserver,
+				Protocol:        t.protocol,
 				EstablishedConn: t.connID,
 			})
 			// Stop monitoring sockets opened by this call to resolver's Dial.
@@ -957,7 +998,10 @@ func (c *HTTPClient) proxyForRequest(req *http.Request) (*url.URL, error) {
 
 func (c *HTTPClient) dial(ctx context.Context, network, addr string) (net.Conn, error) {
 	dialer := newTracedDialer(c, c.log, c.sourceIP, c.tcpHandshakeTimeout,
-		c.tcpKeepAliveInterval, c.withDNSTrace, c.skipNameserver)
+		c.tcpKeepAliveInterval, c.withDNSTrace, c.skipNameserver, c.dialNameserver)
+	if c.dialTunnel != nil {
+		return c.dialTunnel(ctx, dialer.dial, network, addr)
+	}
 	return dialer.dial(ctx, network, addr)
 }
 
@@ -4,6 +4,8 @@
 package nettrace_test
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net"
 	"net/http"
@@ -713,3 +715,46 @@ func TestAllNameserversSkipped(test *testing.T) {
 	err = client.Close()
 	t.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestCustomNameserverDialer(test *testing.T) {
+	t := NewWithT(test)
+
+	opts := []nettrace.TraceOpt{
+		&nettrace.WithHTTPReqTrace{},
+		&nettrace.WithDNSQueryTrace{},
+	}
+	var dialedNameservers []string
+	client, err := nettrace.NewHTTPClient(nettrace.HTTPClientCfg{
+		DialNameserver: func(ctx context.Context, dial nettrace.DialFunc,
+			network, address string) (net.Conn, string, string, error) {
+			dialedNameservers = append(dialedNameservers, address)
+			return nil, "dot", "192.0.2.1:853", errors.New("DoT server is not reachable")
+		},
+	}, opts...)
+	t.Expect(err).ToNot(HaveOccurred())
+
+	req, err := http.NewRequest("GET", "https://www.example.com", nil)
+	t.Expect(err).ToNot(HaveOccurred())
+	resp, err := client.Do(req)
+	t.Expect(err).To(HaveOccurred())
+	t.Expect(resp).To(BeNil())
+	t.Expect(dialedNameservers).ToNot(BeEmpty())
+
+	trace, _, err := client.GetTrace("GET www.example.com with unreachable DoT server")
+	t.Expect(err).ToNot(HaveOccurred())
+
+	t.Expect(trace.Dials).To(HaveLen(1))
+	dial := trace.Dials[0]
+	t.Expect(dial.DialErr).ToNot(BeEmpty())
+	t.Expect(dial.ResolverDials).ToNot(BeEmpty())
+	for _, resolvDial := range dial.ResolverDials {
+		t.Expect(resolvDial.Protocol).To(Equal("dot"))
+		t.Expect(resolvDial.Nameserver).To(Equal("192.0.2.1:853"))
+		t.Expect(resolvDial.DialErr).To(ContainSubstring("DoT server is not reachable"))
+		t.Expect(resolvDial.EstablishedConn.Undefined()).To(BeTrue())
+	}
+	t.Expect(trace.DNSQueries).To(BeEmpty())
+
+	err = client.Close()
+	t.Expect(err).ToNot(HaveOccurred())
+}
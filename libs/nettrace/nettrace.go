@@ -163,7 +163,12 @@ type ResolverDialTrace struct {
 	// DialErr : if dial failed, here is the reason.
 	DialErr string `json:"dialErr,omitempty"`
 	// Nameserver : destination nameserver address in the format <host>:<port>.
+	// For DNS-over-HTTPS this is the URL of the DoH server.
 	Nameserver string `json:"nameserver"`
+	// Protocol : DNS protocol used to query the nameserver as returned by
+	// HTTPClientCfg.DialNameserver (e.g. "dot" or "doh").
+	// Empty for plain DNS over UDP/TCP.
+	Protocol string `json:"protocol,omitempty"`
 	// EstablishedConn : reference to an established UDP or TCP connection.
 	EstablishedConn TraceID `json:"establishedConn,omitempty"`
 }
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// NTLM message types.
+const (
+	ntlmNegotiateMsg    = 1
+	ntlmChallengeMsg    = 2
+	ntlmAuthenticateMsg = 3
+)
+
+// NTLM negotiate flags (MS-NLMP 2.2.2.5).
+const (
+	ntlmFlagUnicode         = 0x00000001
+	ntlmFlagOEM             = 0x00000002
+	ntlmFlagRequestTarget   = 0x00000004
+	ntlmFlagNTLM            = 0x00000200
+	ntlmFlagAlwaysSign      = 0x00008000
+	ntlmFlagExtendedSession = 0x00080000
+	ntlmFlagTargetInfo      = 0x00800000
+	ntlmFlag128             = 0x20000000
+	ntlmFlag56              = 0x80000000
+
+	ntlmNegotiateFlags = ntlmFlagUnicode | ntlmFlagOEM | ntlmFlagRequestTarget |
+		ntlmFlagNTLM | ntlmFlagAlwaysSign | ntlmFlagExtendedSession |
+		ntlmFlagTargetInfo | ntlmFlag128 | ntlmFlag56
+)
+
+// AV pair IDs used by the client (MS-NLMP 2.2.2.1).
+const (
+	avIDEOL       = 0
+	avIDTimestamp = 7
+)
+
+var ntlmSignature = []byte("NTLMSSP\x00")
+
+// Number of 100ns intervals between the Windows epoch (1601)
+// and the Unix epoch (1970).
+const filetimeUnixOffset = 116444736000000000
+
+// ntlmChallenge : fields of the CHALLENGE_MESSAGE needed to compute the response.
+type ntlmChallenge struct {
+	flags           uint32
+	serverChallenge [8]byte
+	targetInfo      []byte
+}
+
+// ntlmNegotiateMessage returns NEGOTIATE_MESSAGE without domain
+// and workstation names.
+func ntlmNegotiateMessage() []byte {
+	msg := make([]byte, 32)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], ntlmNegotiateMsg)
+	binary.LittleEndian.PutUint32(msg[12:], ntlmNegotiateFlags)
+	// Domain and workstation security buffers are left empty.
+	return msg
+}
+
+// parseNTLMChallenge parses CHALLENGE_MESSAGE sent by the proxy.
+func parseNTLMChallenge(msg []byte) (*ntlmChallenge, error) {
+	if len(msg) < 32 || !bytes.Equal(msg[:8], ntlmSignature) {
+		return nil, errors.New("invalid NTLM challenge message")
+	}
+	if msgType := binary.LittleEndian.Uint32(msg[8:]); msgType != ntlmChallengeMsg {
+		return nil, fmt.Errorf("unexpected NTLM message type %d", msgType)
+	}
+	challenge := &ntlmChallenge{
+		flags: binary.LittleEndian.Uint32(msg[20:]),
+	}
+	copy(challenge.serverChallenge[:], msg[24:32])
+	if len(msg) >= 48 {
+		length := int(binary.LittleEndian.Uint16(msg[40:]))
+		offset := int(binary.LittleEndian.Uint32(msg[44:]))
+		if offset+length > len(msg) {
+			return nil, errors.New("NTLM target info out of bounds")
+		}
+		challenge.targetInfo = msg[offset : offset+length]
+	}
+	return challenge, nil
+}
+
+// timestamp returns MsvAvTimestamp from the target info, if present.
+func (c *ntlmChallenge) timestamp() (ts []byte, found bool) {
+	info := c.targetInfo
+	for len(info) >= 4 {
+		avID := binary.LittleEndian.Uint16(info)
+		avLen := int(binary.LittleEndian.Uint16(info[2:]))
+		if avID == avIDEOL || 4+avLen > len(info) {
+			break
+		}
+		if avID == avIDTimestamp && avLen == 8 {
+			return info[4:12], true
+		}
+		info = info[4+avLen:]
+	}
+	return nil, false
+}
+
+// ntlmAuthenticateMessage computes NTLMv2 response to the challenge
+// and returns AUTHENTICATE_MESSAGE.
+func ntlmAuthenticateMessage(challenge *ntlmChallenge, creds Credentials) ([]byte, error) {
+	var clientChallenge [8]byte
+	if _, err := rand.Read(clientChallenge[:]); err != nil {
+		return nil, err
+	}
+	timestamp, fromServer := challenge.timestamp()
+	if !fromServer {
+		timestamp = make([]byte, 8)
+		filetime := uint64(time.Now().UnixNano()/100) + filetimeUnixOffset
+		binary.LittleEndian.PutUint64(timestamp, filetime)
+	}
+	domain, user := creds.splitDomain()
+	ntResp, lmResp := ntlmV2Response(user, domain, creds.Password,
+		challenge.serverChallenge[:], clientChallenge[:], timestamp, challenge.targetInfo)
+	if fromServer {
+		// With MsvAvTimestamp present, LMv2 response must not be sent.
+		lmResp = make([]byte, 24)
+	}
+	flags := challenge.flags & ntlmNegotiateFlags
+	if flags&ntlmFlagUnicode == 0 {
+		return nil, errors.New("proxy does not support NTLM with unicode")
+	}
+	payload := [][]byte{
+		lmResp,
+		ntResp,
+		toUnicode(domain),
+		toUnicode(user),
+		nil, // workstation
+		nil, // encrypted random session key
+	}
+	const headerLen = 64
+	msg := make([]byte, headerLen)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], ntlmAuthenticateMsg)
+	offset := headerLen
+	for i, field := range payload {
+		pos := 12 + 8*i
+		binary.LittleEndian.PutUint16(msg[pos:], uint16(len(field)))
+		binary.LittleEndian.PutUint16(msg[pos+2:], uint16(len(field)))
+		binary.LittleEndian.PutUint32(msg[pos+4:], uint32(offset))
+		offset += len(field)
+	}
+	binary.LittleEndian.PutUint32(msg[60:], flags)
+	for _, field := range payload {
+		msg = append(msg, field...)
+	}
+	return msg, nil
+}
+
+// ntlmV2Response computes NTLMv2 and LMv2 responses (MS-NLMP 3.3.2).
+func ntlmV2Response(user, domain, password string,
+	serverChallenge, clientChallenge, timestamp, targetInfo []byte) (ntResp, lmResp []byte) {
+	responseKey := ntowfV2(user, domain, password)
+	temp := []byte{1, 1, 0, 0, 0, 0, 0, 0}
+	temp = append(temp, timestamp...)
+	temp = append(temp, clientChallenge...)
+	temp = append(temp, 0, 0, 0, 0)
+	temp = append(temp, targetInfo...)
+	temp = append(temp, 0, 0, 0, 0)
+	ntProof := hmacMD5(responseKey, serverChallenge, temp)
+	ntResp = append(ntProof, temp...)
+	lmResp = append(hmacMD5(responseKey, serverChallenge, clientChallenge), clientChallenge...)
+	return ntResp, lmResp
+}
+
+func ntowfV2(user, domain, password string) []byte {
+	ntHash := md4Sum(toUnicode(password))
+	return hmacMD5(ntHash[:], toUnicode(strings.ToUpper(user)+domain))
+}
+
+func hmacMD5(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(md5.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+func toUnicode(s string) []byte {
+	encoded := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(encoded))
+	for i, r := range encoded {
+		binary.LittleEndian.PutUint16(b[2*i:], r)
+	}
+	return b
+}
+
+// md4Sum computes MD4 digest (RFC 1320), which is needed to derive the NT hash.
+// MD4 is not provided by the standard library.
+func md4Sum(data []byte) (digest [16]byte) {
+	a, b, c, d := uint32(0x67452301), uint32(0xefcdab89), uint32(0x98badcfe), uint32(0x10325476)
+	msgLen := uint64(len(data)) * 8
+	msg := append([]byte{}, data...)
+	msg = append(msg, 0x80)
+	for len(msg)%64 != 56 {
+		msg = append(msg, 0)
+	}
+	msg = binary.LittleEndian.AppendUint64(msg, msgLen)
+
+	f := func(x, y, z uint32) uint32 { return (x & y) | (^x & z) }
+	g := func(x, y, z uint32) uint32 { return (x & y) | (x & z) | (y & z) }
+	h := func(x, y, z uint32) uint32 { return x ^ y ^ z }
+	var x [16]uint32
+	for chunk := 0; chunk < len(msg); chunk += 64 {
+		for i := range x {
+			x[i] = binary.LittleEndian.Uint32(msg[chunk+4*i:])
+		}
+		aa, bb, cc, dd := a, b, c, d
+		// Round 1.
+		for _, i := range []int{0, 4, 8, 12} {
+			a = bits.RotateLeft32(a+f(b, c, d)+x[i], 3)
+			d = bits.RotateLeft32(d+f(a, b, c)+x[i+1], 7)
+			c = bits.RotateLeft32(c+f(d, a, b)+x[i+2], 11)
+			b = bits.RotateLeft32(b+f(c, d, a)+x[i+3], 19)
+		}
+		// Round 2.
+		for _, i := range []int{0, 1, 2, 3} {
+			a = bits.RotateLeft32(a+g(b, c, d)+x[i]+0x5a827999, 3)
+			d = bits.RotateLeft32(d+g(a, b, c)+x[i+4]+0x5a827999, 5)
+			c = bits.RotateLeft32(c+g(d, a, b)+x[i+8]+0x5a827999, 9)
+			b = bits.RotateLeft32(b+g(c, d, a)+x[i+12]+0x5a827999, 13)
+		}
+		// Round 3.
+		for _, i := range []int{0, 2, 1, 3} {
+			a = bits.RotateLeft32(a+h(b, c, d)+x[i]+0x6ed9eba1, 3)
+			d = bits.RotateLeft32(d+h(a, b, c)+x[i+8]+0x6ed9eba1, 9)
+			c = bits.RotateLeft32(c+h(d, a, b)+x[i+4]+0x6ed9eba1, 11)
+			b = bits.RotateLeft32(b+h(c, d, a)+x[i+12]+0x6ed9eba1, 15)
+		}
+		a += aa
+		b += bb
+		c += cc
+		d += dd
+	}
+	binary.LittleEndian.PutUint32(digest[0:], a)
+	binary.LittleEndian.PutUint32(digest[4:], b)
+	binary.LittleEndian.PutUint32(digest[8:], c)
+	binary.LittleEndian.PutUint32(digest[12:], d)
+	return digest
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package proxyauth implements authentication with HTTP(S) proxies.
+//
+// The standard http.Transport is only able to authenticate with a proxy
+// using the Basic scheme (taken from the userinfo of the proxy URL).
+// Connection-oriented schemes like NTLM and Negotiate require multiple
+// request-response round-trips over the same TCP connection, which
+// http.Transport does not support. Tunnel therefore establishes HTTP CONNECT
+// tunnel with the proxy itself, performs the authentication handshake
+// and returns connection which the caller can use as if it was opened directly
+// with the destination (i.e. http.Transport should be used without proxy
+// and with Tunnel.DialContext as the dialer).
+package proxyauth
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Method : authentication scheme used with a proxy.
+type Method uint8
+
+const (
+	// MethodAuto : use the strongest scheme offered by the proxy.
+	MethodAuto Method = iota
+	// MethodBasic : Basic authentication scheme (RFC 7617).
+	MethodBasic
+	// MethodNTLM : NTLMv2 authentication.
+	MethodNTLM
+	// MethodNegotiate : SPNEGO (RFC 4559). Kerberos is not supported,
+	// NTLM tokens are sent inside the Negotiate scheme instead.
+	MethodNegotiate
+)
+
+// String returns the name of the method.
+func (m Method) String() string {
+	switch m {
+	case MethodAuto:
+		return "auto"
+	case MethodBasic:
+		return "basic"
+	case MethodNTLM:
+		return "ntlm"
+	case MethodNegotiate:
+		return "negotiate"
+	}
+	return fmt.Sprintf("unknown(%d)", m)
+}
+
+// scheme returns the name of the scheme as used in HTTP headers.
+func (m Method) scheme() string {
+	switch m {
+	case MethodBasic:
+		return "Basic"
+	case MethodNTLM:
+		return "NTLM"
+	case MethodNegotiate:
+		return "Negotiate"
+	}
+	return ""
+}
+
+// Credentials : username and password used to authenticate with a proxy.
+type Credentials struct {
+	// Username can be given with the domain as DOMAIN\user (used by NTLM).
+	Username string
+	Password string
+}
+
+// IsSet returns true if username is configured.
+func (c Credentials) IsSet() bool {
+	return c.Username != ""
+}
+
+// splitDomain splits DOMAIN\user (or user@DOMAIN) into domain and user.
+func (c Credentials) splitDomain() (domain, user string) {
+	if i := strings.Index(c.Username, `\`); i >= 0 {
+		return c.Username[:i], c.Username[i+1:]
+	}
+	if i := strings.LastIndex(c.Username, "@"); i >= 0 {
+		return c.Username[i+1:], c.Username[:i]
+	}
+	return "", c.Username
+}
+
+// AuthError is returned when proxy authentication failed, i.e. the proxy
+// rejected the credentials, requested authentication while no credentials
+// are configured, or offered only unsupported authentication schemes.
+type AuthError struct {
+	// Proxy : host:port of the proxy.
+	Proxy string
+	// Scheme : authentication scheme that was used (empty if none).
+	Scheme string
+	// Reason : why the authentication failed.
+	Reason string
+}
+
+// Error message.
+func (e *AuthError) Error() string {
+	if e.Scheme == "" {
+		return fmt.Sprintf("proxy %s authentication failed: %s", e.Proxy, e.Reason)
+	}
+	return fmt.Sprintf("proxy %s authentication (%s) failed: %s",
+		e.Proxy, e.Scheme, e.Reason)
+}
+
+// IsAuthError returns true if the error (or any error it wraps) is AuthError.
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr)
+}
+
+// DialFunc is used to open connections.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Tunnel opens connections with destinations through HTTP CONNECT tunnels
+// established with an authenticating proxy.
+type Tunnel struct {
+	// Proxy : URL of the proxy (http or https scheme).
+	Proxy *url.URL
+	// Method : authentication scheme to use.
+	Method Method
+	// Credentials : credentials to use. If empty, CONNECT requests are sent
+	// without authentication (and AuthError is returned if the proxy asks for it).
+	Credentials Credentials
+	// Dial is used to connect with the proxy.
+	// If nil, net.Dialer with default options is used.
+	Dial DialFunc
+	// TLSConfig is used with https proxies.
+	TLSConfig *tls.Config
+}
+
+// Maximum size of a 407 response body which is read and discarded.
+const maxDiscardedBody = 64 << 10
+
+// Timeout applied to the CONNECT handshake if context has no deadline.
+const defaultHandshakeTimeout = 30 * time.Second
+
+// WithDial returns a copy of the Tunnel using the given dial function.
+func (t Tunnel) WithDial(dial DialFunc) *Tunnel {
+	t.Dial = dial
+	return &t
+}
+
+// DialContext connects to the address through the proxy.
+// The signature matches http.Transport.DialContext.
+func (t *Tunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if t.Proxy == nil {
+		return nil, errors.New("proxyauth: missing proxy URL")
+	}
+	method := t.Method
+	var (
+		authHeader string
+		ntlmStage  int // 1 - negotiate message sent, 2 - authenticate message sent
+		err        error
+	)
+	if t.Credentials.IsSet() && method != MethodAuto {
+		// Start authentication right away, do not wait for the proxy to ask.
+		if authHeader, err = t.initialAuth(method); err != nil {
+			return nil, err
+		}
+		if method != MethodBasic {
+			ntlmStage = 1
+		}
+	}
+	conn, br, err := t.connectProxy(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		resp, err := t.sendConnect(ctx, conn, br, addr, authHeader)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusOK {
+			return newTunnelConn(conn, br), nil
+		}
+		closeConn := resp.Close
+		discardBody(resp)
+		if resp.StatusCode != http.StatusProxyAuthRequired {
+			conn.Close()
+			return nil, fmt.Errorf("proxy %s refused to connect to %s: %s",
+				t.Proxy.Host, addr, resp.Status)
+		}
+		if !t.Credentials.IsSet() {
+			conn.Close()
+			return nil, &AuthError{Proxy: t.Proxy.Host,
+				Reason: "proxy requires authentication, but no credentials are configured"}
+		}
+		challenges := resp.Header.Values("Proxy-Authenticate")
+		switch {
+		case authHeader == "":
+			// Auto-selection of the method.
+			method = selectMethod(challenges)
+			if method == MethodAuto {
+				err = &AuthError{Proxy: t.Proxy.Host,
+					Reason: fmt.Sprintf("unsupported authentication schemes %v", challenges)}
+				break
+			}
+			authHeader, err = t.initialAuth(method)
+			if method != MethodBasic {
+				ntlmStage = 1
+			}
+		case ntlmStage == 1:
+			if closeConn {
+				// NTLM handshake is bound to the connection.
+				err = &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+					Reason: "proxy closed connection during the handshake"}
+				break
+			}
+			authHeader, err = t.ntlmResponse(method, challenges)
+			ntlmStage = 2
+		default:
+			err = &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+				Reason: "credentials rejected"}
+		}
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if closeConn {
+			conn.Close()
+			if conn, br, err = t.connectProxy(ctx, network); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// initialAuth returns the first Proxy-Authorization header value for the method.
+func (t *Tunnel) initialAuth(method Method) (string, error) {
+	switch method {
+	case MethodBasic:
+		userPass := t.Credentials.Username + ":" + t.Credentials.Password
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(userPass)), nil
+	case MethodNTLM, MethodNegotiate:
+		return method.scheme() + " " +
+			base64.StdEncoding.EncodeToString(ntlmNegotiateMessage()), nil
+	}
+	return "", fmt.Errorf("proxyauth: unsupported method %v", method)
+}
+
+// ntlmResponse answers the NTLM challenge received from the proxy.
+func (t *Tunnel) ntlmResponse(method Method, challenges []string) (string, error) {
+	scheme := method.scheme()
+	var token string
+	for _, challenge := range challenges {
+		fields := strings.Fields(challenge)
+		if len(fields) == 2 && strings.EqualFold(fields[0], scheme) {
+			token = fields[1]
+			break
+		}
+	}
+	if token == "" {
+		return "", &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+			Reason: "credentials rejected"}
+	}
+	msg, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return "", &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+			Reason: fmt.Sprintf("invalid challenge: %v", err)}
+	}
+	challenge, err := parseNTLMChallenge(msg)
+	if err != nil {
+		return "", &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+			Reason: err.Error()}
+	}
+	authMsg, err := ntlmAuthenticateMessage(challenge, t.Credentials)
+	if err != nil {
+		return "", &AuthError{Proxy: t.Proxy.Host, Scheme: method.String(),
+			Reason: err.Error()}
+	}
+	return scheme + " " + base64.StdEncoding.EncodeToString(authMsg), nil
+}
+
+// selectMethod returns the strongest supported scheme offered by the proxy.
+func selectMethod(challenges []string) Method {
+	selected := MethodAuto
+	for _, challenge := range challenges {
+		scheme := strings.ToLower(strings.Fields(challenge + " ")[0])
+		switch {
+		case scheme == "negotiate":
+			return MethodNegotiate
+		case scheme == "ntlm":
+			selected = MethodNTLM
+		case scheme == "basic" && selected == MethodAuto:
+			selected = MethodBasic
+		}
+	}
+	return selected
+}
+
+func (t *Tunnel) connectProxy(ctx context.Context, network string) (net.Conn, *bufio.Reader, error) {
+	dial := t.Dial
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	proxyAddr := t.Proxy.Host
+	if t.Proxy.Port() == "" {
+		port := "80"
+		if t.Proxy.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(t.Proxy.Hostname(), port)
+	}
+	conn, err := dial(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t.Proxy.Scheme == "https" {
+		tlsConfig := t.TLSConfig.Clone()
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = t.Proxy.Hostname()
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		conn = tlsConn
+	}
+	return conn, bufio.NewReader(conn), nil
+}
+
+func (t *Tunnel) sendConnect(ctx context.Context, conn net.Conn, br *bufio.Reader,
+	addr, authHeader string) (*http.Response, error) {
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline {
+		deadline = time.Now().Add(defaultHandshakeTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	defer conn.SetDeadline(time.Time{})
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	req.Header.Set("Proxy-Connection", "Keep-Alive")
+	if authHeader != "" {
+		req.Header.Set("Proxy-Authorization", authHeader)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, fmt.Errorf("failed to send CONNECT to proxy %s: %w", t.Proxy.Host, err)
+	}
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CONNECT response from proxy %s: %w",
+			t.Proxy.Host, err)
+	}
+	return resp, nil
+}
+
+func discardBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDiscardedBody))
+	resp.Body.Close()
+}
+
+// tunnelConn returns data already buffered while reading the CONNECT response
+// before reading from the underlying connection.
+type tunnelConn struct {
+	net.Conn
+	br *bufio.Reader
+}
+
+func newTunnelConn(conn net.Conn, br *bufio.Reader) net.Conn {
+	if br.Buffered() == 0 {
+		return conn
+	}
+	return &tunnelConn{Conn: conn, br: br}
+}
+
+// Read from the buffer first.
+func (c *tunnelConn) Read(b []byte) (int, error) {
+	if c.br.Buffered() > 0 {
+		return c.br.Read(b)
+	}
+	return c.Conn.Read(b)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestMD4(t *testing.T) {
+	g := NewGomegaWithT(t)
+	// Test vectors from RFC 1320.
+	vectors := map[string]string{
+		"":               "31d6cfe0d16ae931b73c59d7e0c089c0",
+		"a":              "bde52cb31de33e46245e05fbdbd6fb24",
+		"abc":            "a448017aaf21d8525fc10ae87aa6729d",
+		"message digest": "d9130a8164549fe818874806e1c7014b",
+		"12345678901234567890123456789012345678901234567890123456789012345678901234567890": "e33b4ddc9c38f2199c3e7b164fcc0536",
+	}
+	for input, expected := range vectors {
+		digest := md4Sum([]byte(input))
+		g.Expect(hex.EncodeToString(digest[:])).To(Equal(expected), input)
+	}
+}
+
+func TestNTLMv2Response(t *testing.T) {
+	g := NewGomegaWithT(t)
+	// Test vectors from MS-NLMP 4.2.4.
+	g.Expect(ntowfV2("User", "Domain", "Password")).To(
+		Equal(unhex("0c868a403bfd7a93a3001ef22ef02e3f")))
+	serverChallenge := unhex("0123456789abcdef")
+	clientChallenge := unhex("aaaaaaaaaaaaaaaa")
+	timestamp := make([]byte, 8)
+	targetInfo := unhex("02000c0044006f006d00610069006e00" +
+		"01000c0053006500720076006500720000000000")
+	ntResp, lmResp := ntlmV2Response("User", "Domain", "Password",
+		serverChallenge, clientChallenge, timestamp, targetInfo)
+	g.Expect(lmResp).To(Equal(unhex(
+		"86c35097ac9cec102554764a57cccc19aaaaaaaaaaaaaaaa")))
+	g.Expect(ntResp[:16]).To(Equal(unhex("68cd0ab851e51c96aabc927bebef6a1c")))
+}
+
+func TestSplitDomain(t *testing.T) {
+	g := NewGomegaWithT(t)
+	domain, user := Credentials{Username: `CORP\alice`}.splitDomain()
+	g.Expect(domain).To(Equal("CORP"))
+	g.Expect(user).To(Equal("alice"))
+	domain, user = Credentials{Username: "bob@corp.example.com"}.splitDomain()
+	g.Expect(domain).To(Equal("corp.example.com"))
+	g.Expect(user).To(Equal("bob"))
+	domain, user = Credentials{Username: "carol"}.splitDomain()
+	g.Expect(domain).To(BeEmpty())
+	g.Expect(user).To(Equal("carol"))
+}
+
+func TestSelectMethod(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(selectMethod([]string{`Basic realm="proxy"`})).To(Equal(MethodBasic))
+	g.Expect(selectMethod([]string{`Basic realm="proxy"`, "NTLM"})).To(Equal(MethodNTLM))
+	g.Expect(selectMethod([]string{"NTLM", "Negotiate", "Basic"})).To(Equal(MethodNegotiate))
+	g.Expect(selectMethod([]string{"Digest realm=x"})).To(Equal(MethodAuto))
+	g.Expect(selectMethod(nil)).To(Equal(MethodAuto))
+}
+
+// fakeProxy accepts CONNECT requests authenticated with either Basic or NTLM
+// and then echoes all data received through the tunnel.
+type fakeProxy struct {
+	listener  net.Listener
+	schemes   []string
+	username  string
+	password  string
+	challenge []byte
+}
+
+func newFakeProxy(t *testing.T, username, password string, schemes ...string) *fakeProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &fakeProxy{
+		listener:  listener,
+		schemes:   schemes,
+		username:  username,
+		password:  password,
+		challenge: unhex("0123456789abcdef"),
+	}
+	go p.serve()
+	t.Cleanup(func() { listener.Close() })
+	return p
+}
+
+func (p *fakeProxy) url() *url.URL {
+	return &url.URL{Scheme: "http", Host: p.listener.Addr().String()}
+}
+
+func (p *fakeProxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		go p.handleConn(conn)
+	}
+}
+
+func (p *fakeProxy) handleConn(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	for {
+		req, err := http.ReadRequest(br)
+		if err != nil || req.Method != http.MethodConnect {
+			return
+		}
+		auth := req.Header.Get("Proxy-Authorization")
+		fields := strings.Fields(auth)
+		if len(fields) != 2 {
+			p.requireAuth(conn, nil)
+			continue
+		}
+		token, _ := base64.StdEncoding.DecodeString(fields[1])
+		switch fields[0] {
+		case "Basic":
+			if string(token) != p.username+":"+p.password {
+				p.requireAuth(conn, nil)
+				continue
+			}
+		case "NTLM", "Negotiate":
+			msgType := binary.LittleEndian.Uint32(token[8:])
+			if msgType == ntlmNegotiateMsg {
+				p.requireAuth(conn, []string{fields[0] + " " +
+					base64.StdEncoding.EncodeToString(p.challengeMessage())})
+				continue
+			}
+			if !p.verifyNTLM(token) {
+				p.requireAuth(conn, nil)
+				continue
+			}
+		default:
+			p.requireAuth(conn, nil)
+			continue
+		}
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		// Echo data through the tunnel.
+		io.Copy(conn, br)
+		return
+	}
+}
+
+func (p *fakeProxy) requireAuth(conn net.Conn, challenges []string) {
+	if challenges == nil {
+		challenges = p.schemes
+	}
+	resp := "HTTP/1.1 407 Proxy Authentication Required\r\n"
+	for _, challenge := range challenges {
+		resp += "Proxy-Authenticate: " + challenge + "\r\n"
+	}
+	resp += "Content-Length: 0\r\n\r\n"
+	conn.Write([]byte(resp))
+}
+
+func (p *fakeProxy) challengeMessage() []byte {
+	msg := make([]byte, 48)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], ntlmChallengeMsg)
+	binary.LittleEndian.PutUint32(msg[20:], ntlmNegotiateFlags)
+	copy(msg[24:], p.challenge)
+	targetInfo := unhex("02000c0044006f006d00610069006e0000000000")
+	binary.LittleEndian.PutUint16(msg[40:], uint16(len(targetInfo)))
+	binary.LittleEndian.PutUint16(msg[42:], uint16(len(targetInfo)))
+	binary.LittleEndian.PutUint32(msg[44:], uint32(len(msg)))
+	return append(msg, targetInfo...)
+}
+
+func (p *fakeProxy) verifyNTLM(msg []byte) bool {
+	field := func(pos int) []byte {
+		length := int(binary.LittleEndian.Uint16(msg[pos:]))
+		offset := int(binary.LittleEndian.Uint32(msg[pos+4:]))
+		return msg[offset : offset+length]
+	}
+	ntResp := field(20)
+	domain, user := Credentials{Username: p.username}.splitDomain()
+	if !bytes.Equal(field(28), toUnicode(domain)) || !bytes.Equal(field(36), toUnicode(user)) {
+		return false
+	}
+	expected := hmacMD5(ntowfV2(user, domain, p.password), p.challenge, ntResp[16:])
+	return bytes.Equal(ntResp[:16], expected)
+}
+
+func dialAndEcho(g *GomegaWithT, tunnel *Tunnel) error {
+	conn, err := tunnel.DialContext(context.Background(), "tcp", "example.com:443")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	g.Expect(err).ToNot(HaveOccurred())
+	reply := make([]byte, 4)
+	_, err = io.ReadFull(conn, reply)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(reply)).To(Equal("ping"))
+	return nil
+}
+
+func TestTunnelBasic(t *testing.T) {
+	g := NewGomegaWithT(t)
+	proxy := newFakeProxy(t, "alice", "secret", `Basic realm="proxy"`)
+	tunnel := &Tunnel{
+		Proxy:       proxy.url(),
+		Method:      MethodAuto,
+		Credentials: Credentials{Username: "alice", Password: "secret"},
+	}
+	g.Expect(dialAndEcho(g, tunnel)).To(Succeed())
+
+	tunnel.Method = MethodBasic
+	g.Expect(dialAndEcho(g, tunnel)).To(Succeed())
+
+	tunnel.Credentials.Password = "wrong"
+	err := dialAndEcho(g, tunnel)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(IsAuthError(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("credentials rejected"))
+}
+
+func TestTunnelNTLM(t *testing.T) {
+	g := NewGomegaWithT(t)
+	proxy := newFakeProxy(t, `CORP\alice`, "secret", "Negotiate", "NTLM")
+	tunnel := &Tunnel{
+		Proxy:       proxy.url(),
+		Method:      MethodAuto,
+		Credentials: Credentials{Username: `CORP\alice`, Password: "secret"},
+	}
+	g.Expect(dialAndEcho(g, tunnel)).To(Succeed())
+
+	tunnel.Method = MethodNTLM
+	g.Expect(dialAndEcho(g, tunnel)).To(Succeed())
+
+	tunnel.Method = MethodNegotiate
+	tunnel.Credentials.Password = "wrong"
+	err := dialAndEcho(g, tunnel)
+	g.Expect(IsAuthError(err)).To(BeTrue())
+}
+
+func TestTunnelWithoutCredentials(t *testing.T) {
+	g := NewGomegaWithT(t)
+	proxy := newFakeProxy(t, "alice", "secret", `Basic realm="proxy"`)
+	tunnel := &Tunnel{Proxy: proxy.url()}
+	err := dialAndEcho(g, tunnel)
+	g.Expect(IsAuthError(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("no credentials are configured"))
+}
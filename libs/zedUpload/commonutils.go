@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 )
 
 const (
@@ -64,6 +65,8 @@ type httpClientWrapper struct {
 	withCerts   bool
 	certs       [][]byte
 	proxy       *url.URL
+	proxyAuth   proxyauth.Method
+	proxyCreds  proxyauth.Credentials
 	withTracing bool
 	tracingOpts []nettrace.TraceOpt
 	// initialization
@@ -101,9 +104,17 @@ func (c *httpClientWrapper) unwrap() (*http.Client, error) {
 				IdleConnTimeout:       idleConnTimeout,
 				TLSHandshakeTimeout:   tlsHandshakeTimeout,
 				ExpectContinueTimeout: expectContinueTimeout,
-				Proxy:                 http.ProxyURL(c.proxy),
 				TLSClientConfig:       tlsConfig,
 			}
+			if tunnel := c.proxyTunnel(); tunnel != nil {
+				cfg.DialTunnel = func(ctx context.Context, dial nettrace.DialFunc,
+					network, address string) (net.Conn, error) {
+					return tunnel.WithDial(proxyauth.DialFunc(dial)).DialContext(
+						ctx, network, address)
+				}
+			} else {
+				cfg.Proxy = http.ProxyURL(c.proxyURL())
+			}
 			traceClient, err := nettrace.NewHTTPClient(cfg, c.tracingOpts...)
 			if err != nil {
 				c.initErr = fmt.Errorf("failed to create HTTP client with tracing: %w", err)
@@ -145,7 +156,7 @@ func (c *httpClientWrapper) unwrap() (*http.Client, error) {
 			}
 			client := &http.Client{
 				Transport: &http.Transport{
-					Proxy:                 http.ProxyURL(c.proxy),
+					Proxy:                 http.ProxyURL(c.proxyURL()),
 					DialContext:           dialer.DialContext,
 					MaxIdleConns:          maxIdleConns,
 					IdleConnTimeout:       idleConnTimeout,
@@ -153,6 +164,11 @@ func (c *httpClientWrapper) unwrap() (*http.Client, error) {
 					ExpectContinueTimeout: expectContinueTimeout,
 				},
 			}
+			if tunnel := c.proxyTunnel(); tunnel != nil {
+				transport := client.Transport.(*http.Transport)
+				transport.Proxy = nil
+				transport.DialContext = tunnel.WithDial(dialer.DialContext).DialContext
+			}
 			if tlsConfig != nil {
 				client.Transport.(*http.Transport).TLSClientConfig = tlsConfig
 			}
@@ -193,6 +209,39 @@ func (c *httpClientWrapper) withProxy(proxy *url.URL) error {
 	return nil
 }
 
+func (c *httpClientWrapper) withProxyAuth(method proxyauth.Method,
+	creds proxyauth.Credentials) error {
+	c.proxyAuth = method
+	c.proxyCreds = creds
+	return nil
+}
+
+// proxyURL returns the proxy URL, with credentials embedded if Basic
+// authentication was requested. Go HTTP transport then sends them inside
+// the Proxy-Authorization header.
+func (c *httpClientWrapper) proxyURL() *url.URL {
+	if c.proxy == nil || c.proxyAuth != proxyauth.MethodBasic || !c.proxyCreds.IsSet() {
+		return c.proxy
+	}
+	proxy := *c.proxy
+	proxy.User = url.UserPassword(c.proxyCreds.Username, c.proxyCreds.Password)
+	return &proxy
+}
+
+// proxyTunnel returns Tunnel to use instead of the transport proxy support,
+// which is not able to perform multi-step (NTLM/Negotiate) authentication.
+// Returns nil if the transport proxy support is sufficient.
+func (c *httpClientWrapper) proxyTunnel() *proxyauth.Tunnel {
+	if c.proxy == nil || c.proxyAuth == proxyauth.MethodBasic || !c.proxyCreds.IsSet() {
+		return nil
+	}
+	return &proxyauth.Tunnel{
+		Proxy:       c.proxy,
+		Method:      c.proxyAuth,
+		Credentials: c.proxyCreds,
+	}
+}
+
 func (c *httpClientWrapper) withNetTracing(opts ...nettrace.TraceOpt) error {
 	c.tracingOpts = opts
 	c.withTracing = true
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 	"github.com/sirupsen/logrus"
 )
@@ -59,6 +60,7 @@ type DronaEndPoint interface {
 	WithSrcIP(localAddr net.IP) error
 	WithTrustedCerts(certs [][]byte) error
 	WithProxy(proxy *url.URL) error
+	WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error
 	WithBindIntf(intf string) error
 	WithLogging(onoff bool) error
 	WithNetTracing(opts ...nettrace.TraceOpt) error
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	zedAWS "github.com/lf-edge/eve/libs/zedUpload/awsutil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -83,6 +84,11 @@ func (ep *AwsTransportMethod) WithProxy(proxy *url.URL) error {
 	return ep.hClientWrap.withProxy(proxy)
 }
 
+// WithProxyAuth : authenticate with the proxy using the given method and credentials.
+func (ep *AwsTransportMethod) WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error {
+	return ep.hClientWrap.withProxyAuth(method, creds)
+}
+
 // WithTrustedCerts : run requests with these certificates added as trusted.
 func (ep *AwsTransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return ep.hClientWrap.withTrustedCerts(certs)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	azure "github.com/lf-edge/eve/libs/zedUpload/azureutil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -80,6 +81,11 @@ func (ep *AzureTransportMethod) WithProxy(proxy *url.URL) error {
 	return ep.hClientWrap.withProxy(proxy)
 }
 
+// WithProxyAuth : authenticate with the proxy using the given method and credentials.
+func (ep *AzureTransportMethod) WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error {
+	return ep.hClientWrap.withProxyAuth(method, creds)
+}
+
 // WithTrustedCerts : run requests with these certificates added as trusted.
 func (ep *AzureTransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return ep.hClientWrap.withTrustedCerts(certs)
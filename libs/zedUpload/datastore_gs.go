@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	zedGS "github.com/lf-edge/eve/libs/zedUpload/gsutil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -77,6 +78,11 @@ func (ep *GsTransportMethod) WithProxy(proxy *url.URL) error {
 	return ep.hClientWrap.withProxy(proxy)
 }
 
+// WithProxyAuth : authenticate with the proxy using the given method and credentials.
+func (ep *GsTransportMethod) WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error {
+	return ep.hClientWrap.withProxyAuth(method, creds)
+}
+
 // WithTrustedCerts : run requests with these certificates added as trusted.
 func (ep *GsTransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return ep.hClientWrap.withTrustedCerts(certs)
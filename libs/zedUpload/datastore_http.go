@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	zedHttp "github.com/lf-edge/eve/libs/zedUpload/httputil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -77,6 +78,11 @@ func (ep *HttpTransportMethod) WithProxy(proxy *url.URL) error {
 	return ep.hClientWrap.withProxy(proxy)
 }
 
+// WithProxyAuth : authenticate with the proxy using the given method and credentials.
+func (ep *HttpTransportMethod) WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error {
+	return ep.hClientWrap.withProxyAuth(method, creds)
+}
+
 // WithTrustedCerts : run requests with these certificates added as trusted.
 func (ep *HttpTransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return ep.hClientWrap.withTrustedCerts(certs)
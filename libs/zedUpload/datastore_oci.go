@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	ociutil "github.com/lf-edge/eve/libs/zedUpload/ociutil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -89,6 +90,11 @@ func (ep *OCITransportMethod) WithProxy(proxy *url.URL) error {
 	return ep.hClientWrap.withProxy(proxy)
 }
 
+// WithProxyAuth : authenticate with the proxy using the given method and credentials.
+func (ep *OCITransportMethod) WithProxyAuth(method proxyauth.Method, creds proxyauth.Credentials) error {
+	return ep.hClientWrap.withProxyAuth(method, creds)
+}
+
 // WithTrustedCerts : run requests with these certificates added as trusted.
 func (ep *OCITransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return ep.hClientWrap.withTrustedCerts(certs)
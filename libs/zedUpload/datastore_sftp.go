@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	sftp "github.com/lf-edge/eve/libs/zedUpload/sftputil"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
@@ -93,6 +94,12 @@ func (ep *SftpTransportMethod) WithProxy(proxy *url.URL) error {
 	return fmt.Errorf("not supported")
 }
 
+// WithProxyAuth is not supported.
+func (ep *SftpTransportMethod) WithProxyAuth(method proxyauth.Method,
+	creds proxyauth.Credentials) error {
+	return fmt.Errorf("not supported")
+}
+
 // WithTrustedCerts is not supported.
 func (ep *SftpTransportMethod) WithTrustedCerts(certs [][]byte) error {
 	return fmt.Errorf("not supported")
@@ -64,7 +64,9 @@ func initOpts() {
 		"connectivity",
 		"flow",
 		"if",
+		"lldp",
 		"mdns",
+		"netdump",
 		"nslookup",
 		"showcerts",
 		"ping",
@@ -87,6 +89,7 @@ func initOpts() {
 		"newlogd",
 		"nim",
 		"nodeagent",
+		"timesync",
 		"tpmmgr",
 		"vaultmgr",
 		"volumemgr",
@@ -130,6 +133,7 @@ func initOpts() {
 		"usb",
 		"tar",
 		"techsupport",
+		"timesync",
 		"top",
 		"volume",
 		"pprof",
@@ -621,11 +625,20 @@ func printHelp(opt string) {
 		case "if":
 			helpOn("if[/intf-name]", "display interface related information briefly")
 			helpExample("if/eth0", "display interface eth0 related information", true)
+		case "lldp":
+			helpOn("lldp[/intf-name]", "display network devices connected to the ports, discovered using LLDP/CDP")
+			helpExample("lldp", "display LLDP/CDP neighbors of all device ports", true)
+			helpExample("lldp/eth0", "display LLDP/CDP neighbors of port eth0", false)
 		case "mdns":
 			helpOn("mdns[/intf-name][/service]", "display zeroconfig related information")
 			helpExample("mdns/eth0", "display mDNS for default service 'workstation' on interface 'eth0'", true)
 			helpExample("mdns/bn1/https", "display mDNS for service 'https' on bridge 'bn1'", false)
 			helpExample("mdns", "display mDNS for default service 'workstation' on all UP interafces", false)
+		case "netdump":
+			helpOn("netdump[/host:port[/port-labels][/datastore-uuid]]", "collect HTTP/TLS/DNS trace with packet capture towards the target, duration set with -time, default is 30 sec")
+			helpExample("netdump/zedcloud.local.zededa.net:443", "trace connections to the controller over all management ports", true)
+			helpExample("netdump/10.1.0.2:8080/ethernet0/<datastore-uuid> -time 60", "trace connections via port ethernet0 for 60 seconds and upload the netdump into the datastore", false)
+			helpExample("netdump", "display status of netdump requests submitted from edge-view", false)
 		case "nslookup":
 			helpOn("nslookup[/<ip or name>]", "display domain name and dns server information")
 			helpExample("nslookup/www.amazon.com", "display DNS information on www.amazon.com", true)
@@ -702,6 +715,8 @@ func printHelp(opt string) {
 		case "ps":
 			helpOn("ps/<string>", "display the process status information on matching string")
 			helpExample("ps/containerd", "display the processes with name of containerd", true)
+		case "timesync":
+			helpOn("timesync", "display the device clock synchronization status, the offset and the state of NTP/NTS/PTP time sources")
 		case "top":
 			helpOn("top", "display linux 'top' in one batch")
 			helpExample("top -line 20", "display the first 20 lines of linux 'top' output", true)
@@ -32,6 +32,7 @@ var (
 	trigPubchan chan bool
 	rePattern   *regexp.Regexp
 	evStatus    types.EdgeviewStatus
+	netDumpPub  pubsub.Publication
 )
 
 const (
@@ -299,6 +300,7 @@ func main() {
 			log.Noticef("edgeview exit, initpub, instid %d", edgeviewInstID)
 			return
 		}
+		netDumpPub = initpubNetDump(logger)
 
 		// send keepalive to prevent nginx reverse-proxy timeout
 		go sendKeepalive()
@@ -542,6 +544,30 @@ func initpubInfo(logger *logrus.Logger) pubsub.Publication {
 	return infoPub
 }
 
+// initpubNetDump creates publication for on-demand netdump requests,
+// which are executed by downloader. Only the first instance can publish.
+func initpubNetDump(logger *logrus.Logger) pubsub.Publication {
+	if edgeviewInstID > 1 {
+		return nil
+	}
+	ps := *pubsub.New(&socketdriver.SocketDriver{Logger: logger, Log: log}, logger, log)
+	pub, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: NetDumpRequest{},
+		})
+	if err != nil {
+		log.Errorf("netdumppub: pubsub create error: %v", err)
+		return nil
+	}
+	err = pub.ClearRestarted()
+	if err != nil {
+		log.Errorf("netdumppub: pubsub clear restart error: %v", err)
+		return nil
+	}
+	return pub
+}
+
 func sendKeepalive() {
 	ticker := time.NewTicker(90 * time.Second)
 	for {
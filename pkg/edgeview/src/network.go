@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
@@ -119,6 +120,10 @@ func runNetwork(netw string) {
 			getFlow(substring)
 		} else if opt == "mdns" {
 			runmDNS(substring)
+		} else if opt == "lldp" {
+			showNeighbors(substring)
+		} else if opt == "netdump" {
+			runNetDump(substring)
 		} else if opt == "tcp" { // tcp and proxy are special
 			setAndStartProxyTCP(substring)
 		} else if opt == "showcerts" {
@@ -733,6 +738,87 @@ func showIntf(substring string, intfip []intfIP) {
 	getPortCfg(substring, true)
 	printTitle(" proxy:", colorCYAN, false)
 	getProxy(true)
+	showWifiStatus(substring)
+}
+
+// wlanStatus and wlanMetrics mirror the content of status.json and metrics.json
+// published by the wlan service under /run/wlan.
+type wlanStatus struct {
+	Networks []struct {
+		Interface       string `json:"interface"`
+		State           string `json:"state"`
+		SSID            string `json:"ssid"`
+		BSSID           string `json:"bssid"`
+		Frequency       uint32 `json:"frequency"`
+		KeyMgmt         string `json:"key-mgmt"`
+		AuthFailures    uint32 `json:"auth-failures"`
+		LastAuthFailure string `json:"last-auth-failure"`
+		ScanResults     []struct {
+			SSID string `json:"ssid"`
+		} `json:"scan-results"`
+	} `json:"networks"`
+}
+
+type wlanMetrics struct {
+	Networks []struct {
+		Interface  string `json:"interface"`
+		SignalInfo struct {
+			RSSI        int32 `json:"rssi"`
+			Noise       int32 `json:"noise"`
+			LinkQuality int32 `json:"link-quality"`
+		} `json:"signal-info"`
+		TxBitrate uint32 `json:"tx-bitrate"`
+	} `json:"networks"`
+}
+
+func showWifiStatus(substring string) {
+	retbytes, err := os.ReadFile("/run/wlan/status.json")
+	if err != nil {
+		return
+	}
+	var status wlanStatus
+	if err = json.Unmarshal(retbytes, &status); err != nil || len(status.Networks) == 0 {
+		return
+	}
+	var metrics wlanMetrics
+	retbytes, err = os.ReadFile("/run/wlan/metrics.json")
+	if err == nil {
+		_ = json.Unmarshal(retbytes, &metrics)
+	}
+	printTitle(" wifi:", colorCYAN, false)
+	for _, n := range status.Networks {
+		if substring != "" && !strings.Contains(n.Interface, substring) {
+			continue
+		}
+		fmt.Printf("%s: state %s, ssid %q, bssid %s, freq %d MHz, key-mgmt %s\n",
+			n.Interface, n.State, n.SSID, n.BSSID, n.Frequency, n.KeyMgmt)
+		for _, m := range metrics.Networks {
+			if m.Interface != n.Interface {
+				continue
+			}
+			fmt.Printf("  signal: rssi %s, noise %s, link-quality %s, tx-bitrate %d kbps\n",
+				wifiMetricStr(m.SignalInfo.RSSI, " dBm"), wifiMetricStr(m.SignalInfo.Noise, " dBm"),
+				wifiMetricStr(m.SignalInfo.LinkQuality, "%"), m.TxBitrate)
+		}
+		if n.AuthFailures > 0 {
+			fmt.Printf("  auth failures: %d, last: %s\n", n.AuthFailures, n.LastAuthFailure)
+		}
+		var ssids []string
+		for _, ap := range n.ScanResults {
+			if ap.SSID != "" {
+				ssids = append(ssids, ap.SSID)
+			}
+		}
+		fmt.Printf("  scanned networks: %s\n", strings.Join(ssids, ", "))
+	}
+}
+
+func wifiMetricStr(value int32, unit string) string {
+	// the maximum value of int32 represents unavailable metric
+	if value == math.MaxInt32 {
+		return "n/a"
+	}
+	return strconv.Itoa(int(value)) + unit
 }
 
 func showAppDetail(substring string) {
@@ -1166,6 +1252,183 @@ func runTCPDump(intfStat []intfIP, subStr string) {
 	}
 }
 
+// portNeighbors : subset of NetworkPortStatus with neighbors discovered
+// by NIM using LLDP/CDP.
+type portNeighbors struct {
+	IfName       string
+	Logicallabel string
+	Neighbors    []struct {
+		Protocol        uint8
+		ChassisID       string
+		PortID          string
+		PortDescription string
+		SystemName      string
+		VlanID          uint16
+		MgmtAddrs       []net.IP
+	}
+}
+
+func showNeighbors(intf string) {
+	retbytes, err := os.ReadFile("/run/nim/DeviceNetworkStatus/global.json")
+	if err != nil {
+		fmt.Printf("error: %v\n", err)
+		return
+	}
+	var dns struct {
+		Ports []portNeighbors
+	}
+	if err = json.Unmarshal(retbytes, &dns); err != nil {
+		fmt.Printf("error: %v\n", err)
+		return
+	}
+	protocols := map[uint8]string{1: "LLDP", 2: "CDP"}
+	for _, port := range dns.Ports {
+		if intf != "" && !strings.Contains(port.IfName, intf) {
+			continue
+		}
+		printTitle(fmt.Sprintf(" port %s (%s)", port.IfName, port.Logicallabel), colorCYAN, false)
+		if len(port.Neighbors) == 0 {
+			fmt.Printf("  no neighbors discovered\n")
+			continue
+		}
+		for _, n := range port.Neighbors {
+			fmt.Printf("  %s neighbor: %s\n", protocols[n.Protocol], n.SystemName)
+			fmt.Printf("    chassis ID: %s, port ID: %s\n", n.ChassisID, n.PortID)
+			if n.PortDescription != "" {
+				fmt.Printf("    port description: %s\n", n.PortDescription)
+			}
+			if n.VlanID != 0 {
+				fmt.Printf("    VLAN: %d\n", n.VlanID)
+			}
+			if len(n.MgmtAddrs) > 0 {
+				fmt.Printf("    management addresses: %v\n", n.MgmtAddrs)
+			}
+		}
+	}
+}
+
+// NetDumpRequest : on-demand netdump request executed by downloader.
+// Mirrors types.NetDumpRequest of pillar, the type name is used as the pubsub topic.
+type NetDumpRequest struct {
+	RequestID   string
+	Source      uint8
+	Target      string
+	Duration    time.Duration
+	Interfaces  []string
+	DatastoreID uuid.UUID
+}
+
+// Key is used for pubsub
+func (req NetDumpRequest) Key() string {
+	return req.RequestID
+}
+
+// netDumpStatus : subset of types.NetDumpStatus published by downloader.
+type netDumpStatus struct {
+	RequestID      string
+	Source         uint8
+	State          uint8
+	Filename       string
+	UploadLocation string
+	Error          string
+}
+
+const (
+	netDumpSourceEdgeview = 3
+	netDumpStatusDir      = "/persist/status/downloader/NetDumpStatus/"
+)
+
+// runNetDump submits netdump request to downloader in the format of
+// <host:port>[/<port-labels>[/<datastore-uuid>]], or displays status of the
+// edgeview requests if the target is not specified.
+func runNetDump(subStr string) {
+	if subStr == "" {
+		showNetDumpStatus()
+		return
+	}
+	if netDumpPub == nil {
+		fmt.Printf("netdump request can only be submitted from the first edgeview instance\n")
+		return
+	}
+	subs := strings.Split(subStr, "/")
+	if len(subs) > 3 {
+		fmt.Printf("netdump command has too many options\n")
+		return
+	}
+	req := NetDumpRequest{
+		RequestID: fmt.Sprintf("edgeview-%d", time.Now().Unix()),
+		Source:    netDumpSourceEdgeview,
+		Target:    subs[0],
+	}
+	if _, _, err := net.SplitHostPort(req.Target); err != nil {
+		fmt.Printf("netdump target has to be host:port: %v\n", err)
+		return
+	}
+	if cmdTimeout != "" {
+		timeSec, err := strconv.Atoi(cmdTimeout)
+		if err != nil {
+			fmt.Printf("time option has to be seconds: %v\n", err)
+			return
+		}
+		req.Duration = time.Duration(timeSec) * time.Second
+	}
+	if len(subs) > 1 && subs[1] != "" {
+		req.Interfaces = strings.Split(subs[1], ",")
+	}
+	if len(subs) > 2 && subs[2] != "" {
+		dsID, err := uuid.FromString(subs[2])
+		if err != nil {
+			fmt.Printf("invalid datastore UUID: %v\n", err)
+			return
+		}
+		req.DatastoreID = dsID
+	}
+	// Only the most recent request is kept published.
+	for key := range netDumpPub.GetAll() {
+		if err := netDumpPub.Unpublish(key); err != nil {
+			log.Errorf("runNetDump: unpublish %s error: %v", key, err)
+		}
+	}
+	if err := netDumpPub.Publish(req.Key(), req); err != nil {
+		fmt.Printf("netdump request publish error: %v\n", err)
+		return
+	}
+	fmt.Printf("netdump request %s submitted, run 'netdump' to check the status\n", req.RequestID)
+}
+
+func showNetDumpStatus() {
+	states := map[uint8]string{1: "pending", 2: "running", 3: "uploading",
+		4: "completed", 5: "failed"}
+	files, err := os.ReadDir(netDumpStatusDir)
+	if err != nil {
+		fmt.Printf("no netdump requests found\n")
+		return
+	}
+	for _, file := range files {
+		retbytes, err := os.ReadFile(netDumpStatusDir + file.Name())
+		if err != nil {
+			continue
+		}
+		var status netDumpStatus
+		if err = json.Unmarshal(retbytes, &status); err != nil {
+			continue
+		}
+		if status.Source != netDumpSourceEdgeview {
+			continue
+		}
+		printTitle(fmt.Sprintf(" request %s: %s", status.RequestID, states[status.State]), colorCYAN, false)
+		if status.Filename != "" {
+			fmt.Printf("  netdump: %s\n", status.Filename)
+		}
+		if status.UploadLocation != "" {
+			fmt.Printf("  uploaded to: %s\n", status.UploadLocation)
+		}
+		if status.Error != "" {
+			fmt.Printf("  error: %s\n", status.Error)
+		}
+	}
+}
+
 func runWireless() {
 	err := addPackage("/usr/sbin/iwconfig", "wireless-tools")
 	if err != nil {
@@ -1196,6 +1459,24 @@ func runWireless() {
 		}
 	}
 
+	printTitle("\n wlan status", colorCYAN, false)
+	retbytes, err = os.ReadFile("/run/wlan/status.json")
+	if err == nil {
+		prettyJSON, err := formatJSON(retbytes)
+		if err == nil {
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	printTitle("\n wlan metrics", colorCYAN, false)
+	retbytes, err = os.ReadFile("/run/wlan/metrics.json")
+	if err == nil {
+		prettyJSON, err := formatJSON(retbytes)
+		if err == nil {
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
 	printTitle("\n wwan config", colorCYAN, false)
 	retbytes, err = os.ReadFile("/run/wwan/config.json")
 	if err != nil {
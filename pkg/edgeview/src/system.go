@@ -89,6 +89,8 @@ func runSystem(cmds cmdOpt, sysOpt string) {
 			getHW()
 		} else if strings.HasPrefix(opt, "top") {
 			getTop(cmds.Extraline)
+		} else if opt == "timesync" {
+			getTimeSync()
 		} else if strings.HasPrefix(opt, "lastreboot") {
 			getLastReboot()
 		} else if strings.HasPrefix(opt, "techsupport") {
@@ -1230,11 +1232,11 @@ func runTechSupport(cmds cmdOpt, isLocal bool) {
 	closePipe(true)
 
 	printTitle("\n       - system info -\n\n", colorRED, false)
-	runSystem(cmds, "hw,model,pci,usb,lastreboot,newlog,volume,app,datastore,cipher,dmesg,configitem")
+	runSystem(cmds, "hw,model,pci,usb,lastreboot,newlog,volume,app,datastore,cipher,timesync,dmesg,configitem")
 	closePipe(true)
 
 	printTitle("\n       - pub/sub info -\n\n", colorRED, false)
-	runPubsub("nim,domainmgr,nodeagent,baseosmgr,tpmmgr,global,vaultmgr,volumemgr,zedagent,zedmanager,zedrouter,zedclient,zfsmanager,edgeview,watcher")
+	runPubsub("nim,domainmgr,nodeagent,baseosmgr,tpmmgr,global,vaultmgr,volumemgr,zedagent,zedmanager,zedrouter,zedclient,zfsmanager,timesync,edgeview,watcher")
 
 	printTitle("\n       - Done Tech-Support -\n\n", colorYELLOW, false)
 	closePipe(true)
@@ -1383,3 +1385,55 @@ func getDevMemStats() {
 func bToMb(b uint64) uint64 {
 	return b / 1024 / 1024
 }
+
+// timeSyncSource : subset of types.TimeSourceStatus published by timesync.
+type timeSyncSource struct {
+	Address   string        `json:"address"`
+	Type      string        `json:"type"`
+	State     string        `json:"state"`
+	Stratum   uint8         `json:"stratum"`
+	Offset    time.Duration `json:"offset-ns"`
+	NTSFailed bool          `json:"nts-failed"`
+}
+
+// timeSyncStatus : subset of types.TimeSyncStatus published by timesync.
+type timeSyncStatus struct {
+	Synchronized bool             `json:"synchronized"`
+	Source       string           `json:"source"`
+	SourceType   string           `json:"source-type"`
+	Stratum      uint8            `json:"stratum"`
+	Offset       time.Duration    `json:"offset-ns"`
+	LastSync     time.Time        `json:"last-sync"`
+	Sources      []timeSyncSource `json:"sources"`
+	Error        string           `json:"error"`
+}
+
+func getTimeSync() {
+	retbytes, err := os.ReadFile("/run/timesync/TimeSyncStatus/global.json")
+	if err != nil {
+		fmt.Printf("time sync status is not available\n")
+		return
+	}
+	var status timeSyncStatus
+	if err = json.Unmarshal(retbytes, &status); err != nil {
+		fmt.Printf("failed to parse time sync status: %v\n", err)
+		return
+	}
+	printTitle(" time sync status", colorCYAN, false)
+	fmt.Printf("  synchronized: %v\n", status.Synchronized)
+	if status.Synchronized {
+		fmt.Printf("  source: %s (%s), stratum %d\n", status.Source, status.SourceType, status.Stratum)
+		fmt.Printf("  offset: %v, last sync: %v\n", status.Offset, status.LastSync)
+	}
+	if status.Error != "" {
+		fmt.Printf("  error: %s\n", status.Error)
+	}
+	for _, source := range status.Sources {
+		fmt.Printf("  - %s: type %s, state %s, stratum %d, offset %v",
+			source.Address, source.Type, source.State, source.Stratum, source.Offset)
+		if source.NTSFailed {
+			fmt.Printf(", NTS failed")
+		}
+		fmt.Printf("\n")
+	}
+}
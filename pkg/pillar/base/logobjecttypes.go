@@ -100,6 +100,8 @@ const (
 	AppAndImageToHashLogType LogObjectType = "app_and_image_to_hash"
 	// AppContainerMetricsLogType:
 	AppContainerMetricsLogType LogObjectType = "app_container_metric"
+	// AppPrometheusMetricsLogType:
+	AppPrometheusMetricsLogType LogObjectType = "app_prometheus_metric"
 	// AssignableAdaptersLogType:
 	AssignableAdaptersLogType LogObjectType = "assignable_adapters"
 	// PhysicalIOAdapterListLogType:
@@ -140,6 +142,8 @@ const (
 	WwanMetricsLogType LogObjectType = "wwan_metrics"
 	// WwanLocationInfoLogType:
 	WwanLocationInfoLogType LogObjectType = "wwan_location_info"
+	// WlanMetricsLogType:
+	WlanMetricsLogType LogObjectType = "wlan_metrics"
 	// NetworkXObjectConfigLogType:
 	NetworkXObjectConfigLogType LogObjectType = "network_x_object"
 	// UUIDToNumLogType:
@@ -164,6 +168,14 @@ const (
 	EncryptedVaultKeyFromDeviceLogType LogObjectType = "encrypted_vault_key_from_device"
 	// EncryptedVaultKeyFromControllerLogType:
 	EncryptedVaultKeyFromControllerLogType LogObjectType = "encrypted_vault_key_from_controller"
+	// NetDumpRequestLogType:
+	NetDumpRequestLogType LogObjectType = "netdump_request"
+	// NetDumpStatusLogType:
+	NetDumpStatusLogType LogObjectType = "netdump_status"
+	// TimeSyncConfigLogType:
+	TimeSyncConfigLogType LogObjectType = "timesync_config"
+	// TimeSyncStatusLogType:
+	TimeSyncStatusLogType LogObjectType = "timesync_status"
 )
 
 // RelationObjectType :
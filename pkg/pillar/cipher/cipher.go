@@ -25,6 +25,20 @@ func getEncryptionBlock(
 	decBlock.WifiUserName = zconfigDecBlockPtr.WifiUserName
 	decBlock.WifiPassword = zconfigDecBlockPtr.WifiPassword
 	decBlock.ProtectedUserData = zconfigDecBlockPtr.ProtectedUserData
+	decBlock.CellularNetUsername = zconfigDecBlockPtr.CellularNetUsername
+	decBlock.CellularNetPassword = zconfigDecBlockPtr.CellularNetPassword
+	decBlock.CellularSimPin = zconfigDecBlockPtr.CellularSimPin
+	decBlock.PortAuthUsername = zconfigDecBlockPtr.PortAuthUsername
+	decBlock.PortAuthPassword = zconfigDecBlockPtr.PortAuthPassword
+	decBlock.ProxyUsername = zconfigDecBlockPtr.ProxyUsername
+	decBlock.ProxyPassword = zconfigDecBlockPtr.ProxyPassword
+	for _, secret := range zconfigDecBlockPtr.AppSecrets {
+		decBlock.AppSecrets = append(decBlock.AppSecrets, types.AppSecret{
+			Name:    secret.GetName(),
+			Value:   secret.GetValue(),
+			EnvName: secret.GetEnvName(),
+		})
+	}
 	return decBlock
 }
 
@@ -71,6 +85,25 @@ func GetCipherCredentials(ctx *DecryptCipherContext,
 	return *cipherBlock, decBlock, err
 }
 
+// GetAppSecrets : decrypt and validate secrets of an app instance.
+// The returned status of the cipher block should be published by the caller.
+func GetAppSecrets(ctx *DecryptCipherContext,
+	status types.CipherBlockStatus) (types.CipherBlockStatus, []types.AppSecret, error) {
+	if !status.IsCipher {
+		return status, nil, nil
+	}
+	cipherBlock, decBlock, err := GetCipherCredentials(ctx, status)
+	if err != nil {
+		ctx.AgentMetrics.RecordFailure(ctx.Log, types.MissingFallback)
+		return cipherBlock, nil, fmt.Errorf(
+			"secrets cipherblock decryption unsuccessful: %w", err)
+	}
+	if err := types.ValidateAppSecrets(decBlock.AppSecrets); err != nil {
+		return cipherBlock, nil, err
+	}
+	return cipherBlock, decBlock.AppSecrets, nil
+}
+
 // GetCipherData : decrypt plain text
 func GetCipherData(ctx *DecryptCipherContext, status types.CipherBlockStatus,
 	data *string) (types.CipherBlockStatus, *string, error) {
@@ -54,6 +54,7 @@ type diagContext struct {
 	forever                 bool // Keep on reporting until ^C
 	pacContents             bool // Print PAC file contents
 	radioSilence            bool
+	captivePortal           bool
 	ledCounter              types.LedBlinkCount
 	derivedLedCounter       types.LedBlinkCount // Based on ledCounter + usableAddressCount
 	subGlobalConfig         pubsub.Subscription
@@ -380,7 +381,7 @@ func handleLedBlinkImpl(ctxArg interface{}, key string,
 	}
 	ctx.ledCounter = config.BlinkCounter
 	ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-		ctx.usableAddressCount, ctx.radioSilence)
+		ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 	log.Functionf("counter %d usableAddr %d, derived %d",
 		ctx.ledCounter, ctx.usableAddressCount, ctx.derivedLedCounter)
 	// XXX wait in case we get another handle call?
@@ -422,12 +423,13 @@ func handleDNSImpl(ctxArg interface{}, key string,
 	*ctx.DeviceNetworkStatus = status
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(*ctx.DeviceNetworkStatus)
 	log.Functionf("handleDNSImpl %d usable addresses", newAddrCount)
+	captivePortalChanged := updateCaptivePortal(ctx, ctx.DeviceNetworkStatus)
 	if (ctx.usableAddressCount == 0 && newAddrCount != 0) ||
 		(ctx.usableAddressCount != 0 && newAddrCount == 0) ||
-		updateRadioSilence(ctx, ctx.DeviceNetworkStatus) {
+		updateRadioSilence(ctx, ctx.DeviceNetworkStatus) || captivePortalChanged {
 		ctx.usableAddressCount = newAddrCount
 		ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-			ctx.usableAddressCount, ctx.radioSilence)
+			ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 		log.Functionf("counter %d, usableAddr %d, radioSilence %t, derived %d",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 	}
@@ -461,12 +463,13 @@ func handleDNSDelete(ctxArg interface{}, key string,
 	*ctx.DeviceNetworkStatus = types.DeviceNetworkStatus{}
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(*ctx.DeviceNetworkStatus)
 	log.Functionf("handleDNSDelete %d usable addresses", newAddrCount)
+	captivePortalChanged := updateCaptivePortal(ctx, ctx.DeviceNetworkStatus)
 	if (ctx.usableAddressCount == 0 && newAddrCount != 0) ||
 		(ctx.usableAddressCount != 0 && newAddrCount == 0) ||
-		updateRadioSilence(ctx, ctx.DeviceNetworkStatus) {
+		updateRadioSilence(ctx, ctx.DeviceNetworkStatus) || captivePortalChanged {
 		ctx.usableAddressCount = newAddrCount
 		ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-			ctx.usableAddressCount, ctx.radioSilence)
+			ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 		log.Functionf("counter %d, usableAddr %d, radioSilence %t, derived %d",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 	}
@@ -476,6 +479,14 @@ func handleDNSDelete(ctxArg interface{}, key string,
 	log.Functionf("handleDNSDelete done for %s", key)
 }
 
+// updateCaptivePortal returns true if captive portal detection has changed.
+func updateCaptivePortal(ctx *diagContext, status *types.DeviceNetworkStatus) (update bool) {
+	captivePortal := status != nil && status.CaptivePortalDetected()
+	update = ctx.captivePortal != captivePortal
+	ctx.captivePortal = captivePortal
+	return update
+}
+
 func updateRadioSilence(ctx *diagContext, status *types.DeviceNetworkStatus) (update bool) {
 	if status == nil {
 		// by default radio-silence is turned off
@@ -670,6 +681,31 @@ func printOutput(ctx *diagContext) {
 			fmt.Fprintf(outfile, "%s, ", ds.String())
 		}
 		fmt.Fprintf(outfile, "\n")
+		if resolver := port.DNSResolver; !resolver.LastUsed.IsZero() {
+			if resolver.Protocol != types.EncryptedDNSNone {
+				fmt.Fprintf(outfile, "INFO: %s: DNS resolver: %s (%v)\n",
+					ifname, resolver.Server, resolver.Protocol)
+			} else if resolver.Fallback {
+				fmt.Fprintf(outfile, "WARNING: %s: DNS resolver: %s (fallback to plain DNS)\n",
+					ifname, resolver.Server)
+			}
+			if resolver.EncryptedDNSError != "" {
+				fmt.Fprintf(outfile, "WARNING: %s: Encrypted DNS error: %s\n",
+					ifname, resolver.EncryptedDNSError)
+			}
+		}
+		if portal := port.CaptivePortal; portal.Detected() {
+			fmt.Fprintf(outfile, "ERROR: %s: Captive portal detected (%v)\n",
+				ifname, portal.Evidence)
+			if portal.URL != "" {
+				fmt.Fprintf(outfile, "ERROR: %s: Open %s in a web browser to complete the captive portal\n",
+					ifname, portal.URL)
+			}
+			if portal.CertIssuer != "" {
+				fmt.Fprintf(outfile, "ERROR: %s: Controller TLS connection intercepted with certificate issued by %s\n",
+					ifname, portal.CertIssuer)
+			}
+		}
 		// If static print static config
 		if port.Dhcp == types.DT_STATIC {
 			fmt.Fprintf(outfile, "INFO: %s: Static IP subnet: %s\n",
@@ -769,6 +805,19 @@ func printProxy(ctx *diagContext, port types.NetworkPortStatus,
 		fmt.Fprintf(outfile, "ERROR: %s: from WPAD? %s\n",
 			ifname, port.LastError)
 	}
+	if port.ProxyConfig.AuthCipherBlock.IsCipher {
+		if port.ProxyConfig.Credentials == nil {
+			fmt.Fprintf(outfile, "ERROR: %s: failed to decrypt proxy credentials\n",
+				ifname)
+		} else {
+			fmt.Fprintf(outfile, "INFO: %s: proxy authentication (%s) as %s\n",
+				ifname, port.ProxyConfig.AuthMethod,
+				port.ProxyConfig.Credentials.Username)
+		}
+	}
+	if port.HasError() && port.ProxyAuthFailed {
+		fmt.Fprintf(outfile, "ERROR: %s: proxy authentication failed\n", ifname)
+	}
 	if port.ProxyConfig.NetworkProxyEnable {
 		if port.ProxyConfig.NetworkProxyURL == "" {
 			if port.ProxyConfig.WpadURL == "" {
@@ -30,6 +30,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/cpuallocator"
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
@@ -959,8 +960,22 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			}
 		}
 		status.DomainId = 0
+		status.ContainerStatusList = nil
+		status.GuestAgent = nil
 		publishDomainStatus(ctx, status)
 	} else {
+		if status.Activated && status.State == types.BOOTING &&
+			domainStatus != types.BOOTING {
+			log.Noticef("verifyDomain(%s) domain has booted", status.Key())
+			status.State = types.RUNNING
+			publishDomainStatus(ctx, status)
+		}
+		if updatePodStatus(status) {
+			publishDomainStatus(ctx, status)
+		}
+		if updateGuestAgentInfo(status) {
+			publishDomainStatus(ctx, status)
+		}
 		if !status.Activated && domainStatus == types.RUNNING {
 			log.Warnf("verifyDomain(%s) domain came back alive; id  %d",
 				status.Key(), domainID)
@@ -991,6 +1006,66 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 	}
 }
 
+// updatePodStatus restarts additional containers of the domain according
+// to their restart policy and updates their status.
+// Returns true if the status has changed.
+func updatePodStatus(status *types.DomainStatus) bool {
+	podTask, ok := hypervisor.GetPodTask(hyper.Task(status))
+	if !ok {
+		return false
+	}
+	var containers []types.AppContainerStatus
+	if status.Activated {
+		var err error
+		containers, err = podTask.ReconcilePod(status.DomainName)
+		if err != nil {
+			log.Errorf("updatePodStatus(%s) failed: %v", status.Key(), err)
+			return false
+		}
+	}
+	if cmp.Equal(containers, status.ContainerStatusList) {
+		return false
+	}
+	status.ContainerStatusList = containers
+	return true
+}
+
+// guestAgentRefreshInterval : how often unchanged information reported
+// by the guest agent is republished with a new LastUpdate time
+const guestAgentRefreshInterval = 5 * time.Minute
+
+// updateGuestAgentInfo queries the agent running inside of the guest OS
+// of the domain, if any.
+// Returns true if the status has changed.
+func updateGuestAgentInfo(status *types.DomainStatus) bool {
+	gaTask, ok := hyper.Task(status).(hypervisor.GuestAgentTask)
+	if !ok {
+		return false
+	}
+	var info *types.GuestAgentInfo
+	if status.Activated {
+		var err error
+		info, err = gaTask.GuestAgentInfo(status.DomainName)
+		if err != nil {
+			log.Tracef("updateGuestAgentInfo(%s): %v", status.Key(), err)
+		}
+	}
+	if info != nil && status.GuestAgent != nil &&
+		time.Since(status.GuestAgent.LastUpdate) < guestAgentRefreshInterval {
+		// Ignore the time of the update when comparing
+		unchanged := *info
+		unchanged.LastUpdate = status.GuestAgent.LastUpdate
+		if cmp.Equal(&unchanged, status.GuestAgent) {
+			return false
+		}
+	}
+	if info == nil && status.GuestAgent == nil {
+		return false
+	}
+	status.GuestAgent = info
+	return true
+}
+
 func maybeRetry(ctx *domainContext, status *types.DomainStatus) {
 	maybeRetryConfig(ctx, status)
 	maybeRetryBoot(ctx, status)
@@ -1482,6 +1557,23 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 	}
 	defer file.Close()
 
+	if config.VTPM != types.VmTPMNone {
+		// The state of the virtual TPM is encrypted with a key sealed
+		// into the TPM of the device, there is nothing to protect it without.
+		if !etpm.IsTpmEnabled() {
+			err := fmt.Errorf("virtual TPM requires a TPM on the device")
+			log.Errorf("doActivate(%s): %v", config.Key(), err)
+			status.SetErrorNow(err.Error())
+			return
+		}
+		stateDir := types.AppVTPMStateDir(config.UUIDandVersion.UUID)
+		if err := prepareVTPMState(stateDir, config.PurgeCounter); err != nil {
+			log.Errorf("doActivate(%s): %v", config.Key(), err)
+			status.SetErrorNow(err.Error())
+			return
+		}
+	}
+
 	globalConfig := agentlog.GetGlobalConfig(log, ctx.subGlobalConfig)
 	if err := hyper.Task(status).Setup(*status, config, ctx.assignableAdapters, globalConfig, file); err != nil {
 		log.Errorf("Failed to create DomainStatus from %v: %s",
@@ -1593,7 +1685,12 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 			status.DomainId, status.BootTime.Format(time.RFC3339Nano),
 			status.Key())
 	}
+	if state == types.BOOTING {
+		// init containers of the pod are still running
+		status.State = state
+	}
 	status.Activated = true
+	updatePodStatus(status)
 	log.Functionf("doActivateTail(%v) done for %s",
 		status.UUIDandVersion, status.DisplayName)
 }
@@ -1732,6 +1829,8 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 	} else {
 		status.Activated = false
 		status.State = types.HALTED
+		status.ContainerStatusList = nil
+		removeAppSecrets(status)
 	}
 	// first try to unmount containers without force flag
 	if !unmountContainers(ctx, status.DiskStatusList, false) {
@@ -1833,10 +1932,30 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 
 	log.Functionf("configToStatus(%v) for %s",
 		config.UUIDandVersion, config.DisplayName)
+	// Volumes with images of the additional containers
+	containerVolumes := make(map[string]struct{})
+	if len(config.Containers) > 0 {
+		if _, ok := hypervisor.GetPodTask(hyper.Task(status)); !ok {
+			return fmt.Errorf("additional containers are not supported with virtualization mode %v",
+				status.VirtualizationMode)
+		}
+		if !config.IsOCIContainer() {
+			return fmt.Errorf("additional containers require the first volume to be a container")
+		}
+		for _, container := range config.Containers {
+			containerVolumes[container.VolumeKey] = struct{}{}
+		}
+	}
 	status.DiskStatusList = make([]types.DiskStatus,
 		len(config.DiskConfigList))
 	need9P := false
 	for i, dc := range config.DiskConfigList {
+		if _, ok := containerVolumes[dc.VolumeKey]; ok && i > 0 {
+			if dc.Format != zconfig.Format_CONTAINER {
+				return fmt.Errorf("volume %s of an additional container is not a container",
+					dc.DisplayName)
+			}
+		}
 		ds := &status.DiskStatusList[i]
 		ds.VolumeKey = dc.VolumeKey
 		ds.ReadOnly = dc.ReadOnly
@@ -1854,6 +1973,10 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 			if i == 0 {
 				ds.MountDir = "/"
 				status.OCIConfigDir = ds.FileLocation
+			} else if _, ok := containerVolumes[dc.VolumeKey]; ok {
+				// root filesystem of an additional container,
+				// not mounted into the main container
+				ds.MountDir = "/"
 			}
 			ds.Devtype = ""
 			need9P = true
@@ -1874,6 +1997,7 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 
 	//clean environment variables
 	status.EnvVariables = nil
+	status.IgnitionFile = ""
 
 	if config.IsCipher || config.CloudInitUserData != nil {
 		ciStr, err := fetchCloudInit(ctx, config)
@@ -1890,16 +2014,29 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 			status.EnvVariables = envList
 		} else {
 			switch config.MetaDataType {
-			case types.MetaDataDrive, types.MetaDataDriveMultipart:
+			case types.MetaDataDrive, types.MetaDataDriveMultipart,
+				types.MetaDataNoCloud, types.MetaDataConfigDrive:
 				ds, err := createCloudInitISO(ctx, config, ciStr)
 				if err != nil {
 					return err
 				}
 				status.DiskStatusList = append(status.DiskStatusList, *ds)
+			case types.MetaDataIgnition:
+				fileName, err := createIgnitionConfig(config, ciStr)
+				if err != nil {
+					return err
+				}
+				status.IgnitionFile = fileName
 			}
 		}
 	}
 
+	if config.Activate {
+		if err := prepareAppSecrets(ctx, config, status); err != nil {
+			return fmt.Errorf("failed to prepare secrets: %v", err)
+		}
+	}
+
 	if need9P {
 		status.DiskStatusList = append(status.DiskStatusList, types.DiskStatus{
 			FileLocation: "/mnt",
@@ -2026,6 +2163,10 @@ func handleModify(ctx *domainContext, key string,
 	status.PendingModify = true
 	publishDomainStatus(ctx, status)
 
+	if config.Activate && status.Activated {
+		rotateAppSecrets(ctx, *config, status)
+	}
+
 	changed := false
 	// if a VM has an error status, it should be restarted in the maybeRetryBoot function, not here
 	if config.Activate && !status.Activated && status.State != types.BROKEN && !status.HasError() {
@@ -2224,6 +2365,9 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 		log.Errorln(err)
 	}
 	deleteCloudInitISO(ctx, *status)
+	deleteIgnitionConfig(*status)
+	removeVTPMState(status)
+	removeAppSecrets(status)
 
 	status.PendingDelete = false
 	publishDomainStatus(ctx, status)
@@ -2558,44 +2702,53 @@ func createCloudInitISO(ctx *domainContext,
 	}
 	defer os.RemoveAll(dir)
 
-	didMultipart := false
-	// If we need to help the guest VM we look for MIME multi-part
-	// and use it to lay out the file/directory structure for the ISO
-	// image. Even if set, If the content is not multi-part we treat it
-	// as normal and fill in a user-data file below.
-	if config.MetaDataType == types.MetaDataDriveMultipart ||
-		ctx.processCloudInitMultiPart {
-		didMultipart, err = handleMimeMultipart(dir, ciStr, true)
-		if err != nil {
-			return nil, err
+	volumeID := noCloudVolumeID
+	switch config.MetaDataType {
+	case types.MetaDataNoCloud:
+		if err := writeNoCloudFiles(dir, config, ciStr); err != nil {
+			return nil, fmt.Errorf("createCloudInitISO failed %s", err)
 		}
-	}
-	if !didMultipart {
-		metafile, err := os.Create(dir + "/meta-data")
-		if err != nil {
-			log.Fatalf("createCloudInitISO failed %s", err)
+	case types.MetaDataConfigDrive:
+		volumeID = configDriveVolumeID
+		if err := writeConfigDriveFiles(dir, config, ciStr); err != nil {
+			return nil, fmt.Errorf("createCloudInitISO failed %s", err)
 		}
-		metafile.WriteString(fmt.Sprintf("instance-id: %s/%s\n",
-			config.UUIDandVersion.UUID.String(),
-			getCloudInitVersion(config)))
-		metafile.WriteString(fmt.Sprintf("local-hostname: %s\n",
-			config.UUIDandVersion.UUID.String()))
-		metafile.Close()
-
-		// Handle normal user-data
-		userFileName := "/user-data"
-		if strings.Contains(ciStr, "#junos-config") {
-			userFileName = "/juniper.conf"
+	default:
+		didMultipart := false
+		// If we need to help the guest VM we look for MIME multi-part
+		// and use it to lay out the file/directory structure for the ISO
+		// image. Even if set, If the content is not multi-part we treat it
+		// as normal and fill in a user-data file below.
+		if config.MetaDataType == types.MetaDataDriveMultipart ||
+			ctx.processCloudInitMultiPart {
+			didMultipart, err = handleMimeMultipart(dir, ciStr, true)
+			if err != nil {
+				return nil, err
+			}
 		}
-		userfile, err := os.Create(dir + userFileName)
-		if err != nil {
-			log.Fatalf("createCloudInitISO failed %s", err)
+		if !didMultipart {
+			metafile, err := os.Create(dir + "/meta-data")
+			if err != nil {
+				log.Fatalf("createCloudInitISO failed %s", err)
+			}
+			metafile.WriteString(noCloudMetaData(config))
+			metafile.Close()
+
+			// Handle normal user-data
+			userFileName := "/user-data"
+			if strings.Contains(ciStr, "#junos-config") {
+				userFileName = "/juniper.conf"
+			}
+			userfile, err := os.Create(dir + userFileName)
+			if err != nil {
+				log.Fatalf("createCloudInitISO failed %s", err)
+			}
+			userfile.WriteString(ciStr)
+			userfile.Close()
 		}
-		userfile.WriteString(ciStr)
-		userfile.Close()
 	}
 
-	if err := mkisofs(fileName, dir); err != nil {
+	if err := mkisofs(fileName, dir, volumeID); err != nil {
 		errStr := fmt.Sprintf("createCloudInitISO failed %s", err)
 		return nil, errors.New(errStr)
 	}
@@ -2627,8 +2780,8 @@ func deleteCloudInitISO(ctx *domainContext, status types.DomainStatus) {
 	}
 }
 
-// mkisofs -output %s -volid cidata -joliet -rock %s, fileName, dir
-func mkisofs(output string, dir string) error {
+// mkisofs -output %s -volid %s -joliet -rock %s, fileName, volumeID, dir
+func mkisofs(output string, dir string, volumeID string) error {
 	log.Functionf("mkisofs(%s, %s)", output, dir)
 
 	cmd := "mkisofs"
@@ -2636,7 +2789,7 @@ func mkisofs(output string, dir string) error {
 		"-output",
 		output,
 		"-volid",
-		"cidata",
+		volumeID,
 		"-joliet",
 		"-rock",
 		dir,
@@ -10,6 +10,7 @@ package domainmgr
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -455,3 +458,156 @@ func TestHandleMimeMultipart(t *testing.T) {
 	}
 	os.RemoveAll(dir)
 }
+
+func TestPrepareVTPMState(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	stateDir := filepath.Join(t.TempDir(), "vtpm")
+	stateFile := filepath.Join(stateDir, "tpm2-00.permall")
+
+	// first boot creates the directory
+	assert.NoError(t, prepareVTPMState(stateDir, 1))
+	assert.NoError(t, os.WriteFile(stateFile, []byte("state"), 0600))
+
+	// reboot keeps the state
+	assert.NoError(t, prepareVTPMState(stateDir, 1))
+	assert.FileExists(t, stateFile)
+
+	// purge discards the state
+	assert.NoError(t, prepareVTPMState(stateDir, 2))
+	assert.NoFileExists(t, stateFile)
+	assert.DirExists(t, stateDir)
+	counter, err := os.ReadFile(filepath.Join(stateDir, vtpmPurgeCounterFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(counter))
+}
+
+func TestWriteAppSecrets(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	secretsDir := filepath.Join(t.TempDir(), "app-secrets", "app")
+	readSecret := func(name string) string {
+		content, err := os.ReadFile(filepath.Join(secretsDir, name))
+		assert.NoError(t, err)
+		return string(content)
+	}
+
+	assert.NoError(t, writeAppSecrets("app", secretsDir, []types.AppSecret{
+		{Name: "db-password", Value: []byte("secret1")},
+		{Name: "api.key", Value: []byte("secret2"), EnvName: "API_KEY"},
+	}))
+	assert.Equal(t, "secret1", readSecret("db-password"))
+	assert.Equal(t, "secret2", readSecret("api.key"))
+	info, err := os.Stat(filepath.Join(secretsDir, "api.key"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(appSecretFileMode), info.Mode().Perm())
+
+	// rotation replaces changed secrets and removes deleted ones
+	assert.NoError(t, writeAppSecrets("app", secretsDir, []types.AppSecret{
+		{Name: "db-password", Value: []byte("secret3")},
+	}))
+	assert.Equal(t, "secret3", readSecret("db-password"))
+	assert.NoFileExists(t, filepath.Join(secretsDir, "api.key"))
+	entries, err := os.ReadDir(secretsDir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestSetAppSecretsKeepsValuesOutOfStatus(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	secretsDir := filepath.Join(t.TempDir(), "app-secrets", "app")
+	status := types.DomainStatus{
+		EnvVariables: map[string]string{"LOG_LEVEL": "debug"},
+	}
+
+	assert.NoError(t, setAppSecrets("app", secretsDir, []types.AppSecret{
+		{Name: "db-password", Value: []byte("secret-value-1")},
+		{Name: "api.key", Value: []byte("secret-value-2"), EnvName: "API_KEY"},
+	}, &status))
+	assert.Equal(t, secretsDir, status.SecretsDir)
+	assert.Equal(t, map[string]string{"API_KEY": "api.key"}, status.SecretEnvNames)
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "debug"}, status.EnvVariables)
+
+	// the status is published, it must not contain any secret value
+	published, err := json.Marshal(status)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(published), "secret-value-1")
+	assert.NotContains(t, string(published), "secret-value-2")
+
+	// rotation updates the environment variables set to secrets
+	assert.NoError(t, setAppSecrets("app", secretsDir, []types.AppSecret{
+		{Name: "db-password", Value: []byte("secret-value-3"), EnvName: "DB_PASSWORD"},
+	}, &status))
+	assert.Equal(t, map[string]string{"DB_PASSWORD": "db-password"}, status.SecretEnvNames)
+	published, err = json.Marshal(status)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(published), "secret-value-3")
+}
+
+func TestValidateAppSecrets(t *testing.T) {
+	assert.NoError(t, types.ValidateAppSecrets([]types.AppSecret{
+		{Name: "db-password"}, {Name: "tls.key", EnvName: "TLS_KEY"},
+	}))
+	for _, secrets := range [][]types.AppSecret{
+		{{Name: ""}},
+		{{Name: ".."}},
+		{{Name: ".hidden"}},
+		{{Name: "../etc/passwd"}},
+		{{Name: "a"}, {Name: "a"}},
+		{{Name: "a", EnvName: "1ABC"}},
+		{{Name: "a", EnvName: "A"}, {Name: "b", EnvName: "A"}},
+	} {
+		assert.Error(t, types.ValidateAppSecrets(secrets), "%+v", secrets)
+	}
+}
+
+func TestWriteNoCloudFiles(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	config := types.DomainConfig{
+		UUIDandVersion:   types.UUIDandVersion{UUID: uuid.FromStringOrNil("0c2e8d8e-7a5d-4d8a-a9c4-c3d43e9ed7fa")},
+		CloudInitVersion: 2,
+	}
+	expectedMetaData := "instance-id: 0c2e8d8e-7a5d-4d8a-a9c4-c3d43e9ed7fa/2\n" +
+		"local-hostname: 0c2e8d8e-7a5d-4d8a-a9c4-c3d43e9ed7fa\n"
+	readFile := func(dir, name string) string {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		assert.NoError(t, err)
+		return string(content)
+	}
+
+	// plain user data
+	dir := t.TempDir()
+	assert.NoError(t, writeNoCloudFiles(dir, config, "#cloud-config\n"))
+	assert.Equal(t, "#cloud-config\n", readFile(dir, "user-data"))
+	assert.Equal(t, expectedMetaData, readFile(dir, "meta-data"))
+
+	// multi-part with network-config and without user-data
+	dir = t.TempDir()
+	multipart := "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\n" +
+		"MIME-Version: 1.0\n\n" +
+		"--BOUNDARY\n" +
+		"Content-Type: text/plain\n" +
+		"Content-Disposition: attachment; filename=\"network-config\"\n\n" +
+		"version: 2\n" +
+		"--BOUNDARY--\n"
+	assert.NoError(t, writeNoCloudFiles(dir, config, multipart))
+	assert.Equal(t, "version: 2", readFile(dir, "network-config"))
+	assert.Equal(t, "", readFile(dir, "user-data"))
+	assert.Equal(t, expectedMetaData, readFile(dir, "meta-data"))
+}
+
+func TestWriteConfigDriveFiles(t *testing.T) {
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: uuid.FromStringOrNil("0c2e8d8e-7a5d-4d8a-a9c4-c3d43e9ed7fa")},
+		DisplayName:    "appliance",
+	}
+	dir := t.TempDir()
+	assert.NoError(t, writeConfigDriveFiles(dir, config, "#cloud-config\n"))
+	for _, version := range []string{"2012-08-10", "latest"} {
+		content, err := os.ReadFile(filepath.Join(dir, "openstack", version, "meta_data.json"))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"uuid": "0c2e8d8e-7a5d-4d8a-a9c4-c3d43e9ed7fa",
+			"hostname": "appliance", "name": "appliance", "launch_index": 0}`, string(content))
+		content, err = os.ReadFile(filepath.Join(dir, "openstack", version, "user_data"))
+		assert.NoError(t, err)
+		assert.Equal(t, "#cloud-config\n", string(content))
+	}
+}
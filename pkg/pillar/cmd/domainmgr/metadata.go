@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Lay out the meta-data of VMs for the data sources other than
+// the default cloud-init drive: NoCloud and OpenStack ConfigDrive v2
+// ISO images, and Ignition configs passed in the firmware config.
+
+package domainmgr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// noCloudVolumeID is the label of NoCloud (and cloud-init drive) images
+	noCloudVolumeID = "cidata"
+	// configDriveVolumeID is the label of ConfigDrive images
+	configDriveVolumeID = "config-2"
+)
+
+// configDriveVersions are the meta-data versions in ConfigDrive images;
+// the first ConfigDrive v2 version for guests which do not look at "latest"
+var configDriveVersions = []string{"2012-08-10", "latest"}
+
+// noCloudMetaData returns the content of the meta-data file
+func noCloudMetaData(config types.DomainConfig) string {
+	return fmt.Sprintf("instance-id: %s/%s\nlocal-hostname: %s\n",
+		config.UUIDandVersion.UUID.String(),
+		getCloudInitVersion(config),
+		config.UUIDandVersion.UUID.String())
+}
+
+// writeNoCloudFiles lays out the NoCloud data source in dir. The user data
+// may be a MIME multi-part with the files of the data source (e.g.
+// meta-data and network-config); otherwise it is the user-data file.
+// The meta-data and user-data files are always present.
+func writeNoCloudFiles(dir string, config types.DomainConfig, ciStr string) error {
+	didMultipart, err := handleMimeMultipart(dir, ciStr, false)
+	if err != nil {
+		return err
+	}
+	if !didMultipart {
+		if err := os.WriteFile(filepath.Join(dir, "user-data"), []byte(ciStr), 0644); err != nil {
+			return err
+		}
+	}
+	defaults := map[string]string{
+		"meta-data": noCloudMetaData(config),
+		"user-data": "",
+	}
+	for name, content := range defaults {
+		fileName := filepath.Join(dir, name)
+		if _, err := os.Stat(fileName); err == nil {
+			continue
+		}
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeConfigDriveFiles lays out the OpenStack ConfigDrive v2 data source
+// in dir with the same meta-data as served by the meta-data server.
+func writeConfigDriveFiles(dir string, config types.DomainConfig, ciStr string) error {
+	metaData, err := json.Marshal(map[string]interface{}{
+		"uuid":         config.UUIDandVersion.UUID.String(),
+		"hostname":     config.DisplayName,
+		"name":         config.DisplayName,
+		"launch_index": 0,
+	})
+	if err != nil {
+		return err
+	}
+	for _, version := range configDriveVersions {
+		versionDir := filepath.Join(dir, "openstack", version)
+		if err := os.MkdirAll(versionDir, 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(versionDir, "meta_data.json"),
+			metaData, 0644); err != nil {
+			return err
+		}
+		if ciStr == "" {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(versionDir, "user_data"),
+			[]byte(ciStr), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ignitionFileLocation(uuid uuid.UUID) string {
+	return fmt.Sprintf("%s/%s.ign", ciDirname, uuid.String())
+}
+
+// createIgnitionConfig writes the Ignition config of the domain
+// and returns its location
+func createIgnitionConfig(config types.DomainConfig, ciStr string) (string, error) {
+	if !json.Valid([]byte(ciStr)) {
+		return "", errors.New("user data is not a valid Ignition config (JSON)")
+	}
+	fileName := ignitionFileLocation(config.UUIDandVersion.UUID)
+	if err := fileutils.WriteRename(fileName, []byte(ciStr)); err != nil {
+		return "", fmt.Errorf("createIgnitionConfig failed: %v", err)
+	}
+	return fileName, nil
+}
+
+// deleteIgnitionConfig will check if a file exists and if so delete it
+func deleteIgnitionConfig(status types.DomainStatus) {
+	fileName := ignitionFileLocation(status.UUIDandVersion.UUID)
+	if _, err := os.Stat(fileName); err != nil {
+		return
+	}
+	if err := os.Remove(fileName); err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// Secrets of app instances are decrypted into a directory on tmpfs,
+// one file per secret, which is mounted read-only into the containers
+// at /run/secrets. Files are replaced atomically so that the secrets
+// can be rotated while the app instance is running.
+// VMs get their secrets from the meta-data server (see zedrouter).
+
+// appSecretFileMode allows containers running as a non-root user to read
+// the secrets; the parent directory is only accessible by root.
+const appSecretFileMode = 0444
+
+// getAppSecrets : returns decrypted secrets of the app instance
+func getAppSecrets(ctx *domainContext,
+	config types.DomainConfig) ([]types.AppSecret, error) {
+
+	status, secrets, err := cipher.GetAppSecrets(&ctx.decryptCipherContext,
+		config.SecretsCipherBlock)
+	if config.SecretsCipherBlock.IsCipher {
+		ctx.pubCipherBlockStatus.Publish(status.Key(), status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Functionf("%s, secrets cipherblock decryption successful", config.Key())
+	return secrets, nil
+}
+
+// writeAppSecrets makes the content of the secrets directory match
+// the secrets and records every change in the log.
+func writeAppSecrets(key, secretsDir string, secrets []types.AppSecret) error {
+	if err := os.MkdirAll(filepath.Dir(secretsDir), 0700); err != nil {
+		return fmt.Errorf("failed to create secrets directory: %v", err)
+	}
+	if err := os.MkdirAll(secretsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create secrets directory: %v", err)
+	}
+	names := make(map[string]struct{}, len(secrets))
+	for _, secret := range secrets {
+		names[secret.Name] = struct{}{}
+		fileName := filepath.Join(secretsDir, secret.Name)
+		action := "created"
+		content, err := os.ReadFile(fileName)
+		if err == nil {
+			if bytes.Equal(content, secret.Value) {
+				continue
+			}
+			action = "updated"
+		}
+		err = fileutils.WriteRenameWithMode(fileName, secret.Value, appSecretFileMode)
+		if err != nil {
+			return err
+		}
+		log.Noticef("writeAppSecrets(%s): secret %s %s", key, secret.Name, action)
+	}
+	entries, err := os.ReadDir(secretsDir)
+	if err != nil {
+		return fmt.Errorf("failed to read secrets directory: %v", err)
+	}
+	for _, entry := range entries {
+		if _, exists := names[entry.Name()]; exists {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(secretsDir, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove secret %s: %v", entry.Name(), err)
+		}
+		log.Noticef("writeAppSecrets(%s): secret %s removed", key, entry.Name())
+	}
+	return nil
+}
+
+// prepareAppSecrets writes the secrets of a domain about to be activated.
+// Only containers get secrets from the device; VMs use the meta-data server.
+func prepareAppSecrets(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) error {
+
+	status.SecretsDir = ""
+	status.SecretEnvNames = nil
+	if !config.SecretsCipherBlock.IsCipher || status.OCIConfigDir == "" {
+		return nil
+	}
+	secrets, err := getAppSecrets(ctx, config)
+	if err != nil {
+		return err
+	}
+	return setAppSecrets(config.Key(),
+		types.AppSecretsDir(config.UUIDandVersion.UUID), secrets, status)
+}
+
+// setAppSecrets writes the secrets into secretsDir and records in the status
+// which environment variables are set to secrets.
+// DomainStatus is published, therefore it must never hold the secret values;
+// these are read from secretsDir by the hypervisor when the domain is set up.
+func setAppSecrets(key, secretsDir string, secrets []types.AppSecret,
+	status *types.DomainStatus) error {
+
+	if err := writeAppSecrets(key, secretsDir, secrets); err != nil {
+		return err
+	}
+	status.SecretsDir = secretsDir
+	status.SecretEnvNames = nil
+	for _, secret := range secrets {
+		if secret.EnvName == "" {
+			continue
+		}
+		if status.SecretEnvNames == nil {
+			status.SecretEnvNames = make(map[string]string)
+		}
+		status.SecretEnvNames[secret.EnvName] = secret.Name
+	}
+	return nil
+}
+
+// rotateAppSecrets updates the secrets of a running domain.
+// Errors are logged and the previous secrets are kept.
+func rotateAppSecrets(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus) {
+
+	if status.SecretsDir == "" {
+		return
+	}
+	var secrets []types.AppSecret
+	if config.SecretsCipherBlock.IsCipher {
+		var err error
+		secrets, err = getAppSecrets(ctx, config)
+		if err != nil {
+			log.Errorf("rotateAppSecrets(%s): keeping the previous secrets: %v",
+				config.Key(), err)
+			return
+		}
+	}
+	err := setAppSecrets(config.Key(), status.SecretsDir, secrets, status)
+	if err != nil {
+		log.Errorf("rotateAppSecrets(%s) failed: %v", config.Key(), err)
+	}
+}
+
+// removeAppSecrets removes the decrypted secrets of a domain which is
+// no longer running.
+func removeAppSecrets(status *types.DomainStatus) {
+	secretsDir := types.AppSecretsDir(status.UUIDandVersion.UUID)
+	if _, err := os.Stat(secretsDir); err != nil {
+		return
+	}
+	log.Noticef("removeAppSecrets(%s): removing %s", status.Key(), secretsDir)
+	if err := os.RemoveAll(secretsDir); err != nil {
+		log.Errorf("removeAppSecrets(%s) failed: %v", status.Key(), err)
+	}
+	status.SecretsDir = ""
+	status.SecretEnvNames = nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+// vtpmPurgeCounterFile records the purge counter of the app instance
+// the state of its virtual TPM belongs to
+const vtpmPurgeCounterFile = "purge-counter"
+
+// prepareVTPMState makes sure that the state directory of the virtual TPM
+// exists and discards the state left from before the app instance was purged.
+// The state itself is created by swtpm on the first boot of the domain.
+func prepareVTPMState(stateDir string, purgeCounter uint32) error {
+	counterFile := filepath.Join(stateDir, vtpmPurgeCounterFile)
+	counter := strconv.FormatUint(uint64(purgeCounter), 10)
+	content, err := os.ReadFile(counterFile)
+	switch {
+	case err == nil:
+		oldCounter := strings.TrimSpace(string(content))
+		if oldCounter == counter {
+			return nil
+		}
+		log.Noticef("prepareVTPMState(%s): purge counter changed from %s to %s, discarding state",
+			stateDir, oldCounter, counter)
+		if err := os.RemoveAll(stateDir); err != nil {
+			return fmt.Errorf("failed to remove vTPM state: %v", err)
+		}
+	case !os.IsNotExist(err):
+		return fmt.Errorf("failed to read vTPM purge counter: %v", err)
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("failed to create vTPM state directory: %v", err)
+	}
+	return fileutils.WriteRename(counterFile, []byte(counter))
+}
+
+// removeVTPMState destroys the virtual TPM of a deleted app instance.
+func removeVTPMState(status *types.DomainStatus) {
+	stateDir := types.AppVTPMStateDir(status.UUIDandVersion.UUID)
+	if _, err := os.Stat(stateDir); err != nil {
+		return
+	}
+	log.Noticef("removeVTPMState(%s): removing %s", status.Key(), stateDir)
+	if err := os.RemoveAll(stateDir); err != nil {
+		log.Errorf("removeVTPMState(%s) failed: %v", status.Key(), err)
+	}
+}
@@ -36,6 +36,11 @@ type downloaderContext struct {
 	downloadMaxPortCost      uint8
 	netDumper                *netdump.NetDumper // nil if netdump is disabled
 	netdumpWithPCAP          bool
+	// On-demand network diagnostics
+	subNetDumpRequest         pubsub.Subscription // from zedagent
+	subEdgeviewNetDumpRequest pubsub.Subscription
+	pubNetDumpStatus          pubsub.Publication
+	netDumpReqQueue           chan types.NetDumpRequest
 	// cli options
 	versionPtr *bool
 }
@@ -214,6 +219,53 @@ func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
 	ctx.subResolveConfig = subResolveConfig
 	subResolveConfig.Activate()
 
+	// Status of on-demand netdump requests is persisted to avoid running
+	// the same request again after reboot.
+	pubNetDumpStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName:  agentName,
+		TopicType:  types.NetDumpStatus{},
+		Persistent: true,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.pubNetDumpStatus = pubNetDumpStatus
+	markInterruptedNetDumps(ctx)
+
+	subNetDumpRequest, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleNetDumpRequestCreate,
+		ModifyHandler: handleNetDumpRequestModify,
+		DeleteHandler: handleNetDumpRequestDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.NetDumpRequest{},
+		Ctx:           ctx,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.subNetDumpRequest = subNetDumpRequest
+	subNetDumpRequest.Activate()
+
+	subEdgeviewNetDumpRequest, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleNetDumpRequestCreate,
+		ModifyHandler: handleNetDumpRequestModify,
+		DeleteHandler: handleNetDumpRequestDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "edgeview",
+		MyAgentName:   agentName,
+		TopicImpl:     types.NetDumpRequest{},
+		Ctx:           ctx,
+	})
+	if err != nil {
+		return err
+	}
+	ctx.subEdgeviewNetDumpRequest = subEdgeviewNetDumpRequest
+	subEdgeviewNetDumpRequest.Activate()
+
 	pubDownloaderStatus.SignalRestarted()
 	pubResolveStatus.SignalRestarted()
 
@@ -122,6 +122,15 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 			log.Errorf("Set proxy failed: %s", err)
 			return "", cancel, tracedReq, err
 		}
+		method, creds := zedcloud.LookupProxyAuth(&ctx.deviceNetworkStatus, ifname)
+		if creds.IsSet() {
+			log.Functionf("%s: Using proxy authentication (%s)", trType, method)
+			err = dEndPoint.WithProxyAuth(method, creds)
+			if err != nil {
+				log.Errorf("Set proxy authentication failed: %s", err)
+				return "", cancel, tracedReq, err
+			}
+		}
 	}
 
 	downloadedParts := loadDownloadedParts(locFilename)
@@ -318,6 +327,11 @@ func objectMetadata(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	if err == nil && proxyURL != nil {
 		log.Functionf("%s: Using proxy %s", trType, proxyURL.String())
 		dEndPoint.WithProxy(proxyURL)
+		method, creds := zedcloud.LookupProxyAuth(&ctx.deviceNetworkStatus, ifname)
+		if creds.IsSet() {
+			log.Functionf("%s: Using proxy authentication (%s)", trType, method)
+			dEndPoint.WithProxyAuth(method, creds)
+		}
 	}
 
 	var respChan = make(chan *zedUpload.DronaRequest)
@@ -55,6 +55,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	ctx := downloaderContext{
 		zedcloudMetrics: zedcloud.NewAgentMetrics(),
 		cipherMetrics:   cipher.NewAgentMetrics(agentName),
+		netDumpReqQueue: make(chan types.NetDumpRequest, maxPendingNetDumpReqs),
 	}
 	agentbase.Init(&ctx, logger, log, agentName,
 		agentbase.WithArguments(arguments))
@@ -149,6 +150,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus))
 
 	ctx.dCtx = downloaderInit(&ctx)
+	go netDumpWorker(&ctx)
 
 	// run gc every 5 minutes
 	gcInterval := 5 * time.Minute
@@ -184,6 +186,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 			ctx.subDatastoreConfig.ProcessChange(change)
 			log.Noticef("Processed DatastoreConfig")
 
+		case change := <-ctx.subNetDumpRequest.MsgChan():
+			ctx.subNetDumpRequest.ProcessChange(change)
+
+		case change := <-ctx.subEdgeviewNetDumpRequest.MsgChan():
+			ctx.subEdgeviewNetDumpRequest.ProcessChange(change)
+
 		case <-publishTimer.C:
 			start := time.Now()
 			err := ctx.zedcloudMetrics.Publish(log, metricsPub, "global")
@@ -0,0 +1,550 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/encdns"
+	"github.com/lf-edge/eve/pkg/pillar/netdump"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// Topic for netdumps collected on demand (see types.NetDumpRequest).
+	netDumpOnDemandTopic = agentName + "-ondemand"
+	// Maximum number of on-demand netdumps kept under /persist/netdump.
+	maxOnDemandNetDumps = 5
+	// Maximum number of requests waiting for execution.
+	maxPendingNetDumpReqs = 16
+	// How often to repeat request towards the target.
+	netDumpReqInterval = 5 * time.Second
+	// Timeout for a single request towards the target.
+	netDumpReqTimeout = 20 * time.Second
+	// Limit for the size of the response body read from the target.
+	netDumpMaxRespBody = 1 << 20
+	// Wait a little bit at the end to capture all the packets.
+	netDumpPcapDelay = 250 * time.Millisecond
+)
+
+var nilUUID uuid.UUID
+
+// Characters not allowed in the name of the uploaded netdump archive.
+var netDumpObjNameRE = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+func handleNetDumpRequestCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*downloaderContext)
+	req := configArg.(types.NetDumpRequest)
+	if status := lookupNetDumpStatus(ctx, key); status != nil {
+		log.Noticef("handleNetDumpRequestCreate(%s): already %v", key, status.State)
+		return
+	}
+	log.Noticef("handleNetDumpRequestCreate(%s): target %s, source %v",
+		key, req.Target, req.Source)
+	status := types.NetDumpStatus{
+		RequestID: req.RequestID,
+		Source:    req.Source,
+		State:     types.NetDumpStatePending,
+	}
+	// Publish before queueing, netDumpWorker may update the status right away.
+	publishNetDumpStatus(ctx, status)
+	select {
+	case ctx.netDumpReqQueue <- req:
+	default:
+		status.State = types.NetDumpStateFailed
+		status.SetErrorNow(fmt.Sprintf("too many pending netdump requests (max %d)",
+			maxPendingNetDumpReqs))
+		status.CompletedAt = time.Now()
+		publishNetDumpStatus(ctx, status)
+	}
+}
+
+func handleNetDumpRequestModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+	// Request is executed only once, any changes are ignored.
+	log.Warnf("handleNetDumpRequestModify(%s): request was modified, "+
+		"submit a new request (with a new ID) to run it again", key)
+}
+
+func handleNetDumpRequestDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*downloaderContext)
+	status := lookupNetDumpStatus(ctx, key)
+	if status == nil || !status.Done() {
+		// Status of running request is removed by netDumpWorker.
+		return
+	}
+	log.Functionf("handleNetDumpRequestDelete(%s)", key)
+	unpublishNetDumpStatus(ctx, key)
+}
+
+// lookupNetDumpRequest returns request submitted via zedagent or edgeview.
+func lookupNetDumpRequest(ctx *downloaderContext, key string) *types.NetDumpRequest {
+	for _, sub := range []pubsub.Subscription{
+		ctx.subNetDumpRequest, ctx.subEdgeviewNetDumpRequest} {
+		item, err := sub.Get(key)
+		if err == nil {
+			req := item.(types.NetDumpRequest)
+			return &req
+		}
+	}
+	return nil
+}
+
+func lookupNetDumpStatus(ctx *downloaderContext, key string) *types.NetDumpStatus {
+	item, err := ctx.pubNetDumpStatus.Get(key)
+	if err != nil {
+		return nil
+	}
+	status := item.(types.NetDumpStatus)
+	return &status
+}
+
+func publishNetDumpStatus(ctx *downloaderContext, status types.NetDumpStatus) {
+	if err := ctx.pubNetDumpStatus.Publish(status.Key(), status); err != nil {
+		log.Errorf("publishNetDumpStatus(%s) failed: %v", status.Key(), err)
+	}
+}
+
+func unpublishNetDumpStatus(ctx *downloaderContext, key string) {
+	if err := ctx.pubNetDumpStatus.Unpublish(key); err != nil {
+		log.Errorf("unpublishNetDumpStatus(%s) failed: %v", key, err)
+	}
+}
+
+// markInterruptedNetDumps is called on startup to fail requests that did not
+// complete before downloader (or the whole device) restarted.
+func markInterruptedNetDumps(ctx *downloaderContext) {
+	for _, item := range ctx.pubNetDumpStatus.GetAll() {
+		status := item.(types.NetDumpStatus)
+		if status.Done() {
+			continue
+		}
+		status.State = types.NetDumpStateFailed
+		status.SetErrorNow("interrupted by restart")
+		status.CompletedAt = time.Now()
+		publishNetDumpStatus(ctx, status)
+	}
+}
+
+// netDumpWorker executes netdump requests one at a time.
+func netDumpWorker(ctx *downloaderContext) {
+	for req := range ctx.netDumpReqQueue {
+		if lookupNetDumpRequest(ctx, req.Key()) == nil {
+			// Request was removed while waiting in the queue.
+			log.Noticef("netDumpWorker(%s): request was removed, skipping",
+				req.Key())
+			unpublishNetDumpStatus(ctx, req.Key())
+			continue
+		}
+		runNetDumpRequest(ctx, req)
+		if lookupNetDumpRequest(ctx, req.Key()) == nil {
+			// Request was removed while running.
+			unpublishNetDumpStatus(ctx, req.Key())
+		}
+	}
+}
+
+func runNetDumpRequest(ctx *downloaderContext, req types.NetDumpRequest) {
+	status := types.NetDumpStatus{
+		RequestID: req.RequestID,
+		Source:    req.Source,
+		State:     types.NetDumpStateRunning,
+		StartedAt: time.Now(),
+	}
+	publishNetDumpStatus(ctx, status)
+	fail := func(err error) {
+		log.Errorf("runNetDumpRequest(%s): %v", req.Key(), err)
+		status.State = types.NetDumpStateFailed
+		status.SetErrorNow(err.Error())
+		status.CompletedAt = time.Now()
+		publishNetDumpStatus(ctx, status)
+	}
+
+	tracedReqs, err := traceNetDumpTarget(ctx, req)
+	if len(tracedReqs) == 0 {
+		fail(err)
+		return
+	}
+	if err != nil {
+		// Some of the selected ports could not be used, but we still have
+		// something to publish.
+		log.Warnf("runNetDumpRequest(%s): %v", req.Key(), err)
+		status.SetErrorNow(err.Error())
+	}
+	netDumper := &netdump.NetDumper{MaxDumpsPerTopic: maxOnDemandNetDumps}
+	filename, err := netDumper.Publish(netDumpOnDemandTopic, tracedReqs...)
+	if err != nil {
+		fail(fmt.Errorf("failed to publish netdump: %w", err))
+		return
+	}
+	log.Noticef("runNetDumpRequest(%s): published netdump %s", req.Key(), filename)
+	status.Filename = filename
+
+	if req.DatastoreID != nilUUID {
+		status.State = types.NetDumpStateUploading
+		publishNetDumpStatus(ctx, status)
+		location, err := uploadNetDump(ctx, req, filename)
+		if err != nil {
+			fail(fmt.Errorf("failed to upload netdump: %w", err))
+			return
+		}
+		log.Noticef("runNetDumpRequest(%s): uploaded netdump as %s",
+			req.Key(), location)
+		status.UploadLocation = location
+	}
+	status.State = types.NetDumpStateCompleted
+	status.CompletedAt = time.Now()
+	publishNetDumpStatus(ctx, status)
+}
+
+// netDumpTargetURL returns URL to send requests to.
+// With <host>:<port>, HTTPS is used unless the port is 80.
+func netDumpTargetURL(target string) (string, error) {
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return "", err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return "", fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+		}
+		if u.Host == "" {
+			return "", fmt.Errorf("missing host in URL %q", target)
+		}
+		return u.String(), nil
+	}
+	host, port, err := net.SplitHostPort(target)
+	if err != nil {
+		return "", fmt.Errorf("invalid target %q, expected <host>:<port> or URL: %w",
+			target, err)
+	}
+	scheme := "https"
+	if port == "80" {
+		scheme = "http"
+	}
+	return (&url.URL{Scheme: scheme, Host: net.JoinHostPort(host, port), Path: "/"}).String(), nil
+}
+
+// netDumpPorts returns ports selected by the request.
+// Shared labels (e.g. "uplink") are also accepted.
+func netDumpPorts(dns types.DeviceNetworkStatus,
+	req types.NetDumpRequest) (ports []types.NetworkPortStatus, err error) {
+	labels := req.Interfaces
+	if len(labels) == 0 {
+		labels = []string{types.UplinkLabel}
+	}
+	selected := make(map[string]struct{})
+	var unknown []string
+	for _, label := range labels {
+		matched := dns.GetPortsByLogicallabel(label)
+		if len(matched) == 0 {
+			unknown = append(unknown, label)
+			continue
+		}
+		for _, port := range matched {
+			if _, duplicate := selected[port.IfName]; duplicate {
+				continue
+			}
+			selected[port.IfName] = struct{}{}
+			ports = append(ports, *port)
+		}
+	}
+	if len(unknown) > 0 {
+		err = fmt.Errorf("no port matching: %s", strings.Join(unknown, ", "))
+	}
+	return ports, err
+}
+
+// traceNetDumpTarget repeatedly sends requests towards the target from all
+// selected ports in parallel, recording network trace and packet capture.
+func traceNetDumpTarget(ctx *downloaderContext,
+	req types.NetDumpRequest) ([]netdump.TracedNetRequest, error) {
+	targetURL, err := netDumpTargetURL(req.Target)
+	if err != nil {
+		return nil, err
+	}
+	dns := ctx.deviceNetworkStatus
+	ports, portsErr := netDumpPorts(dns, req)
+	if len(ports) == 0 {
+		return nil, portsErr
+	}
+	var (
+		wg         sync.WaitGroup
+		mutex      sync.Mutex
+		tracedReqs []netdump.TracedNetRequest
+		errs       []string
+	)
+	if portsErr != nil {
+		errs = append(errs, portsErr.Error())
+	}
+	for _, port := range ports {
+		wg.Add(1)
+		go func(port types.NetworkPortStatus) {
+			defer wg.Done()
+			tracedReq, err := traceNetDumpViaPort(dns, port, targetURL,
+				req.GetDuration())
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("port %s: %v", port.Logicallabel, err))
+				return
+			}
+			tracedReqs = append(tracedReqs, tracedReq)
+		}(port)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		err = errors.New(strings.Join(errs, "; "))
+	}
+	return tracedReqs, err
+}
+
+func traceNetDumpViaPort(dns types.DeviceNetworkStatus, port types.NetworkPortStatus,
+	targetURL string, duration time.Duration) (netdump.TracedNetRequest, error) {
+	var tracedReq netdump.TracedNetRequest
+	srcIP, err := types.GetLocalAddrAnyNoLinkLocal(dns, 0, port.IfName)
+	if err != nil {
+		return tracedReq, err
+	}
+	dnsServers := types.GetDNSServers(dns, port.IfName)
+	dnsDialer := encdns.NewDialer(dns.EncryptedDNS)
+	clientConfig := nettrace.HTTPClientCfg{
+		SourceIP: srcIP,
+		SkipNameserver: func(ipAddr net.IP, port uint16) (skip bool, reason string) {
+			for _, dnsServer := range dnsServers {
+				if dnsServer != nil && dnsServer.Equal(ipAddr) {
+					return false, ""
+				}
+			}
+			return true, "DNS server is from a different network"
+		},
+		DialNameserver:      dnsDialer.DialNameserver,
+		ReqTimeout:          netDumpReqTimeout,
+		TCPHandshakeTimeout: netDumpReqTimeout / 2,
+		TLSHandshakeTimeout: netDumpReqTimeout / 2,
+		DisableKeepAlive:    true,
+	}
+	proxyURL, err := zedcloud.LookupProxy(log, &dns, port.IfName, targetURL)
+	if err != nil {
+		return tracedReq, fmt.Errorf("proxy lookup failed: %w", err)
+	}
+	if proxyURL != nil {
+		clientConfig.Proxy = http.ProxyURL(proxyURL)
+	}
+	client, err := nettrace.NewHTTPClient(clientConfig,
+		&nettrace.WithLogging{
+			CustomLogger: &base.LogrusWrapper{Log: log},
+		},
+		&nettrace.WithConntrack{},
+		&nettrace.WithSockTrace{},
+		&nettrace.WithDNSQueryTrace{},
+		&nettrace.WithHTTPReqTrace{
+			// Do not disclose sensitive data stored inside headers,
+			// record only their length.
+			HeaderFields: nettrace.HdrFieldsOptValueLenOnly,
+		},
+		&nettrace.WithPacketCapture{
+			Interfaces:     []string{port.IfName},
+			TotalSizeLimit: pcapSizeLimit,
+			IncludeICMP:    true,
+			IncludeARP:     true,
+		})
+	if err != nil {
+		return tracedReq, err
+	}
+	defer client.Close()
+
+	deadline := time.Now().Add(duration)
+	var reqCount, okCount int
+	for {
+		reqCount++
+		err = sendNetDumpRequest(client, targetURL)
+		if err != nil {
+			log.Functionf("traceNetDumpViaPort: request %d via %s failed: %v",
+				reqCount, port.IfName, err)
+		} else {
+			okCount++
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if remaining > netDumpReqInterval {
+			remaining = netDumpReqInterval
+		}
+		time.Sleep(remaining)
+	}
+	time.Sleep(netDumpPcapDelay)
+	description := fmt.Sprintf("On-demand GET %s via %s (%s) src IP %v, "+
+		"duration: %v, requests: %d, succeeded: %d", targetURL, port.IfName,
+		port.Logicallabel, srcIP, duration, reqCount, okCount)
+	netTrace, pcaps, err := client.GetTrace(description)
+	if err != nil {
+		return tracedReq, fmt.Errorf("failed to get network trace: %w", err)
+	}
+	tracedReq.RequestName = netDumpObjNameRE.ReplaceAllString(port.Logicallabel, "_")
+	tracedReq.NetTrace = netTrace
+	tracedReq.PacketCaptures = pcaps
+	return tracedReq, nil
+}
+
+func sendNetDumpRequest(client *nettrace.HTTPClient, targetURL string) error {
+	reqCtx, cancel := context.WithTimeout(context.Background(), netDumpReqTimeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(reqCtx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(io.Discard, io.LimitReader(resp.Body, netDumpMaxRespBody))
+	return err
+}
+
+// uploadNetDump uploads netdump archive into the datastore selected
+// by the request, trying all management ports until success.
+// Returns path of the uploaded archive inside the datastore.
+func uploadNetDump(ctx *downloaderContext, req types.NetDumpRequest,
+	filename string) (string, error) {
+	dst, err := utils.LookupDatastoreConfig(ctx.subDatastoreConfig, req.DatastoreID)
+	if err != nil {
+		return "", err
+	}
+	objName := netDumpObjNameRE.ReplaceAllString(req.RequestID, "_") + "-" +
+		path.Base(filename)
+	dsCtx, err := constructDatastoreContext(ctx, objName, false, *dst)
+	if err != nil {
+		return "", err
+	}
+	var (
+		trType     zedUpload.SyncTransportType
+		auth       *zedUpload.AuthInput
+		serverURL  string
+		dsPath     = dsCtx.Dpath
+		remoteName = objName
+	)
+	switch dsCtx.TransportMethod {
+	case zconfig.DsType_DsS3.String():
+		auth = &zedUpload.AuthInput{
+			AuthType: "s3",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		trType = zedUpload.SyncAwsTr
+		serverURL = dsCtx.DownloadURL
+		// The bucket name is the first element of the datastore path,
+		// the rest is used as a prefix of the object name.
+		splittedPath := strings.Split(path.Clean(strings.TrimLeft(dsPath, "/")), "/")
+		if len(splittedPath) > 1 {
+			dsPath = splittedPath[0]
+			remoteName = path.Join(append(splittedPath[1:], remoteName)...)
+		}
+	case zconfig.DsType_DsAzureBlob.String():
+		auth = &zedUpload.AuthInput{
+			AuthType: "password",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		trType = zedUpload.SyncAzureTr
+		serverURL = strings.TrimSpace(dst.Fqdn)
+	case zconfig.DsType_DsGoogleStorage.String():
+		auth = &zedUpload.AuthInput{
+			AuthType: "gs",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		trType = zedUpload.SyncGSTr
+		serverURL = dsCtx.DownloadURL
+	case zconfig.DsType_DsSFTP.String():
+		auth = &zedUpload.AuthInput{
+			AuthType: "sftp",
+			Uname:    dsCtx.APIKey,
+			Password: dsCtx.Password,
+		}
+		trType = zedUpload.SyncSftpTr
+		serverURL = dst.Fqdn
+	case zconfig.DsType_DsHttp.String(), zconfig.DsType_DsHttps.String(), "":
+		auth = &zedUpload.AuthInput{
+			AuthType: "http",
+		}
+		trType = zedUpload.SyncHttpTr
+		serverURL, err = getServerURL(dsCtx)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("upload to %s datastore is not supported",
+			dsCtx.TransportMethod)
+	}
+
+	addrCount := types.CountLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+		ctx.downloadMaxPortCost)
+	if addrCount == 0 {
+		return "", fmt.Errorf("no IP management port addresses with cost <= %d",
+			ctx.downloadMaxPortCost)
+	}
+	var errs []string
+	for addrIndex := 0; addrIndex < addrCount; addrIndex++ {
+		ipSrc, err := types.GetLocalAddrNoLinkLocalWithCost(ctx.deviceNetworkStatus,
+			addrIndex, "", ctx.downloadMaxPortCost)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		ifname := types.GetMgmtPortFromAddr(ctx.deviceNetworkStatus, ipSrc)
+		// Upload of netdump cannot be cancelled, but download() expects
+		// to be able to pass cancel channel to the caller.
+		receiveChan := make(chan CancelChannel, 2)
+		_, _, _, err = download(ctx, trType, &netDumpUploadProgress{}, zedUpload.SyncOpUpload,
+			serverURL, auth, dsPath, dsCtx.Region, 0, ifname, ipSrc, remoteName, filename,
+			dst.DsCertPEM, false, nil, receiveChan)
+		if err != nil {
+			log.Errorf("uploadNetDump: source IP %s failed: %v", ipSrc, err)
+			errs = append(errs, err.Error())
+			continue
+		}
+		return path.Join(dsPath, remoteName), nil
+	}
+	return "", errors.New(strings.Join(errs, "; "))
+}
+
+// netDumpUploadProgress implements Status for netdump upload.
+// The upload progress is not published, only tracked to detect stalled upload.
+type netDumpUploadProgress struct {
+	currentSize, totalSize int64
+}
+
+// Progress returns true if there was a change to the recorded values.
+func (p *netDumpUploadProgress) Progress(_ uint, currentSize, totalSize int64) bool {
+	if p.currentSize == currentSize && p.totalSize == totalSize {
+		return false
+	}
+	p.currentSize = currentSize
+	p.totalSize = totalSize
+	return true
+}
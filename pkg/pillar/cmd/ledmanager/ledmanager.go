@@ -48,7 +48,8 @@ type ledManagerContext struct {
 	subAppInstanceSummary  pubsub.Subscription
 	usableAddressCount     int
 	radioSilence           bool
-	derivedLedCounter      types.LedBlinkCount // Based on ledCounter, usableAddressCount and radioSilence
+	captivePortal          bool
+	derivedLedCounter      types.LedBlinkCount // Based on ledCounter, usableAddressCount, radioSilence and captivePortal
 	GCInitialized          bool
 	blinkSendStop          chan string // Used by sender to stop the running forever blink routine
 	blinkRecvStop          chan string // Sender waits for the ack.
@@ -488,7 +489,7 @@ func handleLedBlinkImpl(ctxArg interface{}, key string,
 	}
 	ctx.ledCounter = config.BlinkCounter
 	ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-		ctx.usableAddressCount, ctx.radioSilence)
+		ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 	log.Functionf("counter %d usableAddr %d, radioSilence %t, derived %d",
 		ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 	ctx.countChange <- ctx.derivedLedCounter
@@ -508,7 +509,7 @@ func handleLedBlinkDelete(ctxArg interface{}, key string,
 	// XXX or should we tell the blink go routine to exit?
 	ctx.ledCounter = 0
 	ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-		ctx.usableAddressCount, ctx.radioSilence)
+		ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 	log.Functionf("counter %d usableAddr %d, radioSilence %t, derived %d",
 		ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 	ctx.countChange <- ctx.derivedLedCounter
@@ -946,12 +947,13 @@ func handleDNSImpl(ctxArg interface{}, key string,
 	ctx.deviceNetworkStatus = status
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus)
 	log.Functionf("handleDNSImpl %d usable addresses", newAddrCount)
+	captivePortalChanged := updateCaptivePortal(ctx, &ctx.deviceNetworkStatus)
 	if (ctx.usableAddressCount == 0 && newAddrCount != 0) ||
 		(ctx.usableAddressCount != 0 && newAddrCount == 0) ||
-		updateRadioSilence(ctx, &ctx.deviceNetworkStatus) {
+		updateRadioSilence(ctx, &ctx.deviceNetworkStatus) || captivePortalChanged {
 		ctx.usableAddressCount = newAddrCount
 		ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-			ctx.usableAddressCount, ctx.radioSilence)
+			ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 		log.Functionf("counter %d, usableAddr %d, radioSilence %t, derived %d",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 		ctx.countChange <- ctx.derivedLedCounter
@@ -970,12 +972,13 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 	ctx.deviceNetworkStatus = types.DeviceNetworkStatus{}
 	newAddrCount := types.CountLocalAddrAnyNoLinkLocal(ctx.deviceNetworkStatus)
 	log.Functionf("handleDNSDelete %d usable addresses", newAddrCount)
+	captivePortalChanged := updateCaptivePortal(ctx, &ctx.deviceNetworkStatus)
 	if (ctx.usableAddressCount == 0 && newAddrCount != 0) ||
 		(ctx.usableAddressCount != 0 && newAddrCount == 0) ||
-		updateRadioSilence(ctx, &ctx.deviceNetworkStatus) {
+		updateRadioSilence(ctx, &ctx.deviceNetworkStatus) || captivePortalChanged {
 		ctx.usableAddressCount = newAddrCount
 		ctx.derivedLedCounter = types.DeriveLedCounter(ctx.ledCounter,
-			ctx.usableAddressCount, ctx.radioSilence)
+			ctx.usableAddressCount, ctx.radioSilence, ctx.captivePortal)
 		log.Functionf("counter %d, usableAddr %d, radioSilence %t, derived %d",
 			ctx.ledCounter, ctx.usableAddressCount, ctx.radioSilence, ctx.derivedLedCounter)
 		ctx.countChange <- ctx.derivedLedCounter
@@ -983,6 +986,14 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 	log.Functionf("handleDNSDelete done for %s", key)
 }
 
+// updateCaptivePortal returns true if captive portal detection has changed.
+func updateCaptivePortal(ctx *ledManagerContext, status *types.DeviceNetworkStatus) (update bool) {
+	captivePortal := status != nil && status.CaptivePortalDetected()
+	update = ctx.captivePortal != captivePortal
+	ctx.captivePortal = captivePortal
+	return update
+}
+
 func updateRadioSilence(ctx *ledManagerContext, status *types.DeviceNetworkStatus) (update bool) {
 	if status == nil {
 		// by default radio-silence is turned off
@@ -8,7 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net"
+	"net/url"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
@@ -62,7 +66,14 @@ func (n *nim) queryControllerDNS() {
 			// base on ttl from server dns update frequency for controller IP resolve
 			// even if the dns server implementation returns the remaining value of the TTL it caches,
 			// it will still work.
+			dsChanged, dsTTLSec := n.datastoreDNSCache(controllerServer)
 			ipaddrCached, ttlSec = n.controllerDNSCache(etchosts, controllerServer, ipaddrCached)
+			if dsChanged {
+				n.writeHostsFile(n.controllerDNS, etchosts, controllerServer)
+			}
+			if dsTTLSec < ttlSec {
+				ttlSec = dsTTLSec
+			}
 			dnsTimer = time.NewTimer(time.Duration(ttlSec) * time.Second)
 
 		case <-stillRunning.C:
@@ -72,10 +83,19 @@ func (n *nim) queryControllerDNS() {
 }
 
 func (n *nim) resolveWithPorts(domain string) []devicenetwork.DNSResponse {
+	dns := n.dpcManager.GetDNS()
+	resolve := devicenetwork.ResolveWithSrcIP
+	if dns.EncryptedDNS.Protocol != types.EncryptedDNSNone {
+		resolve = func(domain string, dnsServerIP, srcIP net.IP) (
+			[]devicenetwork.DNSResponse, error) {
+			return devicenetwork.ResolveEncryptedWithSrcIP(
+				domain, dns.EncryptedDNS, dnsServerIP, srcIP)
+		}
+	}
 	dnsResponse, errs := devicenetwork.ResolveWithPortsLambda(
 		domain,
-		n.dpcManager.GetDNS(),
-		devicenetwork.ResolveWithSrcIP,
+		dns,
+		resolve,
 	)
 	if len(errs) > 0 {
 		n.Log.Warnf("resolveWithPortsLambda failed: %+v", errs)
@@ -83,6 +103,100 @@ func (n *nim) resolveWithPorts(domain string) []devicenetwork.DNSResponse {
 	return dnsResponse
 }
 
+// datastoreDNSCache resolves domain names of datastores using encrypted DNS
+// (if enabled) so that they can be cached in /etc/hosts alongside the controller
+// and other agents (e.g. downloader) do not need to query plain DNS.
+// Returns true if the cached entries have changed and TTL (in seconds)
+// after which the entries should be refreshed.
+func (n *nim) datastoreDNSCache(controllerServer []byte) (changed bool, ttlSec int) {
+	ttlSec = maxTTLSec + extraSec
+	cache := make(map[string][]devicenetwork.DNSResponse)
+	if n.dpcManager.GetDNS().EncryptedDNS.Protocol != types.EncryptedDNSNone {
+		for _, host := range n.datastoreHosts() {
+			if host == string(controllerServer) {
+				continue
+			}
+			dnsResponses := n.resolveWithPorts(host)
+			if len(dnsResponses) == 0 {
+				// Keep the previous entry and retry soon.
+				if prevResponses, ok := n.datastoreDNS[host]; ok {
+					cache[host] = prevResponses
+				}
+				ttlSec = minTTLSec
+				continue
+			}
+			cache[host] = dnsResponses
+			hostTTLSec := getTTL(time.Duration(dnsResponses[0].TTL) * time.Second)
+			if hostTTLSec < ttlSec {
+				ttlSec = hostTTLSec
+			}
+		}
+	}
+	changed = len(cache) != len(n.datastoreDNS)
+	for host, dnsResponses := range cache {
+		if !sameDNSResponseIPs(dnsResponses, n.datastoreDNS[host]) {
+			changed = true
+		}
+	}
+	n.datastoreDNS = cache
+	return changed, ttlSec
+}
+
+// datastoreHosts returns domain names of all configured datastores.
+func (n *nim) datastoreHosts() (hosts []string) {
+	if n.subDatastoreConfig == nil {
+		return nil
+	}
+	for _, item := range n.subDatastoreConfig.GetAll() {
+		host := datastoreHost(item.(types.DatastoreConfig).Fqdn)
+		if host == "" || net.ParseIP(host) != nil {
+			continue
+		}
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return uniqueStrings(hosts)
+}
+
+// datastoreHost returns host part of the datastore FQDN,
+// which may or may not include URL scheme and port.
+func datastoreHost(fqdn string) string {
+	if strings.Contains(fqdn, "://") {
+		u, err := url.Parse(fqdn)
+		if err != nil {
+			return ""
+		}
+		return u.Hostname()
+	}
+	host, _, _ := strings.Cut(fqdn, "/")
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return host
+}
+
+// uniqueStrings removes duplicates from a sorted slice.
+func uniqueStrings(sorted []string) (unique []string) {
+	for i, item := range sorted {
+		if i == 0 || sorted[i-1] != item {
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
+
+func sameDNSResponseIPs(responses1, responses2 []devicenetwork.DNSResponse) bool {
+	if len(responses1) != len(responses2) {
+		return false
+	}
+	for i := range responses1 {
+		if !responses1[i].IP.Equal(responses2[i].IP) {
+			return false
+		}
+	}
+	return true
+}
+
 // periodical cache the controller DNS resolution into /etc/hosts file
 // it returns the cached ip string, and TTL setting from the server
 func (n *nim) controllerDNSCache(
@@ -153,6 +267,18 @@ func (n *nim) writeHostsFileToDestination(
 			newhosts = append(newhosts, []byte(serverEntry)...)
 		}
 	}
+	n.controllerDNS = dnsResponses
+	datastoreHosts := make([]string, 0, len(n.datastoreDNS))
+	for host := range n.datastoreDNS {
+		datastoreHosts = append(datastoreHosts, host)
+	}
+	sort.Strings(datastoreHosts)
+	for _, host := range datastoreHosts {
+		for _, dnsResponse := range n.datastoreDNS[host] {
+			datastoreEntry := fmt.Sprintf("%s %s\n", dnsResponse.IP.String(), host)
+			newhosts = append(newhosts, []byte(datastoreEntry)...)
+		}
+	}
 
 	err := os.WriteFile(tmpHostFileName, newhosts, 0644)
 	if err != nil {
@@ -77,3 +77,19 @@ func TestWriteHostsFile(t *testing.T) {
 		}
 	}
 }
+
+func TestDatastoreHost(t *testing.T) {
+	tests := map[string]string{
+		"https://datastore.example.com:8443/bucket": "datastore.example.com",
+		"datastore.example.com":                     "datastore.example.com",
+		"datastore.example.com/bucket/path":         "datastore.example.com",
+		"datastore.example.com:9000":                "datastore.example.com",
+		"10.0.0.1:9000":                             "10.0.0.1",
+		"":                                          "",
+	}
+	for fqdn, expected := range tests {
+		if host := datastoreHost(fqdn); host != expected {
+			t.Errorf("datastoreHost(%q) = %q, expected %q", fqdn, host, expected)
+		}
+	}
+}
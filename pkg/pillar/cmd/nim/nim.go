@@ -19,10 +19,12 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/conntester"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/dpcmanager"
 	"github.com/lf-edge/eve/pkg/pillar/dpcreconciler"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -88,6 +90,7 @@ type nim struct {
 	subZedAgentStatus     pubsub.Subscription
 	subAssignableAdapters pubsub.Subscription
 	subOnboardStatus      pubsub.Subscription
+	subDatastoreConfig    pubsub.Subscription
 
 	// Publications
 	pubDummyDevicePortConfig pubsub.Publication // For logging
@@ -100,6 +103,7 @@ type nim struct {
 	pubWwanStatus            pubsub.Publication
 	pubWwanMetrics           pubsub.Publication
 	pubWwanLocationInfo      pubsub.Publication
+	pubWlanMetrics           pubsub.Publication
 
 	// Metrics
 	zedcloudMetrics *zedcloud.AgentMetrics
@@ -112,6 +116,11 @@ type nim struct {
 	enabledLastResort  bool
 	forceLastResort    bool
 	lastResort         *types.DevicePortConfig
+
+	// Domain name resolutions cached in /etc/hosts.
+	// Accessed only by the queryControllerDNS go routine.
+	controllerDNS []devicenetwork.DNSResponse
+	datastoreDNS  map[string][]devicenetwork.DNSResponse // key = hostname
 }
 
 // AddAgentSpecificCLIFlags adds CLI options
@@ -182,6 +191,7 @@ func (n *nim) init() (err error) {
 		PubCipherBlockStatus: n.pubCipherBlockStatus,
 		CipherMetrics:        n.cipherMetrics,
 		HVTypeKube:           base.IsHVTypeKube(),
+		UseNftables:          nftables.Enabled(),
 	}
 	n.dpcManager = &dpcmanager.DpcManager{
 		Log:                      n.Log,
@@ -196,7 +206,12 @@ func (n *nim) init() (err error) {
 		PubWwanStatus:            n.pubWwanStatus,
 		PubWwanMetrics:           n.pubWwanMetrics,
 		PubWwanLocationInfo:      n.pubWwanLocationInfo,
+		PubWlanMetrics:           n.pubWlanMetrics,
 		ZedcloudMetrics:          n.zedcloudMetrics,
+		SubControllerCert:        n.subControllerCert,
+		SubEdgeNodeCert:          n.subEdgeNodeCert,
+		PubCipherBlockStatus:     n.pubCipherBlockStatus,
+		CipherMetrics:            n.cipherMetrics,
 	}
 	return nil
 }
@@ -246,6 +261,9 @@ func (n *nim) run(ctx context.Context) (err error) {
 	if err = n.subOnboardStatus.Activate(); err != nil {
 		return err
 	}
+	if err = n.subDatastoreConfig.Activate(); err != nil {
+		return err
+	}
 
 	// Run a periodic timer so we always update StillRunning
 	stillRunning := time.NewTicker(stillRunTime)
@@ -368,6 +386,9 @@ func (n *nim) run(ctx context.Context) (err error) {
 		case change := <-n.subOnboardStatus.MsgChan():
 			n.subOnboardStatus.ProcessChange(change)
 
+		case change := <-n.subDatastoreConfig.MsgChan():
+			n.subDatastoreConfig.ProcessChange(change)
+
 		case event := <-netEvents:
 			ifChange, isIfChange := event.(netmonitor.IfChange)
 			if isIfChange {
@@ -514,6 +535,15 @@ func (n *nim) initPublications() (err error) {
 	if err != nil {
 		return err
 	}
+
+	n.pubWlanMetrics, err = n.PubSub.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.WlanMetrics{},
+		})
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -659,6 +689,19 @@ func (n *nim) initSubscriptions() (err error) {
 	if err != nil {
 		return err
 	}
+
+	// Datastore domain names are resolved using encrypted DNS (if enabled).
+	n.subDatastoreConfig, err = n.PubSub.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedagent",
+		MyAgentName: agentName,
+		TopicImpl:   types.DatastoreConfig{},
+		Activate:    false,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -706,6 +749,8 @@ func (n *nim) applyGlobalConfig(gcp *types.ConfigItemValueMap) {
 	n.dpcManager.UpdateGCP(n.globalConfig)
 	timeout := gcp.GlobalValueInt(types.NetworkTestTimeout)
 	n.connTester.TestTimeout = time.Second * time.Duration(timeout)
+	n.connTester.CaptivePortalProbeURL = gcp.GlobalValueString(
+		types.NetworkCaptivePortalProbeURL)
 	n.reevaluateLastResortDPC()
 	n.gcInitialized = true
 }
@@ -0,0 +1,336 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timesync
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	runDir         = "/run/" + agentName
+	chronyConfFile = runDir + "/chrony.conf"
+	chronyPidFile  = runDir + "/chronyd.pid"
+	chronyd        = "/usr/sbin/chronyd"
+	chronyc        = "/usr/bin/chronyc"
+	// Drift file and NTS keys and cookies are kept across reboots.
+	// Reusing NTS cookies avoids NTS-KE (TLS) handshake after every boot.
+	chronyPersistDir = types.PersistStatusDir + "/" + agentName
+	// Used if there is no NTP server configured or received from DHCP.
+	defaultNTPServer = "pool.ntp.org"
+	// How long to wait for chronyd to start or stop.
+	chronyStartStopTimeout = 10 * time.Second
+	// PHC is synchronized by ptp4l to TAI, which is currently
+	// 37 seconds ahead of UTC.
+	ptpTAIOffset = -37
+)
+
+// ptpClock : PTP hardware clock of a NIC synchronized by ptp4l
+// and used by chronyd as a reference clock.
+type ptpClock struct {
+	ifName string
+	device string
+	refID  string
+}
+
+// generateChronyConf generates configuration file for chronyd.
+func generateChronyConf(config types.TimeSyncConfig, dhcpServers []net.IP,
+	ptpClocks []ptpClock) string {
+	var sb strings.Builder
+	sb.WriteString("# Generated by timesync, do not edit.\n")
+	var hasNTS, hasServer bool
+	for _, server := range config.NTPServers {
+		line := "server " + server.Address + " iburst"
+		if server.NTS {
+			hasNTS = true
+			line += " nts"
+			if server.NTSKEPort != 0 && server.NTSKEPort != types.DefaultNTSKEPort {
+				line += fmt.Sprintf(" ntsport %d", server.NTSKEPort)
+			}
+		}
+		if server.Prefer {
+			line += " prefer"
+		}
+		sb.WriteString(line + "\n")
+		hasServer = true
+	}
+	if !config.UseOnlyConfiguredServers {
+		for _, server := range dhcpServers {
+			sb.WriteString("server " + server.String() + " iburst\n")
+			hasServer = true
+		}
+	}
+	if !hasServer && len(ptpClocks) == 0 {
+		sb.WriteString("pool " + defaultNTPServer + " iburst\n")
+	}
+	for _, clock := range ptpClocks {
+		sb.WriteString(fmt.Sprintf(
+			"refclock PHC %s poll 0 dpoll -2 offset %d refid %s\n",
+			clock.device, ptpTAIOffset, clock.refID))
+	}
+	// Step the clock if it is off by more than a second during the first
+	// updates, e.g. if the device was powered off for a long time
+	// or does not have RTC.
+	sb.WriteString("makestep 1.0 3\n")
+	sb.WriteString("rtcsync\n")
+	sb.WriteString("driftfile " + chronyPersistDir + "/chrony.drift\n")
+	if hasNTS {
+		sb.WriteString("ntsdumpdir " + chronyPersistDir + "\n")
+		// Without RTC the clock may be way off after boot and the certificate
+		// of the NTS-KE server would be rejected.
+		sb.WriteString("nocerttimecheck 1\n")
+	}
+	sb.WriteString("pidfile " + chronyPidFile + "\n")
+	// Only allow chronyc over the unix socket.
+	sb.WriteString("cmdport 0\n")
+	return sb.String()
+}
+
+// chronyManager runs chronyd and queries it for the status.
+type chronyManager struct {
+	conf string
+	// Reference ID -> interface name
+	refClocks map[string]string
+}
+
+func newChronyManager() *chronyManager {
+	return &chronyManager{refClocks: make(map[string]string)}
+}
+
+// reconcile (re)starts chronyd if the configuration has changed
+// or if chronyd is not running.
+func (m *chronyManager) reconcile(conf string, ptpClocks []ptpClock) error {
+	running := isProcessRunning(chronyPidFile)
+	if running && conf == m.conf {
+		return nil
+	}
+	if _, err := os.Stat(chronyd); err != nil {
+		return fmt.Errorf("chronyd is not available: %w", err)
+	}
+	for _, dir := range []string{runDir, chronyPersistDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(chronyConfFile, []byte(conf), 0644); err != nil {
+		return err
+	}
+	if running {
+		log.Noticef("Restarting chronyd with updated configuration")
+		if err := stopProcess(chronyPidFile, chronyStartStopTimeout); err != nil {
+			return err
+		}
+	} else {
+		log.Noticef("Starting chronyd")
+	}
+	out, err := base.Exec(log, chronyd, "-f", chronyConfFile).CombinedOutputWithTimeout()
+	if err != nil {
+		return fmt.Errorf("failed to start chronyd: %v (%s)",
+			err, strings.TrimSpace(string(out)))
+	}
+	startTime := time.Now()
+	for !isProcessRunning(chronyPidFile) {
+		if time.Since(startTime) > chronyStartStopTimeout {
+			return errors.New("chronyd failed to start in time")
+		}
+		time.Sleep(time.Second)
+	}
+	m.conf = conf
+	m.refClocks = make(map[string]string)
+	for _, clock := range ptpClocks {
+		m.refClocks[clock.refID] = clock.ifName
+	}
+	return nil
+}
+
+// getStatus queries chronyd for the synchronization status.
+func (m *chronyManager) getStatus(config types.TimeSyncConfig) (
+	types.TimeSyncStatus, error) {
+	var outputs []string
+	// -c : CSV output, -N : print names of sources as configured
+	for _, args := range [][]string{
+		{"-c", "tracking"}, {"-c", "-N", "sources"}, {"-c", "-N", "authdata"}} {
+		out, err := base.Exec(log, chronyc, args...).CombinedOutputWithTimeout()
+		if err != nil {
+			return types.TimeSyncStatus{}, fmt.Errorf("chronyc %s failed: %v (%s)",
+				strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+		}
+		outputs = append(outputs, string(out))
+	}
+	return parseChronyStatus(outputs[0], outputs[1], outputs[2],
+		config, m.refClocks)
+}
+
+func parseCSV(output string) ([][]string, error) {
+	reader := csv.NewReader(strings.NewReader(output))
+	reader.FieldsPerRecord = -1
+	return reader.ReadAll()
+}
+
+func parseSeconds(value string) time.Duration {
+	seconds, _ := strconv.ParseFloat(value, 64)
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// parseChronyStatus builds TimeSyncStatus from the CSV output
+// of "chronyc tracking", "chronyc sources" and "chronyc authdata".
+func parseChronyStatus(tracking, sources, authdata string,
+	config types.TimeSyncConfig, refClocks map[string]string) (
+	status types.TimeSyncStatus, err error) {
+	trackingRecords, err := parseCSV(tracking)
+	if err != nil || len(trackingRecords) != 1 || len(trackingRecords[0]) < 14 {
+		return status, fmt.Errorf("unexpected output of chronyc tracking: %q", tracking)
+	}
+	// Reference ID, Name/IP, Stratum, Ref time, System time, Last offset,
+	// RMS offset, Frequency, Residual freq, Skew, Root delay, Root dispersion,
+	// Update interval, Leap status
+	fields := trackingRecords[0]
+	stratum, _ := strconv.Atoi(fields[2])
+	status.Stratum = uint8(stratum)
+	status.Synchronized = fields[0] != "00000000" && fields[13] != "Not synchronised"
+	// Positive correction means that the system clock is behind the true time.
+	status.Offset = -parseSeconds(fields[4])
+	if refTime, _ := strconv.ParseFloat(fields[3], 64); refTime > 0 {
+		sec, frac := int64(refTime), refTime-float64(int64(refTime))
+		status.LastSync = time.Unix(sec, int64(frac*1e9)).UTC()
+	}
+
+	// Name/IP, Mode, KeyID, Type, KLen, Last, Atmp, NAK, Cook, CLen
+	authRecords, err := parseCSV(authdata)
+	if err != nil {
+		return status, fmt.Errorf("unexpected output of chronyc authdata: %q", authdata)
+	}
+	ntsSources := make(map[string]bool) // value: NTS failed
+	for _, fields := range authRecords {
+		if len(fields) < 10 || fields[1] != "NTS" {
+			continue
+		}
+		attempts, _ := strconv.Atoi(fields[6])
+		naks, _ := strconv.Atoi(fields[7])
+		// More than one attempt since the last successful key establishment
+		// indicates a problem with the network or with the server.
+		ntsSources[fields[0]] = attempts > 1 || naks > 0
+	}
+
+	sourceRecords, err := parseCSV(sources)
+	if err != nil {
+		return status, fmt.Errorf("unexpected output of chronyc sources: %q", sources)
+	}
+	reported := make(map[string]bool)
+	// Mode, State, Name/IP, Stratum, Poll, Reach, LastRx, Last sample offset,
+	// Measured offset, Error
+	for _, fields := range sourceRecords {
+		if len(fields) < 10 {
+			continue
+		}
+		source := types.TimeSourceStatus{
+			Address: fields[2],
+			Type:    types.TimeSourceNTP,
+			// Positive offset means that the device clock is ahead of the source.
+			Offset: parseSeconds(fields[7]),
+		}
+		stratum, _ := strconv.Atoi(fields[3])
+		source.Stratum = uint8(stratum)
+		if fields[0] == "#" {
+			source.Type = types.TimeSourcePTP
+			if ifName, ok := refClocks[source.Address]; ok {
+				source.Address = ifName
+			}
+		} else if ntsFailed, isNTS := ntsSources[source.Address]; isNTS {
+			source.Type = types.TimeSourceNTS
+			source.NTSFailed = ntsFailed
+		}
+		switch fields[1] {
+		case "*":
+			source.State = types.TimeSourceStateSelected
+		case "+":
+			source.State = types.TimeSourceStateCandidate
+		case "-", "x", "~":
+			source.State = types.TimeSourceStateRejected
+		case "?":
+			source.State = types.TimeSourceStateUnreachable
+		}
+		if reach, _ := strconv.ParseUint(fields[5], 8, 8); reach == 0 {
+			source.State = types.TimeSourceStateUnreachable
+		}
+		if source.State == types.TimeSourceStateSelected {
+			status.Source = source.Address
+			status.SourceType = source.Type
+		}
+		reported[source.Address] = true
+		status.Sources = append(status.Sources, source)
+	}
+	// Configured servers which chronyd does not use (e.g. the name cannot be
+	// resolved or NTS-KE failed) are not listed by chronyc sources.
+	for _, server := range config.NTPServers {
+		if reported[server.Address] {
+			continue
+		}
+		source := types.TimeSourceStatus{
+			Address: server.Address,
+			Type:    types.TimeSourceNTP,
+			State:   types.TimeSourceStateUnreachable,
+		}
+		if server.NTS {
+			source.Type = types.TimeSourceNTS
+			source.NTSFailed = ntsSources[server.Address]
+		}
+		status.Sources = append(status.Sources, source)
+	}
+	return status, nil
+}
+
+func isProcessRunning(pidFile string) bool {
+	process := getProcess(pidFile)
+	if process == nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+func getProcess(pidFile string) *os.Process {
+	pidBytes, err := os.ReadFile(pidFile)
+	if err != nil {
+		return nil
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil {
+		log.Errorf("getProcess(%s): invalid PID: %v", filepath.Base(pidFile), err)
+		return nil
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return nil
+	}
+	return process
+}
+
+func stopProcess(pidFile string, timeout time.Duration) error {
+	process := getProcess(pidFile)
+	if process == nil {
+		return nil
+	}
+	if err := process.Signal(syscall.SIGTERM); err != nil {
+		return fmt.Errorf("failed to stop process (pid-file=%s): %w", pidFile, err)
+	}
+	stopTime := time.Now()
+	for isProcessRunning(pidFile) {
+		if time.Since(stopTime) > timeout {
+			return fmt.Errorf("process (pid-file=%s) failed to stop in time", pidFile)
+		}
+		time.Sleep(time.Second)
+	}
+	return nil
+}
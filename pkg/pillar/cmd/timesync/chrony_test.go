@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timesync
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	. "github.com/onsi/gomega"
+)
+
+func TestGenerateChronyConf(t *testing.T) {
+	g := NewGomegaWithT(t)
+	config := types.TimeSyncConfig{
+		NTPServers: []types.NTPServerConfig{
+			{Address: "nts.example.com", NTS: true, Prefer: true},
+			{Address: "nts2.example.com", NTS: true, NTSKEPort: 4461},
+			{Address: "10.1.1.1"},
+		},
+	}
+	dhcpServers := []net.IP{net.ParseIP("192.168.1.1")}
+	ptpClocks := []ptpClock{{ifName: "eth0", device: "/dev/ptp0", refID: "PTP0"}}
+	conf := generateChronyConf(config, dhcpServers, ptpClocks)
+	g.Expect(conf).To(ContainSubstring("server nts.example.com iburst nts prefer\n"))
+	g.Expect(conf).To(ContainSubstring("server nts2.example.com iburst nts ntsport 4461\n"))
+	g.Expect(conf).To(ContainSubstring("server 10.1.1.1 iburst\n"))
+	g.Expect(conf).To(ContainSubstring("server 192.168.1.1 iburst\n"))
+	g.Expect(conf).To(ContainSubstring("refclock PHC /dev/ptp0 poll 0 dpoll -2 offset -37 refid PTP0\n"))
+	g.Expect(conf).To(ContainSubstring("ntsdumpdir "))
+	g.Expect(conf).ToNot(ContainSubstring("pool "))
+
+	// DHCP servers are ignored.
+	config.UseOnlyConfiguredServers = true
+	conf = generateChronyConf(config, dhcpServers, nil)
+	g.Expect(conf).ToNot(ContainSubstring("192.168.1.1"))
+	g.Expect(conf).ToNot(ContainSubstring("refclock"))
+
+	// Fallback to the default pool.
+	conf = generateChronyConf(types.TimeSyncConfig{}, nil, nil)
+	g.Expect(conf).To(ContainSubstring("pool " + defaultNTPServer + " iburst\n"))
+	g.Expect(conf).ToNot(ContainSubstring("ntsdumpdir"))
+}
+
+func TestParseChronyStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tracking := "C0A80101,192.168.1.1,3,1696245600.500000000,-0.000012000," +
+		"0.000001,0.000010,-12.345,0.001,0.050,0.012345,0.000678,64.5,Normal\n"
+	sources := strings.Join([]string{
+		"^,*,nts.example.com,2,6,377,34,0.000020000,0.000021000,0.000456",
+		"^,-,192.168.1.1,3,6,377,12,-0.001000000,-0.001000000,0.000500",
+		"^,?,nts2.example.com,0,6,0,-,0.000000000,0.000000000,0.000000",
+		"#,+,PTP0,0,0,377,1,0.000000100,0.000000100,0.000000010",
+	}, "\n") + "\n"
+	authdata := strings.Join([]string{
+		"nts.example.com,NTS,1,15,256,33,0,0,8,100",
+		"192.168.1.1,-,0,0,0,-,0,0,0,0",
+		"nts2.example.com,NTS,0,0,0,-,5,0,0,0",
+	}, "\n") + "\n"
+	config := types.TimeSyncConfig{
+		NTPServers: []types.NTPServerConfig{
+			{Address: "nts.example.com", NTS: true},
+			{Address: "nts2.example.com", NTS: true},
+			{Address: "unresolvable.example.com"},
+		},
+	}
+	status, err := parseChronyStatus(tracking, sources, authdata, config,
+		map[string]string{"PTP0": "eth0"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status.Synchronized).To(BeTrue())
+	g.Expect(status.Stratum).To(BeEquivalentTo(3))
+	g.Expect(status.Offset).To(Equal(12 * time.Microsecond))
+	g.Expect(status.LastSync).To(Equal(time.Unix(1696245600, 500000000).UTC()))
+	g.Expect(status.Source).To(Equal("nts.example.com"))
+	g.Expect(status.SourceType).To(Equal(types.TimeSourceNTS))
+	g.Expect(status.Sources).To(HaveLen(5))
+
+	g.Expect(status.Sources[0].State).To(Equal(types.TimeSourceStateSelected))
+	g.Expect(status.Sources[0].NTSFailed).To(BeFalse())
+	g.Expect(status.Sources[0].Offset).To(Equal(20 * time.Microsecond))
+	g.Expect(status.Sources[1].Type).To(Equal(types.TimeSourceNTP))
+	g.Expect(status.Sources[1].State).To(Equal(types.TimeSourceStateRejected))
+	g.Expect(status.Sources[2].Type).To(Equal(types.TimeSourceNTS))
+	g.Expect(status.Sources[2].State).To(Equal(types.TimeSourceStateUnreachable))
+	g.Expect(status.Sources[2].NTSFailed).To(BeTrue())
+	g.Expect(status.Sources[3].Address).To(Equal("eth0"))
+	g.Expect(status.Sources[3].Type).To(Equal(types.TimeSourcePTP))
+	g.Expect(status.Sources[3].State).To(Equal(types.TimeSourceStateCandidate))
+	g.Expect(status.Sources[4].Address).To(Equal("unresolvable.example.com"))
+	g.Expect(status.Sources[4].State).To(Equal(types.TimeSourceStateUnreachable))
+
+	// Not synchronized.
+	tracking = "00000000,,0,0.000000000,0.000000000,0.0,0.0,0.0,0.0,0.0," +
+		"1.0,1.0,0.0,Not synchronised\n"
+	status, err = parseChronyStatus(tracking, "", "", types.TimeSyncConfig{}, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status.Synchronized).To(BeFalse())
+	g.Expect(status.LastSync.IsZero()).To(BeTrue())
+	g.Expect(status.Sources).To(BeEmpty())
+
+	_, err = parseChronyStatus("garbage", "", "", types.TimeSyncConfig{}, nil)
+	g.Expect(err).To(HaveOccurred())
+}
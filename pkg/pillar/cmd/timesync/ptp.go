@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package timesync
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const ptp4l = "/usr/sbin/ptp4l"
+
+// ptpProcess : ptp4l running on one interface.
+type ptpProcess struct {
+	cmd    *exec.Cmd
+	domain uint8
+	done   chan struct{}
+}
+
+func (p *ptpProcess) isRunning() bool {
+	select {
+	case <-p.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// ptpManager runs ptp4l on interfaces with a PTP hardware clock (PHC).
+// ptp4l synchronizes the PHC with the PTP grandmaster and chronyd then uses
+// the PHC as a reference clock to synchronize the system clock.
+type ptpManager struct {
+	// Interface name -> ptp4l process
+	processes map[string]*ptpProcess
+}
+
+func newPTPManager() *ptpManager {
+	return &ptpManager{processes: make(map[string]*ptpProcess)}
+}
+
+// phcDevice returns path to the PTP hardware clock of the interface
+// or an empty string if the NIC does not have one.
+func phcDevice(ifName string) string {
+	entries, err := os.ReadDir(filepath.Join("/sys/class/net", ifName, "device/ptp"))
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "ptp") {
+			return "/dev/" + entry.Name()
+		}
+	}
+	return ""
+}
+
+// selectPTPInterfaces returns names of interfaces to run PTP on.
+func selectPTPInterfaces(config types.PTPConfig,
+	dns types.DeviceNetworkStatus) (ifNames []string) {
+	for _, port := range dns.Ports {
+		if port.IfName == "" {
+			continue
+		}
+		if len(config.Ports) == 0 {
+			if port.IsMgmt {
+				ifNames = append(ifNames, port.IfName)
+			}
+			continue
+		}
+		for _, label := range config.Ports {
+			if port.Logicallabel == label {
+				ifNames = append(ifNames, port.IfName)
+				break
+			}
+		}
+	}
+	sort.Strings(ifNames)
+	return ifNames
+}
+
+// reconcile starts and stops ptp4l processes to match the configuration
+// and returns the PTP clocks which chronyd should use.
+func (m *ptpManager) reconcile(config types.PTPConfig,
+	dns types.DeviceNetworkStatus) (clocks []ptpClock) {
+	if config.Enabled {
+		for _, ifName := range selectPTPInterfaces(config, dns) {
+			device := phcDevice(ifName)
+			if device == "" {
+				log.Functionf("ptpManager: %s does not have PTP hardware clock",
+					ifName)
+				continue
+			}
+			clocks = append(clocks, ptpClock{
+				ifName: ifName,
+				device: device,
+				refID:  fmt.Sprintf("PTP%d", len(clocks)),
+			})
+		}
+	}
+	wanted := make(map[string]bool)
+	for _, clock := range clocks {
+		wanted[clock.ifName] = true
+	}
+	for ifName, process := range m.processes {
+		if !wanted[ifName] || !process.isRunning() ||
+			process.domain != config.Domain {
+			m.stop(ifName)
+		}
+	}
+	for _, clock := range clocks {
+		if _, running := m.processes[clock.ifName]; running {
+			continue
+		}
+		if err := m.start(clock.ifName, config.Domain); err != nil {
+			log.Errorf("ptpManager: %v", err)
+		}
+	}
+	return clocks
+}
+
+func (m *ptpManager) start(ifName string, domain uint8) error {
+	// -s : slave only, -m : print messages to stdout
+	cmd := exec.Command(ptp4l, "-i", ifName, "-s", "-m",
+		fmt.Sprintf("--domainNumber=%d", domain),
+		// Only warnings and errors.
+		"--logging_level=4")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	cmd.Stderr = cmd.Stdout
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start ptp4l on %s: %w", ifName, err)
+	}
+	log.Noticef("Started ptp4l on %s (domain %d)", ifName, domain)
+	process := &ptpProcess{cmd: cmd, domain: domain, done: make(chan struct{})}
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			log.Warnf("ptp4l(%s): %s", ifName, scanner.Text())
+		}
+		err := cmd.Wait()
+		log.Noticef("ptp4l on %s exited: %v", ifName, err)
+		close(process.done)
+	}()
+	m.processes[ifName] = process
+	return nil
+}
+
+func (m *ptpManager) stop(ifName string) {
+	process := m.processes[ifName]
+	delete(m.processes, ifName)
+	if !process.isRunning() {
+		return
+	}
+	log.Noticef("Stopping ptp4l on %s", ifName)
+	_ = process.cmd.Process.Kill()
+	<-process.done
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package timesync manages synchronization of the device clock.
+// It runs chronyd with NTP servers configured by the controller (optionally
+// authenticated with NTS) and/or received from DHCP, runs ptp4l on ports
+// with a PTP hardware clock if PTP is enabled, and publishes TimeSyncStatus.
+package timesync
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/agentbase"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	agentName = "timesync"
+	// Time limits for event loop handlers
+	errorTime            = 3 * time.Minute
+	warningTime          = 40 * time.Second
+	stillRunningInterval = 25 * time.Second
+
+	// How often to query chronyd for the status.
+	statusPollInterval = 30 * time.Second
+	// Offset and the time of the last sync are changing with every poll.
+	// To avoid flooding subscribers (and the controller via device info),
+	// the status is republished only when something significant changes
+	// or when it is older than this.
+	statusRepublishInterval = 10 * time.Minute
+)
+
+var (
+	logger *logrus.Logger
+	log    *base.LogObject
+)
+
+type timeSyncContext struct {
+	agentbase.AgentBase
+	ps                     *pubsub.PubSub
+	subGlobalConfig        pubsub.Subscription
+	subTimeSyncConfig      pubsub.Subscription
+	subDeviceNetworkStatus pubsub.Subscription
+	pubTimeSyncStatus      pubsub.Publication
+	GCInitialized          bool
+
+	config        types.TimeSyncConfig
+	deviceNetwork types.DeviceNetworkStatus
+
+	chrony *chronyManager
+	ptp    *ptpManager
+
+	lastStatus    types.TimeSyncStatus
+	lastPublished time.Time
+}
+
+// Run : timesync main function.
+func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, arguments []string) int {
+	logger = loggerArg
+	log = logArg
+
+	ctx := &timeSyncContext{
+		ps:     ps,
+		chrony: newChronyManager(),
+		ptp:    newPTPManager(),
+	}
+	agentbase.Init(ctx, logger, log, agentName,
+		agentbase.WithPidFile(),
+		agentbase.WithWatchdog(ps, warningTime, errorTime),
+		agentbase.WithArguments(arguments))
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(stillRunningInterval)
+
+	pubTimeSyncStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.TimeSyncStatus{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubTimeSyncStatus = pubTimeSyncStatus
+
+	// Look for global config such as log levels
+	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ConfigItemValueMap{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           ctx,
+		CreateHandler: handleGlobalConfigCreate,
+		ModifyHandler: handleGlobalConfigModify,
+		DeleteHandler: handleGlobalConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
+
+	// Pick up debug aka log level before we start real work
+	for !ctx.GCInitialized {
+		log.Functionf("waiting for GCInitialized")
+		select {
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
+		case <-stillRunning.C:
+		}
+		ps.StillRunning(agentName, warningTime, errorTime)
+	}
+	log.Functionf("processed GlobalConfig")
+
+	// Time sync config from the controller.
+	// Persisted by zedagent so that it is available before the device
+	// connects to the controller.
+	subTimeSyncConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.TimeSyncConfig{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           ctx,
+		CreateHandler: handleTimeSyncConfigCreate,
+		ModifyHandler: handleTimeSyncConfigModify,
+		DeleteHandler: handleTimeSyncConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subTimeSyncConfig = subTimeSyncConfig
+	subTimeSyncConfig.Activate()
+
+	// NTP servers from DHCP and port configuration.
+	subDeviceNetworkStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "nim",
+		MyAgentName:   agentName,
+		TopicImpl:     types.DeviceNetworkStatus{},
+		Activate:      false,
+		Ctx:           ctx,
+		CreateHandler: handleDNSCreate,
+		ModifyHandler: handleDNSModify,
+		DeleteHandler: handleDNSDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDeviceNetworkStatus = subDeviceNetworkStatus
+	subDeviceNetworkStatus.Activate()
+
+	// Start with the default configuration, it will be updated
+	// as soon as the config and the network status are received.
+	ctx.reconcile()
+
+	statusTicker := time.NewTicker(statusPollInterval)
+	for {
+		select {
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
+
+		case change := <-subTimeSyncConfig.MsgChan():
+			subTimeSyncConfig.ProcessChange(change)
+
+		case change := <-subDeviceNetworkStatus.MsgChan():
+			subDeviceNetworkStatus.ProcessChange(change)
+
+		case <-statusTicker.C:
+			// Restart daemons which have crashed.
+			ctx.reconcile()
+			ctx.updateStatus()
+
+		case <-stillRunning.C:
+		}
+		ps.StillRunning(agentName, warningTime, errorTime)
+	}
+}
+
+// reconcile makes sure that chronyd and ptp4l are running with the up-to-date
+// configuration.
+func (ctx *timeSyncContext) reconcile() {
+	ptpClocks := ctx.ptp.reconcile(ctx.config.PTP, ctx.deviceNetwork)
+	ntpServers := types.GetNTPServers(ctx.deviceNetwork, "")
+	conf := generateChronyConf(ctx.config, ntpServers, ptpClocks)
+	if err := ctx.chrony.reconcile(conf, ptpClocks); err != nil {
+		ctx.publishStatus(types.TimeSyncStatus{Error: err.Error()})
+	}
+}
+
+// updateStatus queries chronyd for the status and publishes it.
+func (ctx *timeSyncContext) updateStatus() {
+	status, err := ctx.chrony.getStatus(ctx.config)
+	if err != nil {
+		log.Warnf("updateStatus: %v", err)
+		status.Error = err.Error()
+	}
+	ctx.publishStatus(status)
+}
+
+func (ctx *timeSyncContext) publishStatus(status types.TimeSyncStatus) {
+	if timeSyncStatusMostlyEqual(status, ctx.lastStatus) &&
+		time.Since(ctx.lastPublished) < statusRepublishInterval {
+		return
+	}
+	if err := ctx.pubTimeSyncStatus.Publish(status.Key(), status); err != nil {
+		log.Errorf("publishStatus: %v", err)
+		return
+	}
+	ctx.lastStatus = status
+	ctx.lastPublished = time.Now()
+}
+
+// timeSyncStatusMostlyEqual compares two statuses ignoring fields
+// which change with every poll.
+func timeSyncStatusMostlyEqual(status1, status2 types.TimeSyncStatus) bool {
+	if status1.Synchronized != status2.Synchronized ||
+		status1.Source != status2.Source ||
+		status1.SourceType != status2.SourceType ||
+		status1.Stratum != status2.Stratum ||
+		status1.Error != status2.Error ||
+		len(status1.Sources) != len(status2.Sources) {
+		return false
+	}
+	for i := range status1.Sources {
+		source1, source2 := status1.Sources[i], status2.Sources[i]
+		source1.Offset, source2.Offset = 0, 0
+		if !reflect.DeepEqual(source1, source2) {
+			return false
+		}
+	}
+	return true
+}
+
+func handleTimeSyncConfigCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+	handleTimeSyncConfigImpl(ctxArg, key, configArg)
+}
+
+func handleTimeSyncConfigModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+	handleTimeSyncConfigImpl(ctxArg, key, configArg)
+}
+
+func handleTimeSyncConfigImpl(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*timeSyncContext)
+	config := configArg.(types.TimeSyncConfig)
+	log.Functionf("handleTimeSyncConfigImpl: %+v", config)
+	ctx.config = config
+	ctx.reconcile()
+	log.Functionf("handleTimeSyncConfigImpl done")
+}
+
+func handleTimeSyncConfigDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*timeSyncContext)
+	log.Functionf("handleTimeSyncConfigDelete")
+	ctx.config = types.TimeSyncConfig{}
+	ctx.reconcile()
+	log.Functionf("handleTimeSyncConfigDelete done")
+}
+
+func handleDNSCreate(ctxArg interface{}, key string, statusArg interface{}) {
+	handleDNSImpl(ctxArg, key, statusArg)
+}
+
+func handleDNSModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleDNSImpl(ctxArg, key, statusArg)
+}
+
+func handleDNSImpl(ctxArg interface{}, key string, statusArg interface{}) {
+	ctx := ctxArg.(*timeSyncContext)
+	status := statusArg.(types.DeviceNetworkStatus)
+	if key != "global" {
+		log.Functionf("handleDNSImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleDNSImpl for %s", key)
+	ctx.deviceNetwork = status
+	ctx.reconcile()
+	log.Functionf("handleDNSImpl done for %s", key)
+}
+
+func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
+	ctx := ctxArg.(*timeSyncContext)
+	if key != "global" {
+		log.Functionf("handleDNSDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleDNSDelete for %s", key)
+	ctx.deviceNetwork = types.DeviceNetworkStatus{}
+	ctx.reconcile()
+	log.Functionf("handleDNSDelete done for %s", key)
+}
+
+func handleGlobalConfigCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*timeSyncContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigImpl for %s", key)
+	gcp := agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		ctx.CLIParams().DebugOverride, logger)
+	if gcp != nil {
+		ctx.GCInitialized = true
+	}
+	log.Functionf("handleGlobalConfigImpl done for %s", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*timeSyncContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigDelete for %s", key)
+	agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		ctx.CLIParams().DebugOverride, logger)
+	log.Functionf("handleGlobalConfigDelete done for %s", key)
+}
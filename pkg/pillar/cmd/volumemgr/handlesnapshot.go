@@ -5,6 +5,7 @@ package volumemgr
 
 import (
 	"fmt"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/volumehandlers"
 	"time"
@@ -35,6 +36,10 @@ func handleVolumesSnapshotCreate(ctxArg interface{}, key string, configArg inter
 		publishVolumesSnapshotStatus(ctx, snapshotStatus)
 		return
 	}
+	// If the app is still running, quiesce the filesystems of its guest OS
+	// (requires a guest agent) so that the volumes are captured consistently
+	thaw := hypervisor.FreezeAppFilesystems(config.AppUUID)
+	defer thaw()
 	// Find the corresponding volume status
 	for _, volumeID := range config.VolumeIDs {
 		volumeStatus := ctx.lookupVolumeStatusByUUID(volumeID.String())
@@ -102,6 +102,8 @@ type getconfigContext struct {
 	pubVolumeConfig           pubsub.Publication
 	pubDisksConfig            pubsub.Publication
 	pubEdgeNodeInfo           pubsub.Publication
+	pubNetDumpRequest         pubsub.Publication
+	pubTimeSyncConfig         pubsub.Publication
 	NodeAgentStatus           *types.NodeAgentStatus
 	configProcessingSkipFlag  bool
 	lastReceivedConfig        time.Time // controller or local clocks
@@ -149,6 +151,10 @@ type getconfigContext struct {
 	configEdgeview *types.EdgeviewConfig // edge-view config save
 
 	cipherContexts map[string]types.CipherContext
+
+	// netDumpReqLock : serializes publishing of netdump requests
+	// from the controller and from the local profile server.
+	netDumpReqLock sync.Mutex
 }
 
 // current devUUID from OnboardingStatus
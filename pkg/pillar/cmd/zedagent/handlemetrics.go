@@ -282,6 +282,16 @@ func lookupAppContainerMetric(ctx *zedagentContext, uuidStr string) *types.AppCo
 	return &metric
 }
 
+func lookupAppPrometheusMetrics(ctx *zedagentContext, uuidStr string) *types.AppPrometheusMetrics {
+	sub := ctx.subAppPrometheusMetrics
+	m, _ := sub.Get(uuidStr)
+	if m == nil {
+		return nil
+	}
+	metric := m.(types.AppPrometheusMetrics)
+	return &metric
+}
+
 func publishMetrics(ctx *zedagentContext, iteration int) {
 
 	var ReportMetrics = &metrics.ZMetricMsg{}
@@ -389,6 +399,7 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	ReportDeviceMetric.Acl = aclMetric
 
 	ReportDeviceMetric.Cellular = getCellularMetrics(ctx)
+	ReportDeviceMetric.Wifi = getWifiMetrics(ctx)
 
 	zedboxStats := new(metrics.ZedboxStats)
 	zedboxStats.NumGoRoutines = uint32(runtime.NumGoroutine()) // number of zedbox goroutines
@@ -727,6 +738,18 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 			ReportAppMetric.Memory.UsedPercentage = dm.UsedMemoryPercent
 			availableMemoryPercent := 100.0 - dm.UsedMemoryPercent
 			ReportAppMetric.Memory.AvailPercentage = availableMemoryPercent
+
+			ReportAppMetric.Cgroup = &metrics.AppCgroupMetric{
+				CpuPeriods:          dm.Cgroup.CPUPeriods,
+				CpuThrottledPeriods: dm.Cgroup.CPUThrottledPeriods,
+				CpuThrottledNs:      dm.Cgroup.CPUThrottledNs,
+				Pids:                dm.Cgroup.Pids,
+				PidsMax:             dm.Cgroup.PidsMax,
+				IoReadBytes:         dm.Cgroup.IOReadBytes,
+				IoWriteBytes:        dm.Cgroup.IOWriteBytes,
+				IoReadOps:           dm.Cgroup.IOReadOps,
+				IoWriteOps:          dm.Cgroup.IOWriteOps,
+			}
 		}
 
 		appInterfaceList := aiStatus.GetAppInterfaceList()
@@ -857,6 +880,20 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 			ctx.appContainerStatsTime = acMetric.CollectTime
 		}
 
+		// samples scraped from the Prometheus endpoint of the app
+		apMetrics := lookupAppPrometheusMetrics(ctx, aiStatus.UUIDandVersion.UUID.String())
+		if apMetrics != nil {
+			for _, sample := range apMetrics.Samples {
+				ReportAppMetric.Custom = append(ReportAppMetric.Custom,
+					&metrics.AppCustomMetric{
+						Name:   sample.Name,
+						Labels: sample.Labels,
+						Type:   metrics.MetricItemType(sample.Type),
+						Value:  sample.Value,
+					})
+			}
+		}
+
 		ReportMetrics.Am = append(ReportMetrics.Am, ReportAppMetric)
 	}
 
@@ -916,6 +953,37 @@ func getCellularMetrics(ctx *zedagentContext) (cellularMetrics []*metrics.Cellul
 	return cellularMetrics
 }
 
+func getWifiMetrics(ctx *zedagentContext) (wifiMetrics []*metrics.WifiMetric) {
+	m, err := ctx.subWlanMetrics.Get("global")
+	if err != nil {
+		// Not published until wlan service reports metrics for the first time.
+		log.Functionf("subWlanMetrics.Get failed: %v", err)
+		return
+	}
+	wlanMetrics, ok := m.(types.WlanMetrics)
+	if !ok {
+		log.Errorln("unexpected type of wlan metrics")
+		return
+	}
+	for _, network := range wlanMetrics.Networks {
+		if network.LogicalLabel == "" {
+			// skip adapters not used by the current DPC
+			continue
+		}
+		wifiMetrics = append(wifiMetrics,
+			&metrics.WifiMetric{
+				Logicallabel: network.LogicalLabel,
+				SignalStrength: &metrics.WifiSignalStrength{
+					Rssi:        network.SignalInfo.RSSI,
+					Noise:       network.SignalInfo.Noise,
+					LinkQuality: network.SignalInfo.LinkQuality,
+				},
+				TxBitrate: network.TxBitrate,
+			})
+	}
+	return wifiMetrics
+}
+
 func getDiskInfo(ctx *zedagentContext, vrs types.VolumeRefStatus, appDiskDetails *metrics.AppDiskMetric) error {
 	appDiskMetric := lookupAppDiskMetric(ctx, vrs.ActiveFileLocation)
 	if appDiskMetric == nil {
@@ -1013,7 +1081,8 @@ func setMetricAnyValue(item *metrics.MetricItem, val interface{}) {
 	}
 }
 
-func encodeProxyStatus(proxyConfig *types.ProxyConfig) *info.ProxyStatus {
+func encodeProxyStatus(proxyConfig *types.ProxyConfig,
+	testResults types.TestResults) *info.ProxyStatus {
 	status := new(info.ProxyStatus)
 	status.Proxies = make([]*info.ProxyEntry, len(proxyConfig.Proxies))
 	for i, pe := range proxyConfig.Proxies {
@@ -1029,6 +1098,8 @@ func encodeProxyStatus(proxyConfig *types.ProxyConfig) *info.ProxyStatus {
 	status.NetworkProxyURL = proxyConfig.NetworkProxyURL
 	status.WpadURL = proxyConfig.WpadURL
 	// XXX add? status.ProxyCertPEM = proxyConfig.ProxyCertPEM
+	status.AuthConfigured = proxyConfig.AuthCipherBlock.IsCipher
+	status.AuthFailed = testResults.HasError() && testResults.ProxyAuthFailed
 	log.Tracef("encodeProxyStatus: %+v", status)
 	return status
 }
@@ -1071,7 +1142,7 @@ func encodeNetworkPortConfig(ctx *zedagentContext,
 	// XXX  string dhcpRangeLow = 17;
 	// XXX  string dhcpRangeHigh = 18;
 
-	dp.Proxy = encodeProxyStatus(&npc.ProxyConfig)
+	dp.Proxy = encodeProxyStatus(&npc.ProxyConfig, npc.TestResults)
 
 	dp.Err = encodeTestResults(npc.TestResults)
 
@@ -1163,6 +1234,13 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			for _, ipv6Addr := range ipv6Addrs {
 				networkInfo.IPAddrs = append(networkInfo.IPAddrs, ipv6Addr.String())
 			}
+			if len(networkInfo.IPAddrs) == 0 {
+				// EVE does not allocate addresses on switch network
+				// instances, use those seen by the guest agent if any
+				for _, ip := range aiStatus.GuestAgent.LookupIPAddrs(macAddr) {
+					networkInfo.IPAddrs = append(networkInfo.IPAddrs, ip.String())
+				}
+			}
 			networkInfo.MacAddr = *proto.String(macAddr.String())
 			networkInfo.Ipv4Up = allocated
 			networkInfo.IpAddrMisMatch = ipAddrMismatch
@@ -1212,6 +1290,17 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			snapInfo.SnapErr = encodeErrorInfo(snap.Error)
 			ReportAppInfo.Snapshots = append(ReportAppInfo.Snapshots, snapInfo)
 		}
+		ReportAppInfo.Health = encodeAppHealth(aiStatus.Health)
+		for _, cs := range aiStatus.ContainerStatusList {
+			ReportAppInfo.Containers = append(ReportAppInfo.Containers,
+				&info.ZInfoAppContainer{
+					Name:         cs.Name,
+					State:        cs.State.ZSwState(),
+					RestartCount: cs.RestartCount,
+					ExitCode:     int32(cs.ExitCode),
+				})
+		}
+		ReportAppInfo.GuestAgent = encodeGuestAgentInfo(aiStatus.GuestAgent)
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Ainfo)
@@ -1239,6 +1328,74 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 		info.ZInfoTypes_ZiApp)
 }
 
+// encodeAppHealth returns nil if no health probe is configured for the app.
+func encodeAppHealth(health types.AppHealthStatus) *info.ZInfoAppHealth {
+	if !health.Enabled() {
+		return nil
+	}
+	zHealth := &info.ZInfoAppHealth{
+		Liveness:       encodeAppProbe(health.Liveness),
+		Readiness:      encodeAppProbe(health.Readiness),
+		Ready:          health.Ready,
+		ActionCount:    health.ActionCount,
+		LastAction:     health.LastAction.ZInfoAppHealthAction(),
+		BackoffSeconds: uint32(health.Backoff / time.Second),
+	}
+	if !health.LastActionTime.IsZero() {
+		zHealth.LastActionTime = timestamppb.New(health.LastActionTime)
+	}
+	return zHealth
+}
+
+// encodeGuestAgentInfo returns nil if there is no guest agent running in the app.
+func encodeGuestAgentInfo(gaInfo *types.GuestAgentInfo) *info.ZInfoAppGuestAgent {
+	if gaInfo == nil {
+		return nil
+	}
+	zInfo := &info.ZInfoAppGuestAgent{
+		OsName:        gaInfo.OSName,
+		OsVersion:     gaInfo.OSVersion,
+		KernelRelease: gaInfo.KernelRelease,
+		Hostname:      gaInfo.Hostname,
+		LastUpdate:    timestamppb.New(gaInfo.LastUpdate),
+	}
+	for _, intf := range gaInfo.Interfaces {
+		zIntf := &info.ZInfoAppGuestInterface{Name: intf.Name}
+		if len(intf.MacAddr) != 0 {
+			zIntf.MacAddr = intf.MacAddr.String()
+		}
+		for _, ip := range intf.IPAddrs {
+			zIntf.IpAddrs = append(zIntf.IpAddrs, ip.String())
+		}
+		zInfo.Interfaces = append(zInfo.Interfaces, zIntf)
+	}
+	for _, fs := range gaInfo.Filesystems {
+		zInfo.Filesystems = append(zInfo.Filesystems,
+			&info.ZInfoAppGuestFilesystem{
+				MountPoint: fs.MountPoint,
+				Type:       fs.Type,
+				TotalBytes: fs.TotalBytes,
+				UsedBytes:  fs.UsedBytes,
+			})
+	}
+	return zInfo
+}
+
+func encodeAppProbe(probe types.AppProbeStatus) *info.ZInfoAppProbe {
+	if probe.State == types.AppProbeStateUnspecified {
+		return nil
+	}
+	zProbe := &info.ZInfoAppProbe{
+		State:               probe.State.ZInfoAppProbeState(),
+		LastError:           probe.LastError,
+		ConsecutiveFailures: probe.ConsecutiveFailures,
+	}
+	if !probe.LastTransitionTime.IsZero() {
+		zProbe.LastTransitionTime = timestamppb.New(probe.LastTransitionTime)
+	}
+	return zProbe
+}
+
 // PublishContentInfoToZedCloud is called per change, hence needs to try over all management ports
 // When content tree Status is nil it means a delete and we send a message
 // containing only the UUID to inform zedcloud about the delete.
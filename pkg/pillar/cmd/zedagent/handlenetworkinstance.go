@@ -114,6 +114,18 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 			}
 			info.IpAssignments = append(info.IpAssignments, assignment)
 		}
+		for _, client := range status.WifiAPClients {
+			clientInfo := new(zinfo.ZInfoWifiAPClient)
+			clientInfo.MacAddress = client.MACAddr.String()
+			for _, ip := range client.IPAddrs {
+				clientInfo.IpAddresses = append(clientInfo.IpAddresses, ip.String())
+			}
+			clientInfo.Hostname = client.Hostname
+			associatedAt, _ := ptypes.TimestampProto(client.AssociatedAt)
+			clientInfo.AssociatedAt = associatedAt
+			clientInfo.Rssi = client.RSSI
+			info.WifiApClients = append(info.WifiApClients, clientInfo)
+		}
 		for _, v := range status.Vifs {
 			vi := new(zinfo.ZmetVifInfo)
 			vi.VifName = v.Name
@@ -299,6 +311,7 @@ func protoEncodeAppFlowMonitorProto(ipflow types.IPFlow) *flowlog.FlowMessage {
 		prec.TxPkts = rec.TxPkts
 		prec.RxBytes = rec.RxBytes
 		prec.RxPkts = rec.RxPkts
+		prec.Hostname = rec.Hostname
 		pflows.Flows = append(pflows.Flows, prec)
 	}
 
@@ -313,6 +326,7 @@ func protoEncodeAppFlowMonitorProto(ipflow types.IPFlow) *flowlog.FlowMessage {
 		dnsTime = timeNanoToProto(dns.RequestTime)
 		pdns.RequestTime = dnsTime
 		pdns.AclNum = dns.ACLNum
+		pdns.Blocked = dns.Blocked
 		pflows.DnsReqs = append(pflows.DnsReqs, pdns)
 	}
 
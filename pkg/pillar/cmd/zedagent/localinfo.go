@@ -378,6 +378,42 @@ func processAppCommandStatus(
 	}
 }
 
+// processAppHealthPurgeRequest purges the application when requested by zedmanager
+// because the application failed its liveness probe. Purge is triggered the same way
+// as when requested by the local profile server, because it requires to re-create
+// the application volumes.
+func processAppHealthPurgeRequest(
+	ctx *getconfigContext, appStatus types.AppInstanceStatus) {
+	requestedAt := appStatus.Health.PurgeRequestedAt
+	if requestedAt.IsZero() {
+		return
+	}
+	ctx.localCommands.Lock()
+	defer ctx.localCommands.Unlock()
+	uuid := appStatus.UUIDandVersion.UUID.String()
+	appCounters, hasCounters := ctx.localCommands.AppCounters[uuid]
+	if hasCounters && !requestedAt.After(appCounters.HealthPurgeRequestedAt) {
+		// Already processed.
+		return
+	}
+	appInst := findAppInstance(ctx, appStatus.UUIDandVersion.UUID, "")
+	if appInst == nil {
+		log.Warnf("processAppHealthPurgeRequest: failed to find app instance with UUID=%s",
+			uuid)
+		return
+	}
+	log.Noticef("Purging app instance %s (%s) which failed its liveness probe",
+		appStatus.DisplayName, uuid)
+	changedVolumes := triggerLocalCommand(ctx, types.AppCommandPurge, appInst,
+		requestedAt.String())
+	// triggerLocalCommand creates the counters if missing.
+	ctx.localCommands.AppCounters[uuid].HealthPurgeRequestedAt = requestedAt
+	persistLocalCommands(ctx.localCommands)
+	if changedVolumes {
+		signalVolumeConfigRestarted(ctx)
+	}
+}
+
 // Add config submitted for the application via local profile server.
 // ctx.localCommands should be locked!
 func addLocalAppConfig(ctx *getconfigContext, appInstance *types.AppInstanceConfig) {
@@ -431,6 +467,7 @@ func prepareLocalAppInfo(ctx *getconfigContext) *profile.LocalAppInfoList {
 		if appCmd, hasEntry := ctx.localCommands.AppCommands[zinfoAppInst.Id]; hasEntry {
 			zinfoAppInst.LastCmdTimestamp = appCmd.LastCompletedTimestamp
 		}
+		zinfoAppInst.Health = encodeAppHealth(ais.Health)
 		msg.AppsInfo = append(msg.AppsInfo, zinfoAppInst)
 		return true
 	}
@@ -700,6 +737,15 @@ func processReceivedDevCommands(getconfigCtx *getconfigContext, cmd *profile.Loc
 		return
 	}
 	command := types.DevCommand(cmd.Command)
+	if command == types.DevCommandNetDump {
+		// Network diagnostics do not disrupt anything running on the device
+		// and can be collected even while an update is in progress.
+		log.Noticef("Received netdump request from local profile server")
+		processLocalNetDumpCmd(getconfigCtx, cmd.Netdump)
+		getconfigCtx.lastDevCmdTimestamp = cmd.Timestamp
+		saveLocalDevCmdTimestamp(getconfigCtx)
+		return
+	}
 	if getconfigCtx.updateInprogress {
 		switch command {
 		case types.DevCommandUnspecified:
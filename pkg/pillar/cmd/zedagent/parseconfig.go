@@ -10,15 +10,18 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"math"
 	"net"
 	"net/url"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/api/go/evecommon"
 	"github.com/lf-edge/eve/pkg/pillar/objtonum"
 	"github.com/lf-edge/eve/pkg/pillar/sriov"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -161,6 +164,10 @@ func parseConfig(getconfigCtx *getconfigContext, config *zconfig.EdgeDevConfig,
 			parseDisksConfig(getconfigCtx, config)
 
 			parseEdgeNodeInfo(getconfigCtx, config)
+
+			parseNetDumpRequests(getconfigCtx, config)
+
+			parseTimeSyncConfig(getconfigCtx, config)
 		}
 
 		getconfigCtx.lastProcessedConfig = getconfigCtx.lastReceivedConfig
@@ -375,6 +382,35 @@ func parseDnsNameToIpList(
 	config.DnsNameToIPList = nameToIPs
 }
 
+func parseDNSForwardersAndFilter(
+	apiConfigEntry *zconfig.NetworkInstanceConfig,
+	config *types.NetworkInstanceConfig) {
+
+	config.DNSForwarders = nil
+	for _, forwarder := range apiConfigEntry.GetDnsForwarders() {
+		var servers []net.IP
+		for _, strAddr := range forwarder.GetServers() {
+			ip := net.ParseIP(strAddr)
+			if ip != nil {
+				servers = append(servers, ip)
+			} else {
+				log.Errorf("Bad DNS forwarder server %s for domain %s ignored",
+					strAddr, forwarder.GetDomain())
+			}
+		}
+		config.DNSForwarders = append(config.DNSForwarders, types.DNSForwarder{
+			Domain:  forwarder.GetDomain(),
+			Servers: servers,
+		})
+	}
+	if dnsFilter := apiConfigEntry.GetDnsFilter(); dnsFilter != nil {
+		config.DNSFilter = types.DNSFilter{
+			Mode:    types.DNSFilterMode(dnsFilter.GetMode()),
+			Domains: dnsFilter.GetDomains(),
+		}
+	}
+}
+
 func publishNetworkInstanceConfig(ctx *getconfigContext,
 	networkInstances []*zconfig.NetworkInstanceConfig) {
 
@@ -408,7 +444,21 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 		if apiConfigEntry.Port != nil {
 			networkInstanceConfig.PortLogicalLabel = apiConfigEntry.Port.Name
 		}
+		if apiConfigEntry.WifiAccessPoint != nil {
+			networkInstanceConfig.WifiAPLogicalLabel = apiConfigEntry.WifiAccessPoint.Name
+		}
 		networkInstanceConfig.IpType = types.AddressType(apiConfigEntry.IpType)
+		networkInstanceConfig.HostACLEnforcement = types.HostACLEnforcement(
+			apiConfigEntry.HostAclEnforcement)
+		if flowExport := apiConfigEntry.GetFlowExport(); flowExport != nil {
+			networkInstanceConfig.FlowExport = types.FlowExportConfig{
+				Enabled:                 flowExport.GetEnabled(),
+				Protocol:                types.FlowExportProtocol(flowExport.GetProtocol()),
+				Collector:               flowExport.GetCollector(),
+				SamplingRate:            flowExport.GetSamplingRate(),
+				TemplateRefreshInterval: flowExport.GetTemplateRefreshInterval(),
+			}
+		}
 
 		if networkInstanceConfig.Type == types.NetworkInstanceTypeSwitch {
 			// XXX controller should send AddressTypeNone type for switch
@@ -440,6 +490,8 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 
 			parseDnsNameToIpList(apiConfigEntry,
 				&networkInstanceConfig)
+			parseDNSForwardersAndFilter(apiConfigEntry,
+				&networkInstanceConfig)
 		}
 
 		ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
@@ -542,15 +594,33 @@ func parseAppInstanceConfig(getconfigCtx *getconfigContext,
 		appInstance.Service = cfgApp.Service
 		appInstance.CloudInitVersion = cfgApp.CloudInitVersion
 		appInstance.FixedResources.CPUsPinned = cfgApp.Fixedresources.PinCpu
+		parseVmResourceLimits(&appInstance, cfgApp.Fixedresources.GetLimits())
+		appInstance.FixedResources.VTPM = types.VmTPMModel(cfgApp.Fixedresources.GetVtpm())
+		if appInstance.FixedResources.VTPM != types.VmTPMNone &&
+			appInstance.FixedResources.VirtualizationMode == types.NOHYPER {
+			appInstance.Errors = append(appInstance.Errors,
+				"virtual TPM is not supported without hypervisor")
+			appInstance.FixedResources.VTPM = types.VmTPMNone
+		}
+		parseAppPrometheusConfig(&appInstance, cfgApp.GetPrometheus())
 
 		// Parse the snapshot related fields
 		if cfgApp.Snapshot != nil {
 			parseSnapshotConfig(&appInstance.Snapshot, cfgApp.Snapshot)
 		}
 
+		// Parse health probes
+		if cfgApp.Health != nil {
+			appInstance.Health = parseAppHealthConfig(cfgApp.Health)
+		}
+
+		appInstance.Dependencies = parseAppDependencies(cfgApp.GetDependencies())
+		appInstance.StartGroup = cfgApp.GetStartGroup()
+
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
 			len(cfgApp.VolumeRefList))
 		parseVolumeRefList(appInstance.VolumeRefConfigList, cfgApp.GetVolumeRefList())
+		parseAppContainers(&appInstance, cfgApp.GetContainers())
 
 		// fill in the collect stats IP address of the App
 		appInstance.CollectStatsIPAddr = net.ParseIP(cfgApp.GetCollectStatsIPAddr())
@@ -599,6 +669,10 @@ func parseAppInstanceConfig(getconfigCtx *getconfigContext,
 		}
 		appInstance.RemoteConsole = cfgApp.GetRemoteConsole()
 		appInstance.CipherBlockStatus = parseCipherBlock(getconfigCtx, appInstance.Key(), cfgApp.GetCipherData())
+		if cfgApp.GetSecrets() != nil {
+			appInstance.SecretsCipherBlock = parseCipherBlock(getconfigCtx,
+				appInstance.Key()+"-secrets", cfgApp.GetSecrets())
+		}
 		appInstance.ProfileList = cfgApp.ProfileList
 
 		// Add config submitted via local profile server.
@@ -620,6 +694,250 @@ func parseSnapshotConfig(appInstanceSnapshot *types.SnapshotConfig, cfgAppSnapsh
 	parseSnapshots(appInstanceSnapshot.Snapshots, cfgAppSnapshot.Snapshots)
 }
 
+func parseAppHealthConfig(cfgHealth *zconfig.AppHealthConfig) types.AppHealthConfig {
+	health := types.AppHealthConfig{
+		Liveness:       parseAppProbe(cfgHealth.GetLiveness()),
+		Readiness:      parseAppProbe(cfgHealth.GetReadiness()),
+		Action:         types.AppHealthAction(cfgHealth.GetAction()),
+		BackoffInitial: time.Duration(cfgHealth.GetBackoffInitialSeconds()) * time.Second,
+		BackoffMax:     time.Duration(cfgHealth.GetBackoffMaxSeconds()) * time.Second,
+	}
+	if health.BackoffInitial == 0 {
+		health.BackoffInitial = types.DefaultAppHealthBackoffInitial
+	}
+	if health.BackoffMax == 0 {
+		health.BackoffMax = types.DefaultAppHealthBackoffMax
+	}
+	if health.BackoffMax < health.BackoffInitial {
+		health.BackoffMax = health.BackoffInitial
+	}
+	return health
+}
+
+func parseAppDependencies(cfgDeps []*zconfig.AppDependency) []types.AppDependency {
+	var deps []types.AppDependency
+	for _, cfgDep := range cfgDeps {
+		appUUID, err := uuid.FromString(cfgDep.GetAppUuid())
+		if err != nil {
+			log.Errorf("parseAppDependencies: invalid app UUID %s: %v",
+				cfgDep.GetAppUuid(), err)
+			continue
+		}
+		condition := types.AppDependencyCondition(cfgDep.GetCondition())
+		if condition == types.AppDependencyConditionUnspecified {
+			condition = types.AppDependencyReady
+		}
+		deps = append(deps, types.AppDependency{
+			AppUUID:   appUUID,
+			Condition: condition,
+		})
+	}
+	return deps
+}
+
+const (
+	// cgroup cpu.max does not accept quota lower than 1ms
+	// in the period of 100ms used by EVE
+	minCPUMaxMilliCPUs = 10
+	maxCPUWeight       = 10000
+)
+
+// parseVmResourceLimits parses cgroup limits of the app instance.
+// Invalid limits are reported in appInstance.Errors and ignored.
+func parseVmResourceLimits(appInstance *types.AppInstanceConfig,
+	cfgLimits *zconfig.VmResourceLimits) {
+	if cfgLimits == nil {
+		return
+	}
+	limits := types.VmResourceLimits{
+		CPUMaxMilliCPUs: cfgLimits.GetCpuMaxMillicpus(),
+		CPUWeight:       cfgLimits.GetCpuWeight(),
+		PidsMax:         cfgLimits.GetPidsMax(),
+		IOReadBps:       cfgLimits.GetIoReadBps(),
+		IOWriteBps:      cfgLimits.GetIoWriteBps(),
+		IOReadIOPS:      cfgLimits.GetIoReadIops(),
+		IOWriteIOPS:     cfgLimits.GetIoWriteIops(),
+	}
+	if limits.CPUMaxMilliCPUs != 0 && limits.CPUMaxMilliCPUs < minCPUMaxMilliCPUs {
+		appInstance.Errors = append(appInstance.Errors,
+			fmt.Sprintf("CPU limit %d millicpus is lower than %d",
+				limits.CPUMaxMilliCPUs, minCPUMaxMilliCPUs))
+		limits.CPUMaxMilliCPUs = 0
+	}
+	if limits.CPUWeight > maxCPUWeight {
+		appInstance.Errors = append(appInstance.Errors,
+			fmt.Sprintf("CPU weight %d is out of range 1-%d",
+				limits.CPUWeight, maxCPUWeight))
+		limits.CPUWeight = 0
+	}
+	appInstance.FixedResources.Limits = limits
+}
+
+// parseAppPrometheusConfig parses the Prometheus endpoint of the app instance.
+// Invalid configuration is reported in appInstance.Errors.
+func parseAppPrometheusConfig(appInstance *types.AppInstanceConfig,
+	cfgPrometheus *zconfig.AppPrometheusConfig) {
+	if cfgPrometheus.GetPort() == 0 {
+		return
+	}
+	if cfgPrometheus.GetPort() > math.MaxUint16 {
+		appInstance.Errors = append(appInstance.Errors,
+			fmt.Sprintf("invalid Prometheus endpoint port %d", cfgPrometheus.GetPort()))
+		return
+	}
+	path := cfgPrometheus.GetPath()
+	if path == "" {
+		path = "/metrics"
+	}
+	if !strings.HasPrefix(path, "/") {
+		appInstance.Errors = append(appInstance.Errors,
+			fmt.Sprintf("Prometheus endpoint path %q is not absolute", path))
+		return
+	}
+	if len(cfgPrometheus.GetAllowlist()) == 0 {
+		appInstance.Errors = append(appInstance.Errors,
+			"allowlist of Prometheus metrics is empty")
+		return
+	}
+	appInstance.Prometheus = types.AppPrometheusConfig{
+		Port:      uint16(cfgPrometheus.GetPort()),
+		Path:      path,
+		Allowlist: cfgPrometheus.GetAllowlist(),
+	}
+}
+
+// appContainerNameRegexp : the same rules as for DNS labels.
+var appContainerNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// parseAppContainers parses additional containers of the app instance.
+// Must be called after VolumeRefConfigList is parsed.
+// Invalid containers are reported in appInstance.Errors.
+func parseAppContainers(appInstance *types.AppInstanceConfig,
+	cfgContainers []*zconfig.AppContainer) {
+	names := make(map[string]struct{})
+	volumes := make(map[uuid.UUID]struct{})
+	for _, cfgContainer := range cfgContainers {
+		name := cfgContainer.GetName()
+		if !appContainerNameRegexp.MatchString(name) {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("invalid container name %q", name))
+			continue
+		}
+		if _, duplicate := names[name]; duplicate {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("duplicate container name %s", name))
+			continue
+		}
+		names[name] = struct{}{}
+		volumeID, err := uuid.FromString(cfgContainer.GetVolumeUuid())
+		if err != nil {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("container %s: invalid volume UUID %s: %v",
+					name, cfgContainer.GetVolumeUuid(), err))
+			continue
+		}
+		volumeIdx := -1
+		for i, vr := range appInstance.VolumeRefConfigList {
+			if vr.VolumeID == volumeID {
+				volumeIdx = i
+				break
+			}
+		}
+		if volumeIdx == -1 {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("container %s: volume %s is not in the volume list",
+					name, volumeID))
+			continue
+		}
+		if volumeIdx == 0 {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("container %s: volume %s is the main container volume",
+					name, volumeID))
+			continue
+		}
+		if _, duplicate := volumes[volumeID]; duplicate {
+			appInstance.Errors = append(appInstance.Errors,
+				fmt.Sprintf("container %s: volume %s is used by another container",
+					name, volumeID))
+			continue
+		}
+		volumes[volumeID] = struct{}{}
+		container := types.AppContainerConfig{
+			Name:          name,
+			VolumeID:      volumeID,
+			Type:          types.AppContainerType(cfgContainer.GetType()),
+			RestartPolicy: types.AppContainerRestartPolicy(cfgContainer.GetRestartPolicy()),
+			Command:       cfgContainer.GetCommand(),
+			Env:           cfgContainer.GetEnvironment(),
+		}
+		if container.Type == types.AppContainerTypeUnspecified {
+			container.Type = types.AppContainerSidecar
+		}
+		if container.RestartPolicy == types.AppContainerRestartUnspecified {
+			if container.Type == types.AppContainerInit {
+				container.RestartPolicy = types.AppContainerRestartOnFailure
+			} else {
+				container.RestartPolicy = types.AppContainerRestartAlways
+			}
+		}
+		appInstance.Containers = append(appInstance.Containers, container)
+	}
+}
+
+func parseAppProbe(cfgProbe *zconfig.AppProbe) types.AppProbeConfig {
+	if cfgProbe == nil {
+		return types.AppProbeConfig{}
+	}
+	probe := types.AppProbeConfig{
+		Type:             types.AppProbeType(cfgProbe.GetType()),
+		Path:             cfgProbe.GetPath(),
+		HTTPS:            cfgProbe.GetHttps(),
+		Command:          cfgProbe.GetCommand(),
+		AdapterIndex:     int(cfgProbe.GetAdapterIndex()),
+		InitialDelay:     time.Duration(cfgProbe.GetInitialDelaySeconds()) * time.Second,
+		Period:           time.Duration(cfgProbe.GetPeriodSeconds()) * time.Second,
+		Timeout:          time.Duration(cfgProbe.GetTimeoutSeconds()) * time.Second,
+		FailureThreshold: cfgProbe.GetFailureThreshold(),
+		SuccessThreshold: cfgProbe.GetSuccessThreshold(),
+	}
+	if cfgProbe.GetPort() > 0xffff {
+		log.Errorf("parseAppProbe: invalid port %d", cfgProbe.GetPort())
+		return types.AppProbeConfig{}
+	}
+	probe.Port = uint16(cfgProbe.GetPort())
+	switch probe.Type {
+	case types.AppProbeHTTP, types.AppProbeTCP:
+		if probe.Port == 0 {
+			log.Errorf("parseAppProbe: missing port for %s probe", probe.Type)
+			return types.AppProbeConfig{}
+		}
+	case types.AppProbeExec:
+		if len(probe.Command) == 0 {
+			log.Errorf("parseAppProbe: missing command for exec probe")
+			return types.AppProbeConfig{}
+		}
+	case types.AppProbeGuestAgent:
+	default:
+		return types.AppProbeConfig{}
+	}
+	if probe.Type == types.AppProbeHTTP && probe.Path == "" {
+		probe.Path = "/"
+	}
+	if probe.Period == 0 {
+		probe.Period = types.DefaultAppProbePeriod
+	}
+	if probe.Timeout == 0 {
+		probe.Timeout = types.DefaultAppProbeTimeout
+	}
+	if probe.FailureThreshold == 0 {
+		probe.FailureThreshold = types.DefaultAppProbeFailureThreshold
+	}
+	if probe.SuccessThreshold == 0 {
+		probe.SuccessThreshold = types.DefaultAppProbeSuccessThreshold
+	}
+	return probe
+}
+
 func parseSnapshots(snapshots []types.SnapshotDesc, cfgSnapshots []*zconfig.SnapshotDesc) {
 	for i, cfgSnapshot := range cfgSnapshots {
 		snapshots[i].SnapshotID = cfgSnapshot.Id
@@ -1105,6 +1423,14 @@ func parseOneSystemAdapterConfig(getconfigCtx *getconfigContext,
 				port.AddrSubnet = addrSubnet.String()
 			}
 			port.WirelessCfg = network.WirelessCfg
+			port.PortAuth = network.PortAuth
+			if port.PortAuth.Enabled &&
+				port.WirelessCfg.WType != types.WirelessTypeNone {
+				errStr := fmt.Sprintf("Port %s: 802.1X authentication is supported "+
+					"only for wired ports", port.IfName)
+				log.Errorf("parseSystemAdapterConfig: %s", errStr)
+				port.RecordFailure(errStr)
+			}
 			port.Gateway = network.Gateway
 			port.DomainName = network.DomainName
 			port.NtpServer = network.NtpServer
@@ -1721,12 +2047,34 @@ func parseOneNetworkXObjectConfig(ctx *getconfigContext, netEnt *zconfig.Network
 				proxyEntry.Server, proxyEntry.Port, netEnt.Id)
 		}
 
+		// proxy authentication
+		switch netProxyConfig.GetAuthMethod() {
+		case zconfig.ProxyAuthMethod_PROXY_AUTH_METHOD_BASIC:
+			proxyConfig.AuthMethod = types.ProxyAuthBasic
+		case zconfig.ProxyAuthMethod_PROXY_AUTH_METHOD_NTLM:
+			proxyConfig.AuthMethod = types.ProxyAuthNTLM
+		case zconfig.ProxyAuthMethod_PROXY_AUTH_METHOD_NEGOTIATE:
+			proxyConfig.AuthMethod = types.ProxyAuthNegotiate
+		default:
+			proxyConfig.AuthMethod = types.ProxyAuthAuto
+		}
+		if netProxyConfig.GetCipherData() != nil {
+			key := fmt.Sprintf("%s-proxy-auth", config.Key())
+			proxyConfig.AuthCipherBlock = parseCipherBlock(ctx, key,
+				netProxyConfig.GetCipherData())
+			log.Functionf("parseOneNetworkXObjectConfig: Proxy authentication "+
+				"(%s) configured in %s", proxyConfig.AuthMethod, netEnt.Id)
+		}
+
 		config.Proxy = &proxyConfig
 	}
 
 	// wireless property configuration
 	config.WirelessCfg = parseNetworkWirelessConfig(ctx, config.Key(), netEnt)
 
+	// 802.1X port authentication
+	config.PortAuth = parseNetworkPortAuthConfig(ctx, config.Key(), netEnt)
+
 	ipspec := netEnt.GetIp()
 	switch config.Type {
 	case types.NT_IPV4, types.NT_IPV6:
@@ -1822,6 +2170,13 @@ func parseNetworkWirelessConfig(ctx *getconfigContext, key string, netEnt *zconf
 			wcell.ProbeAddr = cellular.GetProbe().GetProbeAddress()
 			wcell.DisableProbe = cellular.GetProbe().GetDisable()
 			wcell.LocationTracking = cellular.GetLocationTracking()
+			wcell.ActivatedSimSlot = uint8(cellular.GetActivatedSimSlot())
+			wcell.SimFailover = cellular.GetSimFailover()
+			for i, ap := range cellular.GetAccessPoints() {
+				apKey := fmt.Sprintf("%s-ap%d", key, i)
+				wcell.AccessPoints = append(wcell.AccessPoints,
+					parseCellularAccessPoint(ctx, apKey, ap))
+			}
 			wconfig.Cellular = append(wconfig.Cellular, wcell)
 		}
 		log.Functionf("parseNetworkWirelessConfig: Wireless of network Cellular, %v", wconfig.Cellular)
@@ -1847,12 +2202,114 @@ func parseNetworkWirelessConfig(ctx *getconfigContext, key string, netEnt *zconf
 			wconfig.Wifi = append(wconfig.Wifi, wifi)
 		}
 		log.Functionf("parseNetworkWirelessConfig: Wireless of network Wifi, %v", wconfig.Wifi)
+		if apCfg := netWireless.GetAccessPoint(); apCfg != nil {
+			wconfig.AccessPoint = parseWifiAccessPointConfig(ctx, netEnt.Id, apCfg)
+			log.Functionf("parseNetworkWirelessConfig: WiFi access point mode, SSID %s",
+				wconfig.AccessPoint.SSID)
+		}
 	default:
 		log.Errorf("parseNetworkWirelessConfig: unsupported wireless configure type %d", wType)
 	}
 	return wconfig
 }
 
+func parseNetworkPortAuthConfig(ctx *getconfigContext, key string,
+	netEnt *zconfig.NetworkConfig) types.PortAuthConfig {
+	var portAuth types.PortAuthConfig
+	authCfg := netEnt.GetPortAuth()
+	if authCfg == nil || !authCfg.GetEnabled() {
+		return portAuth
+	}
+	portAuth.Enabled = true
+	switch authCfg.GetEapMethod() {
+	case zconfig.EAPMethod_EAP_METHOD_PEAP:
+		portAuth.EAPMethod = types.EAPMethodPEAP
+	default:
+		// EAP-TLS with the device certificate is the default.
+		portAuth.EAPMethod = types.EAPMethodTLS
+	}
+	portAuth.Identity = authCfg.GetIdentity()
+	portAuth.CACertPEM = authCfg.GetCaCertPem()
+	portAuth.ServerDomainSuffix = authCfg.GetServerDomainSuffix()
+	key = fmt.Sprintf("%s-port-auth", key)
+	portAuth.CipherBlockStatus = parseCipherBlock(ctx, key, authCfg.GetCipherData())
+	log.Functionf("parseNetworkPortAuthConfig: 802.1X enabled for network %s, "+
+		"EAP method %s", netEnt.Id, portAuth.EAPMethod)
+	return portAuth
+}
+
+func parseCellularAccessPoint(ctx *getconfigContext, key string,
+	apCfg *zconfig.CellularAccessPoint) types.CellularAccessPoint {
+	ap := types.CellularAccessPoint{
+		SIMSlot:        uint8(apCfg.GetSimSlot()),
+		APN:            apCfg.GetApn(),
+		PreferredPLMNs: apCfg.GetPreferredPlmns(),
+		ForbidRoaming:  apCfg.GetForbidRoaming(),
+	}
+	switch apCfg.GetAuthProtocol() {
+	case zconfig.CellularAuthProtocol_CELLULAR_AUTH_PROTOCOL_PAP:
+		ap.AuthProtocol = types.WwanAuthProtocolPAP
+	case zconfig.CellularAuthProtocol_CELLULAR_AUTH_PROTOCOL_CHAP:
+		ap.AuthProtocol = types.WwanAuthProtocolCHAP
+	case zconfig.CellularAuthProtocol_CELLULAR_AUTH_PROTOCOL_PAP_AND_CHAP:
+		ap.AuthProtocol = types.WwanAuthProtocolPAPAndCHAP
+	default:
+		ap.AuthProtocol = types.WwanAuthProtocolNone
+	}
+	switch apCfg.GetIpType() {
+	case zconfig.CellularIPType_CELLULAR_IP_TYPE_IPV4:
+		ap.IPType = types.WwanIPTypeIPv4
+	case zconfig.CellularIPType_CELLULAR_IP_TYPE_IPV6:
+		ap.IPType = types.WwanIPTypeIPv6
+	case zconfig.CellularIPType_CELLULAR_IP_TYPE_IPV4_AND_IPV6:
+		ap.IPType = types.WwanIPTypeIPv4AndIPv6
+	default:
+		ap.IPType = types.WwanIPTypeUnspecified
+	}
+	for _, rat := range apCfg.GetPreferredRats() {
+		switch rat {
+		case evecommon.RadioAccessTechnology_RADIO_ACCESS_TECHNOLOGY_GSM:
+			ap.PreferredRATs = append(ap.PreferredRATs, types.WwanRATGSM)
+		case evecommon.RadioAccessTechnology_RADIO_ACCESS_TECHNOLOGY_UMTS:
+			ap.PreferredRATs = append(ap.PreferredRATs, types.WwanRATUMTS)
+		case evecommon.RadioAccessTechnology_RADIO_ACCESS_TECHNOLOGY_LTE:
+			ap.PreferredRATs = append(ap.PreferredRATs, types.WwanRATLTE)
+		case evecommon.RadioAccessTechnology_RADIO_ACCESS_TECHNOLOGY_5GNR:
+			ap.PreferredRATs = append(ap.PreferredRATs, types.WwanRAT5GNR)
+		}
+	}
+	ap.CipherBlockStatus = parseCipherBlock(ctx, key, apCfg.GetCipherData())
+	return ap
+}
+
+func parseWifiAccessPointConfig(ctx *getconfigContext, key string,
+	apCfg *zconfig.WifiAccessPointConfig) *types.WifiAPConfig {
+	ap := &types.WifiAPConfig{
+		SSID:        apCfg.GetSsid(),
+		HiddenSSID:  apCfg.GetHiddenSsid(),
+		Channel:     uint16(apCfg.GetChannel()),
+		CountryCode: apCfg.GetCountryCode(),
+		MaxClients:  apCfg.GetMaxClients(),
+	}
+	switch apCfg.GetSecurity() {
+	case zconfig.WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_WPA3_SAE:
+		ap.Security = types.WifiAPSecurityWPA3SAE
+	case zconfig.WifiAccessPointSecurity_WIFI_ACCESS_POINT_SECURITY_WPA2_WPA3:
+		ap.Security = types.WifiAPSecurityWPA2WPA3
+	default:
+		ap.Security = types.WifiAPSecurityWPA2PSK
+	}
+	switch apCfg.GetBand() {
+	case zconfig.WifiBand_WIFI_BAND_5GHZ:
+		ap.Band = types.WifiBand5GHz
+	default:
+		ap.Band = types.WifiBand2GHz
+	}
+	key = fmt.Sprintf("%s-ap-%s", key, ap.SSID)
+	ap.CipherBlockStatus = parseCipherBlock(ctx, key, apCfg.GetCipherData())
+	return ap
+}
+
 func parseIpspecNetworkXObject(ipspec *zconfig.Ipspec, config *types.NetworkXObjectConfig) error {
 	config.Dhcp = types.DhcpType(ipspec.Dhcp)
 	config.DomainName = ipspec.GetDomain()
@@ -2216,6 +2673,26 @@ func parseUnderlayNetworkConfigEntry(
 	// XXX set ulCfg.IntfOrder from API once available
 	ulCfg.IntfOrder = intfOrder
 	ulCfg.AccessVlanID = intfEnt.AccessVlanId
+	if qos := intfEnt.GetQos(); qos != nil {
+		ulCfg.QoS = types.AppInterfaceQoS{
+			Egress: types.TrafficShaping{
+				RateKbps:   qos.GetEgress().GetRateKbps(),
+				BurstBytes: qos.GetEgress().GetBurstBytes(),
+			},
+			Ingress: types.TrafficShaping{
+				RateKbps:   qos.GetIngress().GetRateKbps(),
+				BurstBytes: qos.GetIngress().GetBurstBytes(),
+			},
+			MarkDSCP: qos.GetMarkDscp(),
+		}
+		if qos.GetDscp() > types.MaxDSCP {
+			ulCfg.Error = fmt.Sprintf("App %s-%s: invalid DSCP value: %d\n",
+				cfgApp.Displayname, cfgApp.Uuidandversion.Uuid, qos.GetDscp())
+			log.Errorf("%s", ulCfg.Error)
+			return ulCfg
+		}
+		ulCfg.QoS.DSCP = uint8(qos.GetDscp())
+	}
 	return ulCfg
 }
 
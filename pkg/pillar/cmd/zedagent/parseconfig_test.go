@@ -6,6 +6,7 @@ package zedagent
 import (
 	"sort"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
@@ -16,6 +17,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/sriov"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
 )
 
 func initGetConfigCtx(g *GomegaWithT) *getconfigContext {
@@ -1254,3 +1256,212 @@ func TestParseSRIOV(t *testing.T) {
 		},
 	}))
 }
+
+func TestParseNetDumpRequests(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getconfigCtx := initGetConfigCtx(g)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pubNetDumpRequest, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.NetDumpRequest{},
+	})
+	g.Expect(err).To(BeNil())
+	getconfigCtx.pubNetDumpRequest = pubNetDumpRequest
+
+	processLocalNetDumpCmd(getconfigCtx, &zconfig.NetDumpRequest{
+		Id:     "lps-1",
+		Target: "10.0.0.1:8080",
+	})
+	config := &zconfig.EdgeDevConfig{
+		NetdumpRequests: []*zconfig.NetDumpRequest{
+			{
+				Id:              "req-1",
+				Target:          "zedcloud.example.com:443",
+				DurationSeconds: 60,
+				Interfaces:      []string{"ethernet0"},
+				DatastoreId:     "8d7ff3ad-0a4f-4ea9-9c7b-b2e0d1d2f0a8",
+			},
+			{
+				// Missing target, skipped.
+				Id: "req-2",
+			},
+			{
+				Id:          "req-3",
+				Target:      "zedcloud.example.com:443",
+				DatastoreId: "not-a-uuid",
+			},
+			{
+				// Already submitted by LPS, skipped.
+				Id:     "lps-1",
+				Target: "zedcloud.example.com:443",
+			},
+		},
+	}
+	parseNetDumpRequests(getconfigCtx, config)
+
+	items := pubNetDumpRequest.GetAll()
+	g.Expect(items).To(HaveLen(2))
+	g.Expect(items).To(HaveKey("req-1"))
+	req := items["req-1"].(types.NetDumpRequest)
+	g.Expect(req.Source).To(Equal(types.NetDumpSourceController))
+	g.Expect(req.GetDuration()).To(Equal(time.Minute))
+	g.Expect(req.Interfaces).To(Equal([]string{"ethernet0"}))
+	g.Expect(req.DatastoreID.String()).To(Equal("8d7ff3ad-0a4f-4ea9-9c7b-b2e0d1d2f0a8"))
+	lpsReq := items["lps-1"].(types.NetDumpRequest)
+	g.Expect(lpsReq.Source).To(Equal(types.NetDumpSourceLPS))
+	g.Expect(lpsReq.Target).To(Equal("10.0.0.1:8080"))
+	g.Expect(lpsReq.GetDuration()).To(Equal(types.DefaultNetDumpDuration))
+
+	// Requests removed from the config are unpublished,
+	// requests from LPS are left untouched.
+	parseNetDumpRequests(getconfigCtx, &zconfig.EdgeDevConfig{})
+	items = pubNetDumpRequest.GetAll()
+	g.Expect(items).To(HaveLen(1))
+	g.Expect(items).To(HaveKey("lps-1"))
+}
+
+func TestParseAppHealthConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+
+	health := parseAppHealthConfig(&zconfig.AppHealthConfig{
+		Liveness: &zconfig.AppProbe{
+			Type: zconfig.AppProbeType_APP_PROBE_TYPE_HTTP,
+			Port: 8080,
+		},
+		Readiness: &zconfig.AppProbe{
+			Type:             zconfig.AppProbeType_APP_PROBE_TYPE_EXEC,
+			Command:          []string{"/bin/check", "--ready"},
+			PeriodSeconds:    5,
+			FailureThreshold: 1,
+		},
+		Action:                zconfig.AppHealthAction_APP_HEALTH_ACTION_ROLLBACK,
+		BackoffInitialSeconds: 60,
+	})
+	g.Expect(health.Action).To(Equal(types.AppHealthActionRollback))
+	g.Expect(health.BackoffInitial).To(Equal(time.Minute))
+	g.Expect(health.BackoffMax).To(Equal(types.DefaultAppHealthBackoffMax))
+	g.Expect(health.Liveness).To(Equal(types.AppProbeConfig{
+		Type:             types.AppProbeHTTP,
+		Port:             8080,
+		Path:             "/",
+		Period:           types.DefaultAppProbePeriod,
+		Timeout:          types.DefaultAppProbeTimeout,
+		FailureThreshold: types.DefaultAppProbeFailureThreshold,
+		SuccessThreshold: types.DefaultAppProbeSuccessThreshold,
+	}))
+	g.Expect(health.Readiness.Type).To(Equal(types.AppProbeExec))
+	g.Expect(health.Readiness.Command).To(Equal([]string{"/bin/check", "--ready"}))
+	g.Expect(health.Readiness.Period).To(Equal(5 * time.Second))
+	g.Expect(health.Readiness.FailureThreshold).To(BeEquivalentTo(1))
+
+	// Invalid probes are ignored.
+	health = parseAppHealthConfig(&zconfig.AppHealthConfig{
+		Liveness: &zconfig.AppProbe{
+			Type: zconfig.AppProbeType_APP_PROBE_TYPE_TCP,
+		},
+		Readiness: &zconfig.AppProbe{
+			Type: zconfig.AppProbeType_APP_PROBE_TYPE_EXEC,
+		},
+	})
+	g.Expect(health.Enabled()).To(BeFalse())
+}
+
+func TestParseAppContainers(t *testing.T) {
+	g := NewGomegaWithT(t)
+	mainVolume, _ := uuid.NewV4()
+	sidecarVolume, _ := uuid.NewV4()
+	initVolume, _ := uuid.NewV4()
+	appInstance := types.AppInstanceConfig{
+		VolumeRefConfigList: []types.VolumeRefConfig{
+			{VolumeID: mainVolume},
+			{VolumeID: sidecarVolume},
+			{VolumeID: initVolume},
+		},
+	}
+	parseAppContainers(&appInstance, []*zconfig.AppContainer{
+		{
+			Name:        "proxy",
+			VolumeUuid:  sidecarVolume.String(),
+			Command:     []string{"envoy", "-c", "/etc/envoy.yaml"},
+			Environment: map[string]string{"LOG_LEVEL": "info"},
+		},
+		{
+			Name:       "migrate-db",
+			VolumeUuid: initVolume.String(),
+			Type:       zconfig.AppContainerType_APP_CONTAINER_TYPE_INIT,
+		},
+		// Invalid containers follow.
+		{
+			Name:       "Proxy_2",
+			VolumeUuid: sidecarVolume.String(),
+		},
+		{
+			Name:       "proxy",
+			VolumeUuid: initVolume.String(),
+		},
+		{
+			Name:       "main",
+			VolumeUuid: mainVolume.String(),
+		},
+		{
+			Name:       "reused",
+			VolumeUuid: sidecarVolume.String(),
+		},
+		{
+			Name:       "unknown",
+			VolumeUuid: "6c2b3a54-2b1e-4bd6-9ba4-41b7b6c50a4e",
+		},
+	})
+	g.Expect(appInstance.Containers).To(Equal([]types.AppContainerConfig{
+		{
+			Name:          "proxy",
+			VolumeID:      sidecarVolume,
+			Type:          types.AppContainerSidecar,
+			RestartPolicy: types.AppContainerRestartAlways,
+			Command:       []string{"envoy", "-c", "/etc/envoy.yaml"},
+			Env:           map[string]string{"LOG_LEVEL": "info"},
+		},
+		{
+			Name:          "migrate-db",
+			VolumeID:      initVolume,
+			Type:          types.AppContainerInit,
+			RestartPolicy: types.AppContainerRestartOnFailure,
+		},
+	}))
+	g.Expect(appInstance.Errors).To(HaveLen(5))
+}
+
+func TestParseVmResourceLimits(t *testing.T) {
+	g := NewGomegaWithT(t)
+	var appInstance types.AppInstanceConfig
+	parseVmResourceLimits(&appInstance, &zconfig.VmResourceLimits{
+		CpuMaxMillicpus: 1500,
+		CpuWeight:       200,
+		PidsMax:         512,
+		IoReadBps:       50 << 20,
+		IoWriteBps:      10 << 20,
+		IoWriteIops:     1000,
+	})
+	g.Expect(appInstance.Errors).To(BeEmpty())
+	g.Expect(appInstance.FixedResources.Limits).To(Equal(types.VmResourceLimits{
+		CPUMaxMilliCPUs: 1500,
+		CPUWeight:       200,
+		PidsMax:         512,
+		IOReadBps:       50 << 20,
+		IOWriteBps:      10 << 20,
+		IOWriteIOPS:     1000,
+	}))
+
+	appInstance = types.AppInstanceConfig{}
+	parseVmResourceLimits(&appInstance, &zconfig.VmResourceLimits{
+		CpuMaxMillicpus: 5,
+		CpuWeight:       20000,
+		PidsMax:         64,
+	})
+	g.Expect(appInstance.Errors).To(HaveLen(2))
+	g.Expect(appInstance.FixedResources.Limits).To(Equal(types.VmResourceLimits{
+		PidsMax: 64,
+	}))
+}
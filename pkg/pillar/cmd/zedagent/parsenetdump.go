@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// parseNetDumpRequests publishes on-demand network diagnostics requests
+// received from the controller. Requests which are no longer present in the
+// config are unpublished. Requests submitted by the local profile server
+// are left untouched.
+func parseNetDumpRequests(ctx *getconfigContext, config *zconfig.EdgeDevConfig) {
+	ctx.netDumpReqLock.Lock()
+	defer ctx.netDumpReqLock.Unlock()
+	pub := ctx.pubNetDumpRequest
+	requested := make(map[string]struct{})
+	for _, reqConfig := range config.GetNetdumpRequests() {
+		req, err := parseNetDumpRequest(reqConfig, types.NetDumpSourceController)
+		if err != nil {
+			log.Errorf("parseNetDumpRequests: %v", err)
+			continue
+		}
+		requested[req.Key()] = struct{}{}
+		publishNetDumpRequest(ctx, req)
+	}
+	for key, item := range pub.GetAll() {
+		req := item.(types.NetDumpRequest)
+		if req.Source != types.NetDumpSourceController {
+			continue
+		}
+		if _, stillRequested := requested[key]; !stillRequested {
+			log.Functionf("parseNetDumpRequests: unpublishing %s", key)
+			if err := pub.Unpublish(key); err != nil {
+				log.Errorf("parseNetDumpRequests: failed to unpublish %s: %v", key, err)
+			}
+		}
+	}
+}
+
+// processLocalNetDumpCmd publishes network diagnostics request received
+// from the local profile server. Only the most recent request is kept published.
+func processLocalNetDumpCmd(ctx *getconfigContext, reqConfig *zconfig.NetDumpRequest) {
+	req, err := parseNetDumpRequest(reqConfig, types.NetDumpSourceLPS)
+	if err != nil {
+		log.Errorf("processLocalNetDumpCmd: %v", err)
+		return
+	}
+	ctx.netDumpReqLock.Lock()
+	defer ctx.netDumpReqLock.Unlock()
+	pub := ctx.pubNetDumpRequest
+	for key, item := range pub.GetAll() {
+		prevReq := item.(types.NetDumpRequest)
+		if prevReq.Source == types.NetDumpSourceLPS && key != req.Key() {
+			if err := pub.Unpublish(key); err != nil {
+				log.Errorf("processLocalNetDumpCmd: failed to unpublish %s: %v", key, err)
+			}
+		}
+	}
+	publishNetDumpRequest(ctx, req)
+}
+
+func parseNetDumpRequest(reqConfig *zconfig.NetDumpRequest,
+	source types.NetDumpSource) (req types.NetDumpRequest, err error) {
+	if reqConfig == nil {
+		return req, errors.New("missing netdump request parameters")
+	}
+	if reqConfig.GetId() == "" {
+		return req, fmt.Errorf("netdump request for target %q without ID",
+			reqConfig.GetTarget())
+	}
+	if reqConfig.GetTarget() == "" {
+		return req, fmt.Errorf("netdump request %s without target", reqConfig.GetId())
+	}
+	req = types.NetDumpRequest{
+		RequestID:  reqConfig.GetId(),
+		Source:     source,
+		Target:     reqConfig.GetTarget(),
+		Duration:   time.Duration(reqConfig.GetDurationSeconds()) * time.Second,
+		Interfaces: reqConfig.GetInterfaces(),
+	}
+	if dsID := reqConfig.GetDatastoreId(); dsID != "" {
+		req.DatastoreID, err = uuid.FromString(dsID)
+		if err != nil {
+			return req, fmt.Errorf("netdump request %s with invalid datastore ID %q: %v",
+				req.RequestID, dsID, err)
+		}
+	}
+	return req, nil
+}
+
+func publishNetDumpRequest(ctx *getconfigContext, req types.NetDumpRequest) {
+	pub := ctx.pubNetDumpRequest
+	if prevReq, err := pub.Get(req.Key()); err == nil {
+		if prevReq.(types.NetDumpRequest).Source != req.Source {
+			log.Warnf("publishNetDumpRequest: request %s already submitted by %v",
+				req.Key(), prevReq.(types.NetDumpRequest).Source)
+			return
+		}
+	}
+	if err := pub.Publish(req.Key(), req); err != nil {
+		log.Errorf("publishNetDumpRequest: failed to publish %s: %v", req.Key(), err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var timeSyncHash []byte
+
+// parseTimeSyncConfig parses configuration of the device clock synchronization
+// and publishes it for timesync.
+func parseTimeSyncConfig(ctx *getconfigContext,
+	config *zconfig.EdgeDevConfig) {
+
+	log.Tracef("Started parsing time sync config")
+	cfgTimeSync := config.GetTimeSync()
+	h := sha256.New()
+	if cfgTimeSync != nil {
+		computeConfigElementSha(h, cfgTimeSync)
+	}
+	newHash := h.Sum(nil)
+	if bytes.Equal(newHash, timeSyncHash) {
+		return
+	}
+	log.Functionf("parseTimeSyncConfig: Applying updated config "+
+		"Last Sha: % x, "+
+		"New  Sha: % x, "+
+		"Num of NTP servers: %d",
+		timeSyncHash, newHash, len(cfgTimeSync.GetNtpServers()))
+
+	timeSyncHash = newHash
+
+	publishTimeSyncConfig(ctx, parseTimeSync(cfgTimeSync))
+
+	log.Traceln("parsing time sync config done")
+}
+
+func parseTimeSync(config *zconfig.TimeSyncConfig) types.TimeSyncConfig {
+	timeSync := types.TimeSyncConfig{
+		UseOnlyConfiguredServers: config.GetUseOnlyConfiguredServers(),
+	}
+	for _, server := range config.GetNtpServers() {
+		if server.GetAddress() == "" {
+			log.Warnf("parseTimeSync: ignoring NTP server without address")
+			continue
+		}
+		if server.GetNtsKePort() > 0xffff {
+			log.Warnf("parseTimeSync: invalid NTS-KE port %d for server %s",
+				server.GetNtsKePort(), server.GetAddress())
+			continue
+		}
+		timeSync.NTPServers = append(timeSync.NTPServers, types.NTPServerConfig{
+			Address:   server.GetAddress(),
+			NTS:       server.GetNts(),
+			NTSKEPort: uint16(server.GetNtsKePort()),
+			Prefer:    server.GetPrefer(),
+		})
+	}
+	if ptp := config.GetPtp(); ptp != nil {
+		if ptp.GetDomain() > 127 {
+			log.Warnf("parseTimeSync: invalid PTP domain %d, PTP is disabled",
+				ptp.GetDomain())
+		} else {
+			timeSync.PTP = types.PTPConfig{
+				Enabled: ptp.GetEnabled(),
+				Ports:   ptp.GetPorts(),
+				Domain:  uint8(ptp.GetDomain()),
+			}
+		}
+	}
+	return timeSync
+}
+
+func publishTimeSyncConfig(ctx *getconfigContext,
+	config types.TimeSyncConfig) {
+
+	key := config.Key()
+	log.Tracef("publishTimeSyncConfig(%s)\n", key)
+	pub := ctx.pubTimeSyncConfig
+	pub.Publish(key, config)
+	log.Tracef("publishTimeSyncConfig(%s) done\n", key)
+}
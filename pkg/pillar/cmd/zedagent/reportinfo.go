@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -647,6 +648,9 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext, dest destinationBitset) {
 		}
 	}
 
+	ReportDeviceInfo.Netdumps = encodeNetDumpStatus(ctx)
+	ReportDeviceInfo.TimeSync = encodeTimeSyncStatus(ctx)
+
 	log.Tracef("PublishDeviceInfoToZedCloud sending %v", ReportInfo)
 	data, err := proto.Marshal(ReportInfo)
 	if err != nil {
@@ -852,7 +856,7 @@ func encodeNetInfo(port types.NetworkPortStatus) *info.ZInfoNetwork {
 	// Any error or test result?
 	networkInfo.NetworkErr = encodeTestResults(port.TestResults)
 
-	networkInfo.Proxy = encodeProxyStatus(&port.ProxyConfig)
+	networkInfo.Proxy = encodeProxyStatus(&port.ProxyConfig, port.TestResults)
 	networkInfo.NtpServers = []string{}
 	for _, server := range port.NtpServers {
 		networkInfo.NtpServers = append(networkInfo.NtpServers, server.String())
@@ -908,6 +912,8 @@ func encodeSimCards(cellModule string, wwanSimCards []types.WwanSimCard) (simCar
 			Imsi:           simCard.IMSI,
 			Iccid:          simCard.ICCID,
 			State:          simCard.Status,
+			SlotNumber:     uint32(simCard.SlotNumber),
+			SlotActivated:  simCard.SlotActivated,
 		})
 	}
 	return simCards
@@ -925,6 +931,56 @@ func encodeCellProviders(wwanProviders []types.WwanProvider) (providers []*info.
 	return providers
 }
 
+func encodeWifiStatus(wifiStatus types.WifiNetworkStatus) *info.ZWifiStatus {
+	var state info.WifiState
+	switch wifiStatus.State {
+	case types.WifiStateUnspecified:
+		state = info.WifiState_WIFI_STATE_UNSPECIFIED
+	case types.WifiStateDisconnected:
+		state = info.WifiState_WIFI_STATE_DISCONNECTED
+	case types.WifiStateInactive:
+		state = info.WifiState_WIFI_STATE_INACTIVE
+	case types.WifiStateScanning:
+		state = info.WifiState_WIFI_STATE_SCANNING
+	case types.WifiStateAuthenticating:
+		state = info.WifiState_WIFI_STATE_AUTHENTICATING
+	case types.WifiStateAssociating:
+		state = info.WifiState_WIFI_STATE_ASSOCIATING
+	case types.WifiStateHandshake:
+		state = info.WifiState_WIFI_STATE_HANDSHAKE
+	case types.WifiStateConnected:
+		state = info.WifiState_WIFI_STATE_CONNECTED
+	case types.WifiStateDisabled:
+		state = info.WifiState_WIFI_STATE_DISABLED
+	default:
+		log.Errorf("Invalid WiFi state: %v", wifiStatus.State)
+	}
+	var scanResults []*info.ZWifiScanResult
+	for _, ap := range wifiStatus.ScanResults {
+		scanResults = append(scanResults, &info.ZWifiScanResult{
+			Ssid:      ap.SSID,
+			Bssid:     ap.BSSID,
+			Frequency: ap.Frequency,
+			Rssi:      ap.RSSI,
+			Flags:     ap.Flags,
+		})
+	}
+	status := &info.ZWifiStatus{
+		State:           state,
+		Ssid:            wifiStatus.SSID,
+		Bssid:           wifiStatus.BSSID,
+		Frequency:       wifiStatus.Frequency,
+		KeyMgmt:         wifiStatus.KeyMgmt,
+		AuthFailures:    wifiStatus.AuthFailures,
+		LastAuthFailure: wifiStatus.LastAuthFailure,
+		ScanResults:     scanResults,
+	}
+	if !wifiStatus.LastScan.IsZero() {
+		status.LastScan, _ = ptypes.TimestampProto(wifiStatus.LastScan)
+	}
+	return status
+}
+
 func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 	dpcl := *ctx.DevicePortConfigList
 	sainfo := new(info.SystemAdapterInfo)
@@ -977,6 +1033,28 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 					},
 				}
 			}
+			if i == dpcl.CurrentIndex && p.WirelessCfg.WType == types.WirelessTypeWifi {
+				portStatus := deviceNetworkStatus.GetPortByIfName(p.IfName)
+				if portStatus != nil &&
+					portStatus.WirelessStatus.WType == types.WirelessTypeWifi {
+					dps.Ports[j].WirelessStatus = &info.WirelessStatus{
+						Type: info.WirelessType_WIRELESS_TYPE_WIFI,
+						Wifi: encodeWifiStatus(portStatus.WirelessStatus.Wifi),
+					}
+				}
+			}
+			if i == dpcl.CurrentIndex && p.PortAuth.Enabled {
+				portStatus := deviceNetworkStatus.GetPortByIfName(p.IfName)
+				if portStatus != nil && portStatus.PortAuth.Enabled {
+					dps.Ports[j].PortAuth = encodePortAuthStatus(portStatus.PortAuth)
+				}
+			}
+			if i == dpcl.CurrentIndex {
+				portStatus := deviceNetworkStatus.GetPortByIfName(p.IfName)
+				if portStatus != nil {
+					dps.Ports[j].Neighbors = encodePortNeighbors(portStatus.Neighbors)
+				}
+			}
 		}
 		sainfo.Status[i] = dps
 	}
@@ -984,7 +1062,147 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 	return sainfo
 }
 
+func encodePortAuthStatus(status types.PortAuthStatus) *info.PortAuthStatus {
+	protoStatus := &info.PortAuthStatus{
+		EapMethod: status.EAPMethod,
+		Error:     status.Error,
+	}
+	switch status.State {
+	case types.PortAuthStateDown:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_DOWN
+	case types.PortAuthStateAuthenticating:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_AUTHENTICATING
+	case types.PortAuthStateAuthenticated:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_AUTHENTICATED
+	case types.PortAuthStateFailed:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_FAILED
+	case types.PortAuthStateUnauthenticated:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_UNAUTHENTICATED
+	default:
+		protoStatus.State = info.PortAuthState_PORT_AUTH_STATE_UNSPECIFIED
+	}
+	if !status.LastChange.IsZero() {
+		protoStatus.LastChange, _ = ptypes.TimestampProto(status.LastChange)
+	}
+	return protoStatus
+}
+
+func encodePortNeighbors(neighbors []types.PortNeighbor) (protoNeighbors []*info.PortNeighbor) {
+	for _, neighbor := range neighbors {
+		protoNeighbor := &info.PortNeighbor{
+			ChassisId:       neighbor.ChassisID,
+			PortId:          neighbor.PortID,
+			PortDescription: neighbor.PortDescription,
+			SystemName:      neighbor.SystemName,
+			VlanId:          uint32(neighbor.VlanID),
+		}
+		switch neighbor.Protocol {
+		case types.NeighborDiscoveryProtocolLLDP:
+			protoNeighbor.Protocol = info.NeighborDiscoveryProtocol_NEIGHBOR_DISCOVERY_PROTOCOL_LLDP
+		case types.NeighborDiscoveryProtocolCDP:
+			protoNeighbor.Protocol = info.NeighborDiscoveryProtocol_NEIGHBOR_DISCOVERY_PROTOCOL_CDP
+		default:
+			protoNeighbor.Protocol = info.NeighborDiscoveryProtocol_NEIGHBOR_DISCOVERY_PROTOCOL_UNSPECIFIED
+		}
+		for _, ip := range neighbor.MgmtAddrs {
+			protoNeighbor.MgmtAddrs = append(protoNeighbor.MgmtAddrs, ip.String())
+		}
+		protoNeighbors = append(protoNeighbors, protoNeighbor)
+	}
+	return protoNeighbors
+}
+
 // getDataSecAtRestInfo prepares status related to Data security at Rest
+func encodeNetDumpStatus(ctx *zedagentContext) (netdumps []*info.ZInfoNetDump) {
+	if ctx.subNetDumpStatus == nil {
+		return nil
+	}
+	for _, item := range ctx.subNetDumpStatus.GetAll() {
+		status := item.(types.NetDumpStatus)
+		netdumpInfo := &info.ZInfoNetDump{
+			Id:             status.RequestID,
+			Source:         info.NetDumpSource(status.Source),
+			State:          info.NetDumpState(status.State),
+			Filename:       status.Filename,
+			UploadLocation: status.UploadLocation,
+		}
+		if status.HasError() {
+			netdumpInfo.Error = encodeErrorInfo(status.ErrorDescription)
+		}
+		if !status.StartedAt.IsZero() {
+			netdumpInfo.StartedAt, _ = ptypes.TimestampProto(status.StartedAt)
+		}
+		if !status.CompletedAt.IsZero() {
+			netdumpInfo.CompletedAt, _ = ptypes.TimestampProto(status.CompletedAt)
+		}
+		netdumps = append(netdumps, netdumpInfo)
+	}
+	sort.Slice(netdumps, func(i, j int) bool {
+		return netdumps[i].Id < netdumps[j].Id
+	})
+	return netdumps
+}
+
+// encodeTimeSyncStatus prepares status of the device clock synchronization.
+func encodeTimeSyncStatus(ctx *zedagentContext) *info.ZInfoTimeSync {
+	if ctx.subTimeSyncStatus == nil {
+		return nil
+	}
+	item, err := ctx.subTimeSyncStatus.Get(types.TimeSyncStatus{}.Key())
+	if err != nil {
+		return nil
+	}
+	status := item.(types.TimeSyncStatus)
+	timeSync := &info.ZInfoTimeSync{
+		Synchronized: status.Synchronized,
+		Source:       status.Source,
+		SourceType:   encodeTimeSourceType(status.SourceType),
+		Stratum:      uint32(status.Stratum),
+		OffsetNs:     status.Offset.Nanoseconds(),
+		Error:        status.Error,
+	}
+	if !status.LastSync.IsZero() {
+		timeSync.LastSync, _ = ptypes.TimestampProto(status.LastSync)
+	}
+	for _, source := range status.Sources {
+		timeSync.Sources = append(timeSync.Sources, &info.ZInfoTimeSource{
+			Address:   source.Address,
+			Type:      encodeTimeSourceType(source.Type),
+			State:     encodeTimeSourceState(source.State),
+			Stratum:   uint32(source.Stratum),
+			OffsetNs:  source.Offset.Nanoseconds(),
+			NtsFailed: source.NTSFailed,
+		})
+	}
+	return timeSync
+}
+
+func encodeTimeSourceType(sourceType types.TimeSourceType) info.TimeSourceType {
+	switch sourceType {
+	case types.TimeSourceNTP:
+		return info.TimeSourceType_TIME_SOURCE_TYPE_NTP
+	case types.TimeSourceNTS:
+		return info.TimeSourceType_TIME_SOURCE_TYPE_NTS
+	case types.TimeSourcePTP:
+		return info.TimeSourceType_TIME_SOURCE_TYPE_PTP
+	}
+	return info.TimeSourceType_TIME_SOURCE_TYPE_UNSPECIFIED
+}
+
+func encodeTimeSourceState(state types.TimeSourceState) info.TimeSourceState {
+	switch state {
+	case types.TimeSourceStateSelected:
+		return info.TimeSourceState_TIME_SOURCE_STATE_SELECTED
+	case types.TimeSourceStateCandidate:
+		return info.TimeSourceState_TIME_SOURCE_STATE_CANDIDATE
+	case types.TimeSourceStateRejected:
+		return info.TimeSourceState_TIME_SOURCE_STATE_REJECTED
+	case types.TimeSourceStateUnreachable:
+		return info.TimeSourceState_TIME_SOURCE_STATE_UNREACHABLE
+	}
+	return info.TimeSourceState_TIME_SOURCE_STATE_UNSPECIFIED
+}
+
 func getDataSecAtRestInfo(ctx *zedagentContext) *info.DataSecAtRest {
 	subVaultStatus := ctx.subVaultStatus
 	ReportDataSecAtRestInfo := new(info.DataSecAtRest)
@@ -132,15 +132,19 @@ type zedagentContext struct {
 	GCInitialized             bool // Received initial GlobalConfig
 	subZbootStatus            pubsub.Subscription
 	subAppContainerMetrics    pubsub.Subscription
+	subAppPrometheusMetrics   pubsub.Subscription
 	subDiskMetric             pubsub.Subscription
 	subAppDiskMetric          pubsub.Subscription
 	subCapabilities           pubsub.Subscription
 	subAppInstMetaData        pubsub.Subscription
 	subWwanMetrics            pubsub.Subscription
+	subWlanMetrics            pubsub.Subscription
 	subLocationInfo           pubsub.Subscription
 	subZFSPoolStatus          pubsub.Subscription
 	subZFSPoolMetrics         pubsub.Subscription
 	subEdgeviewStatus         pubsub.Subscription
+	subNetDumpStatus          pubsub.Subscription
+	subTimeSyncStatus         pubsub.Subscription
 	subNetworkMetrics         pubsub.Subscription
 	subClientMetrics          pubsub.Subscription
 	subLoguploaderMetrics     pubsub.Subscription
@@ -714,6 +718,9 @@ func waitUntilDNSReady(zedagentCtx *zedagentContext, stillRunning *time.Ticker)
 		case change := <-zedagentCtx.subWwanMetrics.MsgChan():
 			zedagentCtx.subWwanMetrics.ProcessChange(change)
 
+		case change := <-zedagentCtx.subWlanMetrics.MsgChan():
+			zedagentCtx.subWlanMetrics.ProcessChange(change)
+
 		case change := <-zedagentCtx.subLocationInfo.MsgChan():
 			zedagentCtx.subLocationInfo.ProcessChange(change)
 
@@ -956,6 +963,9 @@ func mainEventLoop(zedagentCtx *zedagentContext, stillRunning *time.Ticker) {
 		case change := <-zedagentCtx.subAppContainerMetrics.MsgChan():
 			zedagentCtx.subAppContainerMetrics.ProcessChange(change)
 
+		case change := <-zedagentCtx.subAppPrometheusMetrics.MsgChan():
+			zedagentCtx.subAppPrometheusMetrics.ProcessChange(change)
+
 		case change := <-zedagentCtx.subDiskMetric.MsgChan():
 			zedagentCtx.subDiskMetric.ProcessChange(change)
 
@@ -974,6 +984,9 @@ func mainEventLoop(zedagentCtx *zedagentContext, stillRunning *time.Ticker) {
 		case change := <-zedagentCtx.subWwanMetrics.MsgChan():
 			zedagentCtx.subWwanMetrics.ProcessChange(change)
 
+		case change := <-zedagentCtx.subWlanMetrics.MsgChan():
+			zedagentCtx.subWlanMetrics.ProcessChange(change)
+
 		case change := <-zedagentCtx.subLocationInfo.MsgChan():
 			zedagentCtx.subLocationInfo.ProcessChange(change)
 
@@ -990,6 +1003,14 @@ func mainEventLoop(zedagentCtx *zedagentContext, stillRunning *time.Ticker) {
 		case change := <-zedagentCtx.subEdgeviewStatus.MsgChan():
 			zedagentCtx.subEdgeviewStatus.ProcessChange(change)
 
+		case change := <-zedagentCtx.subNetDumpStatus.MsgChan():
+			zedagentCtx.subNetDumpStatus.ProcessChange(change)
+			triggerPublishDevInfo(zedagentCtx)
+
+		case change := <-zedagentCtx.subTimeSyncStatus.MsgChan():
+			zedagentCtx.subTimeSyncStatus.ProcessChange(change)
+			triggerPublishDevInfo(zedagentCtx)
+
 		case <-stillRunning.C:
 			// Fault injection
 			if zedagentCtx.fatalFlag {
@@ -1151,6 +1172,18 @@ func initPublications(zedagentCtx *zedagentContext) {
 	}
 	getconfigCtx.pubDisksConfig.ClearRestarted()
 
+	// for time sync config Publisher
+	getconfigCtx.pubTimeSyncConfig, err = ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  agentName,
+			TopicType:  types.TimeSyncConfig{},
+			Persistent: true,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubTimeSyncConfig.ClearRestarted()
+
 	// for Edge Node Info Publisher
 	getconfigCtx.pubEdgeNodeInfo, err = ps.NewPublication(
 		pubsub.PublicationOptions{
@@ -1163,6 +1196,17 @@ func initPublications(zedagentCtx *zedagentContext) {
 	}
 	getconfigCtx.pubEdgeNodeInfo.ClearRestarted()
 
+	// for on-demand network diagnostics requests
+	getconfigCtx.pubNetDumpRequest, err = ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.NetDumpRequest{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubNetDumpRequest.ClearRestarted()
+
 }
 
 // All but one zedagent subscription (subOnboardStatus) are activated
@@ -1412,6 +1456,20 @@ func initPostOnboardSubs(zedagentCtx *zedagentContext) {
 		log.Fatal(err)
 	}
 
+	// sub AppPrometheusMetrics from zedrouter
+	zedagentCtx.subAppPrometheusMetrics, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "zedrouter",
+		MyAgentName: agentName,
+		TopicImpl:   types.AppPrometheusMetrics{},
+		Activate:    true,
+		Ctx:         zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	zedagentCtx.subBaseOsStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "baseosmgr",
 		MyAgentName:   agentName,
@@ -1662,6 +1720,19 @@ func initPostOnboardSubs(zedagentCtx *zedagentContext) {
 		log.Fatal(err)
 	}
 
+	zedagentCtx.subWlanMetrics, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "nim",
+		MyAgentName: agentName,
+		TopicImpl:   types.WlanMetrics{},
+		Activate:    true,
+		Ctx:         zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	zedagentCtx.subLocationInfo, err = ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "nim",
 		MyAgentName: agentName,
@@ -1811,6 +1882,35 @@ func initPostOnboardSubs(zedagentCtx *zedagentContext) {
 		log.Fatal(err)
 	}
 
+	// Status of on-demand network diagnostics requests
+	zedagentCtx.subNetDumpStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "downloader",
+		MyAgentName: agentName,
+		TopicImpl:   types.NetDumpStatus{},
+		Activate:    true,
+		Persistent:  true,
+		Ctx:         zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Status of the device clock synchronization
+	zedagentCtx.subTimeSyncStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "timesync",
+		MyAgentName: agentName,
+		TopicImpl:   types.TimeSyncStatus{},
+		Activate:    true,
+		Ctx:         zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	zedagentCtx.subZFSPoolMetrics, err = ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "zfsmanager",
 		MyAgentName: agentName,
@@ -1968,6 +2068,7 @@ func handleAppInstanceStatusCreate(ctxArg interface{}, key string,
 		ctx.iteration, AllDest)
 	triggerPublishDevInfo(ctx)
 	processAppCommandStatus(ctx.getconfigCtx, status)
+	processAppHealthPurgeRequest(ctx.getconfigCtx, status)
 	triggerLocalAppInfoPOST(ctx.getconfigCtx)
 	log.Functionf("handleAppInstanceStatusCreate(%s) DONE", key)
 }
@@ -1985,6 +2086,7 @@ func handleAppInstanceStatusModify(ctxArg interface{}, key string,
 	PublishAppInfoToZedCloud(ctx, uuidStr, &status, ctx.assignableAdapters,
 		ctx.iteration, AllDest)
 	processAppCommandStatus(ctx.getconfigCtx, status)
+	processAppHealthPurgeRequest(ctx.getconfigCtx, status)
 	triggerLocalAppInfoPOST(ctx.getconfigCtx)
 	log.Functionf("handleAppInstanceStatusModify(%s) DONE", key)
 }
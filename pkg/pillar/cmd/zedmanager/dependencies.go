@@ -0,0 +1,415 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Dependencies between app instances are modeled using a dependency graph
+// (libs/depgraph) where each app instance is represented by one item and
+// each edge points from an app instance to an app instance it depends on.
+// Besides explicit dependencies from AppInstanceConfig.Dependencies, an app
+// instance with a non-zero StartGroup implicitly depends on (i.e. must wait
+// for readiness of) every app instance from a lower non-zero start group.
+// The graph is cheap to build and it is therefore always re-built from
+// the current set of AppInstanceConfigs.
+
+const appDepItemType = "AppInstance"
+
+// appDep : dependency of an app instance on another app instance.
+type appDep struct {
+	appUUID   uuid.UUID
+	condition types.AppDependencyCondition
+	// implicit is true if the dependency was derived from start groups.
+	implicit bool
+}
+
+// appDepItem : app instance represented as an item of the dependency graph.
+type appDepItem struct {
+	appUUID     uuid.UUID
+	displayName string
+	deps        []appDep
+}
+
+// Name returns the app instance UUID.
+func (a appDepItem) Name() string {
+	return a.appUUID.String()
+}
+
+// Label returns the app instance display name.
+func (a appDepItem) Label() string {
+	return a.displayName
+}
+
+// Type of the item.
+func (a appDepItem) Type() string {
+	return appDepItemType
+}
+
+// Equal compares dependencies of app instances.
+func (a appDepItem) Equal(other dg.Item) bool {
+	a2 := other.(appDepItem)
+	if len(a.deps) != len(a2.deps) {
+		return false
+	}
+	for i := range a.deps {
+		if a.deps[i] != a2.deps[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// External returns false.
+func (a appDepItem) External() bool {
+	return false
+}
+
+// String describes the app instance and its dependencies.
+func (a appDepItem) String() string {
+	var deps []string
+	for _, dep := range a.deps {
+		deps = append(deps, fmt.Sprintf("%s (%s)", dep.appUUID, dep.condition))
+	}
+	return fmt.Sprintf("App instance %s (%s) depending on: [%s]",
+		a.displayName, a.appUUID, strings.Join(deps, ", "))
+}
+
+// Dependencies returns one dependency for every required app instance.
+func (a appDepItem) Dependencies() (deps []dg.Dependency) {
+	for _, dep := range a.deps {
+		deps = append(deps, dg.Dependency{
+			RequiredItem: appDepItemRef(dep.appUUID),
+			Description: fmt.Sprintf("App instance must be %s",
+				dep.condition),
+		})
+	}
+	return deps
+}
+
+func appDepItemRef(appUUID uuid.UUID) dg.ItemRef {
+	return dg.ItemRef{
+		ItemType: appDepItemType,
+		ItemName: appUUID.String(),
+	}
+}
+
+// getAppDeps returns explicit and start-group dependencies of the app instance.
+// Every required app instance is listed at most once.
+func getAppDeps(config types.AppInstanceConfig,
+	configs []types.AppInstanceConfig) (deps []appDep) {
+	seen := make(map[uuid.UUID]struct{})
+	for _, dep := range config.Dependencies {
+		if _, duplicate := seen[dep.AppUUID]; duplicate {
+			continue
+		}
+		seen[dep.AppUUID] = struct{}{}
+		condition := dep.Condition
+		if condition == types.AppDependencyConditionUnspecified {
+			condition = types.AppDependencyReady
+		}
+		deps = append(deps, appDep{
+			appUUID:   dep.AppUUID,
+			condition: condition,
+		})
+	}
+	if config.StartGroup == 0 {
+		return deps
+	}
+	for _, other := range configs {
+		if other.StartGroup == 0 || other.StartGroup >= config.StartGroup {
+			continue
+		}
+		if _, duplicate := seen[other.UUIDandVersion.UUID]; duplicate {
+			continue
+		}
+		seen[other.UUIDandVersion.UUID] = struct{}{}
+		deps = append(deps, appDep{
+			appUUID:   other.UUIDandVersion.UUID,
+			condition: types.AppDependencyReady,
+			implicit:  true,
+		})
+	}
+	return deps
+}
+
+// buildAppDepGraph builds the dependency graph for the given app instances.
+func buildAppDepGraph(configs []types.AppInstanceConfig) dg.Graph {
+	// Sort to get deterministic ordering of implicit dependencies
+	// and of the reported cycles.
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Key() < configs[j].Key()
+	})
+	var items []dg.Item
+	for _, config := range configs {
+		items = append(items, appDepItem{
+			appUUID:     config.UUIDandVersion.UUID,
+			displayName: config.DisplayName,
+			deps:        getAppDeps(config, configs),
+		})
+	}
+	return dg.New(dg.InitArgs{
+		Name:        "AppInstanceDependencies",
+		Description: "Dependencies between application instances",
+		Items:       items,
+	})
+}
+
+func getAppDepGraph(ctx *zedmanagerContext) dg.Graph {
+	var configs []types.AppInstanceConfig
+	for _, c := range ctx.subAppInstanceConfig.GetAll() {
+		configs = append(configs, c.(types.AppInstanceConfig))
+	}
+	return buildAppDepGraph(configs)
+}
+
+// findDependencyCycle returns a cycle of dependencies which starts and ends
+// with the given app instance. Returns nil if there is no such cycle.
+func findDependencyCycle(graph dg.GraphR, start dg.ItemRef) []dg.ItemRef {
+	visited := make(map[dg.ItemRef]struct{})
+	var path []dg.ItemRef
+	var visit func(ref dg.ItemRef) bool
+	visit = func(ref dg.ItemRef) bool {
+		path = append(path, ref)
+		edges := graph.OutgoingEdges(ref)
+		for edges.Next() {
+			next := edges.Edge().ToItem
+			if next == start {
+				path = append(path, next)
+				return true
+			}
+			if _, done := visited[next]; done {
+				continue
+			}
+			visited[next] = struct{}{}
+			if visit(next) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(start) {
+		return path
+	}
+	return nil
+}
+
+// formatDependencyCycle returns the cycle as a string using app display names.
+func formatDependencyCycle(graph dg.GraphR, cycle []dg.ItemRef) string {
+	var names []string
+	for _, ref := range cycle {
+		name := ref.ItemName
+		if item, _, _, found := graph.Item(ref); found && item.Label() != "" {
+			name = item.Label()
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, " -> ")
+}
+
+// isAppDepSatisfied returns true if the status of the required app instance
+// satisfies the dependency condition.
+func isAppDepSatisfied(status types.AppInstanceStatus,
+	condition types.AppDependencyCondition) bool {
+	if condition == types.AppDependencyStarted {
+		return status.State == types.RUNNING
+	}
+	return status.IsReady()
+}
+
+// checkAppDependencies checks if all app instances which the given app
+// instance depends on are started or ready (depending on the dependency
+// condition). If not, the app instance is marked as START_DELAYED with
+// AwaitingDependencies set and an error describing what it is waiting for.
+// Returns blocked as true if the app instance must not be activated yet.
+func checkAppDependencies(ctx *zedmanagerContext, config types.AppInstanceConfig,
+	status *types.AppInstanceStatus) (changed, blocked bool) {
+
+	graph := getAppDepGraph(ctx)
+	ref := appDepItemRef(config.UUIDandVersion.UUID)
+	item, _, _, found := graph.Item(ref)
+	if !found {
+		// Config was just removed.
+		return false, false
+	}
+	var description types.ErrorDescription
+	if cycle := findDependencyCycle(graph, ref); cycle != nil {
+		description.Error = fmt.Sprintf("dependency cycle: %s",
+			formatDependencyCycle(graph, cycle))
+		description.ErrorSeverity = types.ErrorSeverityError
+		description.ErrorRetryCondition = "Will retry when the cycle is removed from the configuration"
+	} else {
+		var waitingFor []string
+		severity := types.ErrorSeverityNotice
+		for _, dep := range item.(appDepItem).deps {
+			depKey := dep.appUUID.String()
+			depConfig := lookupAppInstanceConfig(ctx, depKey)
+			if depConfig == nil {
+				description.Error = fmt.Sprintf(
+					"depends on app instance %s which is not deployed", depKey)
+				description.ErrorSeverity = types.ErrorSeverityError
+				description.ErrorRetryCondition = "Will retry when the app instance is deployed"
+				description.ErrorEntities = []*types.ErrorEntity{{
+					EntityType: types.ErrorEntityAppInstance,
+					EntityID:   depKey,
+				}}
+				break
+			}
+			if dep.implicit && !effectiveActivateCurrentProfile(*depConfig,
+				ctx.currentProfile) {
+				// Inactive app instances do not hold back higher start groups.
+				continue
+			}
+			depStatus := lookupAppInstanceStatus(ctx, depKey)
+			if depStatus != nil && isAppDepSatisfied(*depStatus, dep.condition) {
+				continue
+			}
+			if depStatus != nil && depStatus.HasError() {
+				severity = types.ErrorSeverityWarning
+			}
+			waitingFor = append(waitingFor, fmt.Sprintf("%s to be %s",
+				depConfig.DisplayName, dep.condition))
+			description.ErrorEntities = append(description.ErrorEntities,
+				&types.ErrorEntity{
+					EntityType: types.ErrorEntityAppInstance,
+					EntityID:   depKey,
+				})
+		}
+		if description.Error == "" && len(waitingFor) > 0 {
+			description.Error = fmt.Sprintf("waiting for %s",
+				strings.Join(waitingFor, ", "))
+			description.ErrorSeverity = severity
+			description.ErrorRetryCondition = "Will start once all dependencies are satisfied"
+		}
+	}
+
+	if description.Error == "" {
+		changed = clearAwaitingDependencies(status)
+		return changed, false
+	}
+	if status.Error != description.Error || !status.AwaitingDependencies {
+		log.Noticef("checkAppDependencies(%s): %s", status.Key(),
+			description.Error)
+		status.SetErrorWithSourceAndDescription(description,
+			types.AppDependency{})
+		changed = true
+	}
+	if status.State != types.START_DELAYED {
+		status.State = types.START_DELAYED
+		changed = true
+	}
+	if !status.AwaitingDependencies {
+		status.AwaitingDependencies = true
+		changed = true
+	}
+	return changed, true
+}
+
+// clearAwaitingDependencies clears the flag and the error set
+// by checkAppDependencies.
+func clearAwaitingDependencies(status *types.AppInstanceStatus) (changed bool) {
+	if status.IsErrorSource(types.AppDependency{}) {
+		log.Functionf("Clearing dependency error %s", status.Error)
+		status.ClearErrorWithSource()
+		changed = true
+	}
+	if status.AwaitingDependencies {
+		status.AwaitingDependencies = false
+		changed = true
+	}
+	return changed
+}
+
+// dependentsOf returns keys of app instances which depend on the given
+// app instance. Unlike graph.IncomingEdges, this works also for app instances
+// which are not (or no longer) part of the graph.
+func dependentsOf(graph dg.GraphR, ref dg.ItemRef) (dependents []string) {
+	items := graph.Items(false)
+	for items.Next() {
+		item, _ := items.Item()
+		for _, dep := range item.Dependencies() {
+			if dep.RequiredItem == ref {
+				dependents = append(dependents, item.Name())
+				break
+			}
+		}
+	}
+	return dependents
+}
+
+// haltingDependents returns app instances which depend on the given app
+// instance and are being halted but are not yet halted.
+// Halting of the app instance should be postponed until they are halted.
+// App instances which stay active do not postpone the halt, otherwise
+// the app instance could not be halted at all.
+func haltingDependents(ctx *zedmanagerContext, config types.AppInstanceConfig) (
+	dependents []string) {
+
+	graph := getAppDepGraph(ctx)
+	ref := appDepItemRef(config.UUIDandVersion.UUID)
+	if findDependencyCycle(graph, ref) != nil {
+		// There is no right order to halt app instances in a cycle.
+		return nil
+	}
+	for _, depKey := range dependentsOf(graph, ref) {
+		depConfig := lookupAppInstanceConfig(ctx, depKey)
+		if depConfig == nil ||
+			effectiveActivateCurrentProfile(*depConfig, ctx.currentProfile) {
+			continue
+		}
+		depStatus := lookupAppInstanceStatus(ctx, depKey)
+		if depStatus != nil &&
+			(depStatus.Activated || depStatus.ActivateInprogress) {
+			dependents = append(dependents, depConfig.DisplayName)
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// updateRelatedApps re-evaluates app instances whose start or halt may be
+// unblocked by a change in the status of the given app instance, i.e.
+// dependents awaiting the app instance and dependencies whose halt
+// is postponed until the app instance is halted.
+func updateRelatedApps(ctx *zedmanagerContext, appKey string) {
+	appUUID, err := uuid.FromString(appKey)
+	if err != nil {
+		return
+	}
+	graph := getAppDepGraph(ctx)
+	ref := appDepItemRef(appUUID)
+	related := dependentsOf(graph, ref)
+	edges := graph.OutgoingEdges(ref)
+	for edges.Next() {
+		related = append(related, edges.Edge().ToItem.ItemName)
+	}
+	for _, key := range related {
+		config := lookupAppInstanceConfig(ctx, key)
+		status := lookupAppInstanceStatus(ctx, key)
+		if config == nil || status == nil {
+			continue
+		}
+		effectiveActivate := effectiveActivateCurrentProfile(*config,
+			ctx.currentProfile)
+		awaitingStart := effectiveActivate && status.AwaitingDependencies
+		awaitingHalt := !effectiveActivate &&
+			(status.Activated || status.ActivateInprogress)
+		if !awaitingStart && !awaitingHalt {
+			continue
+		}
+		log.Functionf("updateRelatedApps(%s): re-evaluating %s",
+			appKey, key)
+		if doUpdate(ctx, *config, status) {
+			publishAppInstanceStatus(ctx, status)
+		}
+	}
+}
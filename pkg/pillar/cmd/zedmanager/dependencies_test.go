@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+)
+
+func depTestConfig(name string, startGroup uint32,
+	deps ...types.AppInstanceConfig) types.AppInstanceConfig {
+	appUUID, _ := uuid.NewV4()
+	config := types.AppInstanceConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: appUUID},
+		DisplayName:    name,
+		StartGroup:     startGroup,
+	}
+	for _, dep := range deps {
+		config.Dependencies = append(config.Dependencies, types.AppDependency{
+			AppUUID: dep.UUIDandVersion.UUID,
+		})
+	}
+	return config
+}
+
+func TestGetAppDeps(t *testing.T) {
+	g := NewGomegaWithT(t)
+	db := depTestConfig("db", 1)
+	cache := depTestConfig("cache", 1)
+	web := depTestConfig("web", 2, db)
+	web.Dependencies[0].Condition = types.AppDependencyStarted
+	proxy := depTestConfig("proxy", 0, web)
+	configs := []types.AppInstanceConfig{db, cache, web, proxy}
+
+	g.Expect(getAppDeps(db, configs)).To(BeEmpty())
+	// Explicit dependency takes precedence over the implied one.
+	g.Expect(getAppDeps(web, configs)).To(ConsistOf(
+		appDep{appUUID: db.UUIDandVersion.UUID,
+			condition: types.AppDependencyStarted},
+		appDep{appUUID: cache.UUIDandVersion.UUID,
+			condition: types.AppDependencyReady, implicit: true},
+	))
+	// Unspecified condition defaults to ready, start group 0 implies nothing.
+	g.Expect(getAppDeps(proxy, configs)).To(ConsistOf(
+		appDep{appUUID: web.UUIDandVersion.UUID,
+			condition: types.AppDependencyReady},
+	))
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	g := NewGomegaWithT(t)
+	a := depTestConfig("a", 0)
+	b := depTestConfig("b", 0, a)
+	c := depTestConfig("c", 0, b)
+	d := depTestConfig("d", 0, c)
+	missing := depTestConfig("missing", 0)
+	e := depTestConfig("e", 0, missing)
+
+	graph := buildAppDepGraph([]types.AppInstanceConfig{a, b, c, d, e})
+	for _, config := range []types.AppInstanceConfig{a, b, c, d, e} {
+		g.Expect(findDependencyCycle(graph,
+			appDepItemRef(config.UUIDandVersion.UUID))).To(BeNil())
+	}
+	g.Expect(dependentsOf(graph, appDepItemRef(b.UUIDandVersion.UUID))).To(
+		ConsistOf(c.UUIDandVersion.UUID.String()))
+	// Dependents of an app instance which is not deployed.
+	g.Expect(dependentsOf(graph, appDepItemRef(missing.UUIDandVersion.UUID))).To(
+		ConsistOf(e.UUIDandVersion.UUID.String()))
+
+	// Close the cycle a -> c -> b -> a, d only depends on the cycle.
+	a.Dependencies = []types.AppDependency{{AppUUID: c.UUIDandVersion.UUID}}
+	graph = buildAppDepGraph([]types.AppInstanceConfig{a, b, c, d, e})
+	cycle := findDependencyCycle(graph, appDepItemRef(a.UUIDandVersion.UUID))
+	g.Expect(formatDependencyCycle(graph, cycle)).To(Equal("a -> c -> b -> a"))
+	cycle = findDependencyCycle(graph, appDepItemRef(b.UUIDandVersion.UUID))
+	g.Expect(formatDependencyCycle(graph, cycle)).To(Equal("b -> a -> c -> b"))
+	g.Expect(findDependencyCycle(graph,
+		appDepItemRef(d.UUIDandVersion.UUID))).To(BeNil())
+}
+
+func TestIsAppDepSatisfied(t *testing.T) {
+	g := NewGomegaWithT(t)
+	status := types.AppInstanceStatus{State: types.BOOTING}
+	g.Expect(isAppDepSatisfied(status, types.AppDependencyStarted)).To(BeFalse())
+	g.Expect(isAppDepSatisfied(status, types.AppDependencyReady)).To(BeFalse())
+
+	status.State = types.RUNNING
+	status.Health.Readiness.State = types.AppProbeStatePending
+	g.Expect(isAppDepSatisfied(status, types.AppDependencyStarted)).To(BeTrue())
+	g.Expect(isAppDepSatisfied(status, types.AppDependencyReady)).To(BeFalse())
+
+	status.Health.Readiness.State = types.AppProbeStateSuccess
+	status.Health.Ready = true
+	g.Expect(isAppDepSatisfied(status, types.AppDependencyReady)).To(BeTrue())
+}
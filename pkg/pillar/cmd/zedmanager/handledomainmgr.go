@@ -37,18 +37,20 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 	}
 	effectiveActivate := effectiveActivateCurrentProfile(aiConfig, ctx.currentProfile)
 	dc := types.DomainConfig{
-		UUIDandVersion:    aiConfig.UUIDandVersion,
-		DisplayName:       aiConfig.DisplayName,
-		Activate:          effectiveActivate,
-		AppNum:            AppNum,
-		VmConfig:          aiConfig.FixedResources,
-		IoAdapterList:     aiConfig.IoAdapterList,
-		CloudInitUserData: aiConfig.CloudInitUserData,
-		CipherBlockStatus: aiConfig.CipherBlockStatus,
-		GPUConfig:         "legacy",
-		MetaDataType:      aiConfig.MetaDataType,
-		Service:           aiConfig.Service,
-		CloudInitVersion:  aiConfig.CloudInitVersion,
+		UUIDandVersion:     aiConfig.UUIDandVersion,
+		DisplayName:        aiConfig.DisplayName,
+		Activate:           effectiveActivate,
+		AppNum:             AppNum,
+		VmConfig:           aiConfig.FixedResources,
+		IoAdapterList:      aiConfig.IoAdapterList,
+		CloudInitUserData:  aiConfig.CloudInitUserData,
+		CipherBlockStatus:  aiConfig.CipherBlockStatus,
+		GPUConfig:          "legacy",
+		MetaDataType:       aiConfig.MetaDataType,
+		Service:            aiConfig.Service,
+		CloudInitVersion:   aiConfig.CloudInitVersion,
+		PurgeCounter:       aiConfig.PurgeCmd.Counter + aiConfig.LocalPurgeCmd.Counter,
+		SecretsCipherBlock: aiConfig.SecretsCipherBlock,
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
@@ -78,6 +80,21 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		disk.CustomMeta = vrs.CustomMeta
 		dc.DiskConfigList = append(dc.DiskConfigList, disk)
 	}
+	for _, container := range aiConfig.Containers {
+		for _, vrs := range aiStatus.VolumeRefStatusList {
+			if vrs.VolumeID == container.VolumeID {
+				container.VolumeKey = vrs.Key()
+				break
+			}
+		}
+		if container.VolumeKey == "" {
+			errStr := fmt.Sprintf("No volume %s for container %s",
+				container.VolumeID, container.Name)
+			log.Error(errStr)
+			return nil, errors.New(errStr)
+		}
+		dc.Containers = append(dc.Containers, container)
+	}
 	// let's fill some of the default values (arguably we may want controller
 	// to do this for us and give us complete config, but it is easier to
 	// fudge DomainConfig for now on our side)
@@ -53,6 +53,15 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 			log.Functionf("MaybeAddAppNetworkConfig: CipherBlockStatus.CipherData changed")
 			changed = true
 		}
+		if !bytes.Equal(m.SecretsCipherBlock.CipherData, aiConfig.SecretsCipherBlock.CipherData) {
+			log.Functionf("MaybeAddAppNetworkConfig: SecretsCipherBlock.CipherData changed")
+			changed = true
+		}
+		if !reflect.DeepEqual(m.Prometheus, aiConfig.Prometheus) {
+			log.Functionf("MaybeAddAppNetworkConfig: Prometheus changed from %+v to %+v",
+				m.Prometheus, aiConfig.Prometheus)
+			changed = true
+		}
 		for i, new := range aiConfig.UnderlayNetworkList {
 			old := m.UnderlayNetworkList[i]
 			if !reflect.DeepEqual(new.ACLs, old.ACLs) {
@@ -68,13 +77,15 @@ func MaybeAddAppNetworkConfig(ctx *zedmanagerContext,
 	}
 	if changed {
 		nc := types.AppNetworkConfig{
-			UUIDandVersion:    aiConfig.UUIDandVersion,
-			DisplayName:       aiConfig.DisplayName,
-			Activate:          effectiveActivate,
-			GetStatsIPAddr:    aiConfig.CollectStatsIPAddr,
-			CloudInitUserData: aiConfig.CloudInitUserData,
-			CipherBlockStatus: aiConfig.CipherBlockStatus,
-			MetaDataType:      aiConfig.MetaDataType,
+			UUIDandVersion:     aiConfig.UUIDandVersion,
+			DisplayName:        aiConfig.DisplayName,
+			Activate:           effectiveActivate,
+			GetStatsIPAddr:     aiConfig.CollectStatsIPAddr,
+			CloudInitUserData:  aiConfig.CloudInitUserData,
+			CipherBlockStatus:  aiConfig.CipherBlockStatus,
+			MetaDataType:       aiConfig.MetaDataType,
+			SecretsCipherBlock: aiConfig.SecretsCipherBlock,
+			Prometheus:         aiConfig.Prometheus,
 		}
 		nc.UnderlayNetworkList = make([]types.UnderlayNetworkConfig,
 			len(aiConfig.UnderlayNetworkList))
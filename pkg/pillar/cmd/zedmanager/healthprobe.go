@@ -0,0 +1,444 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Health probes are run by go-routines (so that a slow or hanging application
+// does not block zedmanager), but all the state is kept and evaluated
+// in the main event loop. On every tick of healthProbeTicker the main loop
+// starts probes which are due and results are delivered back through
+// zedmanagerContext.healthProbeResults.
+
+const healthProbeInterval = time.Second
+
+type probeKind uint8
+
+const (
+	livenessProbe probeKind = iota
+	readinessProbe
+)
+
+func (k probeKind) String() string {
+	if k == livenessProbe {
+		return "liveness"
+	}
+	return "readiness"
+}
+
+// appProbeResult is sent by a probe go-routine back to the main loop.
+type appProbeResult struct {
+	appKey     string
+	kind       probeKind
+	generation uint64
+	err        error
+}
+
+// appProbeRunner keeps runtime state of a single probe.
+type appProbeRunner struct {
+	inFlight  bool
+	nextRun   time.Time
+	successes uint32
+	failures  uint32
+}
+
+// appHealthChecker keeps runtime state of health checking for one app instance.
+// Generation is incremented whenever the app is (re)started or the probe
+// configuration changes to discard results of probes started before.
+type appHealthChecker struct {
+	generation uint64
+	config     types.AppHealthConfig
+	bootTime   time.Time
+	liveSince  time.Time
+	liveness   appProbeRunner
+	readiness  appProbeRunner
+}
+
+func (c *appHealthChecker) runner(kind probeKind) *appProbeRunner {
+	if kind == livenessProbe {
+		return &c.liveness
+	}
+	return &c.readiness
+}
+
+func (c *appHealthChecker) probeConfig(kind probeKind) types.AppProbeConfig {
+	if kind == livenessProbe {
+		return c.config.Liveness
+	}
+	return c.config.Readiness
+}
+
+func (c *appHealthChecker) reset(config types.AppHealthConfig, bootTime, now time.Time) {
+	c.generation++
+	c.config = config
+	c.bootTime = bootTime
+	c.liveSince = time.Time{}
+	start := bootTime
+	if start.IsZero() {
+		start = now
+	}
+	c.liveness = appProbeRunner{nextRun: start.Add(config.Liveness.InitialDelay)}
+	c.readiness = appProbeRunner{nextRun: start.Add(config.Readiness.InitialDelay)}
+}
+
+func probeStatus(health *types.AppHealthStatus, kind probeKind) *types.AppProbeStatus {
+	if kind == livenessProbe {
+		return &health.Liveness
+	}
+	return &health.Readiness
+}
+
+// pendingHealthStatus returns health status with all configured probes
+// in the pending state while keeping the action counters.
+func pendingHealthStatus(config types.AppHealthConfig,
+	health types.AppHealthStatus) types.AppHealthStatus {
+	pending := types.AppProbeStatus{State: types.AppProbeStatePending}
+	health.Liveness, health.Readiness = types.AppProbeStatus{}, types.AppProbeStatus{}
+	if config.Liveness.Enabled() {
+		health.Liveness = pending
+	}
+	if config.Readiness.Enabled() {
+		health.Readiness = pending
+	}
+	health.Ready = false
+	return health
+}
+
+// checkAppHealth is called periodically from the main loop to start probes
+// which are due and to take delayed actions.
+func checkAppHealth(ctx *zedmanagerContext) {
+	now := time.Now()
+	for key := range ctx.healthCheckers {
+		if lookupAppInstanceStatus(ctx, key) == nil {
+			delete(ctx.healthCheckers, key)
+		}
+	}
+	items := ctx.pubAppInstanceStatus.GetAll()
+	for key, st := range items {
+		status := st.(types.AppInstanceStatus)
+		config := lookupAppInstanceConfig(ctx, key)
+		if config == nil || !config.Health.Enabled() {
+			delete(ctx.healthCheckers, key)
+			if status.Health.Enabled() {
+				status.Health = types.AppHealthStatus{}
+				publishAppInstanceStatus(ctx, &status)
+			}
+			continue
+		}
+		checker := ctx.healthCheckers[key]
+		if checker == nil {
+			checker = &appHealthChecker{}
+			ctx.healthCheckers[key] = checker
+		}
+		running := status.State == types.RUNNING &&
+			status.RestartInprogress == types.NotInprogress &&
+			status.PurgeInprogress == types.NotInprogress
+		if !running || !checker.bootTime.Equal(status.BootTime) ||
+			!cmp.Equal(checker.config, config.Health) {
+			checker.reset(config.Health, status.BootTime, now)
+			health := pendingHealthStatus(config.Health, status.Health)
+			if health != status.Health {
+				status.Health = health
+				publishAppInstanceStatus(ctx, &status)
+			}
+		}
+		if !running {
+			continue
+		}
+		if maybeResetHealthBackoff(checker, &status, now) {
+			publishAppInstanceStatus(ctx, &status)
+		}
+		if status.Health.Liveness.State == types.AppProbeStateFailure {
+			// Action may have been postponed by backoff.
+			if maybeTakeHealthAction(ctx, config, &status, now) {
+				continue
+			}
+		}
+		for _, kind := range []probeKind{livenessProbe, readinessProbe} {
+			probe := checker.probeConfig(kind)
+			runner := checker.runner(kind)
+			if !probe.Enabled() || runner.inFlight || now.Before(runner.nextRun) {
+				continue
+			}
+			runner.inFlight = true
+			startAppProbe(ctx, status, probe, kind, checker.generation)
+		}
+	}
+}
+
+// startAppProbe runs the probe in a go-routine.
+func startAppProbe(ctx *zedmanagerContext, status types.AppInstanceStatus,
+	probe types.AppProbeConfig, kind probeKind, generation uint64) {
+	result := appProbeResult{
+		appKey:     status.Key(),
+		kind:       kind,
+		generation: generation,
+	}
+	var probeFn func() error
+	switch probe.Type {
+	case types.AppProbeHTTP, types.AppProbeTCP:
+		ip := status.GetAdapterIP(probe.AdapterIndex)
+		if ip == nil {
+			result.err = fmt.Errorf("no IP address assigned to adapter %d",
+				probe.AdapterIndex)
+			break
+		}
+		addr := net.JoinHostPort(ip.String(), strconv.Itoa(int(probe.Port)))
+		if probe.Type == types.AppProbeTCP {
+			probeFn = func() error { return probeTCP(addr, probe.Timeout) }
+		} else {
+			probeFn = func() error { return probeHTTP(addr, probe) }
+		}
+	case types.AppProbeExec:
+		domainStatus := lookupDomainStatus(ctx, status.Key())
+		if domainStatus == nil || domainStatus.VirtualizationMode != types.NOHYPER {
+			result.err = errors.New("exec probe is only supported for containers " +
+				"deployed without hypervisor")
+			break
+		}
+		domainName := status.DomainName
+		probeFn = func() error { return probeExec(domainName, probe) }
+	case types.AppProbeGuestAgent:
+		domainName := status.DomainName
+		probeFn = func() error { return hypervisor.QGAPing(domainName, probe.Timeout) }
+	default:
+		result.err = fmt.Errorf("unsupported probe type %s", probe.Type)
+	}
+	results := ctx.healthProbeResults
+	if probeFn == nil {
+		// Deliver the error from a go-routine as well to keep the processing
+		// of results in one place.
+		go func() { results <- result }()
+		return
+	}
+	log.Tracef("startAppProbe(%s): %s %s probe", status.Key(), kind, probe.Type)
+	go func() {
+		result.err = probeFn()
+		results <- result
+	}()
+}
+
+func probeTCP(addr string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func probeHTTP(addr string, probe types.AppProbeConfig) error {
+	scheme := "http"
+	if probe.HTTPS {
+		scheme = "https"
+	}
+	client := &http.Client{
+		Timeout: probe.Timeout,
+		Transport: &http.Transport{
+			// Applications typically use self-signed certificates.
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+	}
+	resp, err := client.Get(fmt.Sprintf("%s://%s%s", scheme, addr, probe.Path))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func probeExec(domainName string, probe types.AppProbeConfig) error {
+	ctrdClient, err := containerd.NewContainerdClient(false)
+	if err != nil {
+		return err
+	}
+	defer ctrdClient.CloseClient()
+	ctrdCtx, done := ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	ctrdCtx, cancel := context.WithTimeout(ctrdCtx, probe.Timeout)
+	defer cancel()
+	stdOut, stdErr, err := ctrdClient.CtrExec(ctrdCtx, domainName, probe.Command)
+	if err != nil {
+		if ctrdCtx.Err() != nil {
+			return fmt.Errorf("command timed out")
+		}
+		return fmt.Errorf("%v: stdout: %s, stderr: %s", err, stdOut, stdErr)
+	}
+	return nil
+}
+
+// handleAppProbeResult processes the result of a probe in the main loop.
+func handleAppProbeResult(ctx *zedmanagerContext, result appProbeResult) {
+	checker := ctx.healthCheckers[result.appKey]
+	if checker == nil || checker.generation != result.generation {
+		log.Tracef("handleAppProbeResult(%s): ignoring stale %s probe result",
+			result.appKey, result.kind)
+		return
+	}
+	status := lookupAppInstanceStatus(ctx, result.appKey)
+	config := lookupAppInstanceConfig(ctx, result.appKey)
+	if status == nil || config == nil {
+		return
+	}
+	now := time.Now()
+	probe := checker.probeConfig(result.kind)
+	runner := checker.runner(result.kind)
+	runner.inFlight = false
+	runner.nextRun = now.Add(probe.Period)
+	probeSt := probeStatus(&status.Health, result.kind)
+	oldHealth := status.Health
+	if updateProbeStatus(probeSt, runner, probe, result.err, now) {
+		log.Noticef("handleAppProbeResult(%s): %s probe of %s changed to %s (%s)",
+			result.appKey, result.kind, status.DisplayName, probeSt.State,
+			probeSt.LastError)
+		if result.kind == livenessProbe && probeSt.State == types.AppProbeStateSuccess {
+			checker.liveSince = now
+		}
+	}
+	status.Health.Ready = !config.Health.Readiness.Enabled() ||
+		status.Health.Readiness.State == types.AppProbeStateSuccess
+	if result.kind == livenessProbe &&
+		status.Health.Liveness.State == types.AppProbeStateFailure &&
+		oldHealth.Liveness.State != types.AppProbeStateFailure {
+		if maybeTakeHealthAction(ctx, config, status, now) {
+			return
+		}
+	}
+	if status.Health != oldHealth {
+		publishAppInstanceStatus(ctx, status)
+	}
+}
+
+// updateProbeStatus updates counters and the state of the probe based
+// on the probe result. Returns true if the state has changed.
+func updateProbeStatus(probeSt *types.AppProbeStatus, runner *appProbeRunner,
+	probe types.AppProbeConfig, probeErr error, now time.Time) bool {
+	newState := probeSt.State
+	if probeErr == nil {
+		runner.successes++
+		runner.failures = 0
+		probeSt.ConsecutiveFailures = 0
+		if runner.successes >= probe.SuccessThreshold {
+			newState = types.AppProbeStateSuccess
+		}
+	} else {
+		runner.failures++
+		runner.successes = 0
+		probeSt.ConsecutiveFailures = runner.failures
+		probeSt.LastError = probeErr.Error()
+		if runner.failures >= probe.FailureThreshold {
+			newState = types.AppProbeStateFailure
+		}
+	}
+	if newState == probeSt.State {
+		return false
+	}
+	probeSt.State = newState
+	probeSt.LastTransitionTime = now
+	return true
+}
+
+// maybeResetHealthBackoff resets the backoff once the app has been live
+// for BackoffMax. Returns true if the status was changed.
+func maybeResetHealthBackoff(checker *appHealthChecker,
+	status *types.AppInstanceStatus, now time.Time) bool {
+	if status.Health.Backoff == 0 || checker.liveSince.IsZero() ||
+		status.Health.Liveness.State != types.AppProbeStateSuccess {
+		return false
+	}
+	if now.Sub(checker.liveSince) < checker.config.BackoffMax {
+		return false
+	}
+	log.Noticef("maybeResetHealthBackoff(%s): %s has been live for %v, resetting backoff",
+		status.Key(), status.DisplayName, checker.config.BackoffMax)
+	status.Health.Backoff = 0
+	return true
+}
+
+// maybeTakeHealthAction takes the configured action for app which failed
+// its liveness probe unless postponed by backoff.
+// Returns true if the action was taken (and the status published).
+func maybeTakeHealthAction(ctx *zedmanagerContext, config *types.AppInstanceConfig,
+	status *types.AppInstanceStatus, now time.Time) bool {
+	health := config.Health
+	if health.Action == types.AppHealthActionNone {
+		return false
+	}
+	if !status.Health.LastActionTime.IsZero() &&
+		now.Before(status.Health.LastActionTime.Add(status.Health.Backoff)) {
+		log.Functionf("maybeTakeHealthAction(%s): %s postponed by backoff %v",
+			status.Key(), health.Action, status.Health.Backoff)
+		return false
+	}
+	action := health.Action
+	var rollbackSnapshot string
+	if action == types.AppHealthActionRollback {
+		rollbackSnapshot = latestSnapshot(status)
+		if rollbackSnapshot == "" {
+			log.Warnf("maybeTakeHealthAction(%s): no snapshot available for rollback, "+
+				"restarting %s instead", status.Key(), status.DisplayName)
+			action = types.AppHealthActionRestart
+		}
+	}
+	log.Noticef("maybeTakeHealthAction(%s): %s failed liveness probe (%s), taking action %s",
+		status.Key(), status.DisplayName, status.Health.Liveness.LastError, action)
+	status.Health.ActionCount++
+	status.Health.LastAction = action
+	status.Health.LastActionTime = now
+	status.Health.Backoff = health.NextBackoff(status.Health.Backoff)
+	switch action {
+	case types.AppHealthActionPurge:
+		// Volumes are re-created by zedagent, which then increases
+		// the local purge counter in AppInstanceConfig.
+		status.Health.PurgeRequestedAt = now
+		publishAppInstanceStatus(ctx, status)
+		return true
+	case types.AppHealthActionRollback:
+		status.SnapStatus.HasRollbackRequest = true
+		status.SnapStatus.ActiveSnapshot = rollbackSnapshot
+	}
+	status.RestartInprogress = types.BringDown
+	status.State = types.RESTARTING
+	status.RestartStartedAt = now
+	publishAppInstanceStatus(ctx, status)
+	if doUpdate(ctx, *config, status) {
+		publishAppInstanceStatus(ctx, status)
+	}
+	return true
+}
+
+// latestSnapshot returns ID of the most recently created snapshot of the app.
+func latestSnapshot(status *types.AppInstanceStatus) string {
+	var latest *types.SnapshotInstanceStatus
+	for i := range status.SnapStatus.AvailableSnapshots {
+		snap := &status.SnapStatus.AvailableSnapshots[i]
+		if snap.TimeCreated.IsZero() {
+			continue
+		}
+		if latest == nil || snap.TimeCreated.After(latest.TimeCreated) {
+			latest = snap
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Snapshot.SnapshotID
+}
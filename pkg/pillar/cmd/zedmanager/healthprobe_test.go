@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedmanager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	. "github.com/onsi/gomega"
+)
+
+func TestUpdateProbeStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	probe := types.AppProbeConfig{
+		Type:             types.AppProbeTCP,
+		FailureThreshold: 3,
+		SuccessThreshold: 2,
+	}
+	probeSt := types.AppProbeStatus{State: types.AppProbeStatePending}
+	runner := appProbeRunner{}
+	now := time.Now()
+	probeErr := errors.New("connection refused")
+
+	// Pending -> Success after 2 successes.
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, nil, now)).To(BeFalse())
+	g.Expect(probeSt.State).To(Equal(types.AppProbeStatePending))
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, nil, now)).To(BeTrue())
+	g.Expect(probeSt.State).To(Equal(types.AppProbeStateSuccess))
+	g.Expect(probeSt.LastTransitionTime).To(Equal(now))
+
+	// Success -> Failure after 3 consecutive failures.
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, probeErr, now)).To(BeFalse())
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, probeErr, now)).To(BeFalse())
+	g.Expect(probeSt.ConsecutiveFailures).To(BeEquivalentTo(2))
+	// Success in between resets the failure counter.
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, nil, now)).To(BeFalse())
+	g.Expect(probeSt.ConsecutiveFailures).To(BeZero())
+	for i := 0; i < 2; i++ {
+		g.Expect(updateProbeStatus(&probeSt, &runner, probe, probeErr, now)).To(BeFalse())
+	}
+	later := now.Add(time.Minute)
+	g.Expect(updateProbeStatus(&probeSt, &runner, probe, probeErr, later)).To(BeTrue())
+	g.Expect(probeSt.State).To(Equal(types.AppProbeStateFailure))
+	g.Expect(probeSt.ConsecutiveFailures).To(BeEquivalentTo(3))
+	g.Expect(probeSt.LastError).To(Equal("connection refused"))
+	g.Expect(probeSt.LastTransitionTime).To(Equal(later))
+}
+
+func TestHealthBackoff(t *testing.T) {
+	g := NewGomegaWithT(t)
+	config := types.AppHealthConfig{
+		BackoffInitial: 10 * time.Second,
+		BackoffMax:     30 * time.Second,
+	}
+	backoff := config.NextBackoff(0)
+	g.Expect(backoff).To(Equal(10 * time.Second))
+	backoff = config.NextBackoff(backoff)
+	g.Expect(backoff).To(Equal(20 * time.Second))
+	backoff = config.NextBackoff(backoff)
+	g.Expect(backoff).To(Equal(30 * time.Second))
+	backoff = config.NextBackoff(backoff)
+	g.Expect(backoff).To(Equal(30 * time.Second))
+}
+
+func TestLatestSnapshot(t *testing.T) {
+	g := NewGomegaWithT(t)
+	status := &types.AppInstanceStatus{}
+	g.Expect(latestSnapshot(status)).To(BeEmpty())
+
+	now := time.Now()
+	status.SnapStatus.AvailableSnapshots = []types.SnapshotInstanceStatus{
+		{
+			Snapshot:    types.SnapshotDesc{SnapshotID: "snap1"},
+			TimeCreated: now.Add(-time.Hour),
+		},
+		{
+			Snapshot:    types.SnapshotDesc{SnapshotID: "snap2"},
+			TimeCreated: now,
+		},
+		{
+			// Not yet created.
+			Snapshot: types.SnapshotDesc{SnapshotID: "snap3"},
+		},
+	}
+	g.Expect(latestSnapshot(status)).To(Equal("snap2"))
+}
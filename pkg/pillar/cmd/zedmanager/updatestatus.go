@@ -205,7 +205,14 @@ func doUpdate(ctx *zedmanagerContext,
 	effectiveActivate := effectiveActivateCurrentProfile(config, ctx.currentProfile)
 
 	if !effectiveActivate {
+		changed = clearAwaitingDependencies(status) || changed
 		if status.Activated || status.ActivateInprogress {
+			// Halt the app instances depending on this one first
+			if dependents := haltingDependents(ctx, config); len(dependents) > 0 {
+				log.Functionf("Postponing halt of %s until %v are halted",
+					uuidStr, dependents)
+				return changed
+			}
 			c := doInactivateHalt(ctx, config, status)
 			changed = changed || c
 		}
@@ -603,6 +610,12 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 			if c {
 				changed = true
 			}
+			if updateContainerStatus(status, *ds) {
+				changed = true
+			}
+			if updateGuestAgentInfo(status, *ds) {
+				changed = true
+			}
 			if !ds.Activated && !ds.HasError() {
 				log.Functionf("RestartInprogress(%s) came down - set bring up",
 					status.Key())
@@ -741,6 +754,15 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		// if the VM already active or in restarting/purging state - continue with the doActivate logic
 	}
 
+	// Wait for the app instances this one depends on, unless it is already running
+	if !status.Activated || status.RestartInprogress == types.BringUp || status.PurgeInprogress == types.BringUp {
+		c, blocked := checkAppDependencies(ctx, config, status)
+		changed = changed || c
+		if blocked {
+			return changed
+		}
+	}
+
 	// Make sure we have a DomainConfig
 	// We modify it below and then publish it
 	dc, err := MaybeAddDomainConfig(ctx, config, *status, ns)
@@ -779,6 +801,12 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	if c {
 		changed = true
 	}
+	if updateContainerStatus(status, *ds) {
+		changed = true
+	}
+	if updateGuestAgentInfo(status, *ds) {
+		changed = true
+	}
 	// Are we doing a restart?
 	if status.RestartInprogress == types.BringDown {
 		if dc.Activate {
@@ -894,6 +922,26 @@ func updateVifUsed(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bo
 	return changed
 }
 
+// Copy status of the additional containers from DomainStatus
+// and return true if it has changed
+func updateContainerStatus(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if cmp.Equal(statusPtr.ContainerStatusList, ds.ContainerStatusList) {
+		return false
+	}
+	statusPtr.ContainerStatusList = ds.ContainerStatusList
+	return true
+}
+
+// Copy information reported by the guest agent from DomainStatus
+// and return true if it has changed
+func updateGuestAgentInfo(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if cmp.Equal(statusPtr.GuestAgent, ds.GuestAgent) {
+		return false
+	}
+	statusPtr.GuestAgent = ds.GuestAgent
+	return true
+}
+
 func purgeCmdDone(ctx *zedmanagerContext, config types.AppInstanceConfig,
 	status *types.AppInstanceStatus) bool {
 
@@ -1016,6 +1064,12 @@ func doInactivate(ctx *zedmanagerContext, appInstID uuid.UUID,
 		if c {
 			changed = true
 		}
+		if updateContainerStatus(status, *ds) {
+			changed = true
+		}
+		if updateGuestAgentInfo(status, *ds) {
+			changed = true
+		}
 		// Look for errors
 		if ds.HasError() {
 			log.Errorf("Received error from domainmgr for %s: %s",
@@ -1184,6 +1238,12 @@ func doInactivateHalt(ctx *zedmanagerContext,
 	if c {
 		changed = true
 	}
+	if updateContainerStatus(status, *ds) {
+		changed = true
+	}
+	if updateGuestAgentInfo(status, *ds) {
+		changed = true
+	}
 	if ds.State != status.State {
 		switch status.State {
 		case types.RESTARTING, types.PURGING:
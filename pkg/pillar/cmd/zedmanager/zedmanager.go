@@ -64,6 +64,9 @@ type zedmanagerContext struct {
 	currentTotalMemoryMB  uint64
 	// The time from which the configured applications delays should be counted
 	delayBaseTime time.Time
+	// Runtime state of application health probes, key is app UUID
+	healthCheckers     map[string]*appHealthChecker
+	healthProbeResults chan appProbeResult
 	// cli options
 	versionPtr *bool
 }
@@ -82,7 +85,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 
 	// Any state needed by handler functions
 	ctx := zedmanagerContext{
-		globalConfig: types.DefaultConfigItemValueMap(),
+		globalConfig:       types.DefaultConfigItemValueMap(),
+		healthCheckers:     make(map[string]*appHealthChecker),
+		healthProbeResults: make(chan appProbeResult, 10),
 	}
 	agentbase.Init(&ctx, logger, log, agentName,
 		agentbase.WithArguments(arguments))
@@ -362,6 +367,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 	// The ticker that triggers a check for the applications in the START_DELAYED state
 	delayedStartTicker := time.NewTicker(1 * time.Second)
 
+	// The ticker that triggers application health probes
+	healthProbeTicker := time.NewTicker(healthProbeInterval)
+
 	log.Functionf("Handling all inputs")
 	for {
 		select {
@@ -406,6 +414,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject, ar
 		case <-delayedStartTicker.C:
 			checkDelayedStartApps(&ctx)
 
+		case <-healthProbeTicker.C:
+			start := time.Now()
+			checkAppHealth(&ctx)
+			ps.CheckMaxTimeTopic(agentName, "checkAppHealth", start,
+				warningTime, errorTime)
+
+		case result := <-ctx.healthProbeResults:
+			start := time.Now()
+			handleAppProbeResult(&ctx, result)
+			ps.CheckMaxTimeTopic(agentName, "handleAppProbeResult", start,
+				warningTime, errorTime)
+
 		case <-stillRunning.C:
 		}
 		ps.StillRunning(agentName, warningTime, errorTime)
@@ -446,7 +466,10 @@ func checkDelayedStartApps(ctx *zedmanagerContext) {
 		config := c.(types.AppInstanceConfig)
 		status := lookupAppInstanceStatus(ctx, config.Key())
 		// Is the application in the delayed state and ready to be started?
-		if status != nil && status.State == types.START_DELAYED && status.StartTime.Before(time.Now()) {
+		// Applications awaiting dependencies are re-evaluated when the status
+		// of a dependency changes.
+		if status != nil && status.State == types.START_DELAYED && !status.AwaitingDependencies &&
+			status.StartTime.Before(time.Now()) {
 			// Change the state immediately, so we do not enter here twice
 			status.State = types.INSTALLED
 			doUpdate(ctx, config, status)
@@ -490,24 +513,30 @@ func handleZedrouterRestarted(ctxArg interface{}, restartCounter int) {
 }
 
 // handleAppInstanceStatusCreate - Handle AIS create. Publish AppStatusSummary to ledmanager
+// and re-evaluate related app instances
 func handleAppInstanceStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateRelatedApps(ctx, key)
 }
 
 // handleAppInstanceStatusModify - Handle AIS modify. Publish AppStatusSummary to ledmanager
+// and re-evaluate related app instances
 func handleAppInstanceStatusModify(ctxArg interface{}, key string,
 	statusArg interface{}, oldStatusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateRelatedApps(ctx, key)
 }
 
 // handleAppInstanceStatusDelete - Handle AIS delete. Publish AppStatusSummary to ledmanager
+// and re-evaluate related app instances
 func handleAppInstanceStatusDelete(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateRelatedApps(ctx, key)
 }
 
 func publishAppInstanceSummary(ctxPtr *zedmanagerContext) {
@@ -59,9 +59,9 @@ func (z *zedrouter) updateVIFsForStateCollecting(
 				"(needed to update VIF arguments for state collecting)", network)
 			continue
 		}
-		_, vifs, err := z.getArgsForNIStateCollecting(network)
+		br, vifs, err := z.getArgsForNIStateCollecting(network)
 		if err == nil {
-			err = z.niStateCollector.UpdateCollectingForNI(*netConfig, vifs)
+			err = z.niStateCollector.UpdateCollectingForNI(*netConfig, br, vifs)
 		}
 		if err != nil {
 			z.log.Error(err)
@@ -145,6 +145,7 @@ func (z *zedrouter) doActivateAppNetwork(config types.AppNetworkConfig,
 
 	// Update state data collecting to include this application.
 	z.checkAppContainerStatsCollecting(&config, status)
+	z.checkAppPrometheusScraping(&config, status)
 	z.updateVIFsForStateCollecting(nil, &config)
 }
 
@@ -300,6 +301,7 @@ func (z *zedrouter) doUpdateActivatedAppNetwork(oldConfig, newConfig types.AppNe
 
 	// Update state data collecting parameters.
 	z.checkAppContainerStatsCollecting(&newConfig, status)
+	z.checkAppPrometheusScraping(&newConfig, status)
 	z.updateVIFsForStateCollecting(&oldConfig, &newConfig)
 
 	// Update app network status as well as status of connected network instances.
@@ -314,6 +316,7 @@ func (z *zedrouter) doInactivateAppNetwork(config types.AppNetworkConfig,
 	// Stop state data collecting for this application.
 	z.updateVIFsForStateCollecting(&config, nil)
 	z.checkAppContainerStatsCollecting(nil, status) // nil config to represent delete
+	z.checkAppPrometheusScraping(nil, status)
 
 	// Use NIReconciler to un-configure connection between the app and the network instance(s)
 	// inside the network stack.
@@ -0,0 +1,273 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Scraping of Prometheus endpoints of app instances. The samples of the
+// allowed metrics are published as AppPrometheusMetrics and reported
+// by zedagent along with the other metrics of the app instance.
+
+package zedrouter
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// appPrometheusTimeout limits the time to scrape the endpoint of one app
+	appPrometheusTimeout = 5 * time.Second
+	// appPrometheusMaxResponse limits the size of the scraped response
+	appPrometheusMaxResponse = 4 << 20
+	// appPrometheusMaxSamples limits the number of samples reported per app
+	appPrometheusMaxSamples = 256
+)
+
+// check if we need to launch the goroutine scraping Prometheus endpoints
+func (z *zedrouter) checkAppPrometheusScraping(config *types.AppNetworkConfig,
+	status *types.AppNetworkStatus) {
+
+	var prometheus types.AppPrometheusConfig
+	if config != nil {
+		prometheus = config.Prometheus
+	}
+	if reflect.DeepEqual(prometheus, status.Prometheus) {
+		return
+	}
+	// The scraping goroutine checks the published status before it publishes
+	// metrics, do not let it publish metrics of an endpoint just removed.
+	z.appPrometheusMutex.Lock()
+	status.Prometheus = prometheus
+	z.publishAppNetworkStatus(status)
+	if prometheus.Port == 0 {
+		z.unpublishAppPrometheusMetrics(status.Key())
+	}
+	z.appPrometheusMutex.Unlock()
+	if prometheus.Port != 0 {
+		z.ensureAppPrometheusIsScraped()
+	}
+}
+
+// publishAppPrometheusMetrics publishes scraped metrics unless the app
+// or its endpoint was removed in the meantime.
+func (z *zedrouter) publishAppPrometheusMetrics(metrics types.AppPrometheusMetrics) bool {
+	z.appPrometheusMutex.Lock()
+	defer z.appPrometheusMutex.Unlock()
+	st, _ := z.pubAppNetworkStatus.Get(metrics.Key())
+	if st == nil || st.(types.AppNetworkStatus).Prometheus.Port == 0 {
+		return false
+	}
+	z.pubAppPrometheusMetrics.Publish(metrics.Key(), metrics)
+	return true
+}
+
+// unpublishAppPrometheusMetrics must be called with appPrometheusMutex locked.
+func (z *zedrouter) unpublishAppPrometheusMetrics(key string) {
+	if m, _ := z.pubAppPrometheusMetrics.Get(key); m != nil {
+		if err := z.pubAppPrometheusMetrics.Unpublish(key); err != nil {
+			z.log.Errorf("unpublishAppPrometheusMetrics(%s): %v", key, err)
+		}
+	}
+}
+
+// goroutine scraping Prometheus endpoints of apps at the metric interval
+func (z *zedrouter) scrapeAppPrometheus() {
+	z.log.Functionf("scrapeAppPrometheus: started")
+	client := &http.Client{Timeout: appPrometheusTimeout}
+	scrapeTimer := time.NewTimer(
+		time.Duration(z.appPrometheusInterval.Load()) * time.Second)
+	for {
+		select {
+		case <-scrapeTimer.C:
+			items, stopped := z.maybeStopAppPrometheusScraping()
+			if stopped {
+				return
+			}
+			var numApps, numSamples int
+			for _, st := range items {
+				status := st.(types.AppNetworkStatus)
+				if status.Prometheus.Port == 0 {
+					continue
+				}
+				numApps++
+				samples, err := z.scrapeAppPrometheusEndpoint(client, status)
+				if err != nil {
+					z.log.Warnf("scrapeAppPrometheus: app %s (%s): %v",
+						status.DisplayName, status.Key(), err)
+					z.appPrometheusMutex.Lock()
+					z.unpublishAppPrometheusMetrics(status.Key())
+					z.appPrometheusMutex.Unlock()
+					continue
+				}
+				apMetrics := types.AppPrometheusMetrics{
+					UUIDandVersion: status.UUIDandVersion,
+					CollectTime:    time.Now(),
+					Samples:        samples,
+				}
+				// the app may have been removed while scraping
+				if z.publishAppPrometheusMetrics(apMetrics) {
+					numSamples += len(samples)
+				}
+			}
+			z.log.Functionf("scrapeAppPrometheus: %d apps scraped, %d samples",
+				numApps, numSamples)
+			scrapeTimer = time.NewTimer(
+				time.Duration(z.appPrometheusInterval.Load()) * time.Second)
+		}
+	}
+}
+
+func (z *zedrouter) ensureAppPrometheusIsScraped() {
+	z.appPrometheusMutex.Lock()
+	defer z.appPrometheusMutex.Unlock()
+	if !z.appPrometheusScraping {
+		z.appPrometheusScraping = true
+		z.log.Functionf("Creating %s at %s", "scrapeAppPrometheus",
+			agentlog.GetMyStack())
+		go z.scrapeAppPrometheus()
+	}
+}
+
+func (z *zedrouter) maybeStopAppPrometheusScraping() (map[string]interface{}, bool) {
+	z.appPrometheusMutex.Lock()
+	defer z.appPrometheusMutex.Unlock()
+	items := z.pubAppNetworkStatus.GetAll()
+	for _, st := range items {
+		status := st.(types.AppNetworkStatus)
+		if status.Prometheus.Port != 0 {
+			return items, false
+		}
+	}
+	z.log.Functionf("maybeStopAppPrometheusScraping: no endpoints anymore. " +
+		"stop and exit out")
+	z.appPrometheusScraping = false
+	return items, true
+}
+
+// scrapeAppPrometheusEndpoint scrapes the endpoint of the app on the address
+// of the first app interface with an address allocated by EVE.
+func (z *zedrouter) scrapeAppPrometheusEndpoint(client *http.Client,
+	status types.AppNetworkStatus) ([]types.AppCustomMetric, error) {
+	var appIP net.IP
+	for _, ulStatus := range status.UnderlayNetworkList {
+		if ulStatus.AllocatedIPv4Addr != nil {
+			appIP = ulStatus.AllocatedIPv4Addr
+			break
+		}
+	}
+	if appIP == nil {
+		return nil, fmt.Errorf("no IP address allocated to the app")
+	}
+	url := fmt.Sprintf("http://%s%s",
+		net.JoinHostPort(appIP.String(), strconv.Itoa(int(status.Prometheus.Port))),
+		status.Prometheus.Path)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return parseAppPrometheusMetrics(
+		io.LimitReader(resp.Body, appPrometheusMaxResponse), status.Prometheus)
+}
+
+// parseAppPrometheusMetrics returns the samples of the allowed metrics
+// in the Prometheus text exposition format.
+func parseAppPrometheusMetrics(r io.Reader,
+	config types.AppPrometheusConfig) ([]types.AppCustomMetric, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %v", err)
+	}
+	names := make([]string, 0, len(families))
+	for name := range families {
+		if config.IsAllowed(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var samples []types.AppCustomMetric
+	add := func(name string, labels map[string]string,
+		metricType types.MetricItemType, value float64) {
+		if math.IsNaN(value) || len(samples) >= appPrometheusMaxSamples {
+			return
+		}
+		samples = append(samples, types.AppCustomMetric{
+			Name:   name,
+			Labels: labels,
+			Type:   metricType,
+			Value:  value,
+		})
+	}
+	for _, name := range names {
+		family := families[name]
+		for _, m := range family.GetMetric() {
+			// labels of the sample with the extra label name and value pairs
+			labels := func(extra ...string) map[string]string {
+				if len(m.GetLabel()) == 0 && len(extra) == 0 {
+					return nil
+				}
+				sampleLabels := make(map[string]string)
+				for _, label := range m.GetLabel() {
+					sampleLabels[label.GetName()] = label.GetValue()
+				}
+				for i := 0; i+1 < len(extra); i += 2 {
+					sampleLabels[extra[i]] = extra[i+1]
+				}
+				return sampleLabels
+			}
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add(name, labels(), types.MetricItemCounter, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add(name, labels(), types.MetricItemGauge, m.GetGauge().GetValue())
+			case dto.MetricType_UNTYPED:
+				add(name, labels(), types.MetricItemOther, m.GetUntyped().GetValue())
+			case dto.MetricType_SUMMARY:
+				summary := m.GetSummary()
+				for _, q := range summary.GetQuantile() {
+					add(name, labels("quantile", formatFloat(q.GetQuantile())),
+						types.MetricItemGauge, q.GetValue())
+				}
+				add(name+"_sum", labels(), types.MetricItemCounter, summary.GetSampleSum())
+				add(name+"_count", labels(), types.MetricItemCounter,
+					float64(summary.GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				histogram := m.GetHistogram()
+				for _, b := range histogram.GetBucket() {
+					add(name+"_bucket", labels("le", formatFloat(b.GetUpperBound())),
+						types.MetricItemCounter, float64(b.GetCumulativeCount()))
+				}
+				add(name+"_sum", labels(), types.MetricItemCounter, histogram.GetSampleSum())
+				add(name+"_count", labels(), types.MetricItemCounter,
+					float64(histogram.GetSampleCount()))
+			}
+		}
+	}
+	return samples, nil
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, +1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPrometheusMetrics = `# TYPE http_requests_total counter
+http_requests_total{method="get",code="200"} 1027
+http_requests_total{method="post",code="200"} 3
+# TYPE temperature gauge
+temperature 21.5
+# TYPE temperature_sensor gauge
+temperature_sensor{sensor="broken"} NaN
+temperature_sensor{sensor="cpu"} 45
+untyped_metric 7
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 0.05
+rpc_duration_seconds{quantile="0.99"} 0.2
+rpc_duration_seconds_sum 17.5
+rpc_duration_seconds_count 250
+# TYPE request_size_bytes histogram
+request_size_bytes_bucket{le="100"} 10
+request_size_bytes_bucket{le="1000"} 15
+request_size_bytes_bucket{le="+Inf"} 17
+request_size_bytes_sum 5400
+request_size_bytes_count 17
+`
+
+func TestParseAppPrometheusMetrics(t *testing.T) {
+	testMatrix := map[string]struct {
+		allowlist []string
+		expected  []types.AppCustomMetric
+	}{
+		"counter": {
+			allowlist: []string{"http_requests_total"},
+			expected: []types.AppCustomMetric{
+				{
+					Name:   "http_requests_total",
+					Labels: map[string]string{"method": "get", "code": "200"},
+					Type:   types.MetricItemCounter,
+					Value:  1027,
+				},
+				{
+					Name:   "http_requests_total",
+					Labels: map[string]string{"method": "post", "code": "200"},
+					Type:   types.MetricItemCounter,
+					Value:  3,
+				},
+			},
+		},
+		"gauge with NaN skipped": {
+			allowlist: []string{"temperature*"},
+			expected: []types.AppCustomMetric{
+				{Name: "temperature", Type: types.MetricItemGauge, Value: 21.5},
+				{
+					Name:   "temperature_sensor",
+					Labels: map[string]string{"sensor": "cpu"},
+					Type:   types.MetricItemGauge,
+					Value:  45,
+				},
+			},
+		},
+		"untyped": {
+			allowlist: []string{"untyped_metric"},
+			expected: []types.AppCustomMetric{
+				{Name: "untyped_metric", Type: types.MetricItemOther, Value: 7},
+			},
+		},
+		"summary": {
+			allowlist: []string{"rpc_duration_seconds"},
+			expected: []types.AppCustomMetric{
+				{
+					Name:   "rpc_duration_seconds",
+					Labels: map[string]string{"quantile": "0.5"},
+					Type:   types.MetricItemGauge,
+					Value:  0.05,
+				},
+				{
+					Name:   "rpc_duration_seconds",
+					Labels: map[string]string{"quantile": "0.99"},
+					Type:   types.MetricItemGauge,
+					Value:  0.2,
+				},
+				{Name: "rpc_duration_seconds_sum", Type: types.MetricItemCounter, Value: 17.5},
+				{Name: "rpc_duration_seconds_count", Type: types.MetricItemCounter, Value: 250},
+			},
+		},
+		"histogram": {
+			allowlist: []string{"request_size_bytes"},
+			expected: []types.AppCustomMetric{
+				{
+					Name:   "request_size_bytes_bucket",
+					Labels: map[string]string{"le": "100"},
+					Type:   types.MetricItemCounter,
+					Value:  10,
+				},
+				{
+					Name:   "request_size_bytes_bucket",
+					Labels: map[string]string{"le": "1000"},
+					Type:   types.MetricItemCounter,
+					Value:  15,
+				},
+				{
+					Name:   "request_size_bytes_bucket",
+					Labels: map[string]string{"le": "+Inf"},
+					Type:   types.MetricItemCounter,
+					Value:  17,
+				},
+				{Name: "request_size_bytes_sum", Type: types.MetricItemCounter, Value: 5400},
+				{Name: "request_size_bytes_count", Type: types.MetricItemCounter, Value: 17},
+			},
+		},
+		"sorted by metric name": {
+			allowlist: []string{"untyped_metric", "temperature"},
+			expected: []types.AppCustomMetric{
+				{Name: "temperature", Type: types.MetricItemGauge, Value: 21.5},
+				{Name: "untyped_metric", Type: types.MetricItemOther, Value: 7},
+			},
+		},
+		"nothing allowed": {
+			allowlist: nil,
+			expected:  nil,
+		},
+	}
+
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			config := types.AppPrometheusConfig{Port: 9100, Allowlist: test.allowlist}
+			samples, err := parseAppPrometheusMetrics(
+				strings.NewReader(testPrometheusMetrics), config)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, samples)
+		})
+	}
+}
+
+func TestParseAppPrometheusMetricsLimit(t *testing.T) {
+	var metrics strings.Builder
+	metrics.WriteString("# TYPE many_series gauge\n")
+	for i := 0; i < 2*appPrometheusMaxSamples; i++ {
+		fmt.Fprintf(&metrics, "many_series{index=\"%d\"} %d\n", i, i)
+	}
+	config := types.AppPrometheusConfig{Port: 9100, Allowlist: []string{"many_series"}}
+	samples, err := parseAppPrometheusMetrics(strings.NewReader(metrics.String()), config)
+	assert.NoError(t, err)
+	assert.Len(t, samples, appPrometheusMaxSamples)
+	last := samples[len(samples)-1]
+	assert.Equal(t, float64(appPrometheusMaxSamples-1), last.Value)
+}
+
+func TestParseAppPrometheusMetricsInvalid(t *testing.T) {
+	config := types.AppPrometheusConfig{Port: 9100, Allowlist: []string{"*"}}
+	_, err := parseAppPrometheusMetrics(
+		strings.NewReader("# TYPE broken counter\nbroken{label=} 1\n"), config)
+	assert.Error(t, err)
+}
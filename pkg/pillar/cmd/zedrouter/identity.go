@@ -0,0 +1,363 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Workload identity for application instances. An app instance can obtain
+// a short-lived JWT from the meta-data server asserting its identity and
+// present it to backend services, which validate it offline against the
+// JWKS of the device.
+// Tokens are signed with a dedicated identity key, which is generated on
+// first use and kept only in memory, i.e. it changes when zedrouter restarts. The identity key is certified
+// by the device certificate. It is never used to sign anything else, unlike
+// the device key, which signs any payload for apps (see signerHandler).
+
+package zedrouter
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// identityTokenDefaultTTL is the lifetime of a token unless requested otherwise
+	identityTokenDefaultTTL = 10 * time.Minute
+	// identityTokenMaxTTL is the longest lifetime of a token an app can request
+	identityTokenMaxTTL = time.Hour
+	// identityTokenMaxAudienceLen limits the audience requested by an app
+	identityTokenMaxAudienceLen = 256
+)
+
+// Issues identity tokens
+type identityTokenHandler struct {
+	zedrouter *zedrouter
+}
+
+// Provides the public key validating the identity tokens
+type identityJWKSHandler struct {
+	zedrouter *zedrouter
+}
+
+// identityClaims are the claims of an identity token
+type identityClaims struct {
+	jwt.StandardClaims
+	AppUUID     string `json:"app_uuid"`
+	AppName     string `json:"app_name"`
+	DeviceUUID  string `json:"device_uuid"`
+	DeviceName  string `json:"device_name,omitempty"`
+	ProjectUUID string `json:"project_uuid,omitempty"`
+}
+
+// jwk is a JSON Web Key (RFC 7517) of the identity key
+type jwk struct {
+	Kty string   `json:"kty"`
+	Crv string   `json:"crv,omitempty"`
+	X   string   `json:"x,omitempty"`
+	Y   string   `json:"y,omitempty"`
+	N   string   `json:"n,omitempty"`
+	E   string   `json:"e,omitempty"`
+	Use string   `json:"use"`
+	Alg string   `json:"alg"`
+	Kid string   `json:"kid"`
+	X5c []string `json:"x5c"`
+}
+
+// identityKey signs the identity tokens
+type identityKey struct {
+	privKey crypto.Signer
+	// certificate of the identity key followed by the device certificate
+	certChain [][]byte
+}
+
+// newIdentityKey generates a new identity key and certifies it with
+// the device certificate.
+func newIdentityKey(deviceCert tls.Certificate) (*identityKey, error) {
+	if len(deviceCert.Certificate) == 0 {
+		return nil, fmt.Errorf("missing device certificate")
+	}
+	deviceSigner, ok := deviceCert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported device key type %T", deviceCert.PrivateKey)
+	}
+	parent, err := x509.ParseCertificate(deviceCert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, err
+	}
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: parent.Subject.Organization,
+			CommonName:   "Workload identity certificate",
+		},
+		NotBefore:             parent.NotBefore,
+		NotAfter:              parent.NotAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &template, parent,
+		privKey.Public(), deviceSigner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to certify identity key: %v", err)
+	}
+	return &identityKey{
+		privKey:   privKey,
+		certChain: [][]byte{certDER, deviceCert.Certificate[0]},
+	}, nil
+}
+
+// isASN1Sequence returns true if data is a single DER encoded ASN.1 sequence,
+// such as the TBSCertificate signed when issuing a certificate.
+func isASN1Sequence(data []byte) bool {
+	var value asn1.RawValue
+	rest, err := asn1.Unmarshal(data, &value)
+	if err != nil || len(rest) != 0 {
+		return false
+	}
+	return value.Class == asn1.ClassUniversal && value.Tag == asn1.TagSequence &&
+		value.IsCompound
+}
+
+// getIdentityKey returns the identity key, which is created on first use.
+func (z *zedrouter) getIdentityKey() (*identityKey, error) {
+	z.identityKeyMutex.Lock()
+	defer z.identityKeyMutex.Unlock()
+	if z.identityKey != nil {
+		return z.identityKey, nil
+	}
+	deviceCert, err := zedcloud.GetClientCert()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load device certificate: %v", err)
+	}
+	key, err := newIdentityKey(deviceCert)
+	if err != nil {
+		return nil, err
+	}
+	z.log.Noticef("Created workload identity key %s", key.keyID())
+	z.identityKey = key
+	return key, nil
+}
+
+// keyID returns the key ID of the identity key, the SHA256 thumbprint
+// of its certificate (as in x5t#S256).
+func (key *identityKey) keyID() string {
+	sum := sha256.Sum256(key.certChain[0])
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// jwsSigningMethod returns the JWS algorithm for signing with the key.
+func jwsSigningMethod(pubKey crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch pubKey := pubKey.(type) {
+	case *ecdsa.PublicKey:
+		switch pubKey.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return jwt.SigningMethodES512, nil
+		}
+		return nil, fmt.Errorf("unsupported curve %s", pubKey.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %T", pubKey)
+}
+
+// jwk returns the JWK of the public part of the identity key.
+func (key *identityKey) jwk() (jwk, error) {
+	method, err := jwsSigningMethod(key.privKey.Public())
+	if err != nil {
+		return jwk{}, err
+	}
+	var x5c []string
+	for _, certDER := range key.certChain {
+		x5c = append(x5c, base64.StdEncoding.EncodeToString(certDER))
+	}
+	keyJWK := jwk{
+		Use: "sig",
+		Alg: method.Alg(),
+		Kid: key.keyID(),
+		X5c: x5c,
+	}
+	switch pubKey := key.privKey.Public().(type) {
+	case *ecdsa.PublicKey:
+		size := (pubKey.Curve.Params().BitSize + 7) / 8
+		keyJWK.Kty = "EC"
+		keyJWK.Crv = pubKey.Curve.Params().Name
+		keyJWK.X = base64.RawURLEncoding.EncodeToString(padBigInt(pubKey.X, size))
+		keyJWK.Y = base64.RawURLEncoding.EncodeToString(padBigInt(pubKey.Y, size))
+	case *rsa.PublicKey:
+		keyJWK.Kty = "RSA"
+		keyJWK.N = base64.RawURLEncoding.EncodeToString(pubKey.N.Bytes())
+		keyJWK.E = base64.RawURLEncoding.EncodeToString(
+			big.NewInt(int64(pubKey.E)).Bytes())
+	}
+	return keyJWK, nil
+}
+
+func padBigInt(n *big.Int, size int) []byte {
+	b := make([]byte, size)
+	return n.FillBytes(b)
+}
+
+// makeIdentityToken returns the JWT with the claims signed by the identity key.
+func makeIdentityToken(claims identityClaims, key *identityKey) (string, error) {
+	method, err := jwsSigningMethod(key.privKey.Public())
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.keyID()
+	return token.SignedString(key.privKey)
+}
+
+// ServeHTTP for identityTokenHandler returns a JWT asserting the identity
+// of the calling app instance. The audience of the token must be requested
+// with the "audience" query parameter, its lifetime may be requested
+// in seconds with the "ttl" query parameter.
+func (hdl identityTokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hdl.zedrouter.log.Tracef("identityTokenHandler.ServeHTTP")
+	if r.Method != http.MethodGet {
+		msg := "identityTokenHandler: request method is not GET"
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusMethodNotAllowed)
+		return
+	}
+	audience := r.URL.Query().Get("audience")
+	if audience == "" || len(audience) > identityTokenMaxAudienceLen {
+		msg := fmt.Sprintf("identityTokenHandler: audience must be set and at most %d characters long",
+			identityTokenMaxAudienceLen)
+		http.Error(w, msg, http.StatusBadRequest)
+		return
+	}
+	ttl := identityTokenDefaultTTL
+	if ttlStr := r.URL.Query().Get("ttl"); ttlStr != "" {
+		seconds, err := strconv.ParseUint(ttlStr, 10, 32)
+		if err != nil || seconds == 0 ||
+			time.Duration(seconds)*time.Second > identityTokenMaxTTL {
+			msg := fmt.Sprintf("identityTokenHandler: ttl must be between 1 and %d seconds",
+				int(identityTokenMaxTTL.Seconds()))
+			http.Error(w, msg, http.StatusBadRequest)
+			return
+		}
+		ttl = time.Duration(seconds) * time.Second
+	}
+	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	anStatus := hdl.zedrouter.lookupAppNetworkStatusByAppIP(remoteIP)
+	if anStatus == nil {
+		msg := fmt.Sprintf("identityTokenHandler: no AppNetworkStatus for %s",
+			remoteIP.String())
+		hdl.zedrouter.log.Errorf(msg)
+		http.Error(w, msg, http.StatusForbidden)
+		return
+	}
+	enInfoObj, err := hdl.zedrouter.subEdgeNodeInfo.Get("global")
+	if err != nil {
+		msg := fmt.Sprintf("identityTokenHandler: cannot fetch edge node information: %s", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	enInfo := enInfoObj.(types.EdgeNodeInfo)
+	key, err := hdl.zedrouter.getIdentityKey()
+	if err != nil {
+		msg := fmt.Sprintf("identityTokenHandler: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	tokenID, err := uuid.NewV4()
+	if err != nil {
+		msg := fmt.Sprintf("identityTokenHandler: cannot generate token ID: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	now := time.Now()
+	appUUID := anStatus.UUIDandVersion.UUID.String()
+	claims := identityClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  audience,
+			ExpiresAt: now.Add(ttl).Unix(),
+			Id:        tokenID.String(),
+			IssuedAt:  now.Unix(),
+			Issuer:    "urn:eve:device:" + enInfo.DeviceID.String(),
+			NotBefore: now.Unix(),
+			Subject:   appUUID,
+		},
+		AppUUID:    appUUID,
+		AppName:    anStatus.DisplayName,
+		DeviceUUID: enInfo.DeviceID.String(),
+		DeviceName: enInfo.DeviceName,
+	}
+	if enInfo.ProjectID != uuid.Nil {
+		claims.ProjectUUID = enInfo.ProjectID.String()
+	}
+	token, err := makeIdentityToken(claims, key)
+	if err != nil {
+		msg := fmt.Sprintf("identityTokenHandler: failed to sign token: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	hdl.zedrouter.log.Noticef("identityTokenHandler: issued token %s for app %s (%s), audience %s",
+		claims.Id, anStatus.DisplayName, appUUID, audience)
+	w.Header().Add("Content-Type", "application/jwt")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(token))
+}
+
+// ServeHTTP for identityJWKSHandler returns the JWK Set validating
+// identity tokens issued by the device.
+func (hdl identityJWKSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hdl.zedrouter.log.Tracef("identityJWKSHandler.ServeHTTP")
+	key, err := hdl.zedrouter.getIdentityKey()
+	if err != nil {
+		msg := fmt.Sprintf("identityJWKSHandler: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	keyJWK, err := key.jwk()
+	if err != nil {
+		msg := fmt.Sprintf("identityJWKSHandler: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusInternalServerError)
+		return
+	}
+	resp, _ := json.Marshal(map[string]interface{}{
+		"keys": []jwk{keyJWK},
+	})
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp)
+}
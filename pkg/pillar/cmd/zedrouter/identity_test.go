@@ -0,0 +1,318 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDeviceCert returns a self-signed device certificate with its key.
+func testDeviceCert(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-device"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template,
+		&key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{certDER}, PrivateKey: key}
+}
+
+// verifyJWKCertChain checks that the key in the JWK is certified by the device
+// certificate and returns it, as a verifier would.
+func verifyJWKCertChain(t *testing.T, key jwk, deviceCertDER []byte) *ecdsa.PublicKey {
+	assert.Len(t, key.X5c, 2)
+	var certs []*x509.Certificate
+	for _, certB64 := range key.X5c {
+		certDER, err := base64.StdEncoding.DecodeString(certB64)
+		assert.NoError(t, err)
+		cert, err := x509.ParseCertificate(certDER)
+		assert.NoError(t, err)
+		certs = append(certs, cert)
+	}
+	assert.Equal(t, deviceCertDER, certs[1].Raw)
+	// device certificate is not a CA, hence no CheckSignatureFrom
+	err := certs[1].CheckSignature(certs[0].SignatureAlgorithm,
+		certs[0].RawTBSCertificate, certs[0].Signature)
+	assert.NoError(t, err)
+
+	decode := func(s string) *big.Int {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return new(big.Int).SetBytes(b)
+	}
+	assert.Equal(t, "EC", key.Kty)
+	assert.Equal(t, "P-256", key.Crv)
+	pubKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: decode(key.X), Y: decode(key.Y)}
+	assert.True(t, pubKey.Equal(certs[0].PublicKey))
+	return pubKey
+}
+
+func TestMakeIdentityToken(t *testing.T) {
+	deviceCert := testDeviceCert(t)
+	key, err := newIdentityKey(deviceCert)
+	assert.NoError(t, err)
+	keyJWK, err := key.jwk()
+	assert.NoError(t, err)
+	assert.Equal(t, "ES256", keyJWK.Alg)
+	assert.Equal(t, key.keyID(), keyJWK.Kid)
+	pubKey := verifyJWKCertChain(t, keyJWK, deviceCert.Certificate[0])
+
+	now := time.Now()
+	claims := identityClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  "https://backend.example.com",
+			ExpiresAt: now.Add(identityTokenDefaultTTL).Unix(),
+			Id:        "token-id",
+			IssuedAt:  now.Unix(),
+			Issuer:    "urn:eve:device:device-uuid",
+			NotBefore: now.Unix(),
+			Subject:   "app-uuid",
+		},
+		AppUUID:    "app-uuid",
+		AppName:    "app1",
+		DeviceUUID: "device-uuid",
+		DeviceName: "device1",
+	}
+	tokenStr, err := makeIdentityToken(claims, key)
+	assert.NoError(t, err)
+
+	var parsedClaims identityClaims
+	token, err := jwt.ParseWithClaims(tokenStr, &parsedClaims,
+		func(token *jwt.Token) (interface{}, error) {
+			if token.Header["kid"] != keyJWK.Kid {
+				return nil, fmt.Errorf("unexpected kid %v", token.Header["kid"])
+			}
+			return pubKey, nil
+		})
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, "ES256", token.Header["alg"])
+	assert.Equal(t, keyJWK.Kid, token.Header["kid"])
+	assert.Equal(t, claims, parsedClaims)
+	assert.True(t, parsedClaims.VerifyAudience("https://backend.example.com", true))
+
+	// token signed by a different key is rejected
+	otherKey, err := newIdentityKey(deviceCert)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key.keyID(), otherKey.keyID())
+	tokenStr, err = makeIdentityToken(claims, otherKey)
+	assert.NoError(t, err)
+	_, err = jwt.ParseWithClaims(tokenStr, &identityClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			return pubKey, nil
+		})
+	assert.Error(t, err)
+}
+
+func TestJWSSigningMethod(t *testing.T) {
+	testMatrix := map[string]struct {
+		generate    func() (crypto.Signer, error)
+		expectedAlg string
+	}{
+		"P-256": {
+			generate: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			},
+			expectedAlg: "ES256",
+		},
+		"P-384": {
+			generate: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+			},
+			expectedAlg: "ES384",
+		},
+		"P-521": {
+			generate: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+			},
+			expectedAlg: "ES512",
+		},
+		"P-224 unsupported": {
+			generate: func() (crypto.Signer, error) {
+				return ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+			},
+		},
+		"RSA": {
+			generate: func() (crypto.Signer, error) {
+				return rsa.GenerateKey(rand.Reader, 2048)
+			},
+			expectedAlg: "RS256",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			privKey, err := test.generate()
+			assert.NoError(t, err)
+			method, err := jwsSigningMethod(privKey.Public())
+			if test.expectedAlg == "" {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedAlg, method.Alg())
+		})
+	}
+}
+
+func TestIsASN1Sequence(t *testing.T) {
+	deviceCert := testDeviceCert(t)
+	cert, err := x509.ParseCertificate(deviceCert.Certificate[0])
+	assert.NoError(t, err)
+	assert.True(t, isASN1Sequence(cert.RawTBSCertificate))
+	assert.True(t, isASN1Sequence(cert.Raw))
+	assert.False(t, isASN1Sequence(append(cert.RawTBSCertificate, 0)))
+	assert.False(t, isASN1Sequence(cert.RawTBSCertificate[:len(cert.RawTBSCertificate)-1]))
+	assert.False(t, isASN1Sequence([]byte("application data")))
+	assert.False(t, isASN1Sequence(nil))
+}
+
+func testZedrouterWithoutApps(t *testing.T) *zedrouter {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pubAppNetworkStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.AppNetworkStatus{},
+	})
+	assert.NoError(t, err)
+	return &zedrouter{log: log, pubAppNetworkStatus: pubAppNetworkStatus}
+}
+
+func TestSignerRefusesCertificates(t *testing.T) {
+	handler := signerHandler{zedrouter: testZedrouterWithoutApps(t)}
+	deviceCert := testDeviceCert(t)
+	cert, err := x509.ParseCertificate(deviceCert.Certificate[0])
+	assert.NoError(t, err)
+
+	testMatrix := map[string]struct {
+		payload        []byte
+		expectedStatus int
+	}{
+		"TBSCertificate": {
+			payload:        cert.RawTBSCertificate,
+			expectedStatus: http.StatusBadRequest,
+		},
+		// passes the check, but comes from an unknown app
+		"application data": {
+			payload:        []byte("application data"),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/eve/v1/tpm/signer",
+				bytes.NewReader(test.payload))
+			req.RemoteAddr = "10.11.12.2:40000"
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			assert.Equal(t, test.expectedStatus, resp.Code, resp.Body.String())
+		})
+	}
+}
+
+func TestIdentityTokenHandlerRequestChecks(t *testing.T) {
+	handler := identityTokenHandler{zedrouter: testZedrouterWithoutApps(t)}
+
+	testMatrix := map[string]struct {
+		method         string
+		query          string
+		expectedStatus int
+	}{
+		"not GET": {
+			method:         http.MethodPost,
+			query:          "audience=backend",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"missing audience": {
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"empty audience": {
+			query:          "audience=",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"oversized audience": {
+			query:          "audience=" + strings.Repeat("a", identityTokenMaxAudienceLen+1),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ttl 0": {
+			query:          "audience=backend&ttl=0",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ttl above max": {
+			query: fmt.Sprintf("audience=backend&ttl=%d",
+				int(identityTokenMaxTTL.Seconds())+1),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ttl negative": {
+			query:          "audience=backend&ttl=-1",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ttl not a number": {
+			query:          "audience=backend&ttl=1h",
+			expectedStatus: http.StatusBadRequest,
+		},
+		// Valid requests pass the checks, but come from an unknown app.
+		"max audience": {
+			query:          "audience=" + strings.Repeat("a", identityTokenMaxAudienceLen),
+			expectedStatus: http.StatusForbidden,
+		},
+		"default ttl": {
+			query:          "audience=backend",
+			expectedStatus: http.StatusForbidden,
+		},
+		"max ttl": {
+			query: fmt.Sprintf("audience=backend&ttl=%d",
+				int(identityTokenMaxTTL.Seconds())),
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			method := test.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, "/eve/v1/identity/token?"+test.query, nil)
+			req.RemoteAddr = "10.11.12.2:40000"
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			assert.Equal(t, test.expectedStatus, resp.Code, resp.Body.String())
+		})
+	}
+}
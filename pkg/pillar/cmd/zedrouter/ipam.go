@@ -100,11 +100,13 @@ func (z *zedrouter) lookupOrAllocateIPv4ForVIF(niStatus *types.NetworkInstanceSt
 			return nil, err
 		}
 		// Pick an IP address from the subnet.
-		ipAddr = types.AddToIP(niStatus.DhcpRange.Start, appNum)
+		// Part of the DHCP range may be reserved for WiFi clients.
+		appIPRange := niStatus.AppIPRange()
+		ipAddr = types.AddToIP(appIPRange.Start, appNum)
 		// Check if the address falls into the Dhcp Range.
-		if !niStatus.DhcpRange.Contains(ipAddr) {
+		if !appIPRange.Contains(ipAddr) {
 			err := fmt.Errorf("no free IP addresses in DHCP range(%v, %v)",
-				niStatus.DhcpRange.Start, niStatus.DhcpRange.End)
+				appIPRange.Start, appIPRange.End)
 			z.log.Errorf("lookupOrAllocateIPv4(NI:%v, app:%v): %v",
 				networkID, appID, err)
 			return nil, err
@@ -70,6 +70,11 @@ type wwanMetricsHandler struct {
 	zedrouter *zedrouter
 }
 
+// Provides status of the device clock synchronization.
+type timeSyncStatusHandler struct {
+	zedrouter *zedrouter
+}
+
 // Provides a signing service
 type signerHandler struct {
 	zedrouter   *zedrouter
@@ -136,6 +141,9 @@ func (z *zedrouter) makeMetadataHandler() http.Handler {
 	wwanMetricsHandler := &wwanMetricsHandler{zedrouter: z}
 	mux.Handle("/eve/v1/wwan/metrics.json", wwanMetricsHandler)
 
+	timeSyncStatusHandler := &timeSyncStatusHandler{zedrouter: z}
+	mux.Handle("/eve/v1/timesync.json", timeSyncStatusHandler)
+
 	AppInfoHandler := &AppInfoHandler{zedrouter: z}
 	mux.Handle("/eve/v1/app/info.json", AppInfoHandler)
 
@@ -148,6 +156,15 @@ func (z *zedrouter) makeMetadataHandler() http.Handler {
 		zedcloudCtx: &zedcloudCtx,
 	}
 	mux.Handle("/eve/v1/tpm/signer", signerHandler)
+
+	identityTokenHandler := &identityTokenHandler{zedrouter: z}
+	mux.Handle("/eve/v1/identity/token", identityTokenHandler)
+	identityJWKSHandler := &identityJWKSHandler{zedrouter: z}
+	mux.Handle("/eve/v1/identity/jwks.json", identityJWKSHandler)
+
+	secretsHandler := &secretsHandler{zedrouter: z}
+	mux.Handle("/eve/v1/secrets", secretsHandler)
+	mux.Handle("/eve/v1/secrets/", secretsHandler)
 	return mux
 }
 
@@ -251,7 +268,9 @@ func (hdl openstackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, errorLine, http.StatusNotImplemented)
 		return
 	}
-	if anConfig.MetaDataType != types.MetaDataOpenStack {
+	// Ignition fetches the config from the OpenStack meta-data service
+	if anConfig.MetaDataType != types.MetaDataOpenStack &&
+		anConfig.MetaDataType != types.MetaDataIgnition {
 		errorLine := fmt.Sprintf("no MetaDataOpenStack for %s",
 			anStatus.Key())
 		hdl.zedrouter.log.Tracef(errorLine)
@@ -311,7 +330,11 @@ func (hdl openstackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, errorLine, http.StatusInternalServerError)
 			return
 		}
-		w.Header().Set("Content-Type", "text/yaml")
+		if anConfig.MetaDataType == types.MetaDataIgnition {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "text/yaml")
+		}
 		w.WriteHeader(http.StatusOK)
 		w.Write(ud)
 	case "vendor_data.json":
@@ -425,6 +448,27 @@ func (hdl wwanStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(resp)
 }
 
+// ServeHTTP for timeSyncStatusHandler returns json output.
+func (hdl timeSyncStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hdl.zedrouter.log.Tracef("timeSyncStatusHandler.ServeHTTP")
+	statusObj, err := hdl.zedrouter.subTimeSyncStatus.Get("global")
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusNoContent), http.StatusNoContent)
+		return
+	}
+	status := statusObj.(types.TimeSyncStatus)
+	resp, err := json.Marshal(status)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to marshal time sync status: %v", err)
+		hdl.zedrouter.log.Errorf(msg)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp)
+}
+
 // ServeHTTP for wwanMetricsHandler returns json output.
 func (hdl wwanMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	hdl.zedrouter.log.Tracef("wwanMetricsHandler.ServeHTTP")
@@ -472,6 +516,14 @@ func (hdl signerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, msg, http.StatusBadRequest)
 		return
 	}
+	// The device key certifies the workload identity key, refuse
+	// to sign anything which could pass for a certificate.
+	if isASN1Sequence(payload) {
+		msg := "signerHandler: refusing to sign ASN.1 DER sequence"
+		hdl.zedrouter.log.Errorf(msg)
+		http.Error(w, msg, http.StatusBadRequest)
+		return
+	}
 	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
 	anStatus := hdl.zedrouter.lookupAppNetworkStatusByAppIP(remoteIP)
 	if anStatus == nil {
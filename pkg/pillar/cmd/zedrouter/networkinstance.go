@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/lf-edge/eve/pkg/pillar/netflow"
 	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
 	"github.com/lf-edge/eve/pkg/pillar/nistate"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -25,6 +26,9 @@ func (z *zedrouter) getArgsForNIStateCollecting(niID uuid.UUID) (
 	br.BrNum = niStatus.BridgeNum
 	br.BrIfName = niStatus.BridgeName
 	br.BrIfMAC = niStatus.BridgeMac
+	if wifiAPPort := z.getNIWifiAPPort(niStatus); wifiAPPort != nil {
+		br.WifiAPIfName = wifiAPPort.IfName
+	}
 	// Find all app instances that (actively) use this network.
 	apps := z.pubAppNetworkStatus.GetAll()
 	for _, app := range apps {
@@ -66,7 +70,53 @@ func (z *zedrouter) getNIBridgeConfig(
 		MACAddress: status.BridgeMac,
 		IPAddress:  ipAddr,
 		Uplink:     z.getNIUplinkConfig(status),
+		WifiAP:     z.getNIWifiAPConfig(status),
+	}
+}
+
+func (z *zedrouter) getNIWifiAPConfig(
+	status *types.NetworkInstanceStatus) *nireconciler.WifiAP {
+	port := z.getNIWifiAPPort(status)
+	if port == nil {
+		return nil
+	}
+	apConfig := *port.WirelessCfg.AccessPoint
+	passphrase, err := z.getWifiAPPassphrase(apConfig, port.Logicallabel)
+	if err != nil {
+		z.log.Errorf("getNIWifiAPConfig(%s): %v", status.UUID, err)
+		return nil
+	}
+	return &nireconciler.WifiAP{
+		LogicalLabel: port.Logicallabel,
+		IfName:       port.IfName,
+		Config:       apConfig,
+		Passphrase:   passphrase,
+	}
+}
+
+// Returns WiFi port operated in the access point mode and bridged into the given NI.
+// Returns nil if NI does not use WiFi access point or if the port is not (yet)
+// available or configured for the access point mode.
+func (z *zedrouter) getNIWifiAPPort(
+	status *types.NetworkInstanceStatus) *types.NetworkPortStatus {
+	if status.WifiAPLogicalLabel == "" {
+		return nil
+	}
+	ports := z.deviceNetworkStatus.GetPortsByLogicallabel(status.WifiAPLogicalLabel)
+	if len(ports) != 1 || ports[0].IfName == "" {
+		return nil
+	}
+	port := ports[0]
+	if !port.WirelessCfg.IsWifiAccessPoint() {
+		z.log.Warnf("getNIWifiAPPort(%s): port %s is not configured "+
+			"for the WiFi access point mode", status.UUID, port.Logicallabel)
+		return nil
+	}
+	if err := checkWifiAPPort(port); err != nil {
+		z.log.Warnf("getNIWifiAPPort(%s): %v", status.UUID, err)
+		return nil
 	}
+	return port
 }
 
 func (z *zedrouter) getNIUplinkConfig(
@@ -186,9 +236,11 @@ func (z *zedrouter) doActivateNetworkInstance(config types.NetworkInstanceConfig
 	if err != nil {
 		z.log.Error(err)
 	}
+	z.updateFlowExporter(config, status)
 }
 
 func (z *zedrouter) doInactivateNetworkInstance(status *types.NetworkInstanceStatus) {
+	z.stopFlowExporter(status.UUID)
 	err := z.niStateCollector.StopCollectingForNI(status.UUID)
 	if err != nil {
 		z.log.Error(err)
@@ -204,6 +256,7 @@ func (z *zedrouter) doInactivateNetworkInstance(status *types.NetworkInstanceSta
 		status.DisplayName)
 	z.processNIReconcileStatus(niRecStatus, status)
 	status.Activated = false
+	status.WifiAPClients = nil
 	z.publishNetworkInstanceStatus(status)
 }
 
@@ -221,16 +274,51 @@ func (z *zedrouter) doUpdateActivatedNetworkInstance(config types.NetworkInstanc
 	z.log.Functionf("Updated activated network instance %s (%s)", status.UUID,
 		status.DisplayName)
 	z.processNIReconcileStatus(niRecStatus, status)
-	_, vifs, err := z.getArgsForNIStateCollecting(config.UUID)
+	br, vifs, err := z.getArgsForNIStateCollecting(config.UUID)
 	if err == nil {
-		err = z.niStateCollector.UpdateCollectingForNI(config, vifs)
+		err = z.niStateCollector.UpdateCollectingForNI(config, br, vifs)
 	}
 	if err != nil {
 		z.log.Error(err)
 	}
+	z.updateFlowExporter(config, status)
 	z.publishNetworkInstanceStatus(status)
 }
 
+// updateFlowExporter (re)starts or stops export of flow records captured
+// for the given network instance to the configured IPFIX/NetFlow v9 collector.
+func (z *zedrouter) updateFlowExporter(config types.NetworkInstanceConfig,
+	status *types.NetworkInstanceStatus) {
+	exporter := z.flowExporters[config.UUID]
+	if exporter != nil {
+		if exporter.Config() == config.FlowExport {
+			return
+		}
+		z.stopFlowExporter(config.UUID)
+	}
+	if !config.FlowExport.Enabled {
+		return
+	}
+	// Bridge number is unique among network instances and therefore can be used
+	// as the observation domain ID.
+	exporter, err := netflow.NewExporter(z.log, config.UUID,
+		uint32(status.BridgeNum), config.FlowExport)
+	if err != nil {
+		// Should be prevented by doNetworkInstanceFlowExportSanityCheck.
+		z.log.Errorf("Failed to start flow export for network instance %s: %v",
+			config.UUID, err)
+		return
+	}
+	z.flowExporters[config.UUID] = exporter
+}
+
+func (z *zedrouter) stopFlowExporter(niID uuid.UUID) {
+	if exporter := z.flowExporters[niID]; exporter != nil {
+		exporter.Stop()
+		delete(z.flowExporters, niID)
+	}
+}
+
 // maybeDelOrInactivateNetworkInstance checks if the VIFs are gone and if so deletes
 // or at least inactivates NI.
 func (z *zedrouter) maybeDelOrInactivateNetworkInstance(
@@ -49,6 +49,7 @@ func (z *zedrouter) handleGlobalConfigImpl(ctxArg interface{}, key string,
 				z.publishTicker.UpdateRangeTicker(time.Duration(min), time.Duration(max))
 			}
 			z.metricInterval = metricInterval
+			z.appPrometheusInterval.Store(metricInterval)
 		}
 		z.enableArpSnooping = gcp.GlobalValueBool(types.EnableARPSnoop)
 		z.niReconciler.ApplyUpdatedGCP(z.runCtx, *gcp)
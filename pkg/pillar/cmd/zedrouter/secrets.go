@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Secrets of application instances served by the meta-data server.
+// An app instance is identified by the source IP address of the request
+// and only gets its own secrets. Every access is recorded in the log.
+
+package zedrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const secretsPathPrefix = "/eve/v1/secrets"
+
+// Provides secrets of the calling app instance
+type secretsHandler struct {
+	zedrouter *zedrouter
+}
+
+// ServeHTTP for secretsHandler returns the JSON list of the names of
+// the secrets for /eve/v1/secrets and the value of the secret
+// for /eve/v1/secrets/<name>.
+func (hdl secretsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hdl.zedrouter.log.Tracef("secretsHandler.ServeHTTP")
+	if r.Method != http.MethodGet {
+		msg := "secretsHandler: request method is not GET"
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, secretsPathPrefix), "/")
+	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	anStatus := hdl.zedrouter.lookupAppNetworkStatusByAppIP(remoteIP)
+	if anStatus == nil {
+		msg := fmt.Sprintf("secretsHandler: no AppNetworkStatus for %s",
+			remoteIP.String())
+		hdl.zedrouter.log.Noticef("%s, access to secret %q denied", msg, name)
+		http.Error(w, msg, http.StatusForbidden)
+		return
+	}
+	anConfig := hdl.zedrouter.lookupAppNetworkConfig(anStatus.Key())
+	if anConfig == nil {
+		msg := fmt.Sprintf("secretsHandler: no AppNetworkConfig for %s",
+			anStatus.Key())
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, msg, http.StatusNotFound)
+		return
+	}
+	secrets, err := hdl.zedrouter.getAppSecrets(anConfig)
+	if err != nil {
+		msg := fmt.Sprintf("secretsHandler: %v", err)
+		hdl.zedrouter.log.Error(msg)
+		http.Error(w, "secretsHandler: failed to decrypt secrets",
+			http.StatusInternalServerError)
+		return
+	}
+	if name == "" {
+		names := []string{}
+		for _, secret := range secrets {
+			names = append(names, secret.Name)
+		}
+		hdl.zedrouter.log.Noticef("secretsHandler: app %s (%s) from %s listed secrets",
+			anStatus.DisplayName, anStatus.Key(), remoteIP)
+		resp, _ := json.Marshal(names)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(resp)
+		return
+	}
+	for _, secret := range secrets {
+		if secret.Name != name {
+			continue
+		}
+		hdl.zedrouter.log.Noticef("secretsHandler: app %s (%s) from %s read secret %s",
+			anStatus.DisplayName, anStatus.Key(), remoteIP, name)
+		w.Header().Add("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write(secret.Value)
+		return
+	}
+	hdl.zedrouter.log.Noticef("secretsHandler: app %s (%s) from %s requested unknown secret %q",
+		anStatus.DisplayName, anStatus.Key(), remoteIP, name)
+	http.Error(w, fmt.Sprintf("secretsHandler: no secret %q", name), http.StatusNotFound)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/pkg/pillar/netflow"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	uuid "github.com/satori/go.uuid"
 )
@@ -49,6 +50,164 @@ func (z *zedrouter) doNetworkInstanceSanityCheck(
 	default:
 		return fmt.Errorf("IpType %d not supported", status.IpType)
 	}
+
+	// HostACLEnforcement
+	switch status.HostACLEnforcement {
+	case types.HostACLEnforcementDNS:
+		// Do nothing
+	case types.HostACLEnforcementL7:
+		if status.Type != types.NetworkInstanceTypeLocal {
+			return fmt.Errorf("layer-7 enforcement of host ACLs is only supported " +
+				"with local network instance")
+		}
+	default:
+		return fmt.Errorf("host ACL enforcement %d is not supported",
+			status.HostACLEnforcement)
+	}
+
+	if err := z.doNetworkInstanceFlowExportSanityCheck(status); err != nil {
+		return err
+	}
+	if err := z.doNetworkInstanceDNSSanityCheck(status); err != nil {
+		return err
+	}
+	return z.doNetworkInstanceWifiAPSanityCheck(status)
+}
+
+func (z *zedrouter) doNetworkInstanceDNSSanityCheck(
+	status *types.NetworkInstanceStatus) error {
+	switch status.DNSFilter.Mode {
+	case types.DNSFilterModeNone, types.DNSFilterModeBlocklist,
+		types.DNSFilterModeAllowlist:
+		// Do nothing
+	default:
+		return fmt.Errorf("DNS filter mode %d is not supported", status.DNSFilter.Mode)
+	}
+	if status.DNSFilter.Mode == types.DNSFilterModeNone && len(status.DNSForwarders) == 0 {
+		return nil
+	}
+	if status.Type != types.NetworkInstanceTypeLocal {
+		return fmt.Errorf("DNS forwarders and DNS filter are only supported " +
+			"with local network instance")
+	}
+	for _, domain := range status.DNSFilter.Domains {
+		if err := validateDNSDomain(domain); err != nil {
+			return fmt.Errorf("invalid DNS filter domain: %v", err)
+		}
+	}
+	for _, forwarder := range status.DNSForwarders {
+		if err := validateDNSDomain(forwarder.Domain); err != nil {
+			return fmt.Errorf("invalid DNS forwarder domain: %v", err)
+		}
+		if len(forwarder.Servers) == 0 {
+			return fmt.Errorf("DNS forwarder for domain %s has no valid server",
+				forwarder.Domain)
+		}
+	}
+	return nil
+}
+
+// validateDNSDomain checks that domain can be used in dnsmasq configuration.
+func validateDNSDomain(domain string) error {
+	if domain == "" {
+		return fmt.Errorf("domain is empty")
+	}
+	if strings.ContainsAny(domain, "/#, \t\n") {
+		return fmt.Errorf("domain %q contains invalid characters", domain)
+	}
+	return nil
+}
+
+func (z *zedrouter) doNetworkInstanceFlowExportSanityCheck(
+	status *types.NetworkInstanceStatus) error {
+	config := status.FlowExport
+	if !config.Enabled {
+		return nil
+	}
+	switch config.Protocol {
+	case types.FlowExportProtocolUnspecified, types.FlowExportProtocolIPFIX,
+		types.FlowExportProtocolNetFlowV9:
+		// Do nothing
+	default:
+		return fmt.Errorf("flow export protocol %d is not supported", config.Protocol)
+	}
+	if _, err := netflow.CollectorAddress(config); err != nil {
+		return fmt.Errorf("invalid flow collector address: %v", err)
+	}
+	return nil
+}
+
+func (z *zedrouter) doNetworkInstanceWifiAPSanityCheck(
+	status *types.NetworkInstanceStatus) error {
+	label := status.WifiAPLogicalLabel
+	if label == "" {
+		return nil
+	}
+	switch status.Type {
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeSwitch:
+		// Supported
+	default:
+		return fmt.Errorf("WiFi access point is only supported with local " +
+			"or switch network instance")
+	}
+	switch label {
+	case types.UplinkLabel, types.FreeUplinkLabel:
+		return fmt.Errorf("shared label %s cannot be used to reference "+
+			"WiFi access point port", label)
+	case status.PortLogicalLabel:
+		return fmt.Errorf("port %s cannot be used both as uplink and as "+
+			"WiFi access point", label)
+	}
+	// DHCP range of local NI is split between applications and WiFi clients.
+	if status.Type == types.NetworkInstanceTypeLocal &&
+		status.WifiAPClientIPRange().Start == nil {
+		return fmt.Errorf("DHCP range (%v, %v) is too small to be shared between "+
+			"applications and clients of WiFi access point %s (IPv4 range with "+
+			"at least 3 addresses is required)", status.DhcpRange.Start,
+			status.DhcpRange.End, label)
+	}
+	// Port may not be known yet, in which case it is checked later
+	// by getNIWifiAPPort.
+	ports := z.deviceNetworkStatus.GetPortsByLogicallabel(label)
+	if len(ports) == 1 {
+		if err := checkWifiAPPort(ports[0]); err != nil {
+			return err
+		}
+	}
+	items := z.pubNetworkInstanceStatus.GetAll()
+	for key2, status2 := range items {
+		niStatus2 := status2.(types.NetworkInstanceStatus)
+		if status.Key() == key2 {
+			continue
+		}
+		if niStatus2.WifiAPLogicalLabel == label {
+			return fmt.Errorf("WiFi access point port %s is already used by "+
+				"network instance %s-%s", label, key2, niStatus2.DisplayName)
+		}
+	}
+	return nil
+}
+
+// checkWifiAPPort verifies that the port operated as WiFi access point is not
+// used by EVE itself. The WiFi interface is bridged into the network instance,
+// therefore it must not be configured with IP address, DHCP client or routes.
+func checkWifiAPPort(port *types.NetworkPortStatus) error {
+	if port.IsMgmt {
+		return fmt.Errorf("WiFi access point port %s must not be used "+
+			"for management", port.Logicallabel)
+	}
+	if port.Dhcp != types.DT_NONE {
+		return fmt.Errorf("WiFi access point port %s must not have IP "+
+			"configuration (DHCP type %d, expected %d)", port.Logicallabel,
+			port.Dhcp, types.DT_NONE)
+	}
+	if port.Subnet.IP != nil || len(port.DNSServers) > 0 ||
+		len(port.DefaultRouters) > 0 {
+		return fmt.Errorf("WiFi access point port %s must not have IP "+
+			"configuration (subnet %s, DNS servers %v, routers %v)",
+			port.Logicallabel, port.Subnet.String(), port.DNSServers,
+			port.DefaultRouters)
+	}
 	return nil
 }
 
@@ -142,6 +301,12 @@ func (z *zedrouter) validateAppNetworkConfig(appNetConfig types.AppNetworkConfig
 	if z.containsHangingACLPortMapRule(ulCfgList1) {
 		return fmt.Errorf("network with no uplink, has portmap")
 	}
+	for _, ulCfg := range ulCfgList1 {
+		if ulCfg.QoS.MarkDSCP && ulCfg.QoS.DSCP > types.MaxDSCP {
+			return fmt.Errorf("invalid DSCP value %d for interface %s",
+				ulCfg.QoS.DSCP, ulCfg.Name)
+		}
+	}
 	sub := z.subAppNetworkConfig
 	items := sub.GetAll()
 	for _, c := range items {
@@ -32,6 +32,8 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentbase"
@@ -40,7 +42,10 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/l7acl"
+	"github.com/lf-edge/eve/pkg/pillar/netflow"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
 	"github.com/lf-edge/eve/pkg/pillar/nistate"
 	"github.com/lf-edge/eve/pkg/pillar/objtonum"
@@ -50,6 +55,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/uplinkprober"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -98,6 +104,7 @@ type zedrouter struct {
 	niReconciler     nireconciler.NIReconciler
 	reachProber      uplinkprober.ReachabilityProber
 	uplinkProber     *uplinkprober.UplinkProber
+	l7Inspector      *l7acl.Inspector
 
 	// Number allocators
 	appNumAllocator     *objtonum.Allocator
@@ -106,11 +113,12 @@ type zedrouter struct {
 	appIntfNumAllocator map[string]*objtonum.Allocator // key: network instance UUID as string
 
 	// Info published to application via metadata server
-	subLocationInfo pubsub.Subscription
-	subWwanStatus   pubsub.Subscription
-	subWwanMetrics  pubsub.Subscription
-	subDomainStatus pubsub.Subscription
-	subEdgeNodeInfo pubsub.Subscription
+	subLocationInfo   pubsub.Subscription
+	subWwanStatus     pubsub.Subscription
+	subWwanMetrics    pubsub.Subscription
+	subDomainStatus   pubsub.Subscription
+	subEdgeNodeInfo   pubsub.Subscription
+	subTimeSyncStatus pubsub.Subscription
 
 	// To collect uplink info
 	subDeviceNetworkStatus pubsub.Subscription
@@ -140,6 +148,16 @@ type zedrouter struct {
 	appContainerStatsMutex      sync.Mutex // to protect appContainerStatsCollecting
 	appContainerStatsInterval   uint32
 	appContainerLogger          *logrus.Logger
+	pubAppPrometheusMetrics     pubsub.Publication
+	appPrometheusScraping       bool
+	// to protect appPrometheusScraping and to serialize (un)publishing
+	// of AppPrometheusMetrics with updates of AppNetworkStatus.Prometheus
+	appPrometheusMutex sync.Mutex
+	// metric interval in seconds, read by the scraping goroutine
+	appPrometheusInterval atomic.Uint32
+	// workload identity key, created on first use
+	identityKey      *identityKey
+	identityKeyMutex sync.Mutex
 
 	// Agent metrics
 	zedcloudMetrics    *zedcloud.AgentMetrics
@@ -150,6 +168,8 @@ type zedrouter struct {
 	// Flow recording
 	pubAppFlowMonitor pubsub.Publication
 	flowPublishMap    map[string]time.Time
+	// Exporters of flow records to IPFIX/NetFlow v9 collectors (key = NI UUID)
+	flowExporters map[uuid.UUID]*netflow.Exporter
 
 	// Decryption of cloud-init user data
 	pubCipherBlockStatus pubsub.Publication
@@ -197,6 +217,7 @@ func (z *zedrouter) init() (err error) {
 	z.agentStartTime = time.Now()
 	z.appContainerLogger = agentlog.CustomLogInit(logrus.InfoLevel)
 	z.flowPublishMap = make(map[string]time.Time)
+	z.flowExporters = make(map[uuid.UUID]*netflow.Exporter)
 	z.deviceNetworkStatus = &types.DeviceNetworkStatus{}
 
 	z.zedcloudMetrics = zedcloud.NewAgentMetrics()
@@ -204,6 +225,7 @@ func (z *zedrouter) init() (err error) {
 
 	gcp := *types.DefaultConfigItemValueMap()
 	z.appContainerStatsInterval = gcp.GlobalValueInt(types.AppContainerStatsInterval)
+	z.appPrometheusInterval.Store(gcp.GlobalValueInt(types.MetricInterval))
 
 	if err = z.ensureDir(runDirname); err != nil {
 		return err
@@ -228,12 +250,14 @@ func (z *zedrouter) init() (err error) {
 
 	// Initialize Zedrouter components (for Linux network stack).
 	z.networkMonitor = &netmonitor.LinuxNetworkMonitor{Log: z.log}
-	z.niStateCollector = nistate.NewLinuxCollector(z.log)
+	useNftables := nftables.Enabled()
+	z.niStateCollector = nistate.NewLinuxCollector(z.log, useNftables)
 	controllerReachProber := uplinkprober.NewControllerReachProber(
 		z.log, agentName, z.zedcloudMetrics)
 	z.reachProber = controllerReachProber
+	z.l7Inspector = l7acl.NewInspector(z.log)
 	z.niReconciler = nireconciler.NewLinuxNIReconciler(z.log, z.logger, z.networkMonitor,
-		z.makeMetadataHandler(), true, true)
+		z.makeMetadataHandler(), z.l7Inspector, useNftables, true, true)
 
 	z.initNumberAllocators()
 	return nil
@@ -287,9 +311,17 @@ func (z *zedrouter) run(ctx context.Context) (err error) {
 	reconcilerUpdates := z.niReconciler.WatchReconcilerUpdates()
 	flowUpdates := z.niStateCollector.WatchFlows()
 	ipAssignUpdates := z.niStateCollector.WatchIPAssignments()
+	wifiAPClientUpdates := z.niStateCollector.WatchWifiAPClients()
 	z.uplinkProber = uplinkprober.NewUplinkProber(
 		z.log, uplinkprober.DefaultConfig(), z.reachProber)
 	probeUpdates := z.uplinkProber.WatchProbeUpdates()
+	go func() {
+		// Without inspector running, connections subject to the layer-7 inspection
+		// of host-based ACLs are not able to pass.
+		if err := z.l7Inspector.Run(ctx); err != nil {
+			z.log.Error(err)
+		}
+	}()
 
 	// Activate all subscriptions.
 	inactiveSubs := []pubsub.Subscription{
@@ -305,6 +337,7 @@ func (z *zedrouter) run(ctx context.Context) (err error) {
 		z.subWwanStatus,
 		z.subWwanMetrics,
 		z.subDomainStatus,
+		z.subTimeSyncStatus,
 	}
 	for _, sub := range inactiveSubs {
 		if err = sub.Activate(); err != nil {
@@ -350,6 +383,9 @@ func (z *zedrouter) run(ctx context.Context) (err error) {
 		case change := <-z.subWwanMetrics.MsgChan():
 			z.subWwanMetrics.ProcessChange(change)
 
+		case change := <-z.subTimeSyncStatus.MsgChan():
+			z.subTimeSyncStatus.ProcessChange(change)
+
 		case change := <-z.subEdgeNodeInfo.MsgChan():
 			z.subEdgeNodeInfo.ProcessChange(change)
 
@@ -461,6 +497,19 @@ func (z *zedrouter) run(ctx context.Context) (err error) {
 				z.publishAppNetworkStatus(appStatus)
 			}
 
+		case wifiAPClientUpdates := <-wifiAPClientUpdates:
+			for _, update := range wifiAPClientUpdates {
+				niKey := update.NI.String()
+				netStatus := z.lookupNetworkInstanceStatus(niKey)
+				if netStatus == nil {
+					z.log.Errorf("Failed to get status for network instance %s "+
+						"(needed to update WiFi AP clients)", niKey)
+					continue
+				}
+				netStatus.WifiAPClients = update.Clients
+				z.publishNetworkInstanceStatus(netStatus)
+			}
+
 		case updates := <-probeUpdates:
 			start := time.Now()
 			z.log.Tracef("ProbeUpdate at %v", time.Now())
@@ -556,6 +605,14 @@ func (z *zedrouter) initPublications() (err error) {
 		return err
 	}
 
+	z.pubAppPrometheusMetrics, err = z.pubSub.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.AppPrometheusMetrics{},
+	})
+	if err != nil {
+		return err
+	}
+
 	z.pubNetworkMetrics, err = z.pubSub.NewPublication(pubsub.PublicationOptions{
 		AgentName: agentName,
 		TopicType: types.NetworkMetrics{},
@@ -765,6 +822,19 @@ func (z *zedrouter) initSubscriptions() (err error) {
 		return err
 	}
 
+	// Look for status of the device clock synchronization
+	z.subTimeSyncStatus, err = z.pubSub.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "timesync",
+		MyAgentName: agentName,
+		TopicImpl:   types.TimeSyncStatus{},
+		Activate:    false,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		return err
+	}
+
 	z.subDomainStatus, err = z.pubSub.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "domainmgr",
 		MyAgentName: agentName,
@@ -1036,6 +1106,17 @@ func (z *zedrouter) unpublishAppNetworkStatus(status *types.AppNetworkStatus) {
 }
 
 func (z *zedrouter) flowPublish(flow types.IPFlow) {
+	for i := range flow.Flows {
+		rec := &flow.Flows[i]
+		if rec.Inbound || rec.Flow.Proto != int32(syscall.IPPROTO_TCP) {
+			continue
+		}
+		rec.Hostname = z.l7Inspector.LookupHostname(rec.Flow.Src, rec.Flow.Dst,
+			uint16(rec.Flow.SrcPort), uint16(rec.Flow.DstPort))
+	}
+	if exporter := z.flowExporters[flow.Scope.NetUUID]; exporter != nil {
+		exporter.Export(flow)
+	}
 	flowKey := flow.Key()
 	z.flowPublishMap[flowKey] = time.Now()
 	err := z.pubAppFlowMonitor.Publish(flowKey, flow)
@@ -1237,6 +1318,41 @@ func (z *zedrouter) getCloudInitUserData(dc *types.AppNetworkConfig) (string, er
 	return decBlock.ProtectedUserData, nil
 }
 
+// getWifiAPPassphrase : returns decrypted passphrase for WiFi access point.
+func (z *zedrouter) getWifiAPPassphrase(apConfig types.WifiAPConfig,
+	portLabel string) (string, error) {
+	if !apConfig.CipherBlockStatus.IsCipher {
+		z.cipherMetrics.RecordFailure(z.log, types.NoCipher)
+		return "", fmt.Errorf("missing passphrase for WiFi access point (port %s)",
+			portLabel)
+	}
+	status, decBlock, err := cipher.GetCipherCredentials(
+		&z.decryptCipherContext, apConfig.CipherBlockStatus)
+	if err != nil {
+		_ = z.pubCipherBlockStatus.Publish(status.Key(), status)
+		z.cipherMetrics.RecordFailure(z.log, types.MissingFallback)
+		return "", fmt.Errorf("WiFi access point (port %s) CipherBlock decryption "+
+			"unsuccessful: %w", portLabel, err)
+	}
+	z.log.Functionf("WiFi access point (port %s) CipherBlock decryption successful",
+		portLabel)
+	return decBlock.WifiPassword, nil
+}
+
+// getAppSecrets : returns decrypted secrets of the app instance.
+func (z *zedrouter) getAppSecrets(dc *types.AppNetworkConfig) ([]types.AppSecret, error) {
+	status, secrets, err := cipher.GetAppSecrets(
+		&z.decryptCipherContext, dc.SecretsCipherBlock)
+	if err != nil {
+		_ = z.pubCipherBlockStatus.Publish(status.Key(), status)
+		return nil, fmt.Errorf("%s, %w", dc.Key(), err)
+	}
+	if dc.SecretsCipherBlock.IsCipher {
+		z.log.Functionf("%s, secrets CipherBlock decryption successful", dc.Key())
+	}
+	return secrets, nil
+}
+
 func (z *zedrouter) getExternalIPForApp(remoteIP net.IP) (net.IP, int) {
 	netstatus := z.lookupNetworkInstanceStatusByAppIP(remoteIP)
 	if netstatus == nil {
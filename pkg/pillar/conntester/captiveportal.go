@@ -0,0 +1,242 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntester
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+// maxProbeBodyLen : how much of the captive portal probe response body is read.
+const maxProbeBodyLen = 64 << 10
+
+// metaRefreshRegexp matches <meta http-equiv="refresh" content="0; url=..."> used
+// by some captive portals instead of an HTTP redirect.
+var metaRefreshRegexp = regexp.MustCompile(
+	`(?i)<meta[^>]+http-equiv=["']?refresh["']?[^>]+content=["']?\s*\d+\s*;\s*url=([^"'>\s]+)`)
+
+// detectCaptivePortals checks every management port which failed the connectivity
+// test for a captive portal. Ports which passed the test are not behind a captive
+// portal (or the portal has been already completed).
+// Returns logical labels of ports behind a captive portal and the portal URL
+// (of the first such port).
+func (t *ZedcloudConnectivityTester) detectCaptivePortals(dns types.DeviceNetworkStatus,
+	intfStatusMap *types.IntfStatusMap, verifyErr error) (ports []string, portalURL string) {
+	if t.CaptivePortalProbeURL == "" {
+		// Captive portal detection is disabled.
+		return nil, ""
+	}
+	for _, port := range dns.Ports {
+		if !port.IsMgmt {
+			continue
+		}
+		testResults, tested := intfStatusMap.StatusMap[port.IfName]
+		if !tested {
+			continue
+		}
+		portal := types.CaptivePortalStatus{
+			State:     types.CaptivePortalNotDetected,
+			LastCheck: time.Now(),
+		}
+		if testResults.HasError() {
+			portal = t.probeCaptivePortal(dns, port.IfName, verifyErr)
+		}
+		intfStatusMap.RecordCaptivePortal(port.IfName, portal)
+		if !portal.Detected() {
+			continue
+		}
+		t.Log.Warnf("detectCaptivePortals: port %s is behind a captive portal "+
+			"(evidence: %v, URL: %s)", port.Logicallabel, portal.Evidence, portal.URL)
+		// Make the test error easier to interpret.
+		intfStatusMap.RecordFailure(port.IfName,
+			fmt.Sprintf("captive portal detected (%v): %s",
+				portal.Evidence, testResults.LastError))
+		ports = append(ports, port.Logicallabel)
+		if portalURL == "" {
+			portalURL = portal.URL
+		}
+	}
+	return ports, portalURL
+}
+
+// probeCaptivePortal sends the captive portal probe via the given port.
+// If the probe is inconclusive, TLS errors from the controller connectivity
+// test are checked for a certificate injected by the captive portal.
+func (t *ZedcloudConnectivityTester) probeCaptivePortal(dns types.DeviceNetworkStatus,
+	ifName string, verifyErr error) types.CaptivePortalStatus {
+	portal := types.CaptivePortalStatus{LastCheck: time.Now()}
+	client, err := t.captivePortalProbeClient(dns, ifName)
+	if err == nil {
+		portal, err = runCaptivePortalProbe(client, t.CaptivePortalProbeURL)
+	}
+	if err != nil {
+		t.Log.Functionf("probeCaptivePortal: probe via %s failed: %v", ifName, err)
+	}
+	if portal.State != types.CaptivePortalUnknown {
+		return portal
+	}
+	// Note that if the probe passed, an injected certificate is more likely
+	// to come from a TLS-intercepting (transparent) proxy.
+	if issuer, injected := injectedCertIssuer(verifyErr, ifName); injected {
+		portal.State = types.CaptivePortalDetected
+		portal.Evidence = types.CaptivePortalEvidenceInjectedCert
+		portal.CertIssuer = issuer
+	}
+	return portal
+}
+
+// captivePortalProbeClient returns HTTP client sending requests via the given port.
+// The probe intentionally uses DNS servers of the port (and not encrypted DNS),
+// because captive portals typically intercept DNS queries as well.
+// Redirects are not followed.
+func (t *ZedcloudConnectivityTester) captivePortalProbeClient(
+	dns types.DeviceNetworkStatus, ifName string) (*http.Client, error) {
+	srcIP, err := types.GetLocalAddrAnyNoLinkLocal(dns, 0, ifName)
+	if err != nil {
+		return nil, err
+	}
+	dnsServers := types.GetDNSServers(dns, ifName)
+	localTCPAddr := net.TCPAddr{IP: srcIP}
+	localUDPAddr := net.UDPAddr{IP: srcIP}
+	resolverDial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, _ := net.SplitHostPort(address)
+		dnsIP := net.ParseIP(host)
+		var fromPort bool
+		for _, dnsServer := range dnsServers {
+			if dnsServer.Equal(dnsIP) {
+				fromPort = true
+				break
+			}
+		}
+		if !fromPort {
+			return nil, fmt.Errorf("skipped nameserver %v: not used by port %s",
+				dnsIP, ifName)
+		}
+		d := net.Dialer{LocalAddr: &localUDPAddr}
+		if strings.HasPrefix(network, "tcp") {
+			d.LocalAddr = &localTCPAddr
+		}
+		return d.DialContext(ctx, network, address)
+	}
+	dialer := net.Dialer{
+		Resolver:  &net.Resolver{Dial: resolverDial, PreferGo: true},
+		LocalAddr: &localTCPAddr,
+		Timeout:   t.TestTimeout,
+	}
+	transport := &http.Transport{
+		DialContext:       dialer.DialContext,
+		DisableKeepAlives: true,
+	}
+	proxyURL, err := zedcloud.LookupProxy(t.Log, &dns, ifName, t.CaptivePortalProbeURL)
+	if err == nil && proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   t.TestTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+// runCaptivePortalProbe sends HTTP GET request to the probe URL, which is expected
+// to return "204 No Content" (or an empty body) when the Internet access is not
+// restricted by a captive portal. Redirect or unexpected content is interpreted
+// as a captive portal. Other responses are inconclusive.
+func runCaptivePortalProbe(client *http.Client,
+	probeURL string) (types.CaptivePortalStatus, error) {
+	portal := types.CaptivePortalStatus{LastCheck: time.Now()}
+	resp, err := client.Get(probeURL)
+	if err != nil {
+		return portal, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNoContent:
+		portal.State = types.CaptivePortalNotDetected
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		portal.State = types.CaptivePortalDetected
+		portal.Evidence = types.CaptivePortalEvidenceRedirect
+		if location, err := resp.Location(); err == nil {
+			portal.URL = location.String()
+		}
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxProbeBodyLen))
+		if err != nil {
+			return portal, err
+		}
+		if len(strings.TrimSpace(string(body))) == 0 {
+			portal.State = types.CaptivePortalNotDetected
+			break
+		}
+		portal.State = types.CaptivePortalDetected
+		portal.Evidence = types.CaptivePortalEvidenceContent
+		portal.URL = probeURL
+		if match := metaRefreshRegexp.FindSubmatch(body); match != nil {
+			if refreshURL, err := resp.Request.URL.Parse(string(match[1])); err == nil {
+				portal.URL = refreshURL.String()
+			}
+		}
+	default:
+		return portal, fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return portal, nil
+}
+
+// injectedCertIssuer checks if any connectivity test attempt made via the given port
+// failed because the server presented a certificate which is not trusted
+// or not issued for the controller - as done by captive portals intercepting
+// HTTPS connections.
+func injectedCertIssuer(verifyErr error, ifName string) (issuer string, injected bool) {
+	var sendErr *zedcloud.SendError
+	if !errors.As(verifyErr, &sendErr) {
+		return "", false
+	}
+	for _, attempt := range sendErr.Attempts {
+		if attempt.IfName != ifName || attempt.Err == nil {
+			continue
+		}
+		var unknownAuthErr x509.UnknownAuthorityError
+		if errors.As(attempt.Err, &unknownAuthErr) {
+			return certIssuer(unknownAuthErr.Cert), true
+		}
+		var hostnameErr x509.HostnameError
+		if errors.As(attempt.Err, &hostnameErr) {
+			return certIssuer(hostnameErr.Certificate), true
+		}
+	}
+	return "", false
+}
+
+func certIssuer(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	return cert.Issuer.String()
+}
+
+// captivePortalErr returns the connectivity test error wrapped as
+// CaptivePortalDetected if any of the failed ports is behind a captive portal.
+func captivePortalErr(err error, ports []string, portalURL string) error {
+	if err == nil || len(ports) == 0 {
+		return err
+	}
+	return &CaptivePortalDetected{
+		WrappedErr: err,
+		Ports:      ports,
+		URL:        portalURL,
+	}
+}
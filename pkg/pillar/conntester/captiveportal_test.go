@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntester
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+func TestRunCaptivePortalProbe(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/no-portal", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/empty-body", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://portal.example.com/login?orig=probe",
+			http.StatusFound)
+	})
+	mux.HandleFunc("/login-page", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "<html><body>Please accept terms of use</body></html>")
+	})
+	mux.HandleFunc("/meta-refresh", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head><meta http-equiv="refresh" `+
+			`content="0; url=/portal/login"></head></html>`)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	tests := []struct {
+		path        string
+		expState    types.CaptivePortalState
+		expEvidence types.CaptivePortalEvidence
+		expURL      string
+		expErr      bool
+	}{
+		{
+			path:     "/no-portal",
+			expState: types.CaptivePortalNotDetected,
+		},
+		{
+			path:     "/empty-body",
+			expState: types.CaptivePortalNotDetected,
+		},
+		{
+			path:        "/redirect",
+			expState:    types.CaptivePortalDetected,
+			expEvidence: types.CaptivePortalEvidenceRedirect,
+			expURL:      "http://portal.example.com/login?orig=probe",
+		},
+		{
+			path:        "/login-page",
+			expState:    types.CaptivePortalDetected,
+			expEvidence: types.CaptivePortalEvidenceContent,
+			expURL:      server.URL + "/login-page",
+		},
+		{
+			path:        "/meta-refresh",
+			expState:    types.CaptivePortalDetected,
+			expEvidence: types.CaptivePortalEvidenceContent,
+			expURL:      server.URL + "/portal/login",
+		},
+		{
+			path:     "/error",
+			expState: types.CaptivePortalUnknown,
+			expErr:   true,
+		},
+	}
+	for _, test := range tests {
+		portal, err := runCaptivePortalProbe(client, server.URL+test.path)
+		if (err != nil) != test.expErr {
+			t.Errorf("%s: unexpected error: %v", test.path, err)
+		}
+		if portal.State != test.expState || portal.Evidence != test.expEvidence ||
+			portal.URL != test.expURL {
+			t.Errorf("%s: unexpected captive portal status: %+v", test.path, portal)
+		}
+		if portal.LastCheck.IsZero() {
+			t.Errorf("%s: LastCheck is not set", test.path)
+		}
+	}
+}
+
+func TestInjectedCertIssuer(t *testing.T) {
+	portalCert := &x509.Certificate{
+		Issuer: pkix.Name{CommonName: "Hotel WiFi CA"},
+	}
+	verifyErr := &zedcloud.SendError{
+		Err: errors.New("all attempts failed"),
+		Attempts: []zedcloud.SendAttempt{
+			{
+				IfName: "eth0",
+				Err:    errors.New("connection refused"),
+			},
+			{
+				IfName: "eth1",
+				Err: &url.Error{
+					Op:  "Get",
+					URL: "https://controller.example.com",
+					Err: x509.UnknownAuthorityError{Cert: portalCert},
+				},
+			},
+		},
+	}
+	if _, injected := injectedCertIssuer(verifyErr, "eth0"); injected {
+		t.Errorf("unexpected injected certificate for eth0")
+	}
+	issuer, injected := injectedCertIssuer(verifyErr, "eth1")
+	if !injected || issuer != "CN=Hotel WiFi CA" {
+		t.Errorf("expected injected certificate for eth1, got: %t, %q",
+			injected, issuer)
+	}
+	if _, injected = injectedCertIssuer(errors.New("other error"), "eth1"); injected {
+		t.Errorf("unexpected injected certificate for non-SendError")
+	}
+}
@@ -58,3 +58,30 @@ func (e *PortsNotReady) Error() string {
 func (e *PortsNotReady) Unwrap() error {
 	return e.WrappedErr
 }
+
+// CaptivePortalDetected can be returned by TestConnectivity to indicate that
+// one or more ports do not have working connectivity because they are behind
+// a captive portal, which has to be completed (e.g. by accepting terms of use)
+// before the device is allowed to access the Internet.
+type CaptivePortalDetected struct {
+	WrappedErr error
+	// Ports which are behind a captive portal.
+	Ports []string
+	// URL of the captive portal (if known).
+	URL string
+}
+
+// Error message.
+func (e *CaptivePortalDetected) Error() string {
+	var withURL string
+	if e.URL != "" {
+		withURL = " " + e.URL
+	}
+	return fmt.Sprintf("Ports %v are behind a captive portal%s: %v",
+		e.Ports, withURL, e.WrappedErr)
+}
+
+// Unwrap : return wrapped error.
+func (e *CaptivePortalDetected) Unwrap() error {
+	return e.WrappedErr
+}
@@ -102,6 +102,16 @@ func (t *MockConnectivityTester) TestConnectivity(dns types.DeviceNetworkStatus,
 		if _, noIPErr := err.(*types.IPAddrNotAvail); noIPErr {
 			portsNotReady = append(portsNotReady, port.Logicallabel)
 		}
+		portal := types.CaptivePortalStatus{
+			State:     types.CaptivePortalNotDetected,
+			LastCheck: time.Now(),
+		}
+		if portalErr, isPortal := err.(*CaptivePortalDetected); isPortal {
+			portal.State = types.CaptivePortalDetected
+			portal.Evidence = types.CaptivePortalEvidenceRedirect
+			portal.URL = portalErr.URL
+		}
+		intfStatusMap.RecordCaptivePortal(ifName, portal)
 		if err != nil {
 			errorList = append(errorList, err)
 			intfStatusMap.RecordFailure(ifName, err.Error())
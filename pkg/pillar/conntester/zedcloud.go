@@ -34,6 +34,9 @@ type ZedcloudConnectivityTester struct {
 	AgentName   string
 	TestTimeout time.Duration // can be changed in run-time
 	Metrics     *zedcloud.AgentMetrics
+	// CaptivePortalProbeURL : HTTP endpoint used to detect captive portals.
+	// Empty value disables captive portal detection. Can be changed in run-time.
+	CaptivePortalProbeURL string
 
 	iteration     int
 	prevTLSConfig *tls.Config
@@ -107,6 +110,7 @@ func (t *ZedcloudConnectivityTester) TestConnectivity(dns types.DeviceNetworkSta
 	rv, err := zedcloud.VerifyAllIntf(&zedcloudCtx, testURL, requiredSuccessCount,
 		t.iteration, withNetTrace)
 	intfStatusMap.SetOrUpdateFromMap(rv.IntfStatusMap)
+	portalPorts, portalURL := t.detectCaptivePortals(dns, &intfStatusMap, err)
 	t.Log.Tracef("TestConnectivity: intfStatusMap = %+v", intfStatusMap)
 	for i := range rv.TracedReqs {
 		// Differentiate ping tests from google tests.
@@ -131,6 +135,8 @@ func (t *ZedcloudConnectivityTester) TestConnectivity(dns types.DeviceNetworkSta
 				WrappedErr: err,
 				Ports:      portsNotReady,
 			}
+		} else {
+			err = captivePortalErr(err, portalPorts, portalURL)
 		}
 		t.Log.Errorf("TestConnectivity: %v", err)
 		return intfStatusMap, rv.TracedReqs, err
@@ -142,6 +148,7 @@ func (t *ZedcloudConnectivityTester) TestConnectivity(dns types.DeviceNetworkSta
 		return intfStatusMap, rv.TracedReqs, nil
 	}
 	err = fmt.Errorf("uplink test FAILED for URL: %s", testURL)
+	err = captivePortalErr(err, portalPorts, portalURL)
 	t.Log.Errorf("TestConnectivity: %v, intfStatusMap: %+v", err, intfStatusMap)
 	return intfStatusMap, rv.TracedReqs, err
 }
@@ -63,6 +63,8 @@ const (
 	snapshotIDFile = "snapshotid.txt"
 	// OCI runtime spec label that tracks all mount points mentioned in OCI Image config
 	eveOCIMountPointsLabel = "org.lfedge.eve.blk_mounts"
+	// OCI runtime spec label that tracks env variables set to app instance secrets
+	eveOCISecretEnvLabel = "org.lfedge.eve.secret_env"
 	// EVEOCIVNCPasswordLabel is OCI runtime spec label that tracks VNC password in OCI Image config
 	EVEOCIVNCPasswordLabel = "org.lfedge.eve.vnc_password"
 
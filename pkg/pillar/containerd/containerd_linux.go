@@ -32,3 +32,35 @@ func bindNS(ns string, path string, pid int) error {
 	}
 	return nil
 }
+
+// diskBlockDevice returns major and minor numbers of the whole disk block
+// device holding the given file, or of the file itself if it is a block
+// device (e.g. a zvol). Disk I/O limits cannot be set on partitions.
+func diskBlockDevice(file string) (int64, int64, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(file, &st); err != nil {
+		return 0, 0, err
+	}
+	dev := st.Dev
+	if st.Mode&unix.S_IFMT == unix.S_IFBLK {
+		dev = st.Rdev
+	}
+	sysDev, err := filepath.EvalSymlinks(fmt.Sprintf("/sys/dev/block/%d:%d",
+		unix.Major(dev), unix.Minor(dev)))
+	if err != nil {
+		// e.g. a file on a ZFS dataset
+		return 0, 0, fmt.Errorf("%s is not on a block device: %v", file, err)
+	}
+	if _, err := os.Stat(filepath.Join(sysDev, "partition")); err == nil {
+		sysDev = filepath.Dir(sysDev)
+	}
+	devStr, err := os.ReadFile(filepath.Join(sysDev, "dev"))
+	if err != nil {
+		return 0, 0, err
+	}
+	var major, minor int64
+	if _, err := fmt.Sscanf(string(devStr), "%d:%d", &major, &minor); err != nil {
+		return 0, 0, fmt.Errorf("cannot parse device number %q: %v", devStr, err)
+	}
+	return major, minor, nil
+}
@@ -16,8 +16,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/containerd/cgroups"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/oci"
@@ -26,6 +29,7 @@ import (
 	"github.com/linuxkit/linuxkit/src/cmd/linuxkit/moby"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
 )
 
 const eveScript = "/bin/eve"
@@ -64,6 +68,9 @@ type OCISpec interface {
 	UpdateFromVolume(string) error
 	UpdateMounts([]types.DiskStatus) error
 	UpdateEnvVar(map[string]string)
+	UpdateSecretEnv(secretsDir string, secretEnvNames map[string]string) error
+	UpdateFromPodContainer(types.AppContainerConfig, map[string]string)
+	CreatePodContainer(podName string, podPid int, index int, container types.AppContainerConfig) error
 }
 
 // NewOciSpec returns a default oci spec from the containerd point of view
@@ -151,7 +158,29 @@ func (s *ociSpec) AddLoader(volume string) error {
 				envContent = envContent + fmt.Sprintf("export %s\n", e)
 			}
 		}
-		if err := os.WriteFile(filepath.Join(volumeRoot, "environment"), []byte(envContent), 0644); err != nil {
+		// secret values are read by the init of the VM from the secrets mount
+		// (see below), so that they are never persisted in the manifest
+		envSecrets, err := s.getSecretEnv()
+		if err != nil {
+			return err
+		}
+		if envSecrets != nil {
+			secretsMountPoint := ""
+			for _, mount := range s.Mounts {
+				if mount.Source == envSecrets.SecretsDir {
+					secretsMountPoint = mount.Destination
+					break
+				}
+			}
+			if secretsMountPoint == "" {
+				return fmt.Errorf("no mount for secrets directory %s", envSecrets.SecretsDir)
+			}
+			for _, name := range envSecrets.envNames() {
+				envContent = envContent + fmt.Sprintf("export %s=\"$(cat %s)\"\n", name,
+					filepath.Join("/mnt/rootfs", secretsMountPoint, envSecrets.Names[name]))
+			}
+		}
+		if err := os.WriteFile(filepath.Join(volumeRoot, "environment"), []byte(envContent), 0600); err != nil {
 			return err
 		}
 
@@ -201,8 +230,9 @@ func (s *ociSpec) AddLoader(volume string) error {
 		spec.Mounts = append(spec.Mounts, mount)
 	}
 
-	// delete unneeded annotation
+	// delete unneeded annotations
 	delete(s.Spec.Annotations, eveOCIMountPointsLabel)
+	delete(s.Spec.Annotations, eveOCISecretEnvLabel)
 
 	// pass annotations into spec
 	spec.Spec.Annotations = s.Spec.Annotations
@@ -251,11 +281,15 @@ func (s *ociSpec) Load(file *os.File) error {
 func (s *ociSpec) CreateContainer(removeExisting bool) error {
 	ctrdCtx, done := s.client.CtrNewUserServicesCtx()
 	defer done()
-	_, err := s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(&s.Spec))
+	spec, err := s.specWithSecretEnv()
+	if err != nil {
+		return err
+	}
+	_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(spec))
 	// if container exists, is stopped and we are asked to remove existing - try that
 	if err != nil && removeExisting {
 		_ = s.client.CtrDeleteContainer(ctrdCtx, s.name)
-		_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(&s.Spec))
+		_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(spec))
 	}
 	return err
 }
@@ -331,6 +365,7 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig, status *types.Domain
 		if status.VmConfig.CPUs != "" {
 			s.Linux.Resources.CPU.Cpus = status.VmConfig.CPUs
 		}
+		s.updateLimits(dom.Limits, status.DiskStatusList)
 
 		s.Linux.CgroupsPath = fmt.Sprintf("/%s/%s", ctrdServicesNamespace, dom.GetTaskName())
 	}
@@ -341,6 +376,103 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig, status *types.Domain
 	s.Annotations[EVEOCIVNCPasswordLabel] = dom.VncPasswd
 }
 
+// cgroupMode returns the cgroup hierarchy of the host, replaced in unit tests.
+var cgroupMode = cgroups.Mode
+
+// unifiedLimits are the cgroup v2 interface files set by updateLimits.
+var unifiedLimits = []string{"cpu.max", "cpu.weight", "pids.max"}
+
+// updateLimits sets CPU, number of processes and disk I/O cgroup limits.
+// Disk I/O limits are set for every block device holding a disk of the domain.
+//
+// With cgroup v2 (unified hierarchy) CPU and process limits are set directly
+// as cgroup interface files (Unified resources), because runc would convert
+// CPU shares into cpu.weight using a formula which does not map the default
+// of 1024 shares to the default weight of 100. Disk limits are kept as blkio
+// throttling, which runc writes into io.max one device at a time.
+// With cgroup v1 the weight is mapped to cpu.shares, the default weight
+// of 100 corresponding to the default of 1024 shares.
+func (s *ociSpec) updateLimits(limits types.VmResourceLimits, disks []types.DiskStatus) {
+	res := s.Linux.Resources
+	if limits.CPUMaxMilliCPUs != 0 {
+		q := int64(*res.CPU.Period) * int64(limits.CPUMaxMilliCPUs) / 1000
+		res.CPU.Quota = &q
+	}
+	if cgroupMode() == cgroups.Unified {
+		if res.Unified == nil {
+			res.Unified = make(map[string]string)
+		}
+		res.Unified["cpu.max"] = fmt.Sprintf("%d %d", *res.CPU.Quota, *res.CPU.Period)
+		if limits.CPUWeight != 0 {
+			res.Unified["cpu.weight"] = strconv.FormatUint(uint64(limits.CPUWeight), 10)
+		}
+		if limits.PidsMax != 0 {
+			res.Unified["pids.max"] = strconv.FormatUint(uint64(limits.PidsMax), 10)
+		}
+	} else {
+		if limits.CPUWeight != 0 {
+			shares := uint64(limits.CPUWeight) * 1024 / 100
+			res.CPU.Shares = &shares
+		}
+		if limits.PidsMax != 0 {
+			res.Pids = &specs.LinuxPids{Limit: int64(limits.PidsMax)}
+		}
+	}
+	if limits.IOReadBps == 0 && limits.IOWriteBps == 0 &&
+		limits.IOReadIOPS == 0 && limits.IOWriteIOPS == 0 {
+		return
+	}
+	var devices []blockDevice
+	seen := make(map[blockDevice]struct{})
+	for _, disk := range disks {
+		if disk.FileLocation == "" {
+			continue
+		}
+		major, minor, err := diskBlockDevice(disk.FileLocation)
+		if err != nil {
+			logrus.Warnf("updateLimits: no disk I/O limits for %s: %v",
+				disk.FileLocation, err)
+			continue
+		}
+		dev := blockDevice{major: major, minor: minor}
+		if _, ok := seen[dev]; !ok {
+			seen[dev] = struct{}{}
+			devices = append(devices, dev)
+		}
+	}
+	res.BlockIO = blockIOLimits(devices, limits)
+}
+
+type blockDevice struct {
+	major, minor int64
+}
+
+// blockIOLimits returns block I/O throttling for the given devices
+func blockIOLimits(devices []blockDevice,
+	limits types.VmResourceLimits) *specs.LinuxBlockIO {
+	blockIO := &specs.LinuxBlockIO{}
+	throttle := func(dev blockDevice, rate uint64) []specs.LinuxThrottleDevice {
+		if rate == 0 {
+			return nil
+		}
+		device := specs.LinuxThrottleDevice{Rate: rate}
+		device.Major = dev.major
+		device.Minor = dev.minor
+		return []specs.LinuxThrottleDevice{device}
+	}
+	for _, dev := range devices {
+		blockIO.ThrottleReadBpsDevice = append(blockIO.ThrottleReadBpsDevice,
+			throttle(dev, limits.IOReadBps)...)
+		blockIO.ThrottleWriteBpsDevice = append(blockIO.ThrottleWriteBpsDevice,
+			throttle(dev, limits.IOWriteBps)...)
+		blockIO.ThrottleReadIOPSDevice = append(blockIO.ThrottleReadIOPSDevice,
+			throttle(dev, uint64(limits.IOReadIOPS))...)
+		blockIO.ThrottleWriteIOPSDevice = append(blockIO.ThrottleWriteIOPSDevice,
+			throttle(dev, uint64(limits.IOWriteIOPS))...)
+	}
+	return blockIO
+}
+
 // UpdateFromVolume updates values in the OCI spec based on the location
 // of an EVE volume. EVE volume's are expected to be structured as directories
 // in the filesystem with either config.json containing the full OCI runtime
@@ -508,3 +640,185 @@ func (s *ociSpec) UpdateEnvVar(envVars map[string]string) {
 		}
 	}
 }
+
+// secretEnv describes env variables set to secrets of an app instance.
+// It is stored in the spec instead of the secret values, which are read from
+// the secrets directory only when the container is created, so that they are
+// never persisted in spec files.
+type secretEnv struct {
+	// SecretsDir is the directory with the secret files
+	SecretsDir string
+	// Names maps env variable names to secret file names
+	Names map[string]string
+}
+
+func (e *secretEnv) envNames() []string {
+	names := make([]string, 0, len(e.Names))
+	for name := range e.Names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UpdateSecretEnv sets env variables to the content of secret files found
+// in secretsDir (secretEnvNames maps env variable names to secret file names).
+// The secrets are read when the container is created.
+func (s *ociSpec) UpdateSecretEnv(secretsDir string, secretEnvNames map[string]string) error {
+	if len(secretEnvNames) == 0 {
+		delete(s.Annotations, eveOCISecretEnvLabel)
+		return nil
+	}
+	b, err := json.Marshal(secretEnv{SecretsDir: secretsDir, Names: secretEnvNames})
+	if err != nil {
+		return fmt.Errorf("failed to serialize secret env: %v", err)
+	}
+	if s.Annotations == nil {
+		s.Annotations = make(map[string]string)
+	}
+	s.Annotations[eveOCISecretEnvLabel] = string(b)
+	return nil
+}
+
+func (s *ociSpec) getSecretEnv() (*secretEnv, error) {
+	value, ok := s.Annotations[eveOCISecretEnvLabel]
+	if !ok {
+		return nil, nil
+	}
+	var e secretEnv
+	if err := json.Unmarshal([]byte(value), &e); err != nil {
+		return nil, fmt.Errorf("failed to parse secret env: %v", err)
+	}
+	return &e, nil
+}
+
+// specWithSecretEnv returns the spec to create the container from, which is
+// a copy of the spec with env variables set to the values of the secrets
+// if there are any (see UpdateSecretEnv).
+func (s *ociSpec) specWithSecretEnv() (*specs.Spec, error) {
+	envSecrets, err := s.getSecretEnv()
+	if err != nil || envSecrets == nil {
+		return &s.Spec, err
+	}
+	spec := s.Spec
+	spec.Annotations = make(map[string]string, len(s.Annotations))
+	for k, v := range s.Annotations {
+		if k != eveOCISecretEnvLabel {
+			spec.Annotations[k] = v
+		}
+	}
+	process := specs.Process{}
+	if s.Process != nil {
+		process = *s.Process
+	}
+	env := process.Env
+	process.Env = nil
+	for _, e := range env {
+		if _, isSecret := envSecrets.Names[strings.SplitN(e, "=", 2)[0]]; !isSecret {
+			process.Env = append(process.Env, e)
+		}
+	}
+	for _, name := range envSecrets.envNames() {
+		secretName := envSecrets.Names[name]
+		value, err := os.ReadFile(filepath.Join(envSecrets.SecretsDir, secretName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret %s: %v", secretName, err)
+		}
+		process.Env = append(process.Env, fmt.Sprintf("%s=%s", name, value))
+	}
+	spec.Process = &process
+	return &spec, nil
+}
+
+// UpdateFromPodContainer updates the spec of an additional container of a pod
+// which was prepared from the same DomainConfig as the spec of the main container
+// (see UpdateFromDomain). User specified env variables are added except for
+// the command override which only applies to the main container.
+func (s *ociSpec) UpdateFromPodContainer(container types.AppContainerConfig,
+	envVars map[string]string) {
+	if s.Linux != nil {
+		// Nested under the cgroup of the main container which limits
+		// resources of the whole pod.
+		s.Linux.CgroupsPath = path.Join(s.Linux.CgroupsPath, container.Name)
+		if s.Linux.Resources != nil {
+			s.Linux.Resources.Memory = nil
+			s.Linux.Resources.Pids = nil
+			s.Linux.Resources.BlockIO = nil
+			for _, limit := range unifiedLimits {
+				delete(s.Linux.Resources.Unified, limit)
+			}
+			if s.Linux.Resources.CPU != nil {
+				s.Linux.Resources.CPU.Period = nil
+				s.Linux.Resources.CPU.Quota = nil
+				s.Linux.Resources.CPU.Shares = nil
+			}
+		}
+	}
+	// UTS namespace is shared with the main container.
+	s.Hostname = ""
+
+	env := make(map[string]string)
+	for k, v := range envVars {
+		if k != eveECOCMDOverride {
+			env[k] = v
+		}
+	}
+	for k, v := range container.Env {
+		env[k] = v
+	}
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s.Process.Env = append(s.Process.Env, fmt.Sprintf("%s=%s", k, env[k]))
+	}
+	if len(container.Command) > 0 {
+		s.Process.Args = container.Command
+	}
+}
+
+// CreatePodContainer creates an additional container of the pod joining network,
+// IPC and UTS namespaces of the main container task with the given PID.
+func (s *ociSpec) CreatePodContainer(podName string, podPid int, index int,
+	container types.AppContainerConfig) error {
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	shared := map[specs.LinuxNamespaceType]string{
+		specs.NetworkNamespace: "net",
+		specs.IPCNamespace:     "ipc",
+		specs.UTSNamespace:     "uts",
+	}
+	for i, ns := range s.Linux.Namespaces {
+		if procName, ok := shared[ns.Type]; ok {
+			s.Linux.Namespaces[i].Path = fmt.Sprintf("/proc/%d/ns/%s", podPid, procName)
+			delete(shared, ns.Type)
+		}
+	}
+	for nsType, procName := range shared {
+		s.Linux.Namespaces = append(s.Linux.Namespaces, specs.LinuxNamespace{
+			Type: nsType,
+			Path: fmt.Sprintf("/proc/%d/ns/%s", podPid, procName),
+		})
+	}
+
+	spec, err := s.specWithSecretEnv()
+	if err != nil {
+		return err
+	}
+	ctrdCtx, done := s.client.CtrNewUserServicesCtx()
+	defer done()
+	opts := []containerd.NewContainerOpts{
+		containerd.WithSpec(spec),
+		containerd.WithContainerLabels(podContainerLabels(podName, index, container)),
+	}
+	_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, opts...)
+	if err != nil {
+		// remove a stale container left behind and try again
+		_ = s.client.CtrDeleteContainer(ctrdCtx, s.name)
+		_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, opts...)
+	}
+	return err
+}
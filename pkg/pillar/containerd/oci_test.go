@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/containerd/cgroups"
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	. "github.com/onsi/gomega"
@@ -791,6 +792,62 @@ func TestEnvs(t *testing.T) {
 	g.Expect(spec.Process.Args).To(Equal([]string{"echo", "hello"}))
 }
 
+func TestSecretEnv(t *testing.T) {
+	g := NewGomegaWithT(t)
+	secretsDir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(secretsDir, "api.key"), []byte("secret"), 0400)).To(Succeed())
+	spec := ociSpec{
+		name: "test",
+		Spec: specs.Spec{
+			Annotations: map[string]string{},
+			Process:     &specs.Process{Env: []string{"API_KEY=image", "LOG_LEVEL=debug"}},
+			Mounts: []specs.Mount{
+				{Destination: "/run/secrets", Source: secretsDir, Type: "bind", Options: []string{"rbind", "ro"}},
+			},
+			Linux: &specs.Linux{},
+		},
+	}
+
+	// without secrets the spec is used as is
+	g.Expect(spec.UpdateSecretEnv(secretsDir, nil)).To(Succeed())
+	resolved, err := spec.specWithSecretEnv()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resolved).To(BeIdenticalTo(&spec.Spec))
+
+	// secret values are only part of the spec the container is created from
+	g.Expect(spec.UpdateSecretEnv(secretsDir, map[string]string{"API_KEY": "api.key"})).To(Succeed())
+	resolved, err = spec.specWithSecretEnv()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resolved.Process.Env).To(Equal([]string{"LOG_LEVEL=debug", "API_KEY=secret"}))
+	g.Expect(resolved.Annotations).ToNot(HaveKey(eveOCISecretEnvLabel))
+	g.Expect(spec.Process.Env).To(Equal([]string{"API_KEY=image", "LOG_LEVEL=debug"}))
+	g.Expect(spec.Annotations).To(HaveKey(eveOCISecretEnvLabel))
+	g.Expect(spec.Annotations[eveOCISecretEnvLabel]).ToNot(ContainSubstring("secret\""))
+
+	// the env manifest of a VM refers to the secrets mount
+	volumeRoot := t.TempDir()
+	rootDir := filepath.Join(volumeRoot, "rootfs")
+	g.Expect(os.Mkdir(rootDir, 0700)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(rootDir, ociRuntimeSpecFilename), []byte(loaderRuntimeSpec), 0600)).To(Succeed())
+	spec.Root = &specs.Root{Path: rootDir}
+	g.Expect(spec.AddLoader(rootDir)).To(Succeed())
+	envFile := filepath.Join(volumeRoot, "environment")
+	env, err := os.ReadFile(envFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(env)).To(Equal("export API_KEY=\"image\"\nexport LOG_LEVEL=\"debug\"\n" +
+		"export API_KEY=\"$(cat /mnt/rootfs/run/secrets/api.key)\"\n"))
+	info, err := os.Stat(envFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	g.Expect(spec.Annotations).ToNot(HaveKey(eveOCISecretEnvLabel))
+
+	// a missing secret fails the creation of the container
+	spec.Annotations = map[string]string{}
+	g.Expect(spec.UpdateSecretEnv(secretsDir, map[string]string{"DB_PASSWORD": "db-password"})).To(Succeed())
+	_, err = spec.specWithSecretEnv()
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestAddLoader(t *testing.T) {
 	g := NewGomegaWithT(t)
 	specTemplate := ociSpec{
@@ -841,6 +898,81 @@ func TestAddLoader(t *testing.T) {
 	g.Expect(spec2.Mounts[0]).To(Equal(specs.Mount{Destination: "/dev", Type: "bind", Source: "/dev", Options: []string{"rw", "rbind", "rshared"}}))
 }
 
+func TestUpdateLimits(t *testing.T) {
+	g := NewGomegaWithT(t)
+	defer func() { cgroupMode = cgroups.Mode }()
+	conf := &types.DomainConfig{
+		VmConfig: types.VmConfig{
+			Memory: 1234,
+			VCpus:  4,
+			Limits: types.VmResourceLimits{
+				CPUMaxMilliCPUs: 1500,
+				CPUWeight:       50,
+				PidsMax:         256,
+			},
+		},
+	}
+	client := &Client{}
+
+	// cgroup v1: the weight is mapped to shares
+	cgroupMode = func() cgroups.CGMode { return cgroups.Legacy }
+	spec, err := client.NewOciSpec("test", false)
+	g.Expect(err).ToNot(HaveOccurred())
+	spec.UpdateFromDomain(conf, &types.DomainStatus{})
+	res := spec.Get().Linux.Resources
+	g.Expect(*res.CPU.Quota).To(BeEquivalentTo(150000))
+	g.Expect(*res.CPU.Period).To(BeEquivalentTo(100000))
+	g.Expect(*res.CPU.Shares).To(BeEquivalentTo(512))
+	g.Expect(res.Pids).To(Equal(&specs.LinuxPids{Limit: 256}))
+	g.Expect(res.BlockIO).To(BeNil())
+	g.Expect(res.Unified).To(BeEmpty())
+
+	// cgroup v2: the limits are set as cgroup interface files
+	cgroupMode = func() cgroups.CGMode { return cgroups.Unified }
+	spec, err = client.NewOciSpec("test", false)
+	g.Expect(err).ToNot(HaveOccurred())
+	spec.UpdateFromDomain(conf, &types.DomainStatus{})
+	res = spec.Get().Linux.Resources
+	g.Expect(res.Unified).To(Equal(map[string]string{
+		"cpu.max":    "150000 100000",
+		"cpu.weight": "50",
+		"pids.max":   "256",
+	}))
+	g.Expect(res.CPU.Shares).To(BeNil())
+	g.Expect(res.Pids).To(BeNil())
+
+	// without limits only the default CPU bandwidth of vcpus CPUs is set
+	spec, err = client.NewOciSpec("test", false)
+	g.Expect(err).ToNot(HaveOccurred())
+	spec.UpdateFromDomain(&types.DomainConfig{VmConfig: types.VmConfig{VCpus: 2}},
+		&types.DomainStatus{})
+	g.Expect(spec.Get().Linux.Resources.Unified).To(Equal(map[string]string{
+		"cpu.max": "200000 100000",
+	}))
+
+	// additional containers of a pod are limited together with the main container
+	spec, err = client.NewOciSpec("test", false)
+	g.Expect(err).ToNot(HaveOccurred())
+	spec.UpdateFromDomain(conf, &types.DomainStatus{})
+	spec.Get().Linux.Resources.Unified["memory.high"] = "max"
+	spec.UpdateFromPodContainer(types.AppContainerConfig{Name: "sidecar"}, nil)
+	g.Expect(spec.Get().Linux.Resources.Unified).To(Equal(map[string]string{
+		"memory.high": "max",
+	}))
+
+	blockIO := blockIOLimits([]blockDevice{{major: 8, minor: 0}, {major: 230, minor: 16}},
+		types.VmResourceLimits{IOWriteBps: 10 << 20, IOReadIOPS: 500})
+	g.Expect(blockIO.ThrottleReadBpsDevice).To(BeEmpty())
+	g.Expect(blockIO.ThrottleWriteIOPSDevice).To(BeEmpty())
+	g.Expect(blockIO.ThrottleWriteBpsDevice).To(HaveLen(2))
+	g.Expect(blockIO.ThrottleWriteBpsDevice[1].Major).To(BeEquivalentTo(230))
+	g.Expect(blockIO.ThrottleWriteBpsDevice[1].Minor).To(BeEquivalentTo(16))
+	g.Expect(blockIO.ThrottleWriteBpsDevice[1].Rate).To(BeEquivalentTo(10 << 20))
+	g.Expect(blockIO.ThrottleReadIOPSDevice).To(HaveLen(2))
+	g.Expect(blockIO.ThrottleReadIOPSDevice[0].Major).To(BeEquivalentTo(8))
+	g.Expect(blockIO.ThrottleReadIOPSDevice[0].Rate).To(BeEquivalentTo(500))
+}
+
 func deepCopy(in interface{}) interface{} {
 	b, _ := json.Marshal(in)
 	p := reflect.New(reflect.TypeOf(in))
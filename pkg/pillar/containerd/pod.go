@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Additional containers (sidecars and init containers) of a container
+// app instance (further called a pod) are run as separate containerd
+// containers which join network, IPC and UTS namespaces of the main
+// container and which are nested under its cgroup, so that the resource
+// limits apply to the whole pod. Everything needed to manage the additional
+// containers once they are created is recorded in their labels.
+
+const (
+	eveOCIPodLabel              = "org.lfedge.eve.pod"
+	eveOCIPodContainerLabel     = "org.lfedge.eve.pod.container"
+	eveOCIPodIndexLabel         = "org.lfedge.eve.pod.index"
+	eveOCIPodTypeLabel          = "org.lfedge.eve.pod.type"
+	eveOCIPodRestartPolicyLabel = "org.lfedge.eve.pod.restart-policy"
+	eveOCIPodRestartCountLabel  = "org.lfedge.eve.pod.restart-count"
+	eveOCIPodRestartedAtLabel   = "org.lfedge.eve.pod.restarted-at"
+	eveOCIPodExitCodeLabel      = "org.lfedge.eve.pod.exit-code"
+)
+
+// PodContainer : additional container of a pod as recorded in its labels.
+type PodContainer struct {
+	ID            string
+	Pod           string
+	Name          string
+	Index         int
+	Type          types.AppContainerType
+	RestartPolicy types.AppContainerRestartPolicy
+	RestartCount  uint32
+	RestartedAt   time.Time
+	// ExitCode of the last finished run (see CtrUpdatePodContainer).
+	ExitCode int
+}
+
+// PodContainerID returns ID of the additional container of the pod.
+func PodContainerID(podName, containerName string) string {
+	return podName + "." + containerName
+}
+
+func podContainerLabels(podName string, index int,
+	container types.AppContainerConfig) map[string]string {
+	return map[string]string{
+		eveOCIPodLabel:              podName,
+		eveOCIPodContainerLabel:     container.Name,
+		eveOCIPodIndexLabel:         strconv.Itoa(index),
+		eveOCIPodTypeLabel:          strconv.Itoa(int(container.Type)),
+		eveOCIPodRestartPolicyLabel: strconv.Itoa(int(container.RestartPolicy)),
+	}
+}
+
+func parsePodContainer(id string, labels map[string]string) PodContainer {
+	c := PodContainer{
+		ID:   id,
+		Pod:  labels[eveOCIPodLabel],
+		Name: labels[eveOCIPodContainerLabel],
+	}
+	// Labels are set only by EVE, ignore parsing errors.
+	c.Index, _ = strconv.Atoi(labels[eveOCIPodIndexLabel])
+	containerType, _ := strconv.Atoi(labels[eveOCIPodTypeLabel])
+	c.Type = types.AppContainerType(containerType)
+	restartPolicy, _ := strconv.Atoi(labels[eveOCIPodRestartPolicyLabel])
+	c.RestartPolicy = types.AppContainerRestartPolicy(restartPolicy)
+	restartCount, _ := strconv.ParseUint(labels[eveOCIPodRestartCountLabel], 10, 32)
+	c.RestartCount = uint32(restartCount)
+	if restartedAt, err := strconv.ParseInt(labels[eveOCIPodRestartedAtLabel], 10, 64); err == nil {
+		c.RestartedAt = time.Unix(restartedAt, 0)
+	}
+	c.ExitCode, _ = strconv.Atoi(labels[eveOCIPodExitCodeLabel])
+	return c
+}
+
+// CtrListPodContainers returns additional containers of the pod in the order
+// in which they were configured. If podName is empty, additional containers
+// of all pods are returned.
+func (client *Client) CtrListPodContainers(ctx context.Context, podName string) ([]PodContainer, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return nil, fmt.Errorf("CtrListPodContainers: exception while verifying ctrd client: %s", err.Error())
+	}
+	filter := fmt.Sprintf("labels.%q", eveOCIPodLabel)
+	if podName != "" {
+		filter = fmt.Sprintf("labels.%q==%q", eveOCIPodLabel, podName)
+	}
+	ctrs, err := client.ctrdClient.Containers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var res []PodContainer
+	for _, ctr := range ctrs {
+		labels, err := ctr.Labels(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, parsePodContainer(ctr.ID(), labels))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Pod != res[j].Pod {
+			return res[i].Pod < res[j].Pod
+		}
+		return res[i].Index < res[j].Index
+	})
+	return res, nil
+}
+
+// CtrUpdatePodContainer records the restart count, the time of the last restart
+// and the exit code of the last run of the additional container.
+func (client *Client) CtrUpdatePodContainer(ctx context.Context, podContainer PodContainer) error {
+	ctr, err := client.CtrLoadContainer(ctx, podContainer.ID)
+	if err != nil {
+		return err
+	}
+	labels := map[string]string{
+		eveOCIPodRestartCountLabel: strconv.FormatUint(uint64(podContainer.RestartCount), 10),
+		eveOCIPodExitCodeLabel:     strconv.Itoa(podContainer.ExitCode),
+	}
+	if !podContainer.RestartedAt.IsZero() {
+		labels[eveOCIPodRestartedAtLabel] = strconv.FormatInt(podContainer.RestartedAt.Unix(), 10)
+	}
+	_, err = ctr.SetLabels(ctx, labels)
+	return err
+}
+
+// CtrRunTask creates and starts the default task in a pre-existing container,
+// waits for it to exit and deletes it. Returns the exit status of the task.
+// The task is killed if it does not exit within the timeout.
+func (client *Client) CtrRunTask(ctx context.Context, containerID string,
+	timeout time.Duration) (int, error) {
+	if _, err := client.CtrCreateTask(ctx, containerID); err != nil {
+		return 0, err
+	}
+	ctr, err := client.CtrLoadContainer(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+	task, err := ctr.Task(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = task.Delete(ctx, containerd.WithProcessKill)
+	}()
+	// Wait must be called before the task is started.
+	exitCh, err := task.Wait(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := client.CtrStartTask(ctx, containerID); err != nil {
+		return 0, err
+	}
+	select {
+	case exit := <-exitCh:
+		code, _, err := exit.Result()
+		return int(code), err
+	case <-time.After(timeout):
+		return 0, fmt.Errorf("task %s did not finish within %v", containerID, timeout)
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
@@ -4,6 +4,7 @@
 package devicenetwork
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/lf-edge/eve/pkg/pillar/encdns"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/miekg/dns"
 )
@@ -87,6 +89,54 @@ func ResolveWithSrcIP(domain string, dnsServerIP net.IP, srcIP net.IP) ([]DNSRes
 	return response, nil
 }
 
+// ResolveEncryptedWithSrcIP resolves a domain with a given source IP using
+// encrypted DNS resolver (see encdns package). Plain dns server is used only
+// if encrypted DNS is disabled or if it is not available and fallback is allowed.
+func ResolveEncryptedWithSrcIP(domain string, config types.EncryptedDNSConfig,
+	dnsServerIP net.IP, srcIP net.IP) ([]DNSResponse, error) {
+	var response []DNSResponse
+	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+	defer cancel()
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		dialer := net.Dialer{LocalAddr: &net.UDPAddr{IP: srcIP}}
+		if strings.HasPrefix(network, "tcp") {
+			dialer.LocalAddr = &net.TCPAddr{IP: srcIP}
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	conn, _, _, err := encdns.NewDialer(config).DialNameserver(ctx, dial, "udp",
+		net.JoinHostPort(dnsServerIP.String(), "53"))
+	if err != nil {
+		return response, fmt.Errorf("dns dial failed: %v", err)
+	}
+	dnsConn := dns.Conn{Conn: conn}
+	defer dnsConn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = dnsConn.SetDeadline(deadline)
+	}
+	msg := dns.Msg{}
+	if domain[len(domain)-1] != '.' {
+		domain = domain + "."
+	}
+	msg.SetQuestion(domain, dns.TypeA)
+	if err = dnsConn.WriteMsg(&msg); err != nil {
+		return response, fmt.Errorf("dns exchange failed: %v", err)
+	}
+	reply, err := dnsConn.ReadMsg()
+	if err != nil {
+		return response, fmt.Errorf("dns exchange failed: %v", err)
+	}
+	for _, answer := range reply.Answer {
+		if aRecord, ok := answer.(*dns.A); ok {
+			response = append(response, DNSResponse{
+				IP:  aRecord.A,
+				TTL: aRecord.Header().Ttl,
+			})
+		}
+	}
+	return response, nil
+}
+
 // ResolveWithPortsLambda resolves a domain by using source IPs and dns servers from DeviceNetworkStatus
 // As a resolver func ResolveWithSrcIP can be used
 func ResolveWithPortsLambda(domain string,
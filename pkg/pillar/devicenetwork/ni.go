@@ -11,3 +11,13 @@ var DnsmasqLeaseDir = "/run/zedrouter/dnsmasq.leases/"
 func DnsmasqLeaseFilePath(bridgeIfName string) string {
 	return path.Join(DnsmasqLeaseDir, bridgeIfName)
 }
+
+// HostapdCtrlDir is a directory with control sockets of hostapd instances
+// operating WiFi access points bridged into network instances.
+var HostapdCtrlDir = "/run/hostapd"
+
+// HostapdCtrlSocketPath returns the path to the control socket of hostapd
+// operating the given WiFi interface.
+func HostapdCtrlSocketPath(wlanIfName string) string {
+	return path.Join(HostapdCtrlDir, wlanIfName)
+}
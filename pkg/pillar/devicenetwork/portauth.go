@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devicenetwork
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// RunWpaWiredDir : directory with configuration and PID files of wpa_supplicant
+	// instances performing 802.1X authentication of wired ports.
+	RunWpaWiredDir = "/run/wpa_supplicant-wired"
+	// WpaWiredCtrlInterface : directory with control sockets of wpa_supplicant
+	// instances running for wired ports (socket is named after the interface).
+	WpaWiredCtrlInterface = RunWpaWiredDir + "/ctrl"
+	// WpaWiredTPMEngineID : OpenSSL engine used by wpa_supplicant to access
+	// the device key stored inside the TPM.
+	WpaWiredTPMEngineID = "tpm2tss"
+
+	wpaCtrlTimeout   = 3 * time.Second
+	wpaCtrlReplySize = 4096
+)
+
+// WpaWiredConfigPath returns path to the wpa_supplicant config file used
+// for 802.1X authentication of the given wired port.
+func WpaWiredConfigPath(ifName string) string {
+	return filepath.Join(RunWpaWiredDir, ifName+".conf")
+}
+
+// WpaWiredPidPath returns path to the PID file of wpa_supplicant
+// running for the given wired port.
+func WpaWiredPidPath(ifName string) string {
+	return filepath.Join(RunWpaWiredDir, ifName+".pid")
+}
+
+// WpaWiredCACertPath returns path to the file with CA certificate(s)
+// used to verify the 802.1X authentication server for the given wired port.
+func WpaWiredCACertPath(ifName string) string {
+	return filepath.Join(RunWpaWiredDir, ifName+"-ca.pem")
+}
+
+// WpaWiredCtrlSocketPath returns path to the control socket of wpa_supplicant
+// running for the given wired port.
+func WpaWiredCtrlSocketPath(ifName string) string {
+	return filepath.Join(WpaWiredCtrlInterface, ifName)
+}
+
+// GetPortAuthStatus queries wpa_supplicant running for the given wired port
+// over its control socket and returns the state of the 802.1X authentication.
+func GetPortAuthStatus(ifName string) (types.PortAuthStatus, error) {
+	status := types.PortAuthStatus{Enabled: true, State: types.PortAuthStateDown}
+	reply, err := wpaCtrlRequest(ifName, "STATUS")
+	if err != nil {
+		status.Error = err.Error()
+		return status, err
+	}
+	return ParseWpaWiredStatus(reply), nil
+}
+
+// ParseWpaWiredStatus parses the reply to the STATUS command of wpa_supplicant
+// running with the wired driver.
+func ParseWpaWiredStatus(reply string) types.PortAuthStatus {
+	status := types.PortAuthStatus{Enabled: true}
+	var paeState, portStatus string
+	for _, line := range strings.Split(reply, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "Supplicant PAE state":
+			paeState = value
+		case "suppPortStatus":
+			portStatus = value
+		case "selectedMethod":
+			// For example: "13 (EAP-TLS)"
+			if start := strings.Index(value, "("); start >= 0 {
+				if end := strings.Index(value, ")"); end > start {
+					status.EAPMethod = value[start+1 : end]
+				}
+			}
+		}
+	}
+	switch paeState {
+	case "AUTHENTICATED", "S_FORCE_AUTH":
+		status.State = types.PortAuthStateAuthenticated
+	case "CONNECTING", "AUTHENTICATING", "RESTART":
+		status.State = types.PortAuthStateAuthenticating
+	case "HELD":
+		status.State = types.PortAuthStateFailed
+	case "DISCONNECTED", "LOGOFF", "S_FORCE_UNAUTH":
+		status.State = types.PortAuthStateUnauthenticated
+	default:
+		status.State = types.PortAuthStateUnknown
+	}
+	if status.State == types.PortAuthStateAuthenticated &&
+		portStatus != "Authorized" {
+		status.State = types.PortAuthStateAuthenticating
+	}
+	return status
+}
+
+// wpaCtrlRequest sends command to wpa_supplicant over its control socket
+// and returns the reply.
+func wpaCtrlRequest(ifName, command string) (string, error) {
+	ctrlPath := WpaWiredCtrlSocketPath(ifName)
+	localPath := filepath.Join(RunWpaWiredDir,
+		fmt.Sprintf("nim_ctrl_%s_%d", ifName, os.Getpid()))
+	_ = os.Remove(localPath)
+	conn, err := net.DialUnix("unixgram",
+		&net.UnixAddr{Name: localPath, Net: "unixgram"},
+		&net.UnixAddr{Name: ctrlPath, Net: "unixgram"})
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to wpa_supplicant socket %s: %w",
+			ctrlPath, err)
+	}
+	defer func() {
+		conn.Close()
+		_ = os.Remove(localPath)
+	}()
+	if err = conn.SetDeadline(time.Now().Add(wpaCtrlTimeout)); err != nil {
+		return "", err
+	}
+	if _, err = conn.Write([]byte(command)); err != nil {
+		return "", fmt.Errorf("failed to send %s to wpa_supplicant: %w", command, err)
+	}
+	buf := make([]byte, wpaCtrlReplySize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return "", fmt.Errorf("failed to read reply to %s from wpa_supplicant: %w",
+			command, err)
+	}
+	return string(buf[:n]), nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devicenetwork_test
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestParseWpaWiredStatus(t *testing.T) {
+	type test struct {
+		name      string
+		reply     string
+		expState  types.PortAuthState
+		expMethod string
+	}
+	tests := []test{
+		{
+			name: "authenticated",
+			reply: "bssid=01:80:c2:00:00:03\nfreq=0\nssid=\nid=0\nmode=station\n" +
+				"key_mgmt=IEEE 802.1X (no WPA)\nwpa_state=COMPLETED\n" +
+				"Supplicant PAE state=AUTHENTICATED\nsuppPortStatus=Authorized\n" +
+				"EAP state=SUCCESS\nselectedMethod=13 (EAP-TLS)\n",
+			expState:  types.PortAuthStateAuthenticated,
+			expMethod: "EAP-TLS",
+		},
+		{
+			name: "waiting for authenticator",
+			reply: "wpa_state=ASSOCIATED\nSupplicant PAE state=CONNECTING\n" +
+				"suppPortStatus=Unauthorized\nEAP state=IDLE\n",
+			expState: types.PortAuthStateAuthenticating,
+		},
+		{
+			name: "authentication failed",
+			reply: "wpa_state=ASSOCIATED\nSupplicant PAE state=HELD\n" +
+				"suppPortStatus=Unauthorized\nEAP state=FAILURE\nselectedMethod=25 (EAP-PEAP)\n",
+			expState:  types.PortAuthStateFailed,
+			expMethod: "EAP-PEAP",
+		},
+		{
+			name:     "disconnected",
+			reply:    "wpa_state=DISCONNECTED\nSupplicant PAE state=DISCONNECTED\n",
+			expState: types.PortAuthStateUnauthenticated,
+		},
+		{
+			name:     "empty reply",
+			reply:    "",
+			expState: types.PortAuthStateUnknown,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := devicenetwork.ParseWpaWiredStatus(test.reply)
+			if !status.Enabled {
+				t.Errorf("expected enabled port auth status")
+			}
+			if status.State != test.expState {
+				t.Errorf("expected state %s, got %s", test.expState, status.State)
+			}
+			if status.EAPMethod != test.expMethod {
+				t.Errorf("expected EAP method %q, got %q", test.expMethod, status.EAPMethod)
+			}
+		})
+	}
+}
@@ -10,4 +10,15 @@ const (
 	RunWlanDir = "/run/wlan"
 	// WpaTempname : name used for a temporary wpa_supplicant file.
 	WpaTempname = "wpa_supplicant.temp"
+	// WpaCtrlInterface : directory with wpa_supplicant control sockets,
+	// used by the wlan microservice to query the WiFi status.
+	WpaCtrlInterface = "/run/wpa_supplicant"
+	// WpaAPModeMarker : written into wpa_supplicant.conf (as a comment) when the WiFi
+	// adapter operates in the access point mode. The wlan microservice then does not
+	// run wpa_supplicant and leaves the adapter to hostapd started by zedrouter.
+	WpaAPModeMarker = "# WiFi adapter operates in the access point mode"
+	// WlanStatusPath : WiFi status data published by wlan microservice.
+	WlanStatusPath = RunWlanDir + "/status.json"
+	// WlanMetricsPath : WiFi metrics published by wlan microservice.
+	WlanMetricsPath = RunWlanDir + "/metrics.json"
 )
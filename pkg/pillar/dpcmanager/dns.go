@@ -10,6 +10,7 @@ import (
 
 	"github.com/eriknordmark/ipinfo"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/encdns"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
@@ -32,6 +33,7 @@ func (g *geoService) GetGeolocationInfo(ipAddr net.IP) (*ipinfo.IPInfo, error) {
 
 func (m *DpcManager) updateDNS() {
 	defer m.publishDNS()
+	m.updateNeighborDiscovery()
 	dpc := m.currentDPC()
 	if dpc == nil {
 		m.deviceNetStatus = types.DeviceNetworkStatus{}
@@ -43,6 +45,7 @@ func (m *DpcManager) updateDNS() {
 	m.deviceNetStatus.Testing = m.dpcVerify.inProgress
 	m.deviceNetStatus.CurrentIndex = m.dpcList.CurrentIndex
 	m.deviceNetStatus.RadioSilence = m.radioSilence
+	m.deviceNetStatus.EncryptedDNS = m.encryptedDNS
 	oldDNS := m.deviceNetStatus
 	m.deviceNetStatus.Ports = make([]types.NetworkPortStatus, len(dpc.Ports))
 	for ix, port := range dpc.Ports {
@@ -54,6 +57,7 @@ func (m *DpcManager) updateDNS() {
 		m.deviceNetStatus.Ports[ix].IsL3Port = port.IsL3Port
 		m.deviceNetStatus.Ports[ix].Cost = port.Cost
 		m.deviceNetStatus.Ports[ix].ProxyConfig = port.ProxyConfig
+		m.deviceNetStatus.Ports[ix].Credentials = m.getProxyCredentials(port)
 		m.deviceNetStatus.Ports[ix].WirelessCfg = port.WirelessCfg
 		// Set fields from the config...
 		m.deviceNetStatus.Ports[ix].Dhcp = port.Dhcp
@@ -67,6 +71,8 @@ func (m *DpcManager) updateDNS() {
 		m.deviceNetStatus.Ports[ix].DNSServers = port.DnsServers
 		m.deviceNetStatus.Ports[ix].NtpServer = port.NtpServer
 		m.deviceNetStatus.Ports[ix].TestResults = port.TestResults
+		m.deviceNetStatus.Ports[ix].DNSResolver = m.dnsResolvers[port.IfName]
+		m.deviceNetStatus.Ports[ix].CaptivePortal = m.captivePortals[port.IfName]
 		// Do not try to get state data for interface which is in PCIback.
 		ioBundle := m.adapters.LookupIoBundleIfName(port.IfName)
 		if ioBundle != nil && ioBundle.IsPCIBack {
@@ -153,6 +159,29 @@ func (m *DpcManager) updateDNS() {
 				}
 			}
 		}
+		// Similarly, add WiFi status information obtained from the wlan service.
+		if port.WirelessCfg.WType == types.WirelessTypeWifi {
+			wifiNetStatus, found := m.wlanStatus.LookupNetworkStatus(port.IfName)
+			if found {
+				m.deviceNetStatus.Ports[ix].WirelessStatus = types.WirelessStatus{
+					WType: types.WirelessTypeWifi,
+					Wifi:  wifiNetStatus,
+				}
+			}
+		}
+		// Add state of the 802.1X authentication obtained from wpa_supplicant.
+		if port.PortAuth.Enabled {
+			portAuthStatus, found := m.portAuthStatus[port.IfName]
+			if !found {
+				portAuthStatus = types.PortAuthStatus{
+					Enabled: true,
+					State:   types.PortAuthStateUnknown,
+				}
+			}
+			m.deviceNetStatus.Ports[ix].PortAuth = portAuthStatus
+		}
+		// Add neighbors discovered using LLDP/CDP.
+		m.deviceNetStatus.Ports[ix].Neighbors = m.NeighborDiscovery.GetNeighbors(port.IfName)
 	}
 
 	// Preserve geo info for existing interface and IP address
@@ -269,3 +298,41 @@ func (m *DpcManager) getDNSInfo(port *types.NetworkPortStatus) error {
 	}
 	return nil
 }
+
+// encryptedDNSConfig returns configuration of the encrypted DNS
+// used for the management traffic, as set by the global config.
+func (m *DpcManager) encryptedDNSConfig() types.EncryptedDNSConfig {
+	gcp := m.globalCfg
+	protocol, err := types.ParseEncryptedDNSProtocol(
+		gcp.GlobalValueString(types.NetworkEncryptedDNSProtocol))
+	if err != nil {
+		m.Log.Warnf("encryptedDNSConfig: %v", err)
+	}
+	config := types.EncryptedDNSConfig{Protocol: protocol}
+	if protocol == types.EncryptedDNSNone {
+		return config
+	}
+	config.AllowFallback = gcp.GlobalValueBool(types.NetworkEncryptedDNSFallback)
+	servers, errs := encdns.ParseServers(protocol,
+		gcp.GlobalValueString(types.NetworkEncryptedDNSServers))
+	for _, err := range errs {
+		m.Log.Warnf("encryptedDNSConfig: ignoring invalid resolver: %v", err)
+	}
+	config.Servers = servers
+	return config
+}
+
+// updateTestedPortState records DNS resolvers used by the connectivity test
+// and results of the captive portal detection.
+func (m *DpcManager) updateTestedPortState(intfStatusMap types.IntfStatusMap) {
+	for ifName, resolver := range intfStatusMap.DNSResolvers {
+		m.dnsResolvers[ifName] = resolver
+	}
+	for ifName, portal := range intfStatusMap.CaptivePortals {
+		if !portal.Equal(m.captivePortals[ifName]) {
+			m.Log.Noticef("Captive portal state of port %s changed to: %+v",
+				ifName, portal)
+		}
+		m.captivePortals[ifName] = portal
+	}
+}
@@ -11,9 +11,11 @@ import (
 
 	"github.com/eriknordmark/ipinfo"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/conntester"
 	"github.com/lf-edge/eve/pkg/pillar/dpcreconciler"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/lldp"
 	"github.com/lf-edge/eve/pkg/pillar/netdump"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -26,6 +28,8 @@ const (
 	errorTime      = 60 * time.Second
 	warningTime    = 40 * time.Second
 	watchdogPeriod = 25 * time.Second
+	// How often to query the state of 802.1X port authentication.
+	portAuthPollPeriod = 10 * time.Second
 )
 
 // LastResortKey : key used for DPC used as a last-resort.
@@ -62,8 +66,11 @@ type DpcManager struct {
 
 	// Keep nil values to let DpcManager to use default implementations.
 	// It is useful to override for unit testing purposes.
-	WwanWatcher WwanWatcher
-	GeoService  GeolocationService
+	WwanWatcher       WwanWatcher
+	WlanWatcher       WlanWatcher
+	PortAuthWatcher   PortAuthWatcher
+	NeighborDiscovery NeighborDiscovery
+	GeoService        GeolocationService
 
 	// Minimum time that should pass after a DPC verification failure
 	// until the DPC is eligible for another round of verification.
@@ -84,10 +91,17 @@ type DpcManager struct {
 	PubWwanStatus            pubsub.Publication
 	PubWwanMetrics           pubsub.Publication
 	PubWwanLocationInfo      pubsub.Publication
+	PubWlanMetrics           pubsub.Publication
 
 	// Metrics
 	ZedcloudMetrics *zedcloud.AgentMetrics
 
+	// Context for decryption of proxy credentials.
+	SubControllerCert    pubsub.Subscription
+	SubEdgeNodeCert      pubsub.Subscription
+	PubCipherBlockStatus pubsub.Publication
+	CipherMetrics        *cipher.AgentMetrics
+
 	// Current configuration
 	dpcList          types.DevicePortConfigList
 	adapters         types.AssignableAdapters
@@ -107,11 +121,20 @@ type DpcManager struct {
 	deviceNetStatus types.DeviceNetworkStatus
 	wwanStatus      types.WwanStatus
 	wwanMetrics     types.WwanMetrics
+	wlanStatus      types.WlanStatus
+	wlanMetrics     types.WlanMetrics
+	portAuthStatus  map[string]types.PortAuthStatus // key = ifName
+	encryptedDNS    types.EncryptedDNSConfig
+	dnsResolvers    map[string]types.DNSResolverStatus   // key = ifName
+	captivePortals  map[string]types.CaptivePortalStatus // key = ifName
+	proxyCreds      map[string]proxyCredsEntry           // key = cipher block ID
 
 	// Channels
 	inputCommands chan inputCommand
 	networkEvents <-chan netmonitor.Event
 	wwanEvents    <-chan WwanEvent
+	wlanEvents    <-chan WlanEvent
+	lldpEvents    <-chan struct{}
 
 	// Timers
 	dpcTestTimer          *time.Timer
@@ -171,6 +194,48 @@ type WwanWatcher interface {
 	LoadLocationInfo() (types.WwanLocationInfo, error)
 }
 
+// WlanEvent is sent by WlanWatcher whenever there is new output coming
+// from wlan microservice.
+type WlanEvent uint8
+
+const (
+	// WlanEventUndefined : undefined event, will be ignored.
+	WlanEventUndefined WlanEvent = iota
+	// WlanEventNewStatus : new WiFi status data are available,
+	// reload with WlanWatcher.LoadStatus()
+	WlanEventNewStatus
+	// WlanEventNewMetrics : new WiFi metrics are available,
+	// reload with WlanWatcher.LoadMetrics()
+	WlanEventNewMetrics
+)
+
+// WlanWatcher allows to watch for output coming from wlan microservice.
+// Similarly to wwan, wlan microservice uses files (under /run/wlan)
+// to publish status and metrics of WiFi connectivity.
+type WlanWatcher interface {
+	Watch(ctx context.Context) (<-chan WlanEvent, error)
+	LoadStatus() (types.WlanStatus, error)
+	LoadMetrics() (types.WlanMetrics, error)
+}
+
+// PortAuthWatcher allows to query the state of 802.1X authentication
+// performed by wpa_supplicant on wired ports.
+type PortAuthWatcher interface {
+	GetStatus(ifName string) (types.PortAuthStatus, error)
+}
+
+// NeighborDiscovery runs LLDP/CDP on device ports to learn about directly
+// connected network devices.
+type NeighborDiscovery interface {
+	// Watch starts neighbor discovery and returns channel used to notify
+	// about changes in the set of learned neighbors.
+	Watch(ctx context.Context) (<-chan struct{}, error)
+	// UpdatePorts updates the set of ports on which neighbor discovery runs.
+	UpdatePorts(ports []lldp.PortConfig, systemName string)
+	// GetNeighbors returns neighbors currently known for the given port.
+	GetNeighbors(ifName string) []types.PortNeighbor
+}
+
 // GeolocationService allows to obtain geolocation information based
 // on assigned IP address.
 type GeolocationService interface {
@@ -210,10 +275,23 @@ type dpcVerify struct {
 // Init DpcManager
 func (m *DpcManager) Init(ctx context.Context) error {
 	m.dpcVerify.crucialIfs = make(map[string]netmonitor.IfAttrs)
+	m.portAuthStatus = make(map[string]types.PortAuthStatus)
+	m.dnsResolvers = make(map[string]types.DNSResolverStatus)
+	m.captivePortals = make(map[string]types.CaptivePortalStatus)
+	m.proxyCreds = make(map[string]proxyCredsEntry)
 	m.inputCommands = make(chan inputCommand, 10)
 	if m.WwanWatcher == nil {
 		m.WwanWatcher = &wwanWatcher{Log: m.Log}
 	}
+	if m.WlanWatcher == nil {
+		m.WlanWatcher = &wlanWatcher{Log: m.Log}
+	}
+	if m.PortAuthWatcher == nil {
+		m.PortAuthWatcher = &portAuthWatcher{Log: m.Log}
+	}
+	if m.NeighborDiscovery == nil {
+		m.NeighborDiscovery = &lldp.Agent{Log: m.Log}
+	}
 	if m.GeoService == nil {
 		m.GeoService = &geoService{}
 	}
@@ -243,6 +321,14 @@ func (m *DpcManager) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	m.wlanEvents, err = m.WlanWatcher.Watch(ctx)
+	if err != nil {
+		return err
+	}
+	m.lldpEvents, err = m.NeighborDiscovery.Watch(ctx)
+	if err != nil {
+		return err
+	}
 
 	go m.run(ctx)
 	return nil
@@ -251,6 +337,7 @@ func (m *DpcManager) Run(ctx context.Context) (err error) {
 func (m *DpcManager) run(ctx context.Context) {
 	wdName := m.AgentName + "-DpcManager"
 	stillRunning := time.NewTicker(watchdogPeriod)
+	portAuthTicker := time.NewTicker(portAuthPollPeriod)
 	m.Watchdog.StillRunning(wdName, warningTime, errorTime)
 	m.Watchdog.RegisterFileWatchdog(wdName)
 
@@ -410,6 +497,31 @@ func (m *DpcManager) run(ctx context.Context) {
 				m.reloadWwanLocationInfo()
 			}
 
+		case event, ok := <-m.wlanEvents:
+			if !ok {
+				m.Log.Warnf("Wlan watcher stopped")
+				continue
+			}
+			switch event {
+			case WlanEventUndefined:
+				m.Log.Warnf("Undefined event received from WlanWatcher")
+			case WlanEventNewStatus:
+				m.reloadWlanStatus()
+			case WlanEventNewMetrics:
+				m.reloadWlanMetrics()
+			}
+
+		case <-portAuthTicker.C:
+			m.reloadPortAuthStatus()
+
+		case _, ok := <-m.lldpEvents:
+			if !ok {
+				m.Log.Warnf("Neighbor discovery stopped")
+				m.lldpEvents = nil
+				continue
+			}
+			m.updateDNS()
+
 		case <-ctx.Done():
 			return
 
@@ -555,6 +667,12 @@ func (m *DpcManager) doUpdateGCP(ctx context.Context, gcp types.ConfigItemValueM
 	}
 	m.geoRedoInterval = geoRedoInterval
 	m.reinitNetdumper()
+	m.updateNeighborDiscovery()
+	if encryptedDNS := m.encryptedDNSConfig(); !encryptedDNS.Equal(m.encryptedDNS) {
+		m.Log.Noticef("Encrypted DNS config changed to: %+v", encryptedDNS)
+		m.encryptedDNS = encryptedDNS
+		m.updateDNS()
+	}
 
 	m.reconcileStatus = m.DpcReconciler.Reconcile(ctx, m.reconcilerArgs())
 	// If we have persisted DPCs then go ahead and pick a working one
@@ -589,6 +707,8 @@ func (m *DpcManager) doUpdateDevUUID(ctx context.Context, newUUID uuid.UUID) {
 	m.devUUID = newUUID
 	// Netdumper uses different publish period after onboarding.
 	m.reinitNetdumper()
+	// Device UUID is advertised as the LLDP system name.
+	m.updateNeighborDiscovery()
 }
 
 func (m *DpcManager) reinitNetdumper() {
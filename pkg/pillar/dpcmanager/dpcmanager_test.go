@@ -28,6 +28,7 @@ import (
 	dpcrec "github.com/lf-edge/eve/pkg/pillar/dpcreconciler"
 	generic "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/genericitems"
 	linux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/lldp"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -38,7 +39,9 @@ var (
 	logObj          *base.LogObject
 	networkMonitor  *netmonitor.MockNetworkMonitor
 	wwanWatcher     *MockWwanWatcher
+	wlanWatcher     *MockWlanWatcher
 	geoService      *MockGeoService
+	lldpAgent       *MockNeighborDiscovery
 	dpcReconciler   *dpcrec.LinuxDpcReconciler
 	dpcManager      *dpcmngr.DpcManager
 	connTester      *conntester.MockConnectivityTester
@@ -48,6 +51,7 @@ var (
 	pubWwwanStatus  pubsub.Publication
 	pubWwwanMetrics pubsub.Publication
 	pubWwanLocInfo  pubsub.Publication
+	pubWlanMetrics  pubsub.Publication
 )
 
 func initTest(test *testing.T) *GomegaWithT {
@@ -110,6 +114,14 @@ func initTest(test *testing.T) *GomegaWithT {
 	if err != nil {
 		log.Fatal(err)
 	}
+	pubWlanMetrics, err = ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: "test",
+			TopicType: types.WlanMetrics{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
 	networkMonitor = &netmonitor.MockNetworkMonitor{
 		Log:    logObj,
 		MainRT: syscall.RT_TABLE_MAIN,
@@ -120,7 +132,9 @@ func initTest(test *testing.T) *GomegaWithT {
 		NetworkMonitor: networkMonitor,
 	}
 	wwanWatcher = &MockWwanWatcher{}
+	wlanWatcher = &MockWlanWatcher{}
 	geoService = &MockGeoService{}
+	lldpAgent = &MockNeighborDiscovery{}
 	connTester = &conntester.MockConnectivityTester{
 		TestDuration: 2 * time.Second,
 	}
@@ -129,7 +143,9 @@ func initTest(test *testing.T) *GomegaWithT {
 		Watchdog:                 &MockWatchdog{},
 		AgentName:                "test",
 		WwanWatcher:              wwanWatcher,
+		WlanWatcher:              wlanWatcher,
 		GeoService:               geoService,
+		NeighborDiscovery:        lldpAgent,
 		DpcMinTimeSinceFailure:   3 * time.Second,
 		NetworkMonitor:           networkMonitor,
 		DpcReconciler:            dpcReconciler,
@@ -140,6 +156,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		PubWwanStatus:            pubWwwanStatus,
 		PubWwanMetrics:           pubWwwanMetrics,
 		PubWwanLocationInfo:      pubWwanLocInfo,
+		PubWlanMetrics:           pubWlanMetrics,
 		ZedcloudMetrics:          zedcloud.NewAgentMetrics(),
 	}
 	ctx := reconciler.MockRun(context.Background())
@@ -527,6 +544,47 @@ func mockWwan0Metrics() types.WwanMetrics {
 	}
 }
 
+func mockWlan0Status() types.WlanStatus {
+	return types.WlanStatus{
+		Networks: []types.WifiNetworkStatus{
+			{
+				Interface: "wlan0",
+				State:     types.WifiStateConnected,
+				SSID:      "ssid",
+				BSSID:     "02:00:00:00:00:01",
+				Frequency: 2437,
+				KeyMgmt:   "WPA2-PSK",
+				ScanResults: []types.WifiScanResult{
+					{
+						SSID:      "ssid",
+						BSSID:     "02:00:00:00:00:01",
+						Frequency: 2437,
+						RSSI:      -52,
+						Flags:     "[WPA2-PSK-CCMP][ESS]",
+					},
+				},
+				LastScan: time.Now(),
+			},
+		},
+	}
+}
+
+func mockWlan0Metrics() types.WlanMetrics {
+	return types.WlanMetrics{
+		Networks: []types.WifiNetworkMetrics{
+			{
+				Interface: "wlan0",
+				SignalInfo: types.WifiSignalInfo{
+					RSSI:        -52,
+					Noise:       -95,
+					LinkQuality: 85,
+				},
+				TxBitrate: 72200,
+			},
+		},
+	}
+}
+
 func mockWwan0LocationInfo() types.WwanLocationInfo {
 	return types.WwanLocationInfo{
 		Latitude:              37.333964,
@@ -1210,6 +1268,38 @@ func TestWireless(test *testing.T) {
 	t.Expect(locInfo.VerticalReliability).To(Equal(types.LocReliabilityLow))
 	t.Expect(locInfo.UTCTimestamp).To(BeEquivalentTo(1648629022000))
 
+	// Simulate some output from wlan microservice.
+	wlanWatcher.UpdateStatus(mockWlan0Status())
+	wlanWatcher.UpdateMetrics(mockWlan0Metrics())
+
+	// Check DNS content, it should include WiFi status.
+	t.Eventually(func() bool {
+		wlanDNS := wirelessStatusFromDNS(types.WirelessTypeWifi)
+		return wlanDNS.Wifi.State == types.WifiStateConnected
+	}).Should(BeTrue())
+	wlanDNS := wirelessStatusFromDNS(types.WirelessTypeWifi)
+	t.Expect(wlanDNS.Wifi.SSID).To(Equal("ssid"))
+	t.Expect(wlanDNS.Wifi.BSSID).To(Equal("02:00:00:00:00:01"))
+	t.Expect(wlanDNS.Wifi.Frequency).To(BeEquivalentTo(2437))
+	t.Expect(wlanDNS.Wifi.KeyMgmt).To(Equal("WPA2-PSK"))
+	t.Expect(wlanDNS.Wifi.ScanResults).To(HaveLen(1))
+	t.Expect(wlanDNS.Wifi.ScanResults[0].RSSI).To(BeEquivalentTo(-52))
+
+	// Check published wlan metrics
+	t.Eventually(func() bool {
+		obj, err := pubWlanMetrics.Get("global")
+		return err == nil && obj != nil
+	}).Should(BeTrue())
+	obj, err = pubWlanMetrics.Get("global")
+	wlanMetrics := obj.(types.WlanMetrics)
+	t.Expect(wlanMetrics.Networks).To(HaveLen(1))
+	t.Expect(wlanMetrics.Networks[0].LogicalLabel).To(Equal("mock-wlan0"))
+	t.Expect(wlanMetrics.Networks[0].Interface).To(Equal("wlan0"))
+	t.Expect(wlanMetrics.Networks[0].SignalInfo.RSSI).To(BeEquivalentTo(-52))
+	t.Expect(wlanMetrics.Networks[0].SignalInfo.Noise).To(BeEquivalentTo(-95))
+	t.Expect(wlanMetrics.Networks[0].SignalInfo.LinkQuality).To(BeEquivalentTo(85))
+	t.Expect(wlanMetrics.Networks[0].TxBitrate).To(BeEquivalentTo(72200))
+
 	// Impose radio silence.
 	// But actually there is a config error coming from upper layers,
 	// so there should be no change in the wwan config.
@@ -1798,6 +1888,75 @@ func TestVlansAndBonds(test *testing.T) {
 	t.Expect(vlan200State.LastSucceeded.After(vlan200State.LastFailed)).To(BeTrue())
 }
 
+func TestNeighborDiscovery(test *testing.T) {
+	t := initTest(test)
+
+	// Prepare simulated network stack.
+	eth0 := mockEth0()
+	eth1 := mockEth1()
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.AddOrUpdateInterface(eth1)
+
+	// Apply global config.
+	dpcManager.UpdateGCP(globalConfig())
+
+	// Apply DPC with two ethernet ports.
+	aa := makeAA(selectedIntfs{eth0: true, eth1: true})
+	timePrio1 := time.Now()
+	dpc := makeDPC("zedagent", timePrio1, selectedIntfs{eth0: true, eth1: true})
+	dpcManager.UpdateAA(aa)
+	dpcManager.AddDPC(dpc)
+	t.Eventually(dnsKeyCb()).Should(Equal("zedagent"))
+	t.Eventually(dpcStateCb(0)).Should(Equal(types.DPCStateSuccess))
+
+	// Neighbor discovery should run on both ports, receive-only by default.
+	ports, _ := lldpAgent.GetPorts()
+	t.Expect(ports).To(ConsistOf(
+		lldp.PortConfig{IfName: "eth0", LogicalLabel: "mock-eth0"},
+		lldp.PortConfig{IfName: "eth1", LogicalLabel: "mock-eth1"}))
+
+	// Simulate switch connected to eth0.
+	neighbor := types.PortNeighbor{
+		Protocol:   types.NeighborDiscoveryProtocolLLDP,
+		ChassisID:  "00:11:22:33:44:55",
+		PortID:     "ge-0/0/1",
+		SystemName: "switch-1",
+		VlanID:     10,
+		MgmtAddrs:  []net.IP{net.ParseIP("192.168.10.2")},
+	}
+	lldpAgent.SetNeighbors("eth0", []types.PortNeighbor{neighbor})
+	portNeighbors := func(ifName string) func() []types.PortNeighbor {
+		return func() []types.PortNeighbor {
+			dns := getDNS()
+			port := dns.GetPortByIfName(ifName)
+			if port == nil {
+				return nil
+			}
+			return port.Neighbors
+		}
+	}
+	t.Eventually(portNeighbors("eth0")).Should(Equal([]types.PortNeighbor{neighbor}))
+	t.Expect(portNeighbors("eth1")()).To(BeEmpty())
+
+	// Enable LLDP transmit.
+	gcp := globalConfig()
+	gcp.SetGlobalValueBool(types.NetworkLLDPTransmit, true)
+	dpcManager.UpdateGCP(gcp)
+	t.Eventually(func() bool {
+		ports, _ := lldpAgent.GetPorts()
+		for _, port := range ports {
+			if !port.Transmit {
+				return false
+			}
+		}
+		return len(ports) == 2
+	}).Should(BeTrue())
+
+	// Neighbor went away.
+	lldpAgent.SetNeighbors("eth0", nil)
+	t.Eventually(portNeighbors("eth0")).Should(BeEmpty())
+}
+
 func TestTransientDNSError(test *testing.T) {
 	t := initTest(test)
 
@@ -1867,6 +2026,60 @@ func TestTransientDNSError(test *testing.T) {
 	t.Expect(dnsEth0.LastError).To(BeEmpty())
 }
 
+func TestCaptivePortal(test *testing.T) {
+	t := initTest(test)
+
+	// Prepare simulated network stack.
+	eth0 := mockEth0()
+	networkMonitor.AddOrUpdateInterface(eth0)
+
+	// Apply global config.
+	dpcManager.UpdateGCP(globalConfig())
+
+	// Apply DPC with single ethernet port.
+	aa := makeAA(selectedIntfs{eth0: true})
+	timePrio1 := time.Now()
+	dpc := makeDPC("zedagent", timePrio1, selectedIntfs{eth0: true})
+	dpcManager.UpdateAA(aa)
+	dpcManager.AddDPC(dpc)
+
+	// Verification should succeed and no captive portal should be reported.
+	t.Eventually(testingInProgressCb()).Should(BeTrue())
+	t.Eventually(testingInProgressCb()).Should(BeFalse())
+	t.Eventually(dpcStateCb(0)).Should(Equal(types.DPCStateSuccess))
+	dns := getDNS()
+	dnsEth0 := dns.GetPortByIfName("eth0")
+	t.Expect(dnsEth0).ToNot(BeNil())
+	t.Expect(dnsEth0.CaptivePortal.State).To(Equal(types.CaptivePortalNotDetected))
+	t.Expect(dns.CaptivePortalDetected()).To(BeFalse())
+
+	// Simulate eth0 being moved behind a captive portal.
+	const portalURL = "http://portal.example.com/login"
+	connTester.SetConnectivityError("zedagent", "eth0",
+		&conntester.CaptivePortalDetected{
+			WrappedErr: errors.New("certificate signed by unknown authority"),
+			Ports:      []string{"mock-eth0"},
+			URL:        portalURL,
+		})
+	t.Eventually(func() bool {
+		return getDNS().CaptivePortalDetected()
+	}).Should(BeTrue())
+	dns = getDNS()
+	dnsEth0 = dns.GetPortByIfName("eth0")
+	t.Expect(dnsEth0).ToNot(BeNil())
+	t.Expect(dnsEth0.CaptivePortal.State).To(Equal(types.CaptivePortalDetected))
+	t.Expect(dnsEth0.CaptivePortal.URL).To(Equal(portalURL))
+	t.Expect(dnsEth0.HasError()).To(BeTrue())
+	t.Expect(dnsEth0.LastError).To(ContainSubstring("captive portal"))
+
+	// Simulate the captive portal being completed by the user.
+	connTester.SetConnectivityError("zedagent", "eth0", nil)
+	t.Eventually(func() bool {
+		return getDNS().CaptivePortalDetected()
+	}, 30*time.Second).Should(BeFalse())
+	t.Eventually(dpcStateCb(0)).Should(Equal(types.DPCStateSuccess))
+}
+
 // Test DPC from before 7.3.0 which does not have IsL3Port flag.
 func TestOldDPC(test *testing.T) {
 	t := initTest(test)
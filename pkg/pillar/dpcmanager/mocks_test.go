@@ -12,6 +12,7 @@ import (
 	"github.com/eriknordmark/ipinfo"
 
 	dpcmngr "github.com/lf-edge/eve/pkg/pillar/dpcmanager"
+	"github.com/lf-edge/eve/pkg/pillar/lldp"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
@@ -102,6 +103,61 @@ func (m *MockWwanWatcher) LoadLocationInfo() (types.WwanLocationInfo, error) {
 	return m.wwanLocInfo, nil
 }
 
+// MockWlanWatcher allows to simulate events coming from wlan microservice.
+// Can be injected to DpcManager.WlanWatcher in UTs.
+type MockWlanWatcher struct {
+	sync.Mutex
+	wlanStatus  types.WlanStatus
+	wlanMetrics types.WlanMetrics
+	wlanEvents  chan dpcmngr.WlanEvent
+}
+
+// UpdateStatus : simulate update of Wlan status.
+func (m *MockWlanWatcher) UpdateStatus(status types.WlanStatus) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.wlanStatus = status
+	if m.wlanEvents != nil {
+		m.wlanEvents <- dpcmngr.WlanEventNewStatus
+	}
+}
+
+// UpdateMetrics : simulate update of Wlan metrics.
+func (m *MockWlanWatcher) UpdateMetrics(metrics types.WlanMetrics) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.wlanMetrics = metrics
+	if m.wlanEvents != nil {
+		m.wlanEvents <- dpcmngr.WlanEventNewMetrics
+	}
+}
+
+// Watch for simulated wlan events.
+func (m *MockWlanWatcher) Watch(context.Context) (<-chan dpcmngr.WlanEvent, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.wlanEvents == nil {
+		m.wlanEvents = make(chan dpcmngr.WlanEvent, 10)
+	}
+	return m.wlanEvents, nil
+}
+
+// LoadStatus returns last status submitted via UpdateStatus.
+func (m *MockWlanWatcher) LoadStatus() (types.WlanStatus, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.wlanStatus, nil
+}
+
+// LoadMetrics returns last metrics submitted via UpdateMetrics.
+func (m *MockWlanWatcher) LoadMetrics() (types.WlanMetrics, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.wlanMetrics, nil
+}
+
 // MockGeoService allows to simulate geolocation service.
 // Can be injected to DpcManager.GeoService in UTs.
 type MockGeoService struct {
@@ -127,3 +183,64 @@ func (m *MockGeoService) GetGeolocationInfo(ipAddr net.IP) (*ipinfo.IPInfo, erro
 	geoInfo := m.geoInfo[ipAddr.String()]
 	return geoInfo, nil
 }
+
+// MockNeighborDiscovery allows to simulate neighbors discovered using LLDP/CDP.
+// Can be injected to DpcManager.NeighborDiscovery in UTs.
+type MockNeighborDiscovery struct {
+	sync.Mutex
+	ports      []lldp.PortConfig
+	systemName string
+	neighbors  map[string][]types.PortNeighbor // key = ifName
+	events     chan struct{}
+}
+
+// SetNeighbors : simulate discovery of neighbors on the given port.
+func (m *MockNeighborDiscovery) SetNeighbors(ifName string, neighbors []types.PortNeighbor) {
+	m.Lock()
+	defer m.Unlock()
+	if m.neighbors == nil {
+		m.neighbors = make(map[string][]types.PortNeighbor)
+	}
+	m.neighbors[ifName] = neighbors
+	if m.events != nil {
+		m.events <- struct{}{}
+	}
+}
+
+// GetPorts returns ports on which neighbor discovery is supposed to run.
+func (m *MockNeighborDiscovery) GetPorts() ([]lldp.PortConfig, string) {
+	m.Lock()
+	defer m.Unlock()
+	return m.ports, m.systemName
+}
+
+// Watch for simulated changes in the set of neighbors.
+func (m *MockNeighborDiscovery) Watch(context.Context) (<-chan struct{}, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.events == nil {
+		m.events = make(chan struct{}, 10)
+	}
+	return m.events, nil
+}
+
+// UpdatePorts records the set of ports with neighbor discovery.
+func (m *MockNeighborDiscovery) UpdatePorts(ports []lldp.PortConfig, systemName string) {
+	m.Lock()
+	defer m.Unlock()
+	m.ports = ports
+	m.systemName = systemName
+}
+
+// GetNeighbors returns neighbors submitted via SetNeighbors for ports
+// with neighbor discovery enabled.
+func (m *MockNeighborDiscovery) GetNeighbors(ifName string) []types.PortNeighbor {
+	m.Lock()
+	defer m.Unlock()
+	for _, port := range m.ports {
+		if port.IfName == ifName {
+			return m.neighbors[ifName]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/lldp"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// updateNeighborDiscovery updates the set of ports on which LLDP/CDP
+// neighbor discovery runs. Neighbors are discovered only on physical
+// Ethernet ports (not on VLAN sub-interfaces, bonds or wireless ports).
+func (m *DpcManager) updateNeighborDiscovery() {
+	var ports []lldp.PortConfig
+	transmit := m.globalCfg.GlobalValueBool(types.NetworkLLDPTransmit)
+	if dpc := m.currentDPC(); dpc != nil {
+		for _, port := range dpc.Ports {
+			if port.L2Type != types.L2LinkTypeNone ||
+				port.WirelessCfg.WType != types.WirelessTypeNone {
+				continue
+			}
+			ioBundle := m.adapters.LookupIoBundleIfName(port.IfName)
+			if ioBundle != nil && ioBundle.IsPCIBack {
+				continue
+			}
+			ports = append(ports, lldp.PortConfig{
+				IfName:       port.IfName,
+				LogicalLabel: port.Logicallabel,
+				Transmit:     transmit,
+			})
+		}
+	}
+	var systemName string
+	if m.devUUID != nilUUID {
+		systemName = m.devUUID.String()
+	}
+	m.NeighborDiscovery.UpdatePorts(ports, systemName)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type portAuthWatcher struct {
+	Log *base.LogObject
+}
+
+func (w *portAuthWatcher) GetStatus(ifName string) (types.PortAuthStatus, error) {
+	return devicenetwork.GetPortAuthStatus(ifName)
+}
+
+// reloadPortAuthStatus queries the state of the 802.1X authentication
+// for every wired port with port authentication enabled.
+func (m *DpcManager) reloadPortAuthStatus() {
+	dpc := m.currentDPC()
+	if dpc == nil {
+		return
+	}
+	var changed bool
+	newStatus := make(map[string]types.PortAuthStatus)
+	for _, port := range dpc.Ports {
+		if !port.PortAuth.Enabled {
+			continue
+		}
+		status, err := m.PortAuthWatcher.GetStatus(port.IfName)
+		if err != nil {
+			// wpa_supplicant may not be running yet.
+			m.Log.Functionf("reloadPortAuthStatus: failed to get status for %s: %v",
+				port.IfName, err)
+		}
+		prevStatus, hadStatus := m.portAuthStatus[port.IfName]
+		if hadStatus && prevStatus.State == status.State {
+			status.LastChange = prevStatus.LastChange
+		} else {
+			status.LastChange = time.Now()
+			m.Log.Noticef("802.1X authentication state of port %s changed to %s",
+				port.IfName, status.State)
+		}
+		if !hadStatus || prevStatus != status {
+			changed = true
+		}
+		newStatus[port.IfName] = status
+	}
+	if len(newStatus) != len(m.portAuthStatus) {
+		changed = true
+	}
+	m.portAuthStatus = newStatus
+	if changed {
+		m.updateDNS()
+	}
+}
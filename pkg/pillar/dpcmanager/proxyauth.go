@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// How long to wait before retrying failed decryption of proxy credentials.
+const proxyCredsRetryPeriod = time.Minute
+
+// proxyCredsEntry : decrypted proxy credentials cached to avoid decryption
+// on every DNS update.
+type proxyCredsEntry struct {
+	cipherData  []byte
+	creds       *types.ProxyCredentials
+	decryptedAt time.Time
+}
+
+// getProxyCredentials returns decrypted credentials configured for the proxy
+// of the given port. Returns nil if there are no credentials or the decryption
+// failed. There is no cleartext fallback.
+func (m *DpcManager) getProxyCredentials(
+	port types.NetworkPortConfig) *types.ProxyCredentials {
+	cipherBlock := port.ProxyConfig.AuthCipherBlock
+	if !cipherBlock.IsCipher {
+		return nil
+	}
+	cached, hasCached := m.proxyCreds[cipherBlock.Key()]
+	if hasCached && bytes.Equal(cached.cipherData, cipherBlock.CipherData) {
+		if cached.creds != nil ||
+			time.Since(cached.decryptedAt) < proxyCredsRetryPeriod {
+			return cached.creds
+		}
+	}
+	entry := proxyCredsEntry{
+		cipherData:  cipherBlock.CipherData,
+		decryptedAt: time.Now(),
+	}
+	defer func() {
+		m.proxyCreds[cipherBlock.Key()] = entry
+	}()
+	decryptAvailable := m.SubControllerCert != nil && m.SubEdgeNodeCert != nil
+	if !decryptAvailable {
+		m.Log.Warnf("%s, context for decryption of proxy credentials "+
+			"is not available", port.Logicallabel)
+		return nil
+	}
+	status, decBlock, err := cipher.GetCipherCredentials(
+		&cipher.DecryptCipherContext{
+			Log:               m.Log,
+			AgentName:         m.AgentName,
+			AgentMetrics:      m.CipherMetrics,
+			SubControllerCert: m.SubControllerCert,
+			SubEdgeNodeCert:   m.SubEdgeNodeCert,
+		},
+		cipherBlock)
+	if m.PubCipherBlockStatus != nil {
+		m.PubCipherBlockStatus.Publish(status.Key(), status)
+	}
+	if err != nil {
+		m.Log.Errorf("%s, proxy credentials decryption was unsuccessful: %v",
+			port.Logicallabel, err)
+		if m.CipherMetrics != nil {
+			m.CipherMetrics.RecordFailure(m.Log, types.MissingFallback)
+		}
+		return nil
+	}
+	m.Log.Functionf("%s, proxy credentials decryption was successful",
+		port.Logicallabel)
+	entry.creds = &types.ProxyCredentials{
+		Username: decBlock.ProxyUsername,
+		Password: decBlock.ProxyPassword,
+	}
+	return entry.creds
+}
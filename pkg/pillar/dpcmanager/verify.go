@@ -235,6 +235,7 @@ func (m *DpcManager) verifyDPC(ctx context.Context) (status types.DPCState) {
 	// for one of the ports.
 	dpc.UpdatePortStatusFromIntfStatusMap(intfStatusMap)
 	m.deviceNetStatus.UpdatePortStatusFromIntfStatusMap(intfStatusMap)
+	m.updateTestedPortState(intfStatusMap)
 	defer func() {
 		// Publish DPCL, DNS and potentially also netdump at the end when dpc.State
 		// is determined.
@@ -388,6 +389,7 @@ func (m *DpcManager) testConnectivityToCloud(ctx context.Context) error {
 	intfStatusMap, tracedProbes, err := m.ConnTester.TestConnectivity(
 		m.deviceNetStatus, withNetTrace)
 	dpc.UpdatePortStatusFromIntfStatusMap(intfStatusMap)
+	m.updateTestedPortState(intfStatusMap)
 	if err == nil {
 		dpc.State = types.DPCStateSuccess
 		dpc.TestResults.RecordSuccess()
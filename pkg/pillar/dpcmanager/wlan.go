@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type wlanWatcher struct {
+	Log *base.LogObject
+}
+
+func (w *wlanWatcher) Watch(ctx context.Context) (<-chan WlanEvent, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		err = fmt.Errorf("failed to create fsnotify watcher for wlan dir: %w", err)
+		w.Log.Error(err)
+		return nil, err
+	}
+	if err = w.createWlanDir(); err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+	if err = fsw.Add(devicenetwork.RunWlanDir); err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+	// Buffered to ensure that runWatcher will not miss fsnotify
+	// notification due to a delayed send.
+	sub := make(chan WlanEvent, 10)
+	go w.runWatcher(ctx, fsw, sub)
+	return sub, nil
+}
+
+func (w *wlanWatcher) runWatcher(ctx context.Context, fsw *fsnotify.Watcher,
+	sub chan WlanEvent) {
+	for {
+		select {
+		case event, ok := <-fsw.Events:
+			if !ok {
+				w.Log.Warnf("fsnotify watcher for wlan directory stopped")
+				return
+			}
+			switch event.Name {
+			case devicenetwork.WlanStatusPath:
+				sub <- WlanEventNewStatus
+			case devicenetwork.WlanMetricsPath:
+				sub <- WlanEventNewMetrics
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *wlanWatcher) LoadStatus() (status types.WlanStatus, err error) {
+	err = w.loadJSON(devicenetwork.WlanStatusPath, &status)
+	return status, err
+}
+
+func (w *wlanWatcher) LoadMetrics() (metrics types.WlanMetrics, err error) {
+	err = w.loadJSON(devicenetwork.WlanMetricsPath, &metrics)
+	return metrics, err
+}
+
+func (w *wlanWatcher) loadJSON(filepath string, obj interface{}) error {
+	file, err := os.Open(filepath)
+	if err != nil {
+		w.Log.Errorf("Failed to open file %s: %v", filepath, err)
+		return err
+	}
+	defer file.Close()
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		w.Log.Errorf("Failed to read file %s: %v", filepath, err)
+		return err
+	}
+	err = json.Unmarshal(fileBytes, obj)
+	if err != nil {
+		w.Log.Errorf("Failed to unmarshall content of %s: %v", filepath, err)
+		return err
+	}
+	return nil
+}
+
+func (w *wlanWatcher) createWlanDir() error {
+	if _, err := os.Stat(devicenetwork.RunWlanDir); err != nil {
+		if err = os.MkdirAll(devicenetwork.RunWlanDir, 0700); err != nil {
+			err = fmt.Errorf("failed to create directory %s: %w",
+				devicenetwork.RunWlanDir, err)
+			w.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// reloadWlanStatus loads the latest WiFi status published by the wlan service.
+func (m *DpcManager) reloadWlanStatus() {
+	status, err := m.WlanWatcher.LoadStatus()
+	if err != nil {
+		// Already logged.
+		return
+	}
+	if reflect.DeepEqual(m.wlanStatus, status) {
+		// nothing really changed
+		return
+	}
+	m.Log.Functionf("Have new wlan status: %+v", status)
+	m.wlanStatus = status
+	m.updateDNS()
+}
+
+// reloadWlanMetrics loads the latest WiFi metrics published by the wlan service.
+func (m *DpcManager) reloadWlanMetrics() {
+	metrics, err := m.WlanWatcher.LoadMetrics()
+	if err != nil {
+		// Already logged.
+		return
+	}
+	// wlan service knows only interface names, fill in logical labels.
+	if dpc := m.currentDPC(); dpc != nil {
+		for i := range metrics.Networks {
+			network := &metrics.Networks[i]
+			if port := dpc.GetPortByIfName(network.Interface); port != nil {
+				network.LogicalLabel = port.Logicallabel
+			}
+		}
+	}
+	if reflect.DeepEqual(metrics, m.wlanMetrics) {
+		// nothing really changed
+		return
+	}
+
+	m.wlanMetrics = metrics
+	if m.PubWlanMetrics != nil {
+		m.Log.Functionf("PubWlanMetrics: %+v", metrics)
+		if err = m.PubWlanMetrics.Publish("global", metrics); err != nil {
+			m.Log.Errorf("Failed to publish wlan metrics: %v", err)
+		}
+	}
+}
@@ -42,4 +42,7 @@ const (
 	WlanTypename = "WLAN"
 	// WwanTypename : typename for WWAN (LTE) configuration (read by wwan microservice).
 	WwanTypename = "WWAN"
+	// WpaSupplicantTypename : typename for wpa_supplicant performing 802.1X
+	// authentication of a wired port.
+	WpaSupplicantTypename = "WPA-Supplicant"
 )
@@ -0,0 +1,382 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package genericitems
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/evetpm"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	wpaSupplicantBinary       = "/sbin/wpa_supplicant"
+	wpaSupplicantStartTimeout = 3 * time.Second
+	wpaSupplicantStopTimeout  = 10 * time.Second
+	// Bit of the bridge group_fwd_mask enabling forwarding of EAPOL frames
+	// (destination MAC 01:80:C2:00:00:03).
+	eapolGroupFwdBit = 1 << 3
+)
+
+// WpaSupplicant : wpa_supplicant with the wired driver, performing IEEE 802.1X
+// authentication of an Ethernet port.
+type WpaSupplicant struct {
+	// AdapterLL : Adapter's logical label.
+	AdapterLL     string
+	AdapterIfName string
+	EAPMethod     types.EAPMethod
+	// Identity presented to the authenticator. With EAP-TLS it defaults
+	// to the common name of the device certificate.
+	// With PEAP it is used as the outer (anonymous) identity.
+	Identity string
+	// Username and Password are used with PEAP only.
+	Username string
+	Password string
+	// CACertPEM is used to verify the authentication server.
+	CACertPEM          string
+	ServerDomainSuffix string
+}
+
+// Name is based on the adapter interface name (one supplicant per interface).
+func (s WpaSupplicant) Name() string {
+	return s.AdapterIfName
+}
+
+// Label is more human-readable than name.
+func (s WpaSupplicant) Label() string {
+	return "802.1X supplicant for " + s.AdapterLL
+}
+
+// Type of the item.
+func (s WpaSupplicant) Type() string {
+	return WpaSupplicantTypename
+}
+
+// Equal is a comparison method for two equally-named WpaSupplicant instances.
+func (s WpaSupplicant) Equal(other depgraph.Item) bool {
+	s2 := other.(WpaSupplicant)
+	return s == s2
+}
+
+// External returns false.
+func (s WpaSupplicant) External() bool {
+	return false
+}
+
+// String describes the supplicant config (without revealing the password).
+func (s WpaSupplicant) String() string {
+	var password string
+	if s.Password != "" {
+		password = "<hidden>"
+	}
+	return fmt.Sprintf("802.1X supplicant: {AdapterLL: %s, AdapterIfName: %s, "+
+		"EAPMethod: %s, Identity: %s, Username: %s, Password: %s, "+
+		"HasCACert: %t, ServerDomainSuffix: %s}", s.AdapterLL, s.AdapterIfName,
+		s.EAPMethod, s.Identity, s.Username, password, s.CACertPEM != "",
+		s.ServerDomainSuffix)
+}
+
+// Dependencies lists the adapter as the only dependency of the supplicant.
+func (s WpaSupplicant) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: AdapterTypename,
+				ItemName: s.AdapterIfName,
+			},
+			Description: "Network adapter must exist",
+		},
+	}
+}
+
+// WpaSupplicantConfigurator implements Configurator interface (libs/reconciler)
+// for wpa_supplicant running on wired ports.
+type WpaSupplicantConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create starts wpa_supplicant.
+func (c *WpaSupplicantConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	supplicant := item.(WpaSupplicant)
+	ifName := supplicant.AdapterIfName
+	if c.supplicantExists(ifName) {
+		err := fmt.Errorf("wpa_supplicant for interface %s is already running", ifName)
+		c.Log.Error(err)
+		return err
+	}
+	if err := c.installConfig(supplicant); err != nil {
+		return err
+	}
+	// Adapter is typically a bridge with the physical interface enslaved.
+	// By default, bridge does not pass EAPOL frames up to the bridge interface,
+	// which has the MAC address of the port and is used by wpa_supplicant.
+	if err := c.setEAPOLForwarding(ifName, true); err != nil {
+		return err
+	}
+	done := reconciler.ContinueInBackground(ctx)
+	go func() {
+		args := []string{"-B", "-D", "wired", "-i", ifName,
+			"-c", devicenetwork.WpaWiredConfigPath(ifName),
+			"-P", devicenetwork.WpaWiredPidPath(ifName)}
+		c.Log.Functionf("Calling command %s %v\n", wpaSupplicantBinary, args)
+		out, err := base.Exec(c.Log, wpaSupplicantBinary, args...).CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("wpa_supplicant command %s failed: %s; output: %s",
+				args, err, out)
+			c.Log.Error(err)
+			done(err)
+			return
+		}
+		// Wait for a bit then give up
+		startTime := time.Now()
+		for !c.supplicantExists(ifName) {
+			if time.Since(startTime) > wpaSupplicantStartTimeout {
+				err = fmt.Errorf("wpa_supplicant for interface %s failed to start in time",
+					ifName)
+				c.Log.Error(err)
+				done(err)
+				return
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+		c.Log.Functionf("wpa_supplicant for interface %s is running", ifName)
+		done(nil)
+	}()
+	return nil
+}
+
+// Modify is not implemented.
+func (c *WpaSupplicantConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete stops wpa_supplicant.
+func (c *WpaSupplicantConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	supplicant := item.(WpaSupplicant)
+	ifName := supplicant.AdapterIfName
+	done := reconciler.ContinueInBackground(ctx)
+	go func() {
+		defer c.removeConfig(ifName)
+		defer func() {
+			_ = c.setEAPOLForwarding(ifName, false)
+		}()
+		pid, err := c.readPid(ifName)
+		if err != nil {
+			// Not running.
+			c.Log.Warnf("wpa_supplicant for interface %s: %v", ifName, err)
+			done(nil)
+			return
+		}
+		if err = syscall.Kill(pid, syscall.SIGTERM); err != nil {
+			c.Log.Warnf("Failed to send SIGTERM to wpa_supplicant for interface %s: %v",
+				ifName, err)
+		}
+		startTime := time.Now()
+		for c.supplicantExists(ifName) {
+			if time.Since(startTime) > wpaSupplicantStopTimeout {
+				c.Log.Errorf("wpa_supplicant for interface %s is still running, "+
+					"will kill it, elapsed time %v", ifName, time.Since(startTime))
+				_ = syscall.Kill(pid, syscall.SIGKILL)
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+		c.Log.Functionf("wpa_supplicant for interface %s is gone, elapsed time %v",
+			ifName, time.Since(startTime))
+		done(nil)
+	}()
+	return nil
+}
+
+// NeedsRecreate returns true because Modify is not implemented.
+func (c *WpaSupplicantConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
+
+func (c *WpaSupplicantConfigurator) installConfig(supplicant WpaSupplicant) error {
+	ifName := supplicant.AdapterIfName
+	for _, dir := range []string{devicenetwork.RunWpaWiredDir,
+		devicenetwork.WpaWiredCtrlInterface} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			err = fmt.Errorf("failed to create directory %s: %w", dir, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	if supplicant.CACertPEM != "" {
+		caCertPath := devicenetwork.WpaWiredCACertPath(ifName)
+		err := os.WriteFile(caCertPath, []byte(supplicant.CACertPEM), 0600)
+		if err != nil {
+			err = fmt.Errorf("failed to write file %s: %w", caCertPath, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	identity := supplicant.Identity
+	if identity == "" && supplicant.EAPMethod == types.EAPMethodTLS {
+		var err error
+		identity, err = deviceCertCommonName()
+		if err != nil {
+			// Authenticator may still accept an empty identity.
+			c.Log.Warnf("Failed to get identity from the device certificate: %v", err)
+		}
+	}
+	config := WpaSupplicantConfig(supplicant, identity, evetpm.IsTpmEnabled())
+	configPath := devicenetwork.WpaWiredConfigPath(ifName)
+	// Config may contain the PEAP password.
+	if err := os.WriteFile(configPath, []byte(config), 0600); err != nil {
+		err = fmt.Errorf("failed to write file %s: %w", configPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *WpaSupplicantConfigurator) removeConfig(ifName string) {
+	for _, file := range []string{
+		devicenetwork.WpaWiredConfigPath(ifName),
+		devicenetwork.WpaWiredCACertPath(ifName),
+		devicenetwork.WpaWiredPidPath(ifName),
+	} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			c.Log.Warnf("Failed to remove file %s: %v", file, err)
+		}
+	}
+}
+
+// setEAPOLForwarding enables or disables forwarding of EAPOL frames
+// by the bridge with the given name. Does nothing if the interface is not a bridge.
+func (c *WpaSupplicantConfigurator) setEAPOLForwarding(brIfName string, enable bool) error {
+	maskPath := fmt.Sprintf("/sys/class/net/%s/bridge/group_fwd_mask", brIfName)
+	content, err := os.ReadFile(maskPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Not a bridge.
+			return nil
+		}
+		err = fmt.Errorf("failed to read %s: %w", maskPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	mask, err := strconv.ParseUint(strings.TrimSpace(string(content)), 0, 16)
+	if err != nil {
+		err = fmt.Errorf("failed to parse %s: %w", maskPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	if enable {
+		mask |= eapolGroupFwdBit
+	} else {
+		mask &^= eapolGroupFwdBit
+	}
+	err = os.WriteFile(maskPath, []byte(strconv.FormatUint(mask, 10)), 0644)
+	if err != nil {
+		err = fmt.Errorf("failed to write %s: %w", maskPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *WpaSupplicantConfigurator) readPid(ifName string) (int, error) {
+	content, err := os.ReadFile(devicenetwork.WpaWiredPidPath(ifName))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
+
+func (c *WpaSupplicantConfigurator) supplicantExists(ifName string) bool {
+	pid, err := c.readPid(ifName)
+	if err != nil {
+		return false
+	}
+	// Does the pid exist?
+	return syscall.Kill(pid, syscall.Signal(0)) == nil
+}
+
+// deviceCertCommonName returns the common name of the device certificate.
+func deviceCertCommonName() (string, error) {
+	certBytes, err := os.ReadFile(types.DeviceCertName)
+	if err != nil {
+		return "", err
+	}
+	block, _ := pem.Decode(certBytes)
+	if block == nil {
+		return "", fmt.Errorf("failed to decode PEM from %s", types.DeviceCertName)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return cert.Subject.CommonName, nil
+}
+
+// WpaSupplicantConfig generates wpa_supplicant.conf for 802.1X authentication
+// of a wired port. Exposed only for unit-testing purposes.
+func WpaSupplicantConfig(supplicant WpaSupplicant, identity string, useTPM bool) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("ctrl_interface=%s\n", devicenetwork.WpaWiredCtrlInterface))
+	// Wired driver does not scan.
+	sb.WriteString("ap_scan=0\n")
+	sb.WriteString("network={\n")
+	sb.WriteString("\tkey_mgmt=IEEE8021X\n")
+	// Do not expect dynamic WEP keys from the authenticator.
+	sb.WriteString("\teapol_flags=0\n")
+	switch supplicant.EAPMethod {
+	case types.EAPMethodPEAP:
+		sb.WriteString("\teap=PEAP\n")
+		sb.WriteString(fmt.Sprintf("\tidentity=%s\n", wpaString(supplicant.Username)))
+		if identity != "" {
+			sb.WriteString(fmt.Sprintf("\tanonymous_identity=%s\n", wpaString(identity)))
+		}
+		sb.WriteString(fmt.Sprintf("\tpassword=%s\n", wpaString(supplicant.Password)))
+		sb.WriteString("\tphase2=\"auth=MSCHAPV2\"\n")
+	default:
+		sb.WriteString("\teap=TLS\n")
+		if identity != "" {
+			sb.WriteString(fmt.Sprintf("\tidentity=%s\n", wpaString(identity)))
+		}
+		sb.WriteString(fmt.Sprintf("\tclient_cert=\"%s\"\n", types.DeviceCertName))
+		if useTPM {
+			sb.WriteString("\tengine=1\n")
+			sb.WriteString(fmt.Sprintf("\tengine_id=\"%s\"\n",
+				devicenetwork.WpaWiredTPMEngineID))
+			sb.WriteString(fmt.Sprintf("\tkey_id=\"0x%X\"\n",
+				uint32(evetpm.TpmDeviceKeyHdl)))
+		} else {
+			sb.WriteString(fmt.Sprintf("\tprivate_key=\"%s\"\n", types.DeviceKeyName))
+		}
+	}
+	if supplicant.CACertPEM != "" {
+		sb.WriteString(fmt.Sprintf("\tca_cert=\"%s\"\n",
+			devicenetwork.WpaWiredCACertPath(supplicant.AdapterIfName)))
+	}
+	if supplicant.ServerDomainSuffix != "" {
+		sb.WriteString(fmt.Sprintf("\tdomain_suffix_match=%s\n",
+			wpaString(supplicant.ServerDomainSuffix)))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// wpaString encodes string as hex so that wpa_supplicant.conf does not need
+// to escape special characters.
+func wpaString(s string) string {
+	return hex.EncodeToString([]byte(s))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package genericitems_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	configitems "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/genericitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestWpaSupplicantConfigEAPTLS(t *testing.T) {
+	supplicant := configitems.WpaSupplicant{
+		AdapterLL:          "eth0",
+		AdapterIfName:      "eth0",
+		EAPMethod:          types.EAPMethodTLS,
+		CACertPEM:          "-----BEGIN CERTIFICATE-----",
+		ServerDomainSuffix: "radius.example.com",
+	}
+	expLines := []string{
+		"ap_scan=0",
+		"key_mgmt=IEEE8021X",
+		"eap=TLS",
+		"identity=" + hex.EncodeToString([]byte("device-cn")),
+		"client_cert=\"" + types.DeviceCertName + "\"",
+		"engine=1",
+		"engine_id=\"tpm2tss\"",
+		"key_id=\"0x817FFFFF\"",
+		"ca_cert=\"/run/wpa_supplicant-wired/eth0-ca.pem\"",
+		"domain_suffix_match=" + hex.EncodeToString([]byte("radius.example.com")),
+	}
+	config := configitems.WpaSupplicantConfig(supplicant, "device-cn", true)
+	for _, line := range expLines {
+		if !strings.Contains(config, line) {
+			t.Errorf("config is missing %q:\n%s", line, config)
+		}
+	}
+	if strings.Contains(config, "private_key") {
+		t.Errorf("private_key is not expected with TPM:\n%s", config)
+	}
+
+	config = configitems.WpaSupplicantConfig(supplicant, "device-cn", false)
+	if !strings.Contains(config, "private_key=\""+types.DeviceKeyName+"\"") ||
+		strings.Contains(config, "engine") {
+		t.Errorf("expected device key from file without TPM:\n%s", config)
+	}
+}
+
+func TestWpaSupplicantConfigPEAP(t *testing.T) {
+	supplicant := configitems.WpaSupplicant{
+		AdapterLL:     "eth1",
+		AdapterIfName: "eth1",
+		EAPMethod:     types.EAPMethodPEAP,
+		Username:      "user",
+		Password:      "pa\"ss",
+	}
+	config := configitems.WpaSupplicantConfig(supplicant, "anonymous", false)
+	expLines := []string{
+		"eap=PEAP",
+		"identity=" + hex.EncodeToString([]byte("user")),
+		"anonymous_identity=" + hex.EncodeToString([]byte("anonymous")),
+		"password=" + hex.EncodeToString([]byte("pa\"ss")),
+		"phase2=\"auth=MSCHAPV2\"",
+	}
+	for _, line := range expLines {
+		if !strings.Contains(config, line) {
+			t.Errorf("config is missing %q:\n%s", line, config)
+		}
+	}
+	if strings.Contains(config, "client_cert") || strings.Contains(config, "ca_cert") {
+		t.Errorf("unexpected certificates in PEAP config:\n%s", config)
+	}
+	if strings.Contains(supplicant.String(), supplicant.Password) {
+		t.Errorf("password revealed by String(): %s", supplicant.String())
+	}
+}
@@ -105,7 +105,9 @@ func (c *WwanConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recrea
 func (c *WwanConfigurator) installWwanConfig(config types.WwanConfig) (err error) {
 	c.Log.Noticef("installWwanConfig: write file %s with config %+v",
 		devicenetwork.WwanConfigPath, config)
-	file, err := os.Create(devicenetwork.WwanConfigPath)
+	// Config may contain cellular credentials and SIM PIN.
+	file, err := os.OpenFile(devicenetwork.WwanConfigPath,
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
 	if err != nil {
 		err = fmt.Errorf("failed to create file %s: %w",
 			devicenetwork.WwanConfigPath, err)
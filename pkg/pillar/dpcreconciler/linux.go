@@ -24,6 +24,7 @@ import (
 	generic "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/genericitems"
 	linux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
 )
 
@@ -67,6 +68,9 @@ import (
 //	|  |  | +------------+   +------------+        |   +-------------------------------+  |  |
 //	|  |  | | DhcpClient |   | DhcpClient | ...    |   |            Routes             |  |  |
 //	|  |  | +------------+   +------------+        |   |                               |  |  |
+//	|  |  | +---------------+                      |   |                               |  |  |
+//	|  |  | | WpaSupplicant | ...                  |   |                               |  |  |
+//	|  |  | +---------------+                      |   |                               |  |  |
 //	|  |  | +------------------------------------+ |   | +-------+  +-------+          |  |  |
 //	|  |  | |            AdapterAddrs            | |   | | Route |  | Route | ...      |  |  |
 //	|  |  | |                                    | |   | +-------+  +-------+          |  |  |
@@ -188,6 +192,9 @@ type LinuxDpcReconciler struct {
 	radioSilence types.RadioSilence
 
 	HVTypeKube bool
+	// UseNftables : apply firewall rules using nftables instead of iptables.
+	// See pkg/pillar/nftables.
+	UseNftables bool
 }
 
 type pendingReconcile struct {
@@ -232,6 +239,9 @@ func (r *LinuxDpcReconciler) init() (startWatcher func()) {
 	if err := iptables.RegisterItems(r.Log, registry); err != nil {
 		r.Log.Fatal(err)
 	}
+	if err := nftables.RegisterItems(r.Log, registry); err != nil {
+		r.Log.Fatal(err)
+	}
 	r.registry = registry
 	configurator := registry.GetConfigurator(generic.Wwan{})
 	r.wwanConfigurator = configurator.(*generic.WwanConfigurator)
@@ -980,14 +990,21 @@ func (r *LinuxDpcReconciler) getIntendedAdapters(dpc types.DevicePortConfig) dg.
 				Usage:      generic.IOUsageL3Adapter,
 			}, nil)
 		}
+		// WiFi interface operated as access point is bridged into a network
+		// instance, it must not run DHCP client.
 		if port.Dhcp != types.DT_NONE &&
-			port.WirelessCfg.WType != types.WirelessTypeCellular {
+			port.WirelessCfg.WType != types.WirelessTypeCellular &&
+			!port.WirelessCfg.IsWifiAccessPoint() {
 			intendedAdapters.PutItem(generic.Dhcpcd{
 				AdapterLL:     port.Logicallabel,
 				AdapterIfName: port.IfName,
 				DhcpConfig:    port.DhcpConfig,
 			}, nil)
 		}
+		if port.PortAuth.Enabled &&
+			port.WirelessCfg.WType == types.WirelessTypeNone {
+			intendedAdapters.PutItem(r.getIntendedWpaSupplicant(port), nil)
+		}
 		// Inside the intended state the external items (like AdapterAddrs)
 		// are only informatory, hence ignore any errors below.
 		if ifIndex, found, _ := r.NetworkMonitor.GetInterfaceIndex(port.IfName); found {
@@ -1004,6 +1021,61 @@ func (r *LinuxDpcReconciler) getIntendedAdapters(dpc types.DevicePortConfig) dg.
 	return intendedAdapters
 }
 
+func (r *LinuxDpcReconciler) getIntendedWpaSupplicant(
+	port types.NetworkPortConfig) generic.WpaSupplicant {
+	supplicant := generic.WpaSupplicant{
+		AdapterLL:          port.Logicallabel,
+		AdapterIfName:      port.IfName,
+		EAPMethod:          port.PortAuth.EAPMethod,
+		Identity:           port.PortAuth.Identity,
+		CACertPEM:          port.PortAuth.CACertPEM,
+		ServerDomainSuffix: port.PortAuth.ServerDomainSuffix,
+	}
+	if port.PortAuth.EAPMethod == types.EAPMethodPEAP {
+		supplicant.Username, supplicant.Password = r.getPortAuthCredentials(
+			port.Logicallabel, port.PortAuth)
+	}
+	return supplicant
+}
+
+// getPortAuthCredentials decrypts username and password configured for PEAP
+// authentication of a wired port. There is no cleartext fallback.
+func (r *LinuxDpcReconciler) getPortAuthCredentials(logicalLabel string,
+	portAuth types.PortAuthConfig) (username, password string) {
+	if !portAuth.CipherBlockStatus.IsCipher {
+		r.Log.Warnf("%s, 802.1X credentials are not provided", logicalLabel)
+		return "", ""
+	}
+	decryptAvailable := r.SubControllerCert != nil && r.SubEdgeNodeCert != nil
+	if !decryptAvailable {
+		r.Log.Warnf("%s, context for decryption of 802.1X credentials "+
+			"is not available", logicalLabel)
+		return "", ""
+	}
+	status, decBlock, err := cipher.GetCipherCredentials(
+		&cipher.DecryptCipherContext{
+			Log:               r.Log,
+			AgentName:         r.AgentName,
+			AgentMetrics:      r.CipherMetrics,
+			SubControllerCert: r.SubControllerCert,
+			SubEdgeNodeCert:   r.SubEdgeNodeCert,
+		},
+		portAuth.CipherBlockStatus)
+	if r.PubCipherBlockStatus != nil {
+		r.PubCipherBlockStatus.Publish(status.Key(), status)
+	}
+	if err != nil {
+		r.Log.Errorf("%s, 802.1X credentials decryption was unsuccessful: %v",
+			logicalLabel, err)
+		if r.CipherMetrics != nil {
+			r.CipherMetrics.RecordFailure(r.Log, types.MissingFallback)
+		}
+		return "", ""
+	}
+	r.Log.Functionf("%s, 802.1X credentials decryption was successful", logicalLabel)
+	return decBlock.PortAuthUsername, decBlock.PortAuthPassword
+}
+
 func (r *LinuxDpcReconciler) getIntendedSrcIPRules(dpc types.DevicePortConfig) dg.Graph {
 	graphArgs := dg.InitArgs{
 		Name:        IPRulesSG,
@@ -1233,7 +1305,11 @@ func (r *LinuxDpcReconciler) getIntendedWlanConfig(
 		}
 	}
 	var wifiConfig []linux.WifiConfig
-	if wifiPort != nil {
+	var apMode bool
+	if wifiPort != nil && wifiPort.WirelessCfg.IsWifiAccessPoint() {
+		// Access point is operated by zedrouter (as part of a network instance).
+		apMode = true
+	} else if wifiPort != nil {
 		for _, wifi := range wifiPort.WirelessCfg.Wifi {
 			credentials, err := r.getWifiCredentials(wifi)
 			if err != nil {
@@ -1248,6 +1324,7 @@ func (r *LinuxDpcReconciler) getIntendedWlanConfig(
 	return linux.Wlan{
 		Config:   wifiConfig,
 		EnableRF: wifiPort != nil && !radioSilence,
+		APMode:   apMode,
 	}
 }
 
@@ -1306,6 +1383,48 @@ func (r *LinuxDpcReconciler) getWifiCredentials(wifi types.WifiConfig) (types.En
 	return decBlock, nil
 }
 
+// getCellularCredentials decrypts username, password and SIM PIN configured
+// for a cellular access point. Unlike with WiFi, there is no cleartext fallback.
+func (r *LinuxDpcReconciler) getCellularCredentials(logicalLabel string,
+	ap types.CellularAccessPoint) (creds types.WwanCredentials) {
+	if !ap.CipherBlockStatus.IsCipher {
+		return creds
+	}
+	decryptAvailable := r.SubControllerCert != nil && r.SubEdgeNodeCert != nil
+	if !decryptAvailable {
+		r.Log.Warnf("%s (APN %s), context for decryption of cellular credentials "+
+			"is not available", logicalLabel, ap.APN)
+		return creds
+	}
+	status, decBlock, err := cipher.GetCipherCredentials(
+		&cipher.DecryptCipherContext{
+			Log:               r.Log,
+			AgentName:         r.AgentName,
+			AgentMetrics:      r.CipherMetrics,
+			SubControllerCert: r.SubControllerCert,
+			SubEdgeNodeCert:   r.SubEdgeNodeCert,
+		},
+		ap.CipherBlockStatus)
+	if r.PubCipherBlockStatus != nil {
+		r.PubCipherBlockStatus.Publish(status.Key(), status)
+	}
+	if err != nil {
+		r.Log.Errorf("%s (APN %s), cellular credentials decryption was unsuccessful: %v",
+			logicalLabel, ap.APN, err)
+		if r.CipherMetrics != nil {
+			r.CipherMetrics.RecordFailure(r.Log, types.MissingFallback)
+		}
+		return creds
+	}
+	r.Log.Functionf("%s (APN %s), cellular credentials decryption was successful",
+		logicalLabel, ap.APN)
+	return types.WwanCredentials{
+		Username: decBlock.CellularNetUsername,
+		Password: decBlock.CellularNetPassword,
+		SimPIN:   decBlock.CellularSimPin,
+	}
+}
+
 func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 	aa types.AssignableAdapters, radioSilence bool) dg.Item {
 	config := types.WwanConfig{RadioSilence: radioSilence, Networks: []types.WwanNetworkConfig{}}
@@ -1326,6 +1445,23 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 		}
 		// XXX Limited to a single APN for now
 		cellCfg := port.WirelessCfg.Cellular[0]
+		var accessPoints []types.WwanAccessPoint
+		for _, ap := range cellCfg.AccessPoints {
+			accessPoints = append(accessPoints, types.WwanAccessPoint{
+				SIMSlot:        ap.SIMSlot,
+				APN:            ap.APN,
+				AuthProtocol:   ap.AuthProtocol,
+				IPType:         ap.IPType,
+				Credentials:    r.getCellularCredentials(port.Logicallabel, ap),
+				PreferredPLMNs: ap.PreferredPLMNs,
+				ForbidRoaming:  ap.ForbidRoaming,
+				PreferredRATs:  ap.PreferredRATs,
+			})
+		}
+		apn := cellCfg.APN
+		if len(accessPoints) > 0 {
+			apn = accessPoints[0].APN
+		}
 		network := types.WwanNetworkConfig{
 			LogicalLabel: port.Logicallabel,
 			PhysAddrs: types.WwanPhysAddrs{
@@ -1333,13 +1469,16 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 				USB:       ioBundle.UsbAddr,
 				PCI:       ioBundle.PciLong,
 			},
-			Apns:    []string{cellCfg.APN},
+			Apns:    []string{apn},
 			Proxies: port.Proxies,
 			Probe: types.WwanProbe{
 				Disable: cellCfg.DisableProbe,
 				Address: cellCfg.ProbeAddr,
 			},
 			LocationTracking: cellCfg.LocationTracking,
+			AccessPoints:     accessPoints,
+			ActivatedSimSlot: cellCfg.ActivatedSimSlot,
+			SimFailover:      cellCfg.SimFailover,
 		}
 		config.Networks = append(config.Networks, network)
 	}
@@ -1723,5 +1862,8 @@ func (r *LinuxDpcReconciler) getIntendedACLs(
 		outputRule.ForIPv6 = true
 		intendedIPv6ACLs.PutItem(outputRule, nil)
 	}
+	if r.UseNftables {
+		nftables.LowerGraph(intendedACLs)
+	}
 	return intendedACLs
 }
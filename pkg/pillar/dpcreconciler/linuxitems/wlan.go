@@ -23,6 +23,10 @@ type Wlan struct {
 	Config []WifiConfig
 	// EnableRF : Enable or disable radio transmission.
 	EnableRF bool
+	// APMode : WiFi adapter operates in the access point mode.
+	// Access point is managed by zedrouter (using hostapd) and wpa_supplicant
+	// should not be running.
+	APMode bool
 }
 
 // WifiConfig : WiFi configuration.
@@ -56,7 +60,8 @@ func (w Wlan) Type() string {
 func (w Wlan) Equal(other depgraph.Item) bool {
 	w2 := other.(Wlan)
 	return reflect.DeepEqual(w.Config, w2.Config) &&
-		w.EnableRF == w2.EnableRF
+		w.EnableRF == w2.EnableRF &&
+		w.APMode == w2.APMode
 }
 
 // External returns false.
@@ -66,8 +71,8 @@ func (w Wlan) External() bool {
 
 // String describes the WLAN configuration.
 func (w Wlan) String() string {
-	return fmt.Sprintf("WLAN configuration: %v, enable RF: %t",
-		w.Config, w.EnableRF)
+	return fmt.Sprintf("WLAN configuration: %v, enable RF: %t, AP mode: %t",
+		w.Config, w.EnableRF, w.APMode)
 }
 
 // Dependencies returns nothing.
@@ -85,7 +90,7 @@ type WlanConfigurator struct {
 // Create installs wpa_supplicant.conf.
 func (c *WlanConfigurator) Create(ctx context.Context, item depgraph.Item) error {
 	wlan := item.(Wlan)
-	err := c.installWifiConfig(wlan.Config)
+	err := c.installWifiConfig(wlan.Config, wlan.APMode)
 	if err != nil {
 		return err
 	}
@@ -95,7 +100,7 @@ func (c *WlanConfigurator) Create(ctx context.Context, item depgraph.Item) error
 // Modify updates the content of wpa_supplicant.conf.
 func (c *WlanConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) error {
 	wlan := newItem.(Wlan)
-	err := c.installWifiConfig(wlan.Config)
+	err := c.installWifiConfig(wlan.Config, wlan.APMode)
 	if err != nil {
 		return err
 	}
@@ -104,7 +109,7 @@ func (c *WlanConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph
 
 // Delete clears previously installed wpa file.
 func (c *WlanConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
-	err := c.installWifiConfig([]WifiConfig{})
+	err := c.installWifiConfig([]WifiConfig{}, false)
 	if err != nil {
 		return err
 	}
@@ -116,7 +121,7 @@ func (c *WlanConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recrea
 	return false
 }
 
-func (c *WlanConfigurator) installWifiConfig(config []WifiConfig) error {
+func (c *WlanConfigurator) installWifiConfig(config []WifiConfig, apMode bool) error {
 	if _, err := os.Stat(devicenetwork.RunWlanDir); os.IsNotExist(err) {
 		err = os.Mkdir(devicenetwork.RunWlanDir, 600)
 		if err != nil {
@@ -141,11 +146,19 @@ func (c *WlanConfigurator) installWifiConfig(config []WifiConfig) error {
 		c.Log.Error(err)
 		return err
 	}
-	if len(config) == 0 {
+	if apMode {
+		// The wlan service looks for this comment and stops wpa_supplicant
+		// to give hostapd (started by zedrouter) exclusive access to the adapter.
+		tmpfile.WriteString(devicenetwork.WpaAPModeMarker + "\n")
+	} else if len(config) == 0 {
 		// generate dummy wpa_supplicant.conf
 		tmpfile.WriteString("# No WiFi config received\n")
 	} else {
 		tmpfile.WriteString("# Automatically generated\n")
+		// Control interface is used by the wlan service to collect status
+		// and scan results (using wpa_cli).
+		tmpfile.WriteString(
+			fmt.Sprintf("ctrl_interface=%s\n", devicenetwork.WpaCtrlInterface))
 		for _, wifi := range config {
 			tmpfile.WriteString("network={\n")
 			s := fmt.Sprintf("        ssid=\"%s\"\n", wifi.SSID)
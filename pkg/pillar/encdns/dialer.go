@@ -0,0 +1,286 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encdns
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	dohContentType = "application/dns-message"
+	maxDNSMsgLen   = 65535
+)
+
+// Dialer opens connections with encrypted DNS resolvers.
+// A single Dialer should be used for one name resolution (or for one HTTP request).
+// It remembers which resolvers have failed and once all of them have failed,
+// it falls back to plain DNS (if allowed by the config).
+type Dialer struct {
+	config  types.EncryptedDNSConfig
+	servers []Server
+	rootCAs *x509.CertPool // nil to use the system root CAs
+
+	sync.Mutex
+	failed  map[string]error // key: Server.String()
+	lastErr error
+	status  types.DNSResolverStatus
+}
+
+// NewDialer creates a new Dialer for the given config.
+// Built-in public resolvers are used if config does not specify any.
+func NewDialer(config types.EncryptedDNSConfig) *Dialer {
+	d := &Dialer{
+		config: config,
+		failed: make(map[string]error),
+	}
+	if config.Protocol == types.EncryptedDNSNone {
+		return d
+	}
+	servers := config.Servers
+	if len(servers) == 0 {
+		servers = BootstrapServers[config.Protocol]
+	}
+	for _, server := range servers {
+		parsed, err := ParseServer(config.Protocol, server)
+		if err != nil {
+			d.lastErr = err
+			continue
+		}
+		d.servers = append(d.servers, parsed)
+	}
+	if len(d.servers) == 0 && d.lastErr == nil {
+		d.lastErr = errors.New("no encrypted DNS resolver is configured")
+	}
+	return d
+}
+
+// DialNameserver opens connection with an encrypted DNS resolver, or with
+// the given plain-DNS nameserver if encrypted DNS is disabled or is not available
+// and fallback is allowed.
+// dial is used to open the underlying network connections.
+// The function signature matches nettrace.NameserverDialer.
+func (d *Dialer) DialNameserver(ctx context.Context, dial nettrace.DialFunc,
+	network, address string) (conn net.Conn, protocol, nameserver string, err error) {
+	if d.config.Protocol == types.EncryptedDNSNone {
+		d.recordUsed(types.DNSResolverStatus{Server: address}, nil)
+		conn, err = dial(ctx, network, address)
+		return conn, "", address, err
+	}
+	for _, server := range d.servers {
+		if d.hasFailed(server) {
+			continue
+		}
+		conn, err = d.dialServer(ctx, dial, server)
+		if err != nil {
+			d.markFailed(server, err)
+			continue
+		}
+		d.recordUsed(types.DNSResolverStatus{
+			Protocol: server.Protocol,
+			Server:   server.String(),
+		}, nil)
+		conn = &encConn{Conn: conn, onError: func(err error) {
+			d.markFailed(server, err)
+		}}
+		return conn, server.Protocol.String(), server.String(), nil
+	}
+	// None of the encrypted DNS resolvers is available.
+	d.Lock()
+	lastErr := d.lastErr
+	d.Unlock()
+	if !d.config.AllowFallback {
+		err = fmt.Errorf("encrypted DNS (%v) is not available: %w",
+			d.config.Protocol, lastErr)
+		d.recordUsed(types.DNSResolverStatus{Protocol: d.config.Protocol}, err)
+		return nil, d.config.Protocol.String(), "", err
+	}
+	d.recordUsed(types.DNSResolverStatus{Server: address, Fallback: true}, lastErr)
+	conn, err = dial(ctx, network, address)
+	return conn, "", address, err
+}
+
+// ResolverDial returns function to be used as net.Resolver.Dial.
+// dial is used to open the underlying network connections.
+func (d *Dialer) ResolverDial(dial nettrace.DialFunc) func(
+	ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		conn, _, _, err := d.DialNameserver(ctx, dial, network, address)
+		return conn, err
+	}
+}
+
+// Status returns the DNS resolver used most recently.
+// Returns zero value if no resolver has been used yet.
+func (d *Dialer) Status() types.DNSResolverStatus {
+	d.Lock()
+	defer d.Unlock()
+	return d.status
+}
+
+func (d *Dialer) hasFailed(server Server) bool {
+	d.Lock()
+	defer d.Unlock()
+	_, failed := d.failed[server.String()]
+	return failed
+}
+
+func (d *Dialer) markFailed(server Server, err error) {
+	d.Lock()
+	defer d.Unlock()
+	err = fmt.Errorf("%v resolver %s failed: %w", server.Protocol, server, err)
+	d.failed[server.String()] = err
+	d.lastErr = err
+	d.status.EncryptedDNSError = err.Error()
+}
+
+func (d *Dialer) recordUsed(status types.DNSResolverStatus, err error) {
+	d.Lock()
+	defer d.Unlock()
+	if err != nil {
+		status.EncryptedDNSError = err.Error()
+	} else {
+		status.EncryptedDNSError = d.status.EncryptedDNSError
+	}
+	status.LastUsed = time.Now()
+	d.status = status
+}
+
+func (d *Dialer) dialServer(ctx context.Context, dial nettrace.DialFunc,
+	server Server) (net.Conn, error) {
+	conn, err := dial(ctx, "tcp", server.Address)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		ServerName: server.TLSServerName,
+		RootCAs:    d.rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	if server.Protocol == types.EncryptedDNSOverHTTPS {
+		tlsConfig.NextProtos = []string{"http/1.1"}
+	}
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err = tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if server.Protocol == types.EncryptedDNSOverHTTPS {
+		return &dohConn{Conn: tlsConn, url: server.URL}, nil
+	}
+	return tlsConn, nil
+}
+
+// encConn reports errors of the connection with an encrypted DNS resolver
+// back to Dialer, which then avoids using the resolver again.
+type encConn struct {
+	net.Conn
+	onError func(error)
+}
+
+func (c *encConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if err != nil {
+		c.onError(err)
+	}
+	return n, err
+}
+
+func (c *encConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if err != nil {
+		c.onError(err)
+	}
+	return n, err
+}
+
+// dohConn translates DNS messages written (with two-byte length prefix,
+// as with DNS over TCP) into DNS-over-HTTPS POST requests.
+// DNS replies are returned (also prefixed with length) by Read.
+type dohConn struct {
+	net.Conn // TLS connection with the DoH server
+	url      string
+	reader   *bufio.Reader
+	query    []byte
+	reply    []byte
+}
+
+func (c *dohConn) Write(b []byte) (int, error) {
+	c.query = append(c.query, b...)
+	if len(c.query) < 2 {
+		return len(b), nil
+	}
+	msgLen := int(binary.BigEndian.Uint16(c.query))
+	if len(c.query) < 2+msgLen {
+		// Wait for the rest of the message.
+		return len(b), nil
+	}
+	msg := c.query[2 : 2+msgLen]
+	reply, err := c.exchange(msg)
+	c.query = c.query[2+msgLen:]
+	if err != nil {
+		return 0, err
+	}
+	c.reply = binary.BigEndian.AppendUint16(c.reply, uint16(len(reply)))
+	c.reply = append(c.reply, reply...)
+	return len(b), nil
+}
+
+func (c *dohConn) Read(b []byte) (int, error) {
+	if len(c.reply) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, c.reply)
+	c.reply = c.reply[n:]
+	return n, nil
+}
+
+func (c *dohConn) exchange(msg []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(msg))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+	if err = req.Write(c.Conn); err != nil {
+		return nil, err
+	}
+	if c.reader == nil {
+		c.reader = bufio.NewReader(c.Conn)
+	}
+	resp, err := http.ReadResponse(c.reader, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DoH server returned status %s", resp.Status)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != dohContentType {
+		return nil, fmt.Errorf("DoH server returned unexpected content type %q",
+			contentType)
+	}
+	reply, err := io.ReadAll(io.LimitReader(resp.Body, maxDNSMsgLen+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) > maxDNSMsgLen {
+		return nil, errors.New("DoH server returned too large DNS message")
+	}
+	return reply, nil
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encdns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+var testAnswer = net.ParseIP("192.0.2.10").To4()
+
+func TestParseServer(t *testing.T) {
+	tests := []struct {
+		protocol types.EncryptedDNSProtocol
+		server   string
+		expected Server
+		fail     bool
+	}{
+		{
+			protocol: types.EncryptedDNSOverTLS,
+			server:   "1.1.1.1#cloudflare-dns.com",
+			expected: Server{Protocol: types.EncryptedDNSOverTLS,
+				Address: "1.1.1.1:853", TLSServerName: "cloudflare-dns.com"},
+		},
+		{
+			protocol: types.EncryptedDNSOverTLS,
+			server:   "[2620:fe::fe]:8853",
+			expected: Server{Protocol: types.EncryptedDNSOverTLS,
+				Address: "[2620:fe::fe]:8853", TLSServerName: "2620:fe::fe"},
+		},
+		{
+			protocol: types.EncryptedDNSOverTLS,
+			server:   "2620:fe::fe",
+			expected: Server{Protocol: types.EncryptedDNSOverTLS,
+				Address: "[2620:fe::fe]:853", TLSServerName: "2620:fe::fe"},
+		},
+		{
+			protocol: types.EncryptedDNSOverTLS,
+			server:   "dns.quad9.net",
+			fail:     true,
+		},
+		{
+			protocol: types.EncryptedDNSOverHTTPS,
+			server:   "https://dns.quad9.net/dns-query#9.9.9.9",
+			expected: Server{Protocol: types.EncryptedDNSOverHTTPS,
+				Address: "9.9.9.9:443", TLSServerName: "dns.quad9.net",
+				URL: "https://dns.quad9.net/dns-query"},
+		},
+		{
+			protocol: types.EncryptedDNSOverHTTPS,
+			server:   "https://10.0.0.1:8443/dns-query",
+			expected: Server{Protocol: types.EncryptedDNSOverHTTPS,
+				Address: "10.0.0.1:8443", TLSServerName: "10.0.0.1",
+				URL: "https://10.0.0.1:8443/dns-query"},
+		},
+		{
+			protocol: types.EncryptedDNSOverHTTPS,
+			server:   "https://dns.quad9.net/dns-query",
+			fail:     true,
+		},
+		{
+			protocol: types.EncryptedDNSOverHTTPS,
+			server:   "http://10.0.0.1/dns-query",
+			fail:     true,
+		},
+	}
+	for _, test := range tests {
+		server, err := ParseServer(test.protocol, test.server)
+		if test.fail {
+			if err == nil {
+				t.Errorf("expected error for %q", test.server)
+			}
+			continue
+		}
+		if err != nil || server != test.expected {
+			t.Errorf("ParseServer(%q) = %+v, %v; expected %+v",
+				test.server, server, err, test.expected)
+		}
+	}
+	valid, errs := ParseServers(types.EncryptedDNSOverTLS, "1.1.1.1, dns.google,,9.9.9.9")
+	if len(valid) != 2 || len(errs) != 1 {
+		t.Errorf("unexpected result of ParseServers: %v, %v", valid, errs)
+	}
+}
+
+// answerQuery returns reply with testAnswer for the given DNS query.
+func answerQuery(t *testing.T, query []byte) []byte {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(query); err != nil {
+		t.Errorf("failed to parse DNS query: %v", err)
+		return nil
+	}
+	msg.Header.Response = true
+	for _, q := range msg.Questions {
+		if q.Type != dnsmessage.TypeA {
+			continue
+		}
+		msg.Answers = append(msg.Answers, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{
+				Name: q.Name, Type: q.Type, Class: q.Class, TTL: 60},
+			Body: &dnsmessage.AResource{A: [4]byte(testAnswer)},
+		})
+	}
+	msg.Additionals = nil
+	reply, err := msg.Pack()
+	if err != nil {
+		t.Errorf("failed to pack DNS reply: %v", err)
+	}
+	return reply
+}
+
+// serveDNSStream answers DNS queries received over a stream connection.
+func serveDNSStream(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+	for {
+		var msgLen uint16
+		if err := binary.Read(conn, binary.BigEndian, &msgLen); err != nil {
+			return
+		}
+		query := make([]byte, msgLen)
+		if _, err := io.ReadFull(conn, query); err != nil {
+			return
+		}
+		reply := answerQuery(t, query)
+		reply = append(binary.BigEndian.AppendUint16(nil, uint16(len(reply))), reply...)
+		if _, err := conn.Write(reply); err != nil {
+			return
+		}
+	}
+}
+
+func tlsServer(conn net.Conn, httpServer *httptest.Server) net.Conn {
+	return tls.Server(conn, httpServer.TLS)
+}
+
+func lookup(dialer *Dialer, dial func(ctx context.Context,
+	network, address string) (net.Conn, error)) ([]net.IP, error) {
+	resolver := net.Resolver{PreferGo: true, Dial: dialer.ResolverDial(dial)}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return resolver.LookupIP(ctx, "ip4", "controller.encdns.test.")
+}
+
+func TestDoH(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/dns-query" ||
+				r.Header.Get("Content-Type") != dohContentType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			query, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", dohContentType)
+			w.Write(answerQuery(t, query))
+		}))
+	defer server.Close()
+
+	dohURL := server.URL + "/dns-query"
+	dialer := NewDialer(types.EncryptedDNSConfig{
+		Protocol: types.EncryptedDNSOverHTTPS,
+		Servers:  []string{dohURL},
+	})
+	dialer.rootCAs = x509.NewCertPool()
+	dialer.rootCAs.AddCert(server.Certificate())
+	var netDialer net.Dialer
+	ips, err := lookup(dialer, netDialer.DialContext)
+	if err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+	if len(ips) != 1 || !ips[0].Equal(testAnswer) {
+		t.Errorf("unexpected lookup result: %v", ips)
+	}
+	status := dialer.Status()
+	if status.Protocol != types.EncryptedDNSOverHTTPS || status.Server != dohURL ||
+		status.Fallback || status.LastUsed.IsZero() {
+		t.Errorf("unexpected resolver status: %+v", status)
+	}
+}
+
+func TestDoT(t *testing.T) {
+	// Borrow TLS certificate from the httptest server.
+	httpServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer httpServer.Close()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveDNSStream(t, tlsServer(conn, httpServer))
+		}
+	}()
+
+	dotServer := listener.Addr().String() + "#example.com"
+	dialer := NewDialer(types.EncryptedDNSConfig{
+		Protocol: types.EncryptedDNSOverTLS,
+		Servers:  []string{dotServer},
+	})
+	dialer.rootCAs = x509.NewCertPool()
+	dialer.rootCAs.AddCert(httpServer.Certificate())
+	var netDialer net.Dialer
+	ips, err := lookup(dialer, netDialer.DialContext)
+	if err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+	if len(ips) != 1 || !ips[0].Equal(testAnswer) {
+		t.Errorf("unexpected lookup result: %v", ips)
+	}
+	status := dialer.Status()
+	if status.Protocol != types.EncryptedDNSOverTLS ||
+		status.Server != listener.Addr().String() || status.Fallback {
+		t.Errorf("unexpected resolver status: %+v", status)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	// Nothing listens on the DoT server address.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dotServer := listener.Addr().String()
+	listener.Close()
+
+	var plainDials int
+	var netDialer net.Dialer
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		if address == dotServer {
+			return netDialer.DialContext(ctx, network, address)
+		}
+		// Plain DNS nameserver.
+		plainDials++
+		client, server := net.Pipe()
+		go serveDNSStream(t, server)
+		return client, nil
+	}
+
+	config := types.EncryptedDNSConfig{
+		Protocol: types.EncryptedDNSOverTLS,
+		Servers:  []string{dotServer},
+	}
+	dialer := NewDialer(config)
+	if _, err = lookup(dialer, dial); err == nil {
+		t.Errorf("expected lookup to fail without fallback")
+	}
+	if plainDials != 0 {
+		t.Errorf("plain DNS was used without fallback")
+	}
+	status := dialer.Status()
+	if status.Fallback || !strings.Contains(status.EncryptedDNSError, dotServer) {
+		t.Errorf("unexpected resolver status: %+v", status)
+	}
+
+	config.AllowFallback = true
+	dialer = NewDialer(config)
+	ips, err := lookup(dialer, dial)
+	if err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+	if len(ips) != 1 || !ips[0].Equal(testAnswer) {
+		t.Errorf("unexpected lookup result: %v", ips)
+	}
+	if plainDials == 0 {
+		t.Errorf("plain DNS was not used")
+	}
+	status = dialer.Status()
+	if status.Protocol != types.EncryptedDNSNone || !status.Fallback ||
+		status.EncryptedDNSError == "" {
+		t.Errorf("unexpected resolver status: %+v", status)
+	}
+}
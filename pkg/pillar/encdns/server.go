@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package encdns implements DNS-over-TLS (RFC 7858) and DNS-over-HTTPS (RFC 8484)
+// transports used by EVE to resolve domain names of the controller and datastores.
+// Dialer is meant to be installed as the Dial function of net.Resolver
+// (or as nettrace.HTTPClientCfg.DialNameserver). It replaces connections
+// with plain-DNS nameservers (received from DHCP or configured statically)
+// by connections with encrypted DNS resolvers, optionally falling back
+// to plain DNS if none of the encrypted DNS resolvers is available.
+package encdns
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// DefaultDoTPort : default port of DNS-over-TLS resolvers.
+	DefaultDoTPort = 853
+	// DefaultDoHPort : default port of DNS-over-HTTPS resolvers.
+	DefaultDoHPort = 443
+)
+
+// BootstrapServers : public encrypted DNS resolvers used when none are configured.
+var BootstrapServers = map[types.EncryptedDNSProtocol][]string{
+	types.EncryptedDNSOverTLS: {
+		"1.1.1.1#cloudflare-dns.com",
+		"9.9.9.9#dns.quad9.net",
+	},
+	types.EncryptedDNSOverHTTPS: {
+		"https://cloudflare-dns.com/dns-query#1.1.1.1",
+		"https://dns.quad9.net/dns-query#9.9.9.9",
+	},
+}
+
+// Server : encrypted DNS resolver.
+type Server struct {
+	Protocol types.EncryptedDNSProtocol
+	// Address : "<ip>:<port>" of the resolver.
+	Address string
+	// TLSServerName : name used to verify the resolver certificate.
+	TLSServerName string
+	// URL of the DNS-over-HTTPS resolver (empty for DNS-over-TLS).
+	URL string
+}
+
+// String returns the resolver URL for DNS-over-HTTPS and the resolver
+// address for DNS-over-TLS.
+func (s Server) String() string {
+	if s.URL != "" {
+		return s.URL
+	}
+	return s.Address
+}
+
+// ParseServer parses encrypted DNS resolver as configured by the global config
+// item network.dns.encrypted.servers.
+// DNS-over-TLS resolver is expected in the format "<ip>[:<port>][#<tls-server-name>]",
+// DNS-over-HTTPS resolver as "https://<host>[:<port>]/<path>[#<ip>]", where IP address
+// is required (to bootstrap the resolver) if host is a domain name.
+func ParseServer(protocol types.EncryptedDNSProtocol, server string) (Server, error) {
+	server = strings.TrimSpace(server)
+	switch protocol {
+	case types.EncryptedDNSOverTLS:
+		return parseDoTServer(server)
+	case types.EncryptedDNSOverHTTPS:
+		return parseDoHServer(server)
+	}
+	return Server{}, fmt.Errorf("encrypted DNS protocol %v does not use servers", protocol)
+}
+
+// ParseServers parses comma-separated list of encrypted DNS resolvers.
+// Invalid entries are skipped and reported in the returned errors.
+func ParseServers(protocol types.EncryptedDNSProtocol,
+	servers string) (valid []string, errs []error) {
+	for _, server := range strings.Split(servers, ",") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if _, err := ParseServer(protocol, server); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		valid = append(valid, server)
+	}
+	return valid, errs
+}
+
+func parseDoTServer(server string) (Server, error) {
+	addr, name, _ := strings.Cut(server, "#")
+	host, port := addr, strconv.Itoa(DefaultDoTPort)
+	if net.ParseIP(strings.Trim(addr, "[]")) != nil {
+		host = strings.Trim(addr, "[]")
+	} else {
+		var err error
+		host, port, err = net.SplitHostPort(addr)
+		if err != nil {
+			return Server{}, fmt.Errorf("invalid DoT server %q: %w", server, err)
+		}
+	}
+	if net.ParseIP(host) == nil {
+		return Server{}, fmt.Errorf("invalid DoT server %q: %q is not an IP address",
+			server, host)
+	}
+	if portNum, err := strconv.ParseUint(port, 10, 16); err != nil || portNum == 0 {
+		return Server{}, fmt.Errorf("invalid DoT server %q: invalid port %q", server, port)
+	}
+	if name == "" {
+		name = host
+	}
+	return Server{
+		Protocol:      types.EncryptedDNSOverTLS,
+		Address:       net.JoinHostPort(host, port),
+		TLSServerName: name,
+	}, nil
+}
+
+func parseDoHServer(server string) (Server, error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return Server{}, fmt.Errorf("invalid DoH server %q: %w", server, err)
+	}
+	if u.Scheme != "https" {
+		return Server{}, fmt.Errorf("invalid DoH server %q: expected https URL", server)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return Server{}, fmt.Errorf("invalid DoH server %q: missing host", server)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil && u.Fragment != "" {
+		ip = net.ParseIP(u.Fragment)
+		if ip == nil {
+			return Server{}, fmt.Errorf("invalid DoH server %q: %q is not an IP address",
+				server, u.Fragment)
+		}
+	}
+	if ip == nil {
+		return Server{}, fmt.Errorf(
+			"invalid DoH server %q: IP address is required to bootstrap the resolver", server)
+	}
+	port := u.Port()
+	if port == "" {
+		port = strconv.Itoa(DefaultDoHPort)
+	}
+	u.Fragment = ""
+	return Server{
+		Protocol:      types.EncryptedDNSOverHTTPS,
+		Address:       net.JoinHostPort(ip.String(), port),
+		TLSServerName: host,
+		URL:           u.String(),
+	}, nil
+}
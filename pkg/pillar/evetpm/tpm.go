@@ -612,6 +612,57 @@ func SealDiskKey(key []byte, pcrSel tpm2.PCRSelection) error {
 	return nil
 }
 
+// SealedKey is a key sealed into TPM2.0 by SealKey. It can be stored
+// outside of the TPM, only the same TPM is able to unseal it.
+type SealedKey struct {
+	Public  []byte
+	Private []byte
+}
+
+// SealKey seals key into TPM2.0 under the storage root key, without PCR policy,
+// i.e. the key survives updates of EVE, but can be unsealed only on this device.
+// Unlike SealDiskKey, the sealed key is not stored in the TPM NV storage
+// but returned to the caller.
+func SealKey(key []byte) (SealedKey, error) {
+	rw, err := tpm2.OpenTPM(TpmDevicePath)
+	if err != nil {
+		return SealedKey{}, err
+	}
+	defer rw.Close()
+	// Same as tpm2.Seal, but authorized with the (empty) password instead of a policy.
+	inPublic := tpm2.Public{
+		Type:       tpm2.AlgKeyedHash,
+		NameAlg:    tpm2.AlgSHA256,
+		Attributes: tpm2.FlagFixedTPM | tpm2.FlagFixedParent | tpm2.FlagUserWithAuth,
+	}
+	priv, public, _, _, _, err := tpm2.CreateKeyWithSensitive(rw, TpmSRKHdl,
+		tpm2.PCRSelection{}, EmptyPassword, EmptyPassword, inPublic, key)
+	if err != nil {
+		return SealedKey{}, fmt.Errorf("Unable to seal key: %v", err)
+	}
+	return SealedKey{Public: public, Private: priv}, nil
+}
+
+// UnsealKey unseals key sealed by SealKey.
+func UnsealKey(sealedKey SealedKey) ([]byte, error) {
+	rw, err := tpm2.OpenTPM(TpmDevicePath)
+	if err != nil {
+		return nil, err
+	}
+	defer rw.Close()
+	sealedObjHandle, _, err := tpm2.Load(rw, TpmSRKHdl, EmptyPassword,
+		sealedKey.Public, sealedKey.Private)
+	if err != nil {
+		return nil, fmt.Errorf("Load failed: %v", err)
+	}
+	defer tpm2.FlushContext(rw, sealedObjHandle)
+	key, err := tpm2.Unseal(rw, sealedObjHandle, EmptyPassword)
+	if err != nil {
+		return nil, fmt.Errorf("Unseal failed: %v", err)
+	}
+	return key, nil
+}
+
 func isSealedKeyPresent() bool {
 	rw, err := tpm2.OpenTPM(TpmDevicePath)
 	if err != nil {
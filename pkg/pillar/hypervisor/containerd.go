@@ -4,19 +4,32 @@
 package hypervisor
 
 import (
+	"context"
 	"fmt"
-	"github.com/lf-edge/eve/pkg/pillar/containerd"
-	"github.com/lf-edge/eve/pkg/pillar/types"
-	"github.com/opencontainers/runtime-spec/specs-go"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
 const (
 	vifsDir string = "/run/tasks/vifs"
+	// podsDir keeps OCI specs of additional containers of domains
+	podsDir string = "/run/tasks/pods"
+
+	// podInitTimeout limits the time spent running init containers
+	podInitTimeout = 5 * time.Minute
+	// podRestartDelay is the delay before restarting an additional container
+	// which is doubled with every restart up to podMaxRestartDelay
+	podRestartDelay    = 10 * time.Second
+	podMaxRestartDelay = 5 * time.Minute
 
 	//ContainerdHypervisorName is a name of containerd hypervisor
 	ContainerdHypervisorName = "containerd"
@@ -26,6 +39,7 @@ type ctrdContext struct {
 	domCounter int
 	PCI        map[string]bool
 	ctrdClient *containerd.Client
+	podInits   *podInits
 }
 
 func initContainerd() (*ctrdContext, error) {
@@ -37,6 +51,7 @@ func initContainerd() (*ctrdContext, error) {
 		domCounter: 0,
 		PCI:        map[string]bool{},
 		ctrdClient: ctrdClient,
+		podInits:   newPodInits(),
 	}, nil
 }
 
@@ -79,6 +94,14 @@ func (ctx ctrdContext) setupSpec(status *types.DomainStatus, config *types.Domai
 	spec.UpdateMounts(status.DiskStatusList)
 	spec.UpdateVifList(config.VifList)
 	spec.UpdateEnvVar(status.EnvVariables)
+	if err := spec.UpdateSecretEnv(status.SecretsDir, status.SecretEnvNames); err != nil {
+		return nil, err
+	}
+	if status.SecretsDir != "" {
+		// in case of a VM running the container the mount is passed
+		// to the VM along with the volumes (see AddLoader)
+		spec.Get().Mounts = append(spec.Get().Mounts, secretsMount(status.SecretsDir))
+	}
 
 	return spec, nil
 }
@@ -107,19 +130,341 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	}
 	defer f.Close()
 
-	spec.Get().Mounts = append(spec.Get().Mounts, specs.Mount{
-		Type:        "bind",
-		Source:      vifsTaskResolv,
-		Destination: "/etc/resolv.conf",
-		Options:     []string{"rbind", "ro"}})
+	spec.Get().Mounts = append(spec.Get().Mounts, resolvMount(vifsTaskResolv))
 
 	if err := spec.CreateContainer(true); err != nil {
 		return logError("Failed to create container for task %s from %v: %v", status.DomainName, config, err)
 	}
 
+	return ctx.setupPod(&status, &config, vifsTaskResolv)
+}
+
+func resolvMount(resolv string) specs.Mount {
+	return specs.Mount{
+		Type:        "bind",
+		Source:      resolv,
+		Destination: "/etc/resolv.conf",
+		Options:     []string{"rbind", "ro"}}
+}
+
+// secretsMount makes the secrets of the domain available to its containers
+func secretsMount(secretsDir string) specs.Mount {
+	return specs.Mount{
+		Type:        "bind",
+		Source:      secretsDir,
+		Destination: "/run/secrets",
+		Options:     []string{"rbind", "ro"}}
+}
+
+func podContainerSpecFile(domainName, containerName string) string {
+	return filepath.Join(podsDir, domainName, containerName+".json")
+}
+
+// setupPod prepares OCI specs of additional containers of the domain.
+// The containers themselves are created in Create once the PID
+// of the main container, whose namespaces they join, is known.
+func (ctx ctrdContext) setupPod(status *types.DomainStatus, config *types.DomainConfig,
+	resolv string) error {
+	podDir := filepath.Join(podsDir, status.DomainName)
+	if err := os.RemoveAll(podDir); err != nil {
+		return logError("cannot clear pod dir %s: %v", podDir, err)
+	}
+	if len(config.Containers) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(podDir, 0755); err != nil {
+		return logError("cannot create pod dir %s: %v", podDir, err)
+	}
+	for _, container := range config.Containers {
+		var volume string
+		for _, ds := range status.DiskStatusList {
+			if ds.VolumeKey == container.VolumeKey {
+				volume = ds.FileLocation
+				break
+			}
+		}
+		if volume == "" {
+			return logError("no volume %s for container %s of domain %s",
+				container.VolumeKey, container.Name, status.DomainName)
+		}
+		spec, err := ctx.ctrdClient.NewOciSpec(
+			containerd.PodContainerID(status.DomainName, container.Name), false)
+		if err != nil {
+			return logError("setting up OCI spec for container %s of domain %s failed %v",
+				container.Name, status.DomainName, err)
+		}
+		if err := spec.UpdateFromVolume(volume); err != nil {
+			return logError("setting up OCI spec for container %s of domain %s failed %v",
+				container.Name, status.DomainName, err)
+		}
+		spec.UpdateFromDomain(config, status)
+		spec.UpdateMounts(status.DiskStatusList)
+		spec.UpdateFromPodContainer(container, status.EnvVariables)
+		// env variables of the container take precedence over secrets
+		secretEnvNames := make(map[string]string)
+		for envName, secretName := range status.SecretEnvNames {
+			if _, ok := container.Env[envName]; !ok {
+				secretEnvNames[envName] = secretName
+			}
+		}
+		if err := spec.UpdateSecretEnv(status.SecretsDir, secretEnvNames); err != nil {
+			return logError("setting up OCI spec for container %s of domain %s failed %v",
+				container.Name, status.DomainName, err)
+		}
+		spec.Get().Mounts = append(spec.Get().Mounts, resolvMount(resolv))
+		if status.SecretsDir != "" {
+			spec.Get().Mounts = append(spec.Get().Mounts, secretsMount(status.SecretsDir))
+		}
+
+		specFile := podContainerSpecFile(status.DomainName, container.Name)
+		f, err := os.OpenFile(specFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return logError("cannot create OCI spec file %s: %v", specFile, err)
+		}
+		err = spec.Save(f)
+		f.Close()
+		if err != nil {
+			return logError("cannot save OCI spec file %s: %v", specFile, err)
+		}
+	}
+	return nil
+}
+
+// createPod (re)creates additional containers of the domain joining
+// namespaces of the main container task with the given PID.
+func (ctx ctrdContext) createPod(ctrdCtx context.Context, domainName string, pid int,
+	config *types.DomainConfig) error {
+	ctx.deletePod(ctrdCtx, domainName)
+	for i, container := range config.Containers {
+		spec, err := ctx.ctrdClient.NewOciSpec(
+			containerd.PodContainerID(domainName, container.Name), false)
+		if err != nil {
+			return err
+		}
+		specFile := podContainerSpecFile(domainName, container.Name)
+		f, err := os.Open(specFile)
+		if err != nil {
+			return logError("cannot open OCI spec file %s: %v", specFile, err)
+		}
+		err = spec.Load(f)
+		f.Close()
+		if err != nil {
+			return logError("cannot load OCI spec file %s: %v", specFile, err)
+		}
+		if err := spec.CreatePodContainer(domainName, pid, i, container); err != nil {
+			return logError("failed to create container %s of domain %s: %v",
+				container.Name, domainName, err)
+		}
+	}
+	return nil
+}
+
+// deletePod deletes all additional containers of the domain
+func (ctx ctrdContext) deletePod(ctrdCtx context.Context, domainName string) {
+	podContainers, err := ctx.ctrdClient.CtrListPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		logrus.Errorf("cannot list containers of domain %s: %v", domainName, err)
+		return
+	}
+	for _, c := range podContainers {
+		if err := ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, c.ID); err != nil {
+			logrus.Errorf("cannot delete container %s: %v", c.ID, err)
+		}
+	}
+}
+
+func podRestartBackoff(restartCount uint32) time.Duration {
+	delay := podRestartDelay
+	for i := uint32(0); i < restartCount && delay < podMaxRestartDelay; i++ {
+		delay *= 2
+	}
+	if delay > podMaxRestartDelay {
+		delay = podMaxRestartDelay
+	}
+	return delay
+}
+
+// podInit is a start of a domain with init containers running in the background.
+type podInit struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	// err is valid once done is closed
+	err error
+}
+
+// podInits tracks domains started in the background by their name, so that
+// Start does not block domainmgr while init containers run to completion.
+type podInits struct {
+	sync.Mutex
+	inits map[string]*podInit
+}
+
+func newPodInits() *podInits {
+	return &podInits{inits: make(map[string]*podInit)}
+}
+
+// start runs startFn for the domain in the background with a context
+// created by newCtx. A previous run, if any, is cancelled first.
+func (p *podInits) start(domainName string,
+	newCtx func() (context.Context, context.CancelFunc),
+	startFn func(context.Context) error) {
+	p.stop(domainName)
+	ctx, cancel := newCtx()
+	init := &podInit{cancel: cancel, done: make(chan struct{})}
+	p.Lock()
+	p.inits[domainName] = init
+	p.Unlock()
+	go func() {
+		defer cancel()
+		init.err = startFn(ctx)
+		close(init.done)
+	}()
+}
+
+// status returns true if the start of the domain is still in progress,
+// otherwise the error it has failed with, if any.
+func (p *podInits) status(domainName string) (inProgress bool, err error) {
+	p.Lock()
+	init := p.inits[domainName]
+	p.Unlock()
+	if init == nil {
+		return false, nil
+	}
+	select {
+	case <-init.done:
+		return false, init.err
+	default:
+		return true, nil
+	}
+}
+
+// stop cancels the start of the domain running in the background,
+// waits for it to return and forgets about it.
+func (p *podInits) stop(domainName string) {
+	p.Lock()
+	init := p.inits[domainName]
+	delete(p.inits, domainName)
+	p.Unlock()
+	if init != nil {
+		init.cancel()
+		<-init.done
+	}
+}
+
+// runPodInitContainers runs init containers of the domain one after another,
+// each to completion, retrying failed ones according to their restart policy.
+func (ctx ctrdContext) runPodInitContainers(ctrdCtx context.Context, domainName string,
+	podContainers []containerd.PodContainer) error {
+	deadline := time.Now().Add(podInitTimeout)
+	for _, c := range podContainers {
+		if c.Type != types.AppContainerInit {
+			continue
+		}
+		for {
+			var err error
+			c.ExitCode, err = ctx.ctrdClient.CtrRunTask(ctrdCtx, c.ID, time.Until(deadline))
+			if err != nil {
+				return fmt.Errorf("init container %s failed: %v", c.Name, err)
+			}
+			if c.ExitCode == 0 {
+				break
+			}
+			delay := podRestartBackoff(c.RestartCount)
+			if !c.RestartPolicy.ShouldRestart(c.ExitCode) ||
+				time.Now().Add(delay).After(deadline) {
+				_ = ctx.ctrdClient.CtrUpdatePodContainer(ctrdCtx, c)
+				return fmt.Errorf("init container %s exited with status %d",
+					c.Name, c.ExitCode)
+			}
+			logrus.Warnf("init container %s exited with status %d, restarting in %v",
+				c.Name, c.ExitCode, delay)
+			select {
+			case <-ctrdCtx.Done():
+				return ctrdCtx.Err()
+			case <-time.After(delay):
+			}
+			c.RestartCount++
+			c.RestartedAt = time.Now()
+		}
+		if err := ctx.ctrdClient.CtrUpdatePodContainer(ctrdCtx, c); err != nil {
+			logrus.Errorf("cannot update container %s: %v", c.ID, err)
+		}
+	}
 	return nil
 }
 
+// startPodContainer (re)starts the task of a sidecar container
+func (ctx ctrdContext) startPodContainer(ctrdCtx context.Context, containerID string) error {
+	// get rid of the task of the previous run if any
+	_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, containerID, true)
+	if _, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, containerID); err != nil {
+		return err
+	}
+	return ctx.ctrdClient.CtrStartTask(ctrdCtx, containerID)
+}
+
+// ReconcilePod restarts sidecar containers of the running domain according
+// to their restart policy and returns status of all additional containers.
+func (ctx ctrdContext) ReconcilePod(domainName string) ([]types.AppContainerStatus, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	podContainers, err := ctx.ctrdClient.CtrListPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	_, _, mainStatus, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
+	mainRunning := err == nil && mainStatus == "running"
+
+	var res []types.AppContainerStatus
+	for _, c := range podContainers {
+		_, exit, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, c.ID)
+		if err == nil && status == "stopped" && c.Type != types.AppContainerInit &&
+			mainRunning && c.RestartPolicy.ShouldRestart(exit) &&
+			time.Since(c.RestartedAt) >= podRestartBackoff(c.RestartCount) {
+			logrus.Warnf("container %s exited with status %d, restarting", c.ID, exit)
+			c.RestartCount++
+			c.RestartedAt = time.Now()
+			c.ExitCode = exit
+			if err := ctx.startPodContainer(ctrdCtx, c.ID); err != nil {
+				logrus.Errorf("cannot restart container %s: %v", c.ID, err)
+			}
+			if err := ctx.ctrdClient.CtrUpdatePodContainer(ctrdCtx, c); err != nil {
+				logrus.Errorf("cannot update container %s: %v", c.ID, err)
+			}
+			_, exit, status, err = ctx.ctrdClient.CtrContainerInfo(ctrdCtx, c.ID)
+		}
+		containerStatus := types.AppContainerStatus{
+			Name:         c.Name,
+			Type:         c.Type,
+			RestartCount: c.RestartCount,
+			ExitCode:     c.ExitCode,
+		}
+		switch {
+		case err != nil:
+			// no task: init container which has finished
+			// or a sidecar which has not been started yet
+			containerStatus.State = types.HALTED
+			if c.ExitCode != 0 {
+				containerStatus.State = types.BROKEN
+			}
+		case status == "stopped":
+			containerStatus.ExitCode = exit
+			containerStatus.State = types.HALTED
+			if exit != 0 {
+				containerStatus.State = types.BROKEN
+			}
+		default:
+			state, matched := ctrdTaskStates[status]
+			if !matched {
+				state = types.BROKEN
+			}
+			containerStatus.State = state
+		}
+		res = append(res, containerStatus)
+	}
+	return res, nil
+}
+
 func (ctx ctrdContext) Create(domainName string, cfgFilename string, config *types.DomainConfig) (int, error) {
 	// if we are here we may need to get rid of the wedged, stale task just in case
 	// we are ignoring error here since it is cheaper to always call this as opposed
@@ -129,38 +474,95 @@ func (ctx ctrdContext) Create(domainName string, cfgFilename string, config *typ
 	defer done()
 	_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, domainName, true)
 
-	return ctx.ctrdClient.CtrCreateTask(ctrdCtx, domainName)
+	pid, err := ctx.ctrdClient.CtrCreateTask(ctrdCtx, domainName)
+	if err != nil {
+		return pid, err
+	}
+	if err := ctx.createPod(ctrdCtx, domainName, pid, config); err != nil {
+		return pid, err
+	}
+	return pid, nil
 }
 
+// Start starts the domain. If the domain has init containers, these run to
+// completion before the main container starts. That can take a while, therefore
+// it is done in the background and reported by Info as BOOTING in the meantime.
 func (ctx ctrdContext) Start(domainName string) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	podContainers, err := ctx.ctrdClient.CtrListPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		return err
+	}
+	for _, c := range podContainers {
+		if c.Type == types.AppContainerInit {
+			ctx.podInits.start(domainName, ctx.ctrdClient.CtrNewUserServicesCtx,
+				func(ctrdCtx context.Context) error {
+					if err := ctx.runPodInitContainers(ctrdCtx, domainName, podContainers); err != nil {
+						logrus.Errorf("failed to start domain %s: %v", domainName, err)
+						return err
+					}
+					return ctx.startPod(ctrdCtx, domainName, podContainers)
+				})
+			return nil
+		}
+	}
+	return ctx.startPod(ctrdCtx, domainName, podContainers)
+}
+
+// startPod starts the main container of the domain and its sidecars.
+func (ctx ctrdContext) startPod(ctrdCtx context.Context, domainName string,
+	podContainers []containerd.PodContainer) error {
 	err := ctx.ctrdClient.CtrStartTask(ctrdCtx, domainName)
 	if err != nil {
 		return err
 	}
 
 	// now lets wait for task to reach a steady state or for >10sec to elapse
+	steady := false
 	for i := 0; i < 10; i++ {
 		_, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
 		if err == nil && (status == "running" || status == "stopped" || status == "paused") {
-			return nil
+			steady = true
+			break
 		}
 		time.Sleep(time.Second)
 	}
+	if !steady {
+		return fmt.Errorf("task %s couldn't reach a steady state in time", domainName)
+	}
 
-	return fmt.Errorf("task %s couldn't reach a steady state in time", domainName)
+	for _, c := range podContainers {
+		if c.Type == types.AppContainerInit {
+			continue
+		}
+		if err := ctx.startPodContainer(ctrdCtx, c.ID); err != nil {
+			return fmt.Errorf("failed to start container %s: %v", c.ID, err)
+		}
+	}
+	return nil
 }
 
 func (ctx ctrdContext) Stop(domainName string, force bool) error {
+	ctx.podInits.stop(domainName)
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	podContainers, err := ctx.ctrdClient.CtrListPodContainers(ctrdCtx, domainName)
+	if err != nil {
+		return err
+	}
+	for _, c := range podContainers {
+		// init containers and sidecars which were not started have no task
+		_ = ctx.ctrdClient.CtrStopContainer(ctrdCtx, c.ID, force)
+	}
 	return ctx.ctrdClient.CtrStopContainer(ctrdCtx, domainName, force)
 }
 
 func (ctx ctrdContext) Delete(domainName string) error {
+	ctx.podInits.stop(domainName)
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	ctx.deletePod(ctrdCtx, domainName)
 	if err := ctx.ctrdClient.CtrDeleteContainer(ctrdCtx, domainName); err != nil {
 		return err
 	}
@@ -168,6 +570,10 @@ func (ctx ctrdContext) Delete(domainName string) error {
 	if err := os.RemoveAll(vifsTaskDir); err != nil {
 		return logError("cannot clear vifs task dir %s: %v", vifsTaskDir, err)
 	}
+	podDir := filepath.Join(podsDir, domainName)
+	if err := os.RemoveAll(podDir); err != nil {
+		return logError("cannot clear pod dir %s: %v", podDir, err)
+	}
 	return nil
 }
 
@@ -175,6 +581,7 @@ func (ctx ctrdContext) Delete(domainName string) error {
 func (ctx ctrdContext) Cleanup(domainName string) error {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
+	ctx.deletePod(ctrdCtx, domainName)
 	container, _ := ctx.ctrdClient.CtrLoadContainer(ctrdCtx, domainName)
 	if container == nil {
 		return nil
@@ -188,6 +595,15 @@ func (ctx ctrdContext) Annotations(domainName string) (map[string]string, error)
 	return ctx.ctrdClient.CtrGetAnnotations(ctrdCtx, domainName)
 }
 
+// ctrdTaskStates maps containerd task status to the state of a domain
+var ctrdTaskStates = map[string]types.SwState{
+	"created": types.INSTALLED,
+	"running": types.RUNNING,
+	"pausing": types.PAUSING,
+	"paused":  types.PAUSED,
+	"stopped": types.HALTED,
+}
+
 func (ctx ctrdContext) Info(domainName string) (int, types.SwState, error) {
 	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
 	defer done()
@@ -200,14 +616,16 @@ func (ctx ctrdContext) Info(domainName string) (int, types.SwState, error) {
 		return 0, types.BROKEN, logError("task broke with exit status %d", exit)
 	}
 
-	stateMap := map[string]types.SwState{
-		"created": types.INSTALLED,
-		"running": types.RUNNING,
-		"pausing": types.PAUSING,
-		"paused":  types.PAUSED,
-		"stopped": types.HALTED,
+	// the main task is not started until init containers of the pod complete
+	inProgress, err := ctx.podInits.status(domainName)
+	if err != nil {
+		return 0, types.BROKEN, logError("domain %s failed to start: %v", domainName, err)
+	}
+	if inProgress {
+		return effectiveDomainID, types.BOOTING, nil
 	}
-	if effectiveDomainState, matched := stateMap[status]; !matched {
+
+	if effectiveDomainState, matched := ctrdTaskStates[status]; !matched {
 		err := fmt.Errorf("task %s happens to be in an unexpected state %s",
 			domainName, status)
 		logrus.Error(err)
@@ -254,11 +672,25 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 	if err != nil {
 		return nil, err
 	}
+	// additional containers are accounted for in the cgroup
+	// of the main container of the domain
+	podContainerIDs := make(map[string]struct{})
+	podContainers, err := ctx.ctrdClient.CtrListPodContainers(ctrdCtx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range podContainers {
+		podContainerIDs[c.ID] = struct{}{}
+	}
 
 	for _, id := range ids {
+		if _, ok := podContainerIDs[id]; ok {
+			continue
+		}
 		var usedMem, maxUsedMem, availMem, totalMem uint32
 		var usedMemPerc float64
 		var cpuTotal uint64
+		var cgroupMetric types.DomainCgroupMetric
 
 		if metric, err := ctx.ctrdClient.CtrGetContainerMetrics(ctrdCtx, id); err == nil {
 			if metric.Memory == nil || metric.Memory.Usage == nil {
@@ -282,6 +714,7 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 			} else {
 				cpuTotal = metric.CPU.Usage.Total
 			}
+			cgroupMetric = getCgroupMetric(metric)
 		} else {
 			logrus.Errorf("GetDomsCPUMem failed with error %v", err)
 		}
@@ -295,7 +728,42 @@ func (ctx ctrdContext) GetDomsCPUMem() (map[string]types.DomainMetric, error) {
 			MaxUsedMemory:     maxUsedMem,
 			AvailableMemory:   availMem,
 			UsedMemoryPercent: usedMemPerc,
+			Cgroup:            cgroupMetric,
 		}
 	}
 	return res, nil
 }
+
+// getCgroupMetric returns throttling, number of processes and disk I/O
+// statistics of a task cgroup
+func getCgroupMetric(metric *v1stat.Metrics) types.DomainCgroupMetric {
+	var res types.DomainCgroupMetric
+	if metric.CPU != nil && metric.CPU.Throttling != nil {
+		res.CPUPeriods = metric.CPU.Throttling.Periods
+		res.CPUThrottledPeriods = metric.CPU.Throttling.ThrottledPeriods
+		res.CPUThrottledNs = metric.CPU.Throttling.ThrottledTime
+	}
+	if metric.Pids != nil {
+		res.Pids = metric.Pids.Current
+		res.PidsMax = metric.Pids.Limit
+	}
+	if metric.Blkio != nil {
+		for _, entry := range metric.Blkio.IoServiceBytesRecursive {
+			switch strings.ToLower(entry.Op) {
+			case "read":
+				res.IOReadBytes += entry.Value
+			case "write":
+				res.IOWriteBytes += entry.Value
+			}
+		}
+		for _, entry := range metric.Blkio.IoServicedRecursive {
+			switch strings.ToLower(entry.Op) {
+			case "read":
+				res.IOReadOps += entry.Value
+			case "write":
+				res.IOWriteOps += entry.Value
+			}
+		}
+	}
+	return res
+}
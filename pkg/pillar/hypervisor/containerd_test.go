@@ -1,7 +1,13 @@
 package hypervisor
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
+
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
 func TestGetDomsCPUMem(t *testing.T) {
@@ -21,3 +27,106 @@ func TestGetDomsCPUMem(t *testing.T) {
 		}
 	}
 }
+
+func TestPodRestartBackoff(t *testing.T) {
+	expected := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second,
+		80 * time.Second, 160 * time.Second, 5 * time.Minute, 5 * time.Minute}
+	for count, delay := range expected {
+		if res := podRestartBackoff(uint32(count)); res != delay {
+			t.Errorf("podRestartBackoff(%d) = %v, expected %v", count, res, delay)
+		}
+	}
+	if res := podRestartBackoff(1000); res != podMaxRestartDelay {
+		t.Errorf("podRestartBackoff(1000) = %v, expected %v", res, podMaxRestartDelay)
+	}
+}
+
+func TestPodInits(t *testing.T) {
+	inits := newPodInits()
+	newCtx := func() (context.Context, context.CancelFunc) {
+		return context.WithCancel(context.Background())
+	}
+	if inProgress, err := inits.status("pod"); inProgress || err != nil {
+		t.Errorf("status() of unknown pod = %v, %v", inProgress, err)
+	}
+
+	// start in progress until unblocked, then failed
+	unblock := make(chan struct{})
+	finished := make(chan struct{})
+	inits.start("pod", newCtx, func(ctx context.Context) error {
+		defer close(finished)
+		<-unblock
+		return errors.New("init failed")
+	})
+	if inProgress, err := inits.status("pod"); !inProgress || err != nil {
+		t.Errorf("status() of started pod = %v, %v", inProgress, err)
+	}
+	close(unblock)
+	<-finished
+	for i := 0; i < 100; i++ {
+		if inProgress, _ := inits.status("pod"); !inProgress {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if inProgress, err := inits.status("pod"); inProgress || err == nil {
+		t.Errorf("status() of failed pod = %v, %v", inProgress, err)
+	}
+
+	// a new start replaces the previous one, stop cancels it
+	var cancelled bool
+	inits.start("pod", newCtx, func(ctx context.Context) error {
+		<-ctx.Done()
+		cancelled = true
+		return ctx.Err()
+	})
+	if inProgress, err := inits.status("pod"); !inProgress || err != nil {
+		t.Errorf("status() of restarted pod = %v, %v", inProgress, err)
+	}
+	inits.stop("pod")
+	if !cancelled {
+		t.Errorf("stop() did not wait for the cancelled start")
+	}
+	if inProgress, err := inits.status("pod"); inProgress || err != nil {
+		t.Errorf("status() of stopped pod = %v, %v", inProgress, err)
+	}
+}
+
+func TestGetCgroupMetric(t *testing.T) {
+	metric := &v1stat.Metrics{
+		CPU: &v1stat.CPUStat{
+			Throttling: &v1stat.Throttle{Periods: 100, ThrottledPeriods: 20, ThrottledTime: 5000},
+		},
+		Pids: &v1stat.PidsStat{Current: 12, Limit: 256},
+		Blkio: &v1stat.BlkIOStat{
+			IoServiceBytesRecursive: []*v1stat.BlkIOEntry{
+				{Op: "Read", Major: 8, Value: 4096},
+				{Op: "Write", Major: 8, Value: 8192},
+				{Op: "Total", Major: 8, Value: 12288},
+				{Op: "Write", Major: 230, Value: 1024},
+			},
+			IoServicedRecursive: []*v1stat.BlkIOEntry{
+				{Op: "Read", Major: 8, Value: 1},
+				{Op: "Write", Major: 8, Value: 2},
+				{Op: "Write", Major: 230, Value: 1},
+			},
+		},
+	}
+	expected := types.DomainCgroupMetric{
+		CPUPeriods:          100,
+		CPUThrottledPeriods: 20,
+		CPUThrottledNs:      5000,
+		Pids:                12,
+		PidsMax:             256,
+		IOReadBytes:         4096,
+		IOWriteBytes:        9216,
+		IOReadOps:           1,
+		IOWriteOps:          3,
+	}
+	if res := getCgroupMetric(metric); res != expected {
+		t.Errorf("getCgroupMetric() = %+v, expected %+v", res, expected)
+	}
+	if res := getCgroupMetric(&v1stat.Metrics{}); res != (types.DomainCgroupMetric{}) {
+		t.Errorf("getCgroupMetric() of empty metrics = %+v", res)
+	}
+}
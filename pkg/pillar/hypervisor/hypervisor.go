@@ -27,6 +27,32 @@ type Hypervisor interface {
 	GetCapabilities() (*types.Capabilities, error)
 }
 
+// PodTask is implemented by tasks which support running additional containers
+// (DomainConfig.Containers) together with the main container of a domain.
+type PodTask interface {
+	types.Task
+	// ReconcilePod restarts additional containers of a running domain
+	// according to their restart policy and returns their status.
+	ReconcilePod(domainName string) ([]types.AppContainerStatus, error)
+}
+
+// GetPodTask returns the task as PodTask if it supports additional containers.
+// Note that hypervisors embed the containerd task to run containers without
+// hypervisor, therefore we cannot simply check if the task implements PodTask.
+func GetPodTask(task types.Task) (PodTask, bool) {
+	podTask, ok := task.(ctrdContext)
+	return podTask, ok
+}
+
+// GuestAgentTask is implemented by tasks which can talk to an agent
+// running inside of the guest OS of a domain.
+type GuestAgentTask interface {
+	types.Task
+	// GuestAgentInfo returns information reported by the guest agent
+	// or an error if the agent is not running.
+	GuestAgentInfo(domainName string) (*types.GuestAgentInfo, error)
+}
+
 type hypervisorDesc struct {
 	constructor func() Hypervisor
 	dom0handle  string
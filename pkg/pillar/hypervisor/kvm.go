@@ -157,6 +157,17 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DomainConfig.DisplayName}}/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 {{if .DomainConfig.EnableVnc}}
 [vnc "default"]
   vnc = "0.0.0.0:{{if .DomainConfig.VncDisplay}}{{.DomainConfig.VncDisplay}}{{else}}0{{end}}"
@@ -342,6 +353,28 @@ const qemuSerialTemplate = `
   chardev = "charserial-usr{{.ID}}"
 `
 
+const qemuTPMTemplate = `
+[chardev "chartpm"]
+  backend = "socket"
+  path = "{{.Socket}}"
+
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm"]
+  driver = "{{.Driver}}"
+  tpmdev = "tpm0"
+`
+
+// qemuIgnitionTemplate passes the Ignition config in the firmware
+// config, where Ignition looks for it on the QEMU platform
+const qemuIgnitionTemplate = `
+[fw_cfg]
+  name = "opt/com.coreos/config"
+  file = "{{.}}"
+`
+
 const qemuUsbHostTemplate = `
 [device]
   driver = "usb-host"
@@ -361,6 +394,7 @@ const vfioDriverPath = "/sys/bus/pci/drivers/vfio-pci"
 //
 //	 pid - contains PID of the anchor process
 //	 qmp - UNIX domain socket that allows us to talk to anchor process
+//	 qga - UNIX domain socket connected to qemu-guest-agent running in the domain (if any)
 //	cons - symlink to /dev/pts/X that allows us to talk to the serial console of the domain
 //
 // In addition to that, we also maintain DOMAIN_NAME -> PID mapping in kvmContext, so we don't
@@ -519,6 +553,12 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 
 	os.MkdirAll(kvmStateDir+domainName, 0777)
 
+	if config.VTPM != types.VmTPMNone {
+		if err := startSwtpm(domainName, types.AppVTPMStateDir(domainUUID)); err != nil {
+			return logError("failed to start vTPM for domain %s: %v", domainName, err)
+		}
+	}
+
 	args := []string{ctx.dmExec}
 	args = append(args, dmArgs...)
 	args = append(args, "-name", domainName,
@@ -729,6 +769,22 @@ func (ctx kvmContext) CreateDomConfig(domainName string, config types.DomainConf
 			}
 		}
 	}
+	if config.VTPM != types.VmTPMNone {
+		tpmContext := struct {
+			Socket, Driver string
+		}{getSwtpmSocket(domainName), swtpmDriver(ctx.devicemodel, config.VTPM)}
+
+		t, _ = template.New("qemuTPM").Parse(qemuTPMTemplate)
+		if err := t.Execute(file, tpmContext); err != nil {
+			return logError("can't write vTPM to config file %s (%v)", file.Name(), err)
+		}
+	}
+	if status.IgnitionFile != "" {
+		t, _ = template.New("qemuIgnition").Parse(qemuIgnitionTemplate)
+		if err := t.Execute(file, status.IgnitionFile); err != nil {
+			return logError("can't write Ignition config to config file %s (%v)", file.Name(), err)
+		}
+	}
 
 	return nil
 }
@@ -805,18 +861,35 @@ func (ctx kvmContext) Start(domainName string) error {
 }
 
 func (ctx kvmContext) Stop(domainName string, force bool) error {
+	// Prefer a clean shutdown by the guest OS if it runs the guest agent,
+	// fall back to pressing the ACPI power button otherwise.
+	if !force {
+		err := qgaShutdown(domainName, qgaTimeout)
+		if err == nil {
+			return nil
+		}
+		logrus.Infof("Stop(%s): guest-shutdown failed, falling back to ACPI shutdown: %v",
+			domainName, err)
+	}
 	if err := execShutdown(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("Stop: failed to execute shutdown command %v", err)
 	}
 	return nil
 }
 
+// GuestAgentInfo returns information reported by qemu-guest-agent
+func (ctx kvmContext) GuestAgentInfo(domainName string) (*types.GuestAgentInfo, error) {
+	return QGAGetInfo(domainName, qgaTimeout)
+}
+
 func (ctx kvmContext) Delete(domainName string) (result error) {
 	//Sending a stop signal to then domain before quitting. This is done to freeze the domain before quitting it.
 	execStop(getQmpExecutorSocket(domainName))
 	if err := execQuit(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("failed to execute quit command %v", err)
 	}
+	// swtpm terminates together with QEMU, this is just in case it did not
+	stopSwtpm(domainName)
 	// we may want to wait a little bit here and actually kill qemu process if it gets wedged
 	if err := os.RemoveAll(kvmStateDir + domainName); err != nil {
 		return logError("failed to clean up domain state directory %s (%v)", domainName, err)
@@ -1,13 +1,19 @@
 package hypervisor
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"net"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	uuid "github.com/satori/go.uuid"
 )
@@ -193,6 +199,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -455,6 +472,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -693,6 +721,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -856,6 +895,133 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
 		}
 	})
 }
+func TestCreateDomConfigVTPM(t *testing.T) {
+	initTest(t)
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Errorf("NewV4 failed: %v", err)
+	}
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id, Version: "1.0"},
+		VmConfig: types.VmConfig{
+			Memory: 1024 * 1024 * 10,
+			VCpus:  2,
+			VTPM:   types.VmTPMCRB,
+		},
+	}
+	aa := types.AssignableAdapters{Initialized: true}
+	conf, err := os.CreateTemp("/tmp", "config")
+	if err != nil {
+		t.Fatalf("Can't create config file for a domain %v", err)
+	}
+	defer os.Remove(conf.Name())
+
+	for _, test := range []struct {
+		name   string
+		ctx    kvmContext
+		driver string
+	}{
+		{name: "amd64", ctx: kvmIntel, driver: "tpm-crb"},
+		{name: "arm64", ctx: kvmArm, driver: "tpm-tis-device"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf.Seek(0, 0)
+			if err := test.ctx.CreateDomConfig("test", config, types.DomainStatus{}, nil, &aa, conf); err != nil {
+				t.Errorf("CreateDomConfig failed %v", err)
+			}
+			defer os.Truncate(conf.Name(), 0)
+
+			result, err := os.ReadFile(conf.Name())
+			if err != nil {
+				t.Errorf("reading conf file failed %v", err)
+			}
+			expected := `
+[chardev "chartpm"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/swtpm-ctrl"
+
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm"]
+  driver = "` + test.driver + `"
+  tpmdev = "tpm0"
+`
+			if !strings.HasSuffix(string(result), expected) {
+				t.Errorf("got an unexpected resulting config %s", string(result))
+			}
+		})
+	}
+}
+
+func TestGetSwtpmStateKey(t *testing.T) {
+	// the test TPM just adds a fixed prefix to the key
+	defer func() {
+		sealSwtpmStateKey = etpm.SealKey
+		unsealSwtpmStateKey = etpm.UnsealKey
+	}()
+	sealSwtpmStateKey = func(key []byte) (etpm.SealedKey, error) {
+		return etpm.SealedKey{Private: append([]byte("sealed:"), key...)}, nil
+	}
+	unsealSwtpmStateKey = func(sealedKey etpm.SealedKey) ([]byte, error) {
+		return bytes.TrimPrefix(sealedKey.Private, []byte("sealed:")), nil
+	}
+	stateDir := t.TempDir()
+
+	// first boot generates the key
+	key, err := getSwtpmStateKey(stateDir)
+	if err != nil {
+		t.Fatalf("getSwtpmStateKey failed: %v", err)
+	}
+	if len(key) != swtpmStateKeyLen {
+		t.Errorf("unexpected key length %d", len(key))
+	}
+	content, err := os.ReadFile(filepath.Join(stateDir, swtpmStateKeyFile))
+	if err != nil {
+		t.Fatalf("sealed key not stored: %v", err)
+	}
+	var sealedKey etpm.SealedKey
+	if err = json.Unmarshal(content, &sealedKey); err != nil {
+		t.Fatalf("failed to parse sealed key: %v", err)
+	}
+	if !bytes.Equal(sealedKey.Private, append([]byte("sealed:"), key...)) {
+		t.Errorf("stored key is not sealed: %s", string(content))
+	}
+
+	// the next boot unseals the same key
+	if err = os.WriteFile(filepath.Join(stateDir, swtpmStateFile), []byte("state"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	key2, err := getSwtpmStateKey(stateDir)
+	if err != nil {
+		t.Fatalf("getSwtpmStateKey failed: %v", err)
+	}
+	if !bytes.Equal(key, key2) {
+		t.Errorf("different key returned for existing state")
+	}
+
+	// state cannot be used without its key
+	if err = os.Remove(filepath.Join(stateDir, swtpmStateKeyFile)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = getSwtpmStateKey(stateDir); err == nil {
+		t.Errorf("expected error for state without key")
+	}
+
+	// state cannot be used if the key cannot be unsealed
+	stateDir = t.TempDir()
+	if _, err = getSwtpmStateKey(stateDir); err != nil {
+		t.Fatalf("getSwtpmStateKey failed: %v", err)
+	}
+	unsealSwtpmStateKey = func(sealedKey etpm.SealedKey) ([]byte, error) {
+		return nil, errors.New("TPM failure")
+	}
+	if _, err = getSwtpmStateKey(stateDir); err == nil {
+		t.Errorf("expected error for key which cannot be unsealed")
+	}
+}
+
 func TestCreateDomConfig(t *testing.T) {
 	initTest(t)
 	id, err := uuid.NewV4()
@@ -1035,6 +1201,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1330,6 +1507,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1645,6 +1833,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1930,6 +2129,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -2273,3 +2483,42 @@ func TestCreateDom(t *testing.T) {
 		t.Errorf("can't read stat dir for test domain or state dir is not empty after all domains are gone %v", err)
 	}
 }
+
+func TestCreateDomConfigIgnition(t *testing.T) {
+	initTest(t)
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Errorf("NewV4 failed: %v", err)
+	}
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id, Version: "1.0"},
+		VmConfig: types.VmConfig{
+			Memory: 1024 * 1024 * 10,
+			VCpus:  2,
+		},
+		MetaDataType: types.MetaDataIgnition,
+	}
+	status := types.DomainStatus{IgnitionFile: "/run/domainmgr/cloudinit/" + id.String() + ".ign"}
+	aa := types.AssignableAdapters{Initialized: true}
+	conf, err := os.CreateTemp("/tmp", "config")
+	if err != nil {
+		t.Fatalf("Can't create config file for a domain %v", err)
+	}
+	defer os.Remove(conf.Name())
+
+	if err := kvmIntel.CreateDomConfig("test", config, status, nil, &aa, conf); err != nil {
+		t.Errorf("CreateDomConfig failed %v", err)
+	}
+	result, err := os.ReadFile(conf.Name())
+	if err != nil {
+		t.Errorf("reading conf file failed %v", err)
+	}
+	expected := `
+[fw_cfg]
+  name = "opt/com.coreos/config"
+  file = "` + status.IgnitionFile + `"
+`
+	if !strings.HasSuffix(string(result), expected) {
+		t.Errorf("got an unexpected resulting config %s", string(result))
+	}
+}
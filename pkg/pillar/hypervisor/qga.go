@@ -0,0 +1,364 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements a client for a subset of the qemu-guest-agent protocol
+//     https://qemu.readthedocs.io/en/latest/interop/qemu-ga-ref.html
+// The agent is reachable through the virtio-serial port org.qemu.guest_agent.0
+// (see qemuConfTemplate), which is exposed by qemu as a UNIX domain socket.
+
+type qgaRequest struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type qgaResponse struct {
+	Return json.RawMessage `json:"return"`
+	Error  *struct {
+		Class string `json:"class"`
+		Desc  string `json:"desc"`
+	} `json:"error"`
+}
+
+const (
+	// qgaTimeout is the default timeout for guest agent commands
+	qgaTimeout = 2 * time.Second
+	// qgaFreezeTimeout allows the guest to flush its filesystems
+	qgaFreezeTimeout = 10 * time.Second
+	qgaThawRetries   = 3
+)
+
+func getQGASocket(domainName string) string {
+	return kvmStateDir + domainName + "/qga"
+}
+
+// dialQGA connects to the guest agent of the domain and synchronizes with it.
+func dialQGA(domainName string, timeout time.Duration) (net.Conn,
+	*json.Encoder, *json.Decoder, error) {
+	socket := getQGASocket(domainName)
+	conn, err := net.DialTimeout("unix", socket, timeout)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("guest agent channel is not available: %v", err)
+	}
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return nil, nil, nil, err
+	}
+	enc := json.NewEncoder(conn)
+	dec := json.NewDecoder(conn)
+
+	// Synchronize with the agent first. The channel may contain a stale
+	// response left by a previous client which gave up waiting for it.
+	// The leading 0xFF byte resets the agent's JSON parser.
+	if _, err = conn.Write([]byte{0xFF}); err != nil {
+		conn.Close()
+		return nil, nil, nil, err
+	}
+	syncID := rand.Int31()
+	err = enc.Encode(qgaRequest{
+		Execute:   "guest-sync",
+		Arguments: map[string]int32{"id": syncID},
+	})
+	if err != nil {
+		conn.Close()
+		return nil, nil, nil, err
+	}
+	for {
+		var resp qgaResponse
+		if err = dec.Decode(&resp); err != nil {
+			conn.Close()
+			return nil, nil, nil, fmt.Errorf("guest-sync failed: %v", err)
+		}
+		var id int32
+		if json.Unmarshal(resp.Return, &id) == nil && id == syncID {
+			break
+		}
+		logrus.Debugf("dialQGA(%s): skipping stale response %s",
+			domainName, string(resp.Return))
+	}
+	return conn, enc, dec, nil
+}
+
+// execQGACmd sends command to the guest agent of the domain and returns
+// the content of the response.
+func execQGACmd(domainName, cmd string, args interface{},
+	timeout time.Duration) (json.RawMessage, error) {
+	conn, enc, dec, err := dialQGA(domainName, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	logrus.Debugf("executing QGA command %s for %s", cmd, domainName)
+	if err = enc.Encode(qgaRequest{Execute: cmd, Arguments: args}); err != nil {
+		return nil, err
+	}
+	var resp qgaResponse
+	if err = dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("%s failed: %v", cmd, err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("%s failed: %s: %s", cmd, resp.Error.Class,
+			resp.Error.Desc)
+	}
+	return resp.Return, nil
+}
+
+// QGAPing checks that qemu-guest-agent is running inside the domain
+// and responds within the timeout.
+func QGAPing(domainName string, timeout time.Duration) error {
+	_, err := execQGACmd(domainName, "guest-ping", nil, timeout)
+	return err
+}
+
+// qgaShutdown asks the guest OS to power down. The agent does not respond
+// to guest-shutdown on success, hence only an error response is reported.
+func qgaShutdown(domainName string, timeout time.Duration) error {
+	conn, enc, dec, err := dialQGA(domainName, timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	logrus.Infof("executing QGA command guest-shutdown for %s", domainName)
+	err = enc.Encode(qgaRequest{
+		Execute:   "guest-shutdown",
+		Arguments: map[string]string{"mode": "powerdown"},
+	})
+	if err != nil {
+		return err
+	}
+	var resp qgaResponse
+	if dec.Decode(&resp) == nil && resp.Error != nil {
+		return fmt.Errorf("guest-shutdown failed: %s: %s", resp.Error.Class,
+			resp.Error.Desc)
+	}
+	return nil
+}
+
+// qgaFsFreeze freezes (or thaws) the filesystems of the guest OS and returns
+// the number of filesystems affected.
+func qgaFsFreeze(domainName string, freeze bool, timeout time.Duration) (int, error) {
+	cmd := "guest-fsfreeze-thaw"
+	if freeze {
+		cmd = "guest-fsfreeze-freeze"
+	}
+	raw, err := execQGACmd(domainName, cmd, nil, timeout)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	if err = json.Unmarshal(raw, &count); err != nil {
+		return 0, fmt.Errorf("%s: unexpected response %s: %v",
+			cmd, string(raw), err)
+	}
+	return count, nil
+}
+
+// FreezeAppFilesystems freezes the filesystems of the running VMs of the app
+// instance which have a guest agent, so that a consistent snapshot of their
+// volumes can be taken. The returned function thaws them again and must
+// always be called.
+func FreezeAppFilesystems(appUUID uuid.UUID) (thaw func()) {
+	var frozen []string
+	entries, err := os.ReadDir(kvmStateDir)
+	if err != nil {
+		// not a KVM host or no VM is running
+		return func() {}
+	}
+	for _, entry := range entries {
+		domainName := entry.Name()
+		if !strings.HasPrefix(domainName, appUUID.String()+".") {
+			continue
+		}
+		if _, err := os.Stat(getQGASocket(domainName)); err != nil {
+			continue
+		}
+		count, err := qgaFsFreeze(domainName, true, qgaFreezeTimeout)
+		if err != nil {
+			logrus.Warnf("FreezeAppFilesystems(%s): %v", domainName, err)
+			// The freeze may have been partially done, thaw anyway.
+		} else {
+			logrus.Infof("FreezeAppFilesystems(%s): %d filesystems frozen",
+				domainName, count)
+		}
+		frozen = append(frozen, domainName)
+	}
+	return func() {
+		for _, domainName := range frozen {
+			var err error
+			// A guest with frozen filesystems is unusable, hence retry.
+			for i := 0; i < qgaThawRetries; i++ {
+				if _, err = qgaFsFreeze(domainName, false, qgaFreezeTimeout); err == nil {
+					break
+				}
+			}
+			if err != nil {
+				logrus.Errorf("FreezeAppFilesystems(%s): thaw failed: %v",
+					domainName, err)
+			}
+		}
+	}
+}
+
+type qgaOSInfo struct {
+	Name          string `json:"name"`
+	PrettyName    string `json:"pretty-name"`
+	Version       string `json:"version"`
+	KernelRelease string `json:"kernel-release"`
+}
+
+type qgaHostName struct {
+	HostName string `json:"host-name"`
+}
+
+type qgaInterface struct {
+	Name            string `json:"name"`
+	HardwareAddress string `json:"hardware-address"`
+	IPAddresses     []struct {
+		Type    string `json:"ip-address-type"`
+		Address string `json:"ip-address"`
+		Prefix  int    `json:"prefix"`
+	} `json:"ip-addresses"`
+}
+
+type qgaFilesystem struct {
+	Name       string `json:"name"`
+	MountPoint string `json:"mountpoint"`
+	Type       string `json:"type"`
+	UsedBytes  uint64 `json:"used-bytes"`
+	TotalBytes uint64 `json:"total-bytes"`
+}
+
+// QGAGetInfo collects information about the guest OS from its agent.
+func QGAGetInfo(domainName string, timeout time.Duration) (*types.GuestAgentInfo, error) {
+	raw, err := execQGACmd(domainName, "guest-get-osinfo", nil, timeout)
+	if err != nil {
+		return nil, err
+	}
+	var osInfo qgaOSInfo
+	if err = json.Unmarshal(raw, &osInfo); err != nil {
+		return nil, fmt.Errorf("guest-get-osinfo: %v", err)
+	}
+	info := &types.GuestAgentInfo{
+		OSName:        osInfo.PrettyName,
+		OSVersion:     osInfo.Version,
+		KernelRelease: osInfo.KernelRelease,
+		LastUpdate:    time.Now(),
+	}
+	if info.OSName == "" {
+		info.OSName = osInfo.Name
+	}
+	// The rest of the commands are optional, older agents or agents
+	// started with --block-rpcs may not support them.
+	raw, err = execQGACmd(domainName, "guest-get-host-name", nil, timeout)
+	if err == nil {
+		var hostName qgaHostName
+		if json.Unmarshal(raw, &hostName) == nil {
+			info.Hostname = hostName.HostName
+		}
+	} else {
+		logrus.Debugf("QGAGetInfo(%s): %v", domainName, err)
+	}
+	raw, err = execQGACmd(domainName, "guest-network-get-interfaces", nil, timeout)
+	if err == nil {
+		info.Interfaces, err = parseQGAInterfaces(raw)
+	}
+	if err != nil {
+		logrus.Debugf("QGAGetInfo(%s): %v", domainName, err)
+	}
+	raw, err = execQGACmd(domainName, "guest-get-fsinfo", nil, timeout)
+	if err == nil {
+		info.Filesystems, err = parseQGAFilesystems(raw)
+	}
+	if err != nil {
+		logrus.Debugf("QGAGetInfo(%s): %v", domainName, err)
+	}
+	return info, nil
+}
+
+// parseQGAInterfaces converts the response of guest-network-get-interfaces,
+// skipping the loopback interface.
+func parseQGAInterfaces(raw json.RawMessage) ([]types.GuestInterface, error) {
+	var qgaIntfs []qgaInterface
+	if err := json.Unmarshal(raw, &qgaIntfs); err != nil {
+		return nil, fmt.Errorf("guest-network-get-interfaces: %v", err)
+	}
+	var intfs []types.GuestInterface
+	for _, qgaIntf := range qgaIntfs {
+		intf := types.GuestInterface{Name: qgaIntf.Name}
+		if qgaIntf.HardwareAddress != "" {
+			mac, err := net.ParseMAC(qgaIntf.HardwareAddress)
+			if err != nil {
+				logrus.Warnf("parseQGAInterfaces(%s): %v", qgaIntf.Name, err)
+			} else {
+				intf.MacAddr = mac
+			}
+		}
+		loopback := false
+		for _, addr := range qgaIntf.IPAddresses {
+			ip := net.ParseIP(addr.Address)
+			if ip == nil {
+				continue
+			}
+			if ip.IsLoopback() {
+				loopback = true
+				break
+			}
+			intf.IPAddrs = append(intf.IPAddrs, ip)
+		}
+		if loopback {
+			continue
+		}
+		intfs = append(intfs, intf)
+	}
+	return intfs, nil
+}
+
+// parseQGAFilesystems converts the response of guest-get-fsinfo.
+// Filesystems mounted more than once (e.g. bind mounts) are reported once.
+func parseQGAFilesystems(raw json.RawMessage) ([]types.GuestFilesystem, error) {
+	var qgaFSs []qgaFilesystem
+	if err := json.Unmarshal(raw, &qgaFSs); err != nil {
+		return nil, fmt.Errorf("guest-get-fsinfo: %v", err)
+	}
+	var filesystems []types.GuestFilesystem
+	seen := make(map[string]int)
+	for _, qgaFS := range qgaFSs {
+		fs := types.GuestFilesystem{
+			MountPoint: qgaFS.MountPoint,
+			Type:       qgaFS.Type,
+			TotalBytes: qgaFS.TotalBytes,
+			UsedBytes:  qgaFS.UsedBytes,
+		}
+		if i, ok := seen[qgaFS.Name]; ok && qgaFS.Name != "" {
+			// keep the shortest mount point, e.g. / rather than a bind mount
+			if len(fs.MountPoint) < len(filesystems[i].MountPoint) {
+				filesystems[i] = fs
+			}
+			continue
+		}
+		seen[qgaFS.Name] = len(filesystems)
+		filesystems = append(filesystems, fs)
+	}
+	sort.Slice(filesystems, func(i, j int) bool {
+		return filesystems[i].MountPoint < filesystems[j].MountPoint
+	})
+	return filesystems, nil
+}
@@ -0,0 +1,70 @@
+package hypervisor
+
+import (
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestParseQGAInterfaces(t *testing.T) {
+	raw := json.RawMessage(`[
+		{"name": "lo", "hardware-address": "00:00:00:00:00:00",
+		 "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "127.0.0.1", "prefix": 8},
+		                  {"ip-address-type": "ipv6", "ip-address": "::1", "prefix": 128}]},
+		{"name": "eth0", "hardware-address": "02:16:3e:11:22:33",
+		 "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "192.168.1.10", "prefix": 24},
+		                  {"ip-address-type": "ipv6", "ip-address": "fe80::16:3eff:fe11:2233", "prefix": 64}]},
+		{"name": "eth1", "hardware-address": "02:16:3e:44:55:66"}
+	]`)
+	expected := []types.GuestInterface{
+		{
+			Name:    "eth0",
+			MacAddr: net.HardwareAddr{0x02, 0x16, 0x3e, 0x11, 0x22, 0x33},
+			IPAddrs: []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("fe80::16:3eff:fe11:2233")},
+		},
+		{
+			Name:    "eth1",
+			MacAddr: net.HardwareAddr{0x02, 0x16, 0x3e, 0x44, 0x55, 0x66},
+		},
+	}
+	intfs, err := parseQGAInterfaces(raw)
+	if err != nil {
+		t.Fatalf("parseQGAInterfaces failed: %v", err)
+	}
+	if !reflect.DeepEqual(intfs, expected) {
+		t.Errorf("parseQGAInterfaces returned %+v, expected %+v", intfs, expected)
+	}
+	info := &types.GuestAgentInfo{Interfaces: intfs}
+	ips := info.LookupIPAddrs(net.HardwareAddr{0x02, 0x16, 0x3e, 0x11, 0x22, 0x33})
+	if len(ips) != 2 || !ips[0].Equal(net.ParseIP("192.168.1.10")) {
+		t.Errorf("LookupIPAddrs returned %v", ips)
+	}
+
+	if _, err = parseQGAInterfaces(json.RawMessage(`{}`)); err == nil {
+		t.Errorf("parseQGAInterfaces should fail on unexpected response")
+	}
+}
+
+func TestParseQGAFilesystems(t *testing.T) {
+	raw := json.RawMessage(`[
+		{"name": "vda1", "mountpoint": "/var/lib/docker", "type": "ext4",
+		 "used-bytes": 1000, "total-bytes": 5000, "disk": []},
+		{"name": "vda1", "mountpoint": "/", "type": "ext4",
+		 "used-bytes": 1000, "total-bytes": 5000, "disk": []},
+		{"name": "vda15", "mountpoint": "/boot/efi", "type": "vfat", "disk": []}
+	]`)
+	expected := []types.GuestFilesystem{
+		{MountPoint: "/", Type: "ext4", TotalBytes: 5000, UsedBytes: 1000},
+		{MountPoint: "/boot/efi", Type: "vfat"},
+	}
+	filesystems, err := parseQGAFilesystems(raw)
+	if err != nil {
+		t.Fatalf("parseQGAFilesystems failed: %v", err)
+	}
+	if !reflect.DeepEqual(filesystems, expected) {
+		t.Errorf("parseQGAFilesystems returned %+v, expected %+v", filesystems, expected)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	etpm "github.com/lf-edge/eve/pkg/pillar/evetpm"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements per-domain virtual TPMs emulated by swtpm
+//     https://github.com/stefanberger/swtpm
+// QEMU talks to swtpm over the control socket (see qemuTPMTemplate).
+// The state of the TPM is kept in the vault and swtpm encrypts it with
+// a per-domain key sealed into the TPM of the device (see getSwtpmStateKey).
+
+const (
+	swtpmExec = "/usr/bin/swtpm"
+	// swtpmStateFile is the permanent state of TPM 2.0 created by swtpm
+	swtpmStateFile = "tpm2-00.permall"
+	// swtpmStateKeyFile holds the key encrypting the state, sealed into
+	// the TPM of the device
+	swtpmStateKeyFile = "state-key.sealed"
+	swtpmStateKeyLen  = 32 // AES-256
+)
+
+// replaced in unit tests
+var (
+	sealSwtpmStateKey   = etpm.SealKey
+	unsealSwtpmStateKey = etpm.UnsealKey
+)
+
+func getSwtpmSocket(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm-ctrl"
+}
+
+func getSwtpmPidFile(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm.pid"
+}
+
+// getSwtpmStateKey returns the key encrypting the state of the virtual TPM
+// kept in stateDir. The key is generated together with the state on the first
+// boot of the domain and it is stored sealed into the TPM of the device,
+// so the state can be decrypted only on this device.
+func getSwtpmStateKey(stateDir string) ([]byte, error) {
+	keyFile := filepath.Join(stateDir, swtpmStateKeyFile)
+	content, err := os.ReadFile(keyFile)
+	if err == nil {
+		var sealedKey etpm.SealedKey
+		if err = json.Unmarshal(content, &sealedKey); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", keyFile, err)
+		}
+		key, err := unsealSwtpmStateKey(sealedKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unseal vTPM state key: %v", err)
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read vTPM state key: %v", err)
+	}
+	if _, err = os.Stat(filepath.Join(stateDir, swtpmStateFile)); err == nil {
+		return nil, fmt.Errorf("vTPM state exists without the key in %s", stateDir)
+	}
+	key := make([]byte, swtpmStateKeyLen)
+	if _, err = rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate vTPM state key: %v", err)
+	}
+	sealedKey, err := sealSwtpmStateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seal vTPM state key: %v", err)
+	}
+	content, err = json.Marshal(sealedKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = fileutils.WriteRename(keyFile, content); err != nil {
+		return nil, fmt.Errorf("failed to write vTPM state key: %v", err)
+	}
+	return key, nil
+}
+
+// startSwtpm launches swtpm emulating TPM 2.0 for the domain, with the
+// state kept in stateDir. swtpm terminates on its own once QEMU closes
+// the connection.
+func startSwtpm(domainName, stateDir string) error {
+	// kill the instance left by a domain which failed to start
+	stopSwtpm(domainName)
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("failed to create vTPM state directory: %v", err)
+	}
+	key, err := getSwtpmStateKey(stateDir)
+	if err != nil {
+		return err
+	}
+	// pass the key to swtpm through a pipe, not to have it on the command line
+	// or in a file
+	keyReader, keyWriter, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("failed to create pipe for vTPM state key: %v", err)
+	}
+	defer keyReader.Close()
+	_, err = keyWriter.Write(key)
+	keyWriter.Close()
+	if err != nil {
+		return fmt.Errorf("failed to pass vTPM state key: %v", err)
+	}
+	args := []string{
+		"socket",
+		"--tpm2",
+		"--tpmstate", "dir=" + stateDir + ",mode=0600",
+		// ExtraFiles start with fd 3
+		"--key", "fd=3,format=binary,mode=aes-256-cbc",
+		"--ctrl", "type=unixio,path=" + getSwtpmSocket(domainName) + ",mode=0600",
+		"--pid", "file=" + getSwtpmPidFile(domainName),
+		"--log", "file=" + kvmStateDir + domainName + "/swtpm.log,level=1",
+		"--terminate",
+		"--daemon",
+	}
+	logrus.Infof("starting swtpm for %s: %v", domainName, args)
+	cmd := exec.Command(swtpmExec, args...)
+	cmd.ExtraFiles = []*os.File{keyReader}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("swtpm failed: %v: %s", err, string(out))
+	}
+	return nil
+}
+
+// stopSwtpm kills swtpm of the domain if it is still running.
+func stopSwtpm(domainName string) {
+	pidFile := getSwtpmPidFile(domainName)
+	content, err := os.ReadFile(pidFile)
+	if err != nil {
+		return
+	}
+	os.Remove(pidFile)
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		logrus.Warnf("stopSwtpm(%s): bad pid file: %v", domainName, err)
+		return
+	}
+	if err = syscall.Kill(pid, syscall.SIGTERM); err != nil && err != syscall.ESRCH {
+		logrus.Warnf("stopSwtpm(%s): %v", domainName, err)
+	}
+}
+
+// swtpmDriver returns the QEMU device emulating the TPM interface.
+// The virt machine only supports the sysbus TIS device.
+func swtpmDriver(devicemodel string, model types.VmTPMModel) string {
+	if devicemodel == "virt" {
+		return "tpm-tis-device"
+	}
+	if model == types.VmTPMTIS {
+		return "tpm-tis"
+	}
+	return "tpm-crb"
+}
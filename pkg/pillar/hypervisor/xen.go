@@ -842,6 +842,8 @@ func parseCPUMemoryStat(cpuMemoryStat [][]string, dmList map[string]types.Domain
 			UsedMemory:        uint32(usedMemory),
 			AvailableMemory:   uint32(availableMemory),
 			UsedMemoryPercent: float64(usedMemoryPercent),
+			// cgroup of the device model
+			Cgroup: result[domainname].Cgroup,
 		}
 		result[domainname] = dm
 	}
@@ -24,6 +24,17 @@ const (
 	// DefaultDropAceID : by default, traffic not matched by any ACE is dropped.
 	// For this default rule we use the maximum integer value available for ACE ID.
 	DefaultDropAceID = AceIDMask
+	// L7InspectAceID : connection matched by a host-based ACE, which is pending
+	// layer-7 inspection (see pkg/pillar/l7acl). Once inspected, the mark is
+	// replaced with the mark of the ACE matching the host name or with the default
+	// drop mark.
+	L7InspectAceID = AceIDMask - 1
+	// BridgePortTagAceIDBase : the nftables firewall backend temporarily marks
+	// packets to tag the bridge port through which they were received or are
+	// being sent (see pkg/pillar/nftables). Tags are allocated from the range
+	// of ACE IDs starting at this value (with application ID zero). The mark
+	// is never stored as a connection mark.
+	BridgePortTagAceIDBase = 1 << 22
 )
 
 // ControlProtocolMarkingIDMap : Map describing the control flow marking values
@@ -0,0 +1,247 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package l7acl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+)
+
+const (
+	tlsRecordTypeHandshake   = 0x16
+	tlsHandshakeClientHello  = 0x01
+	tlsExtensionServerName   = 0x0000
+	tlsServerNameTypeHost    = 0x00
+	tlsRecordHeaderLen       = 5
+	tlsHandshakeHeaderLen    = 4
+	maxHTTPRequestHeaderSize = 8 * 1024
+)
+
+var (
+	// errIncomplete is returned when more payload is needed to find out the host name.
+	errIncomplete = errors.New("incomplete message")
+	// errMalformed is returned for payload which looks like TLS or HTTP but cannot
+	// be parsed.
+	errMalformed = errors.New("malformed message")
+
+	httpMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE", "CONNECT",
+		"OPTIONS", "TRACE", "PATCH"}
+)
+
+// ExtractHostname returns the host name carried by the first message sent by a client
+// over TCP connection. Supported are TLS (server name from the SNI extension
+// of ClientHello) and HTTP/1.x (Host request header).
+// Returns complete=false if more payload is needed to find out the host name.
+// For unsupported application protocols, returns empty hostname and complete=true.
+func ExtractHostname(payload []byte) (hostname string, complete bool) {
+	if len(payload) == 0 {
+		return "", false
+	}
+	var err error
+	switch {
+	case payload[0] == tlsRecordTypeHandshake:
+		hostname, err = parseTLSServerName(payload)
+	case looksLikeHTTPRequest(payload):
+		hostname, err = parseHTTPHost(payload)
+	default:
+		return "", true
+	}
+	if err == errIncomplete {
+		return "", false
+	}
+	if err != nil {
+		return "", true
+	}
+	return normalizeHostname(hostname), true
+}
+
+// MatchHostname returns true if the hostname is equal to the host from an ACL rule
+// or if it is its subdomain. This is consistent with how dnsmasq populates
+// ipsets for host-based ACL rules.
+func MatchHostname(ruleHost, hostname string) bool {
+	ruleHost = normalizeHostname(ruleHost)
+	hostname = normalizeHostname(hostname)
+	if ruleHost == "" || hostname == "" {
+		return false
+	}
+	if hostname == ruleHost {
+		return true
+	}
+	return strings.HasSuffix(hostname, "."+ruleHost)
+}
+
+func normalizeHostname(hostname string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+}
+
+// parseTLSServerName parses TLS ClientHello and returns the host name from the SNI
+// extension. ClientHello may span multiple TLS records and multiple TCP segments.
+func parseTLSServerName(payload []byte) (string, error) {
+	// Reassemble handshake message from (possibly multiple) TLS records.
+	var handshake []byte
+	for len(payload) > 0 {
+		if len(payload) < tlsRecordHeaderLen {
+			return "", errIncomplete
+		}
+		if payload[0] != tlsRecordTypeHandshake {
+			return "", errMalformed
+		}
+		recordLen := int(binary.BigEndian.Uint16(payload[3:5]))
+		payload = payload[tlsRecordHeaderLen:]
+		if len(payload) < recordLen {
+			handshake = append(handshake, payload...)
+			break
+		}
+		handshake = append(handshake, payload[:recordLen]...)
+		payload = payload[recordLen:]
+		if len(handshake) >= tlsHandshakeHeaderLen {
+			msgLen := int(handshake[1])<<16 | int(handshake[2])<<8 | int(handshake[3])
+			if len(handshake) >= tlsHandshakeHeaderLen+msgLen {
+				break
+			}
+		}
+	}
+	if len(handshake) < tlsHandshakeHeaderLen {
+		return "", errIncomplete
+	}
+	if handshake[0] != tlsHandshakeClientHello {
+		return "", errMalformed
+	}
+	msgLen := int(handshake[1])<<16 | int(handshake[2])<<8 | int(handshake[3])
+	if len(handshake) < tlsHandshakeHeaderLen+msgLen {
+		return "", errIncomplete
+	}
+	hello := handshake[tlsHandshakeHeaderLen : tlsHandshakeHeaderLen+msgLen]
+	// Skip client version (2 bytes) and random (32 bytes).
+	if len(hello) < 34 {
+		return "", errMalformed
+	}
+	hello = hello[34:]
+	// Skip session ID, cipher suites and compression methods.
+	var ok bool
+	if hello, ok = skipVector(hello, 1); !ok {
+		return "", errMalformed
+	}
+	if hello, ok = skipVector(hello, 2); !ok {
+		return "", errMalformed
+	}
+	if hello, ok = skipVector(hello, 1); !ok {
+		return "", errMalformed
+	}
+	if len(hello) == 0 {
+		// No extensions.
+		return "", nil
+	}
+	if len(hello) < 2 {
+		return "", errMalformed
+	}
+	extsLen := int(binary.BigEndian.Uint16(hello[:2]))
+	exts := hello[2:]
+	if len(exts) < extsLen {
+		return "", errMalformed
+	}
+	exts = exts[:extsLen]
+	for len(exts) >= 4 {
+		extType := binary.BigEndian.Uint16(exts[:2])
+		extLen := int(binary.BigEndian.Uint16(exts[2:4]))
+		exts = exts[4:]
+		if len(exts) < extLen {
+			return "", errMalformed
+		}
+		if extType == tlsExtensionServerName {
+			return parseServerNameExt(exts[:extLen])
+		}
+		exts = exts[extLen:]
+	}
+	return "", nil
+}
+
+func parseServerNameExt(ext []byte) (string, error) {
+	if len(ext) < 2 {
+		return "", errMalformed
+	}
+	listLen := int(binary.BigEndian.Uint16(ext[:2]))
+	list := ext[2:]
+	if len(list) < listLen {
+		return "", errMalformed
+	}
+	list = list[:listLen]
+	for len(list) >= 3 {
+		nameType := list[0]
+		nameLen := int(binary.BigEndian.Uint16(list[1:3]))
+		list = list[3:]
+		if len(list) < nameLen {
+			return "", errMalformed
+		}
+		if nameType == tlsServerNameTypeHost {
+			return string(list[:nameLen]), nil
+		}
+		list = list[nameLen:]
+	}
+	return "", nil
+}
+
+// skipVector skips TLS vector with the length encoded using lenBytes bytes.
+func skipVector(data []byte, lenBytes int) ([]byte, bool) {
+	if len(data) < lenBytes {
+		return nil, false
+	}
+	var vecLen int
+	for i := 0; i < lenBytes; i++ {
+		vecLen = vecLen<<8 | int(data[i])
+	}
+	data = data[lenBytes:]
+	if len(data) < vecLen {
+		return nil, false
+	}
+	return data[vecLen:], true
+}
+
+func looksLikeHTTPRequest(payload []byte) bool {
+	for _, method := range httpMethods {
+		prefix := method + " "
+		if len(payload) < len(prefix) {
+			if bytes.HasPrefix([]byte(prefix), payload) {
+				// Could be HTTP, but we need more data to tell.
+				return true
+			}
+			continue
+		}
+		if bytes.HasPrefix(payload, []byte(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseHTTPHost returns the value of the Host header from HTTP/1.x request
+// (without port number).
+func parseHTTPHost(payload []byte) (string, error) {
+	headerEnd := bytes.Index(payload, []byte("\r\n\r\n"))
+	if headerEnd < 0 {
+		if len(payload) > maxHTTPRequestHeaderSize {
+			return "", errMalformed
+		}
+		return "", errIncomplete
+	}
+	lines := strings.Split(string(payload[:headerEnd]), "\r\n")
+	if len(lines) == 0 || !strings.Contains(lines[0], " HTTP/1.") {
+		return "", errMalformed
+	}
+	for _, line := range lines[1:] {
+		name, value, found := strings.Cut(line, ":")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "host") {
+			continue
+		}
+		host := strings.TrimSpace(value)
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		return host, nil
+	}
+	return "", nil
+}
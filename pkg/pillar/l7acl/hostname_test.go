@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package l7acl
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// captureClientHello returns ClientHello (including TLS record header(s))
+// generated by the Go TLS client for the given server name.
+func captureClientHello(t *testing.T, serverName string) []byte {
+	client, server := net.Pipe()
+	defer server.Close()
+	go func() {
+		tlsConn := tls.Client(client, &tls.Config{ServerName: serverName})
+		_ = tlsConn.Handshake()
+		client.Close()
+	}()
+	_ = server.SetReadDeadline(time.Now().Add(5 * time.Second))
+	header := make([]byte, tlsRecordHeaderLen)
+	if _, err := io.ReadFull(server, header); err != nil {
+		t.Fatalf("failed to read TLS record header: %v", err)
+	}
+	recordLen := int(header[3])<<8 | int(header[4])
+	record := make([]byte, recordLen)
+	if _, err := io.ReadFull(server, record); err != nil {
+		t.Fatalf("failed to read TLS record: %v", err)
+	}
+	return append(header, record...)
+}
+
+func TestExtractHostnameTLS(t *testing.T) {
+	hello := captureClientHello(t, "Api.Example.com")
+	hostname, complete := ExtractHostname(hello)
+	if !complete || hostname != "api.example.com" {
+		t.Errorf("unexpected result: hostname=%q, complete=%t", hostname, complete)
+	}
+	// ClientHello split across TCP segments.
+	for _, splitAt := range []int{1, tlsRecordHeaderLen, len(hello) / 2, len(hello) - 1} {
+		hostname, complete = ExtractHostname(hello[:splitAt])
+		if complete || hostname != "" {
+			t.Errorf("expected incomplete result for split at %d, got hostname=%q",
+				splitAt, hostname)
+		}
+	}
+	// ClientHello fragmented into two TLS records.
+	body := hello[tlsRecordHeaderLen:]
+	half := len(body) / 2
+	var fragmented []byte
+	for _, fragment := range [][]byte{body[:half], body[half:]} {
+		fragmented = append(fragmented, tlsRecordTypeHandshake, hello[1], hello[2],
+			byte(len(fragment)>>8), byte(len(fragment)))
+		fragmented = append(fragmented, fragment...)
+	}
+	hostname, complete = ExtractHostname(fragmented)
+	if !complete || hostname != "api.example.com" {
+		t.Errorf("unexpected result for fragmented ClientHello: hostname=%q, complete=%t",
+			hostname, complete)
+	}
+	// ClientHello without SNI.
+	hello = captureClientHello(t, "10.1.2.3")
+	hostname, complete = ExtractHostname(hello)
+	if !complete || hostname != "" {
+		t.Errorf("unexpected result without SNI: hostname=%q, complete=%t",
+			hostname, complete)
+	}
+}
+
+func TestExtractHostnameHTTP(t *testing.T) {
+	tests := []struct {
+		payload  string
+		hostname string
+		complete bool
+	}{
+		{"GET / HTTP/1.1\r\nHost: www.example.com\r\nAccept: */*\r\n\r\n",
+			"www.example.com", true},
+		{"POST /upload HTTP/1.1\r\nhost: Example.COM:8080\r\n\r\nbody",
+			"example.com", true},
+		{"GET / HTTP/1.0\r\nAccept: */*\r\n\r\n", "", true},
+		{"GET / HTTP/1.1\r\nHost: www.exa", "", false},
+		{"PO", "", false},
+		{"SSH-2.0-OpenSSH_9.0\r\n", "", true},
+		{"\x00\x01\x02", "", true},
+	}
+	for _, test := range tests {
+		hostname, complete := ExtractHostname([]byte(test.payload))
+		if hostname != test.hostname || complete != test.complete {
+			t.Errorf("payload %q: expected hostname=%q, complete=%t; "+
+				"got hostname=%q, complete=%t", test.payload, test.hostname,
+				test.complete, hostname, complete)
+		}
+	}
+}
+
+func TestMatchHostname(t *testing.T) {
+	tests := []struct {
+		ruleHost string
+		hostname string
+		match    bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "www.example.com", true},
+		{"Example.com.", "WWW.example.COM", true},
+		{"example.com", "badexample.com", false},
+		{"www.example.com", "example.com", false},
+		{"example.com", "", false},
+		{"", "example.com", false},
+	}
+	for _, test := range tests {
+		if match := MatchHostname(test.ruleHost, test.hostname); match != test.match {
+			t.Errorf("MatchHostname(%q, %q) = %t, expected %t",
+				test.ruleHost, test.hostname, match, test.match)
+		}
+	}
+}
@@ -0,0 +1,458 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package l7acl implements layer-7 enforcement of ACL rules matching on host names
+// (see types.HostACLEnforcementL7).
+// TCP connections matched by host-based ACL rules are first marked by iptables
+// (mangle table) as pending inspection (iptables.L7InspectAceID) and packets of these
+// connections are then passed to the Inspector using the NFQUEUE target.
+// Inspector extracts the host name from the TLS SNI extension or from the HTTP Host
+// header, finds the first matching host-based ACL rule of the application VIF
+// and replaces the pending connection mark with the mark of the matched rule.
+// If no rule matches, the connection is marked with the default drop mark.
+// Note that this is done regardless of the destination IP address, which may not
+// have been resolved by the network instance DNS server (e.g. when the application
+// uses a hard-coded IP, DNS-over-HTTPS or cached DNS responses).
+// Until the host name is decided, payload-carrying packets are dropped after their
+// payload is buffered (reassembled by TCP sequence numbers) - TCP then retransmits
+// them once the connection is re-marked. This way no payload reaches the destination
+// before the connection is allowed. If the host name cannot be found within
+// the limits on buffered payload and inspected packets, the connection is denied.
+// Once re-marked, packets of the connection are no longer queued and the connection
+// is allowed or dropped (blackholed) just like with other ACL rules.
+// Host names of inspected connections are remembered for some time to be included
+// in flow records (see LookupHostname).
+package l7acl
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/ti-mo/conntrack"
+)
+
+const (
+	// QueueNum : number of the netfilter queue used to pass packets
+	// of connections pending layer-7 inspection to the Inspector.
+	QueueNum uint16 = 100
+	// LogAndErrPrefix is prepended to every log message and error returned
+	// by the Inspector so that they are easy to filter in log file.
+	LogAndErrPrefix = "L7 ACL"
+
+	// Maximum number of bytes copied from every queued packet.
+	copyRange = 0xffff
+	// Maximum number of packets waiting in the queue.
+	maxQueueLen = 1024
+	// Maximum number of payload bytes buffered for a single connection while waiting
+	// for the complete TLS ClientHello or HTTP request header.
+	maxBufferedPayload = 16 * 1024
+	// Connection which does not reveal its host name within this number
+	// of (payload-carrying) packets is denied. Note that this includes
+	// retransmissions of dropped packets.
+	maxInspectedPackets = 16
+	// Connection state is discarded if it is not decided within this period.
+	pendingConnTimeout = time.Minute
+	// How long to remember host names of inspected connections.
+	// Flows are reported once they timeout in conntrack, which can take a while.
+	hostnameTTL = 2 * time.Hour
+	// Maximum number of remembered host names.
+	maxHostnames = 16384
+	// How often to remove expired entries.
+	gcInterval = time.Minute
+)
+
+// HostRule : ACL rule matching TCP connections by host name.
+type HostRule struct {
+	// RuleID : ID of the ACL rule (used in connection mark).
+	RuleID int32
+	// Host : host name to match. Subdomains are matched as well.
+	Host string
+	// MinPort, MaxPort : range of matched destination (remote) ports.
+	// Zero values are used to match any port.
+	MinPort, MaxPort uint16
+	// Drop : true if connections matched by the rule should be dropped.
+	Drop bool
+}
+
+// Equal compares two host rules.
+func (r HostRule) Equal(r2 HostRule) bool {
+	return r == r2
+}
+
+func (r HostRule) matchesPort(port uint16) bool {
+	if r.MinPort == 0 && r.MaxPort == 0 {
+		return true
+	}
+	return port >= r.MinPort && port <= r.MaxPort
+}
+
+// VIFRules : host-based ACL rules applied to TCP connections initiated by an app
+// via the given VIF.
+type VIFRules struct {
+	// HostIfName : host-side name of the VIF.
+	HostIfName string
+	// AppNum : application number used in connection marks.
+	AppNum uint8
+	// GuestIP : IP address assigned to the VIF on the guest side (inside the app).
+	GuestIP net.IP
+	// Rules : host-based ACL rules in the order of evaluation.
+	Rules []HostRule
+}
+
+// conntrackUpdater is used to update mark of an inspected connection.
+// Implemented by conntrack.Conn.
+type conntrackUpdater interface {
+	Update(f conntrack.Flow) error
+}
+
+// Inspector performs layer-7 inspection of application connections.
+type Inspector struct {
+	log *base.LogObject
+
+	mu        sync.Mutex
+	vifs      map[string]VIFRules // key: HostIfName
+	conns     map[flowKey]*pendingConn
+	hostnames map[flowKey]flowHostname
+	lastGC    time.Time
+}
+
+// flowKey identifies TCP connection initiated by an application.
+type flowKey struct {
+	appIP      [16]byte
+	remoteIP   [16]byte
+	appPort    uint16
+	remotePort uint16
+}
+
+func newFlowKey(appIP, remoteIP net.IP, appPort, remotePort uint16) (key flowKey) {
+	copy(key.appIP[:], appIP.To16())
+	copy(key.remoteIP[:], remoteIP.To16())
+	key.appPort = appPort
+	key.remotePort = remotePort
+	return key
+}
+
+// Connection pending inspection.
+type pendingConn struct {
+	createdAt time.Time
+	packets   int
+	// Sequence number of the first payload byte.
+	startSeq uint32
+	// True if startSeq was learned from the ACK completing the TCP handshake.
+	// Otherwise it is guessed from the received payload segments.
+	startKnown bool
+	// Buffered payload segments, key: sequence number of the first byte.
+	segments map[uint32][]byte
+	buffered int
+}
+
+// addSegment buffers payload segment. Returns false if the segment would exceed
+// the limit on buffered payload.
+func (c *pendingConn) addSegment(seq uint32, payload []byte) bool {
+	if c.segments == nil {
+		c.segments = make(map[uint32][]byte)
+		if !c.startKnown {
+			c.startSeq = seq
+		}
+	} else if !c.startKnown && seqDiff(seq, c.startSeq) < 0 {
+		// Segments were reordered.
+		c.startSeq = seq
+	}
+	if seqDiff(seq+uint32(len(payload)), c.startSeq) <= 0 {
+		// Nothing after the start of the stream.
+		return true
+	}
+	if prev, duplicate := c.segments[seq]; duplicate && len(prev) >= len(payload) {
+		// Retransmission.
+		return true
+	}
+	if c.buffered+len(payload) > maxBufferedPayload {
+		return false
+	}
+	c.buffered += len(payload) - len(c.segments[seq])
+	c.segments[seq] = append([]byte(nil), payload...)
+	return true
+}
+
+// payload returns buffered payload up to the first missing segment.
+func (c *pendingConn) payload() (payload []byte) {
+	nextSeq := c.startSeq
+	for {
+		var extended bool
+		for seq, segment := range c.segments {
+			offset := seqDiff(nextSeq, seq)
+			if offset < 0 || int(offset) >= len(segment) {
+				continue
+			}
+			payload = append(payload, segment[offset:]...)
+			nextSeq = seq + uint32(len(segment))
+			extended = true
+		}
+		if !extended {
+			return payload
+		}
+	}
+}
+
+// seqDiff returns the difference between two TCP sequence numbers,
+// taking wrap-around into account.
+func seqDiff(seq1, seq2 uint32) int32 {
+	return int32(seq1 - seq2)
+}
+
+// Host name of an inspected connection.
+type flowHostname struct {
+	hostname    string
+	inspectedAt time.Time
+}
+
+// NewInspector creates a new instance of Inspector.
+// Use Run to start processing of queued packets.
+func NewInspector(log *base.LogObject) *Inspector {
+	return &Inspector{
+		log:       log,
+		vifs:      make(map[string]VIFRules),
+		conns:     make(map[flowKey]*pendingConn),
+		hostnames: make(map[flowKey]flowHostname),
+	}
+}
+
+// UpdateVIFRules : add or update host-based ACL rules for a VIF.
+func (i *Inspector) UpdateVIFRules(rules VIFRules) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.vifs[rules.HostIfName] = rules
+}
+
+// RemoveVIFRules : remove host-based ACL rules of a VIF.
+func (i *Inspector) RemoveVIFRules(hostIfName string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.vifs, hostIfName)
+}
+
+// LookupHostname returns the host name of the inspected TCP connection initiated
+// by an application. Returns empty string if the connection was not inspected
+// or it did not carry a host name.
+func (i *Inspector) LookupHostname(appIP, remoteIP net.IP,
+	appPort, remotePort uint16) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.hostnames[newFlowKey(appIP, remoteIP, appPort, remotePort)].hostname
+}
+
+// Run binds to the netfilter queue and processes queued packets until the context
+// is canceled. Returns error if it fails to bind the queue.
+// Note that without Inspector running, packets of connections pending inspection
+// are dropped.
+func (i *Inspector) Run(ctx context.Context) error {
+	queue, err := openNFQueue(QueueNum, copyRange, maxQueueLen)
+	if err != nil {
+		return fmt.Errorf("%s: %w", LogAndErrPrefix, err)
+	}
+	ct, err := conntrack.Dial(nil)
+	if err != nil {
+		queue.close()
+		return fmt.Errorf("%s: failed to open conntrack netlink socket: %w",
+			LogAndErrPrefix, err)
+	}
+	i.log.Noticef("%s: Bound to netfilter queue %d", LogAndErrPrefix, QueueNum)
+	go func() {
+		<-ctx.Done()
+		queue.close()
+	}()
+	defer ct.Close()
+	for {
+		packets, err := queue.receive()
+		if err != nil {
+			if ctx.Err() != nil {
+				i.log.Noticef("%s: Stopped", LogAndErrPrefix)
+				return nil
+			}
+			i.log.Warnf("%s: Failed to receive packets from queue %d: %v",
+				LogAndErrPrefix, QueueNum, err)
+			continue
+		}
+		for _, packet := range packets {
+			verdict := i.inspectPacket(ct, packet.payload)
+			if err = queue.setVerdict(packet.id, verdict); err != nil {
+				i.log.Errorf("%s: Failed to set verdict for packet %d: %v",
+					LogAndErrPrefix, packet.id, err)
+			}
+		}
+		i.gc()
+	}
+}
+
+// inspectPacket processes packet of a connection pending inspection
+// and returns verdict.
+func (i *Inspector) inspectPacket(ct conntrackUpdater, ipPacket []byte) (verdict uint32) {
+	if len(ipPacket) == 0 {
+		return nfAccept
+	}
+	var decoder gopacket.Decoder = layers.LayerTypeIPv4
+	if ipPacket[0]>>4 == 6 {
+		decoder = layers.LayerTypeIPv6
+	}
+	packet := gopacket.NewPacket(ipPacket, decoder,
+		gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	netLayer := packet.NetworkLayer()
+	tcpLayer, isTCP := packet.TransportLayer().(*layers.TCP)
+	if netLayer == nil || !isTCP {
+		// Only TCP connections are inspected.
+		return nfAccept
+	}
+	appIP := net.IP(netLayer.NetworkFlow().Src().Raw())
+	remoteIP := net.IP(netLayer.NetworkFlow().Dst().Raw())
+	appPort := uint16(tcpLayer.SrcPort)
+	remotePort := uint16(tcpLayer.DstPort)
+	key := newFlowKey(appIP, remoteIP, appPort, remotePort)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(tcpLayer.Payload) == 0 {
+		if tcpLayer.FIN || tcpLayer.RST {
+			delete(i.conns, key)
+		} else if _, pending := i.conns[key]; !pending && tcpLayer.ACK && !tcpLayer.SYN {
+			// ACK completing the handshake carries the sequence number
+			// of the first payload byte.
+			i.conns[key] = &pendingConn{
+				createdAt:  time.Now(),
+				startSeq:   tcpLayer.Seq,
+				startKnown: true,
+			}
+		}
+		// Nothing to inspect yet.
+		return nfAccept
+	}
+	vif, found := i.lookupVIF(appIP)
+	if !found {
+		// Rules for this VIF are not yet known, TCP will retransmit.
+		i.log.Warnf("%s: Missing rules for app IP %v, dropping packet",
+			LogAndErrPrefix, appIP)
+		return nfDrop
+	}
+	conn := i.conns[key]
+	if conn == nil {
+		conn = &pendingConn{createdAt: time.Now()}
+		i.conns[key] = conn
+	}
+	conn.packets++
+	withinLimits := conn.addSegment(tcpLayer.Seq, tcpLayer.Payload) &&
+		conn.packets < maxInspectedPackets
+	hostname, complete := ExtractHostname(conn.payload())
+	if !complete {
+		if withinLimits {
+			// Payload is buffered, TCP will retransmit the packet once the host
+			// name is decided. Nothing should pass through until then.
+			return nfDrop
+		}
+		// Fail closed.
+		i.log.Warnf("%s: Host name of connection %v:%d -> %v:%d of VIF %s "+
+			"not found within limits (packets: %d, buffered bytes: %d)",
+			LogAndErrPrefix, appIP, appPort, remoteIP, remotePort, vif.HostIfName,
+			conn.packets, conn.buffered)
+		hostname = ""
+	}
+	delete(i.conns, key)
+
+	// Find the first matching rule.
+	var matchedRule *HostRule
+	for idx := range vif.Rules {
+		rule := vif.Rules[idx]
+		if rule.matchesPort(remotePort) && MatchHostname(rule.Host, hostname) {
+			matchedRule = &rule
+			break
+		}
+	}
+	var mark uint32
+	denied := matchedRule == nil || matchedRule.Drop
+	if matchedRule != nil {
+		mark = iptables.GetConnmark(vif.AppNum, uint32(matchedRule.RuleID),
+			matchedRule.Drop)
+	} else {
+		mark = iptables.GetConnmark(vif.AppNum, iptables.DefaultDropAceID, true)
+	}
+	flow := conntrack.NewFlow(uint8(layers.IPProtocolTCP), 0, appIP, remoteIP,
+		appPort, remotePort, 0, mark)
+	if err := ct.Update(flow); err != nil {
+		i.log.Errorf("%s: Failed to update mark of connection %v:%d -> %v:%d: %v",
+			LogAndErrPrefix, appIP, appPort, remoteIP, remotePort, err)
+	}
+	if hostname != "" {
+		i.hostnames[key] = flowHostname{
+			hostname:    hostname,
+			inspectedAt: time.Now(),
+		}
+	}
+	if denied {
+		var ruleDescr string
+		if matchedRule != nil {
+			ruleDescr = fmt.Sprintf("ACL rule %d", matchedRule.RuleID)
+		} else {
+			ruleDescr = "default drop rule"
+		}
+		i.log.Noticef("%s: Denied connection %v:%d -> %v:%d of VIF %s "+
+			"with host name %q (%s)", LogAndErrPrefix, appIP, appPort,
+			remoteIP, remotePort, vif.HostIfName, hostname, ruleDescr)
+		return nfDrop
+	}
+	i.log.Functionf("%s: Allowed connection %v:%d -> %v:%d of VIF %s "+
+		"with host name %q (ACL rule %d)", LogAndErrPrefix, appIP, appPort,
+		remoteIP, remotePort, vif.HostIfName, hostname, matchedRule.RuleID)
+	return nfAccept
+}
+
+// lookupVIF finds VIF by the IP address assigned to the app.
+// Network instances with host-based ACLs enforced at layer-7 are local and
+// cannot have overlapping subnets.
+func (i *Inspector) lookupVIF(appIP net.IP) (VIFRules, bool) {
+	for _, vif := range i.vifs {
+		if vif.GuestIP.Equal(appIP) {
+			return vif, true
+		}
+	}
+	return VIFRules{}, false
+}
+
+// gc removes expired connection states and host names.
+func (i *Inspector) gc() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	now := time.Now()
+	if now.Sub(i.lastGC) < gcInterval && len(i.hostnames) <= maxHostnames {
+		return
+	}
+	i.lastGC = now
+	for key, conn := range i.conns {
+		if now.Sub(conn.createdAt) > pendingConnTimeout {
+			delete(i.conns, key)
+		}
+	}
+	var oldest time.Time
+	for key, hostname := range i.hostnames {
+		if now.Sub(hostname.inspectedAt) > hostnameTTL {
+			delete(i.hostnames, key)
+			continue
+		}
+		if oldest.IsZero() || hostname.inspectedAt.Before(oldest) {
+			oldest = hostname.inspectedAt
+		}
+	}
+	// If there are still too many entries, remove the older half.
+	if len(i.hostnames) > maxHostnames {
+		threshold := oldest.Add(now.Sub(oldest) / 2)
+		for key, hostname := range i.hostnames {
+			if hostname.inspectedAt.Before(threshold) {
+				delete(i.hostnames, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package l7acl
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/sirupsen/logrus"
+	"github.com/ti-mo/conntrack"
+)
+
+var (
+	testAppIP    = net.ParseIP("10.11.12.2").To4()
+	testRemoteIP = net.ParseIP("93.184.216.34").To4()
+)
+
+const (
+	testAppNum  = 3
+	testAppPort = 40000
+	testRuleID  = 5
+	testISN     = 0xfffffff0 // to exercise wrap-around of sequence numbers
+)
+
+// mockConntrack records updated connection marks.
+type mockConntrack struct {
+	flows []conntrack.Flow
+}
+
+func (m *mockConntrack) Update(f conntrack.Flow) error {
+	m.flows = append(m.flows, f)
+	return nil
+}
+
+func newTestInspector() *Inspector {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	inspector := NewInspector(log)
+	inspector.UpdateVIFRules(VIFRules{
+		HostIfName: "nbu1x1",
+		AppNum:     testAppNum,
+		GuestIP:    testAppIP,
+		Rules: []HostRule{
+			{RuleID: testRuleID, Host: "example.com", MinPort: 443, MaxPort: 443},
+		},
+	})
+	return inspector
+}
+
+// makeTCPPacket returns IPv4 packet with TCP segment sent by the app.
+func makeTCPPacket(t *testing.T, seq uint32, payload []byte) []byte {
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    testAppIP,
+		DstIP:    testRemoteIP,
+	}
+	tcp := &layers.TCP{
+		SrcPort: testAppPort,
+		DstPort: 443,
+		Seq:     seq,
+		ACK:     true,
+		PSH:     len(payload) > 0,
+		Window:  65535,
+	}
+	if err := tcp.SetNetworkLayerForChecksum(ip); err != nil {
+		t.Fatal(err)
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	err := gopacket.SerializeLayers(buf, opts, ip, tcp, gopacket.Payload(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestInspectSplitClientHello(t *testing.T) {
+	inspector := newTestInspector()
+	ct := &mockConntrack{}
+	hello := captureClientHello(t, "api.example.com")
+	half := len(hello) / 2
+
+	// Handshake ACK without payload is let through.
+	verdict := inspector.inspectPacket(ct, makeTCPPacket(t, testISN, nil))
+	if verdict != nfAccept {
+		t.Errorf("expected accept for packet without payload, got %d", verdict)
+	}
+	// Second segment arrives first (reordered).
+	verdict = inspector.inspectPacket(ct,
+		makeTCPPacket(t, testISN+uint32(half), hello[half:]))
+	if verdict != nfDrop || len(ct.flows) != 0 {
+		t.Errorf("expected partial payload to be held back, got verdict %d", verdict)
+	}
+	verdict = inspector.inspectPacket(ct, makeTCPPacket(t, testISN, hello[:half]))
+	if verdict != nfAccept {
+		t.Errorf("expected accept for allowed host name, got %d", verdict)
+	}
+	if len(ct.flows) != 1 {
+		t.Fatalf("expected connection mark to be updated once, got %d", len(ct.flows))
+	}
+	expMark := iptables.GetConnmark(testAppNum, testRuleID, false)
+	if ct.flows[0].Mark != expMark {
+		t.Errorf("expected mark %#x, got %#x", expMark, ct.flows[0].Mark)
+	}
+	hostname := inspector.LookupHostname(testAppIP, testRemoteIP, testAppPort, 443)
+	if hostname != "api.example.com" {
+		t.Errorf("unexpected host name %q", hostname)
+	}
+}
+
+func TestInspectCraftedPrefix(t *testing.T) {
+	// TLS record header announcing a long record.
+	tlsPrefix := []byte{tlsRecordTypeHandshake, 0x03, 0x01, 0x3f, 0xff,
+		tlsHandshakeClientHello}
+	tests := []struct {
+		name   string
+		prefix []byte
+	}{
+		{name: "fake TLS record header", prefix: tlsPrefix},
+		{name: "unterminated HTTP request", prefix: []byte("GET /")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inspector := newTestInspector()
+			ct := &mockConntrack{}
+			seq := uint32(testISN)
+			filler := make([]byte, 1000)
+			for j := range filler {
+				filler[j] = 'a'
+			}
+			payload := append(append([]byte{}, test.prefix...), filler...)
+			var packets int
+			for len(ct.flows) == 0 {
+				if packets > maxInspectedPackets {
+					t.Fatalf("connection not decided after %d packets", packets)
+				}
+				verdict := inspector.inspectPacket(ct, makeTCPPacket(t, seq, payload))
+				packets++
+				// Nothing should be let through.
+				if verdict != nfDrop {
+					t.Fatalf("packet %d was not dropped (verdict %d)", packets, verdict)
+				}
+				seq += uint32(len(payload))
+				payload = filler
+			}
+			expMark := iptables.GetConnmark(testAppNum, iptables.DefaultDropAceID, true)
+			if ct.flows[0].Mark != expMark {
+				t.Errorf("expected default drop mark %#x, got %#x",
+					expMark, ct.flows[0].Mark)
+			}
+		})
+	}
+}
+
+func TestPendingConnPayload(t *testing.T) {
+	conn := &pendingConn{}
+	conn.addSegment(testISN+3, []byte("def"))
+	conn.addSegment(testISN+9, []byte("jkl"))
+	if payload := string(conn.payload()); payload != "def" {
+		t.Errorf("unexpected payload %q", payload)
+	}
+	conn.addSegment(testISN, []byte("abc"))
+	if payload := string(conn.payload()); payload != "abcdef" {
+		t.Errorf("unexpected payload %q", payload)
+	}
+	// Retransmission with different segmentation.
+	conn.addSegment(testISN+4, []byte("efghi"))
+	if payload := string(conn.payload()); payload != "abcdefghijkl" {
+		t.Errorf("unexpected payload %q", payload)
+	}
+	if !conn.addSegment(testISN, []byte("abc")) {
+		t.Errorf("retransmission should not exceed the limit")
+	}
+	if conn.addSegment(testISN+12, make([]byte, maxBufferedPayload)) {
+		t.Errorf("expected limit on buffered payload to be reached")
+	}
+}
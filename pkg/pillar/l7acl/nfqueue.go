@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package l7acl
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mdlayher/netlink"
+	"github.com/ti-mo/netfilter"
+	"golang.org/x/sys/unix"
+)
+
+// Minimal client for the netfilter queue (nfnetlink_queue) subsystem.
+// Constants are taken from include/uapi/linux/netfilter/nfnetlink_queue.h
+
+// Message types.
+const (
+	nfqnlMsgPacket  = 0
+	nfqnlMsgVerdict = 1
+	nfqnlMsgConfig  = 2
+)
+
+// Attributes of packet and verdict messages.
+const (
+	nfqaPacketHdr  = 1
+	nfqaVerdictHdr = 2
+	nfqaPayload    = 10
+)
+
+// Attributes of config messages.
+const (
+	nfqaCfgCmd         = 1
+	nfqaCfgParams      = 2
+	nfqaCfgQueueMaxLen = 3
+)
+
+// Config command to bind to a queue.
+const nfqnlCfgCmdBind = 1
+
+// Copy mode: copy entire packet (up to copy range).
+const nfqnlCopyPacket = 2
+
+// Verdicts (include/uapi/linux/netfilter.h).
+const (
+	nfDrop   = 0
+	nfAccept = 1
+)
+
+// queuedPacket : packet received from the netfilter queue.
+type queuedPacket struct {
+	id      uint32
+	payload []byte // starts with IP header
+}
+
+// nfQueue : netlink connection bound to a netfilter queue.
+type nfQueue struct {
+	conn     *netlink.Conn
+	queueNum uint16
+}
+
+// openNFQueue binds to the given netfilter queue and configures it to copy
+// up to copyRange bytes of every queued packet.
+func openNFQueue(queueNum uint16, copyRange, maxQueueLen uint32) (*nfQueue, error) {
+	conn, err := netlink.Dial(unix.NETLINK_NETFILTER, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open netfilter netlink socket: %w", err)
+	}
+	q := &nfQueue{conn: conn, queueNum: queueNum}
+	// Do not fail when packets are queued faster than we are able to read them.
+	// Kernel will drop them and TCP will retransmit.
+	_ = conn.SetOption(netlink.NoENOBUFS, true)
+	// struct nfqnl_msg_config_cmd: command (u8), pad (u8), pf (be16)
+	bindCmd := []byte{nfqnlCfgCmdBind, 0, 0, 0}
+	if err = q.configure(netfilter.Attribute{Type: nfqaCfgCmd, Data: bindCmd}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to bind netfilter queue %d: %w", queueNum, err)
+	}
+	// struct nfqnl_msg_config_params: copy_range (be32), copy_mode (u8)
+	params := make([]byte, 5)
+	binary.BigEndian.PutUint32(params[:4], copyRange)
+	params[4] = nfqnlCopyPacket
+	if err = q.configure(netfilter.Attribute{Type: nfqaCfgParams, Data: params}); err != nil {
+		q.close()
+		return nil, fmt.Errorf("failed to set copy mode for netfilter queue %d: %w",
+			queueNum, err)
+	}
+	maxLen := netfilter.Uint32Bytes(maxQueueLen)
+	if err = q.configure(netfilter.Attribute{Type: nfqaCfgQueueMaxLen, Data: maxLen}); err != nil {
+		q.close()
+		return nil, fmt.Errorf("failed to set max length for netfilter queue %d: %w",
+			queueNum, err)
+	}
+	return q, nil
+}
+
+func (q *nfQueue) configure(attr netfilter.Attribute) error {
+	msg, err := netfilter.MarshalNetlink(netfilter.Header{
+		SubsystemID: netfilter.NFSubsysQueue,
+		MessageType: nfqnlMsgConfig,
+		Family:      netfilter.ProtoUnspec,
+		ResourceID:  q.queueNum,
+		Flags:       netlink.Request | netlink.Acknowledge,
+	}, []netfilter.Attribute{attr})
+	if err != nil {
+		return err
+	}
+	_, err = q.conn.Execute(msg)
+	return err
+}
+
+// receive blocks until at least one packet is received from the queue.
+func (q *nfQueue) receive() (packets []queuedPacket, err error) {
+	msgs, err := q.conn.Receive()
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		hdr, ad, err := netfilter.DecodeNetlink(msg)
+		if err != nil {
+			return packets, err
+		}
+		if hdr.SubsystemID != netfilter.NFSubsysQueue ||
+			hdr.MessageType != nfqnlMsgPacket {
+			continue
+		}
+		var packet queuedPacket
+		var haveID bool
+		for ad.Next() {
+			switch ad.Type() {
+			case nfqaPacketHdr:
+				// struct nfqnl_msg_packet_hdr: packet_id (be32), hw_protocol (be16),
+				// hook (u8)
+				data := ad.Bytes()
+				if len(data) >= 4 {
+					packet.id = binary.BigEndian.Uint32(data[:4])
+					haveID = true
+				}
+			case nfqaPayload:
+				packet.payload = ad.Bytes()
+			}
+		}
+		if err = ad.Err(); err != nil {
+			return packets, err
+		}
+		if haveID {
+			packets = append(packets, packet)
+		}
+	}
+	return packets, nil
+}
+
+// setVerdict tells the kernel what to do with a queued packet.
+func (q *nfQueue) setVerdict(packetID, verdict uint32) error {
+	// struct nfqnl_msg_verdict_hdr: verdict (be32), id (be32)
+	verdictHdr := make([]byte, 8)
+	binary.BigEndian.PutUint32(verdictHdr[:4], verdict)
+	binary.BigEndian.PutUint32(verdictHdr[4:], packetID)
+	msg, err := netfilter.MarshalNetlink(netfilter.Header{
+		SubsystemID: netfilter.NFSubsysQueue,
+		MessageType: nfqnlMsgVerdict,
+		Family:      netfilter.ProtoUnspec,
+		ResourceID:  q.queueNum,
+		Flags:       netlink.Request,
+	}, []netfilter.Attribute{{Type: nfqaVerdictHdr, Data: verdictHdr}})
+	if err != nil {
+		return err
+	}
+	_, err = q.conn.Send(msg)
+	return err
+}
+
+// close closes the netlink connection, which also unblocks pending receive.
+// Kernel unbinds the queue once the socket is closed. Packets queued afterwards
+// are dropped by the kernel.
+func (q *nfQueue) close() error {
+	return q.conn.Close()
+}
@@ -0,0 +1,350 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lldp implements LLDP (IEEE 802.1AB) and CDP receiver and LLDP
+// transmitter, used to discover network devices (switches, routers)
+// directly connected to device ports.
+package lldp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	readTimeout = time.Second
+	// txInterval and txTTL are the defaults recommended by IEEE 802.1AB
+	// (msgTxInterval=30s, msgTxHold=4).
+	txInterval = 30 * time.Second
+	txTTL      = 4 * txInterval
+	// How long to wait before trying to re-open socket for a port
+	// (e.g. when the interface does not exist yet).
+	reopenInterval = 10 * time.Second
+)
+
+// PortConfig : neighbor discovery configuration for a single device port.
+type PortConfig struct {
+	IfName       string
+	LogicalLabel string
+	// Transmit enables sending of LLDP advertisements from the port.
+	Transmit bool
+}
+
+// Agent runs LLDP/CDP receiver (and optionally LLDP transmitter) for every
+// configured device port. Neighbors learned on a port are kept until
+// their advertised TTL expires.
+type Agent struct {
+	Log *base.LogObject
+
+	sync.Mutex
+	ctx    context.Context
+	events chan struct{}
+	ports  map[string]*portAgent // key = ifName
+}
+
+type portAgent struct {
+	config     PortConfig
+	systemName string
+	// ctx and cancel are nil until Agent.Watch is called.
+	ctx       context.Context
+	cancel    context.CancelFunc
+	neighbors map[neighborKey]neighborEntry
+}
+
+type neighborKey struct {
+	protocol  types.NeighborDiscoveryProtocol
+	chassisID string
+	portID    string
+}
+
+type neighborEntry struct {
+	neighbor  types.PortNeighbor
+	expiresAt time.Time
+}
+
+// Watch starts neighbor discovery on the configured ports. The returned channel
+// is used to notify about changes in the set of learned neighbors.
+// Neighbor discovery stops when ctx is cancelled.
+func (a *Agent) Watch(ctx context.Context) (<-chan struct{}, error) {
+	a.Lock()
+	defer a.Unlock()
+	if a.ctx != nil {
+		return nil, errors.New("neighbor discovery is already running")
+	}
+	a.ctx = ctx
+	a.events = make(chan struct{}, 1)
+	for _, port := range a.ports {
+		a.startPort(port)
+	}
+	return a.events, nil
+}
+
+// UpdatePorts updates the set of ports on which neighbor discovery runs.
+// systemName is advertised by the LLDP transmitter (hostname is used if empty).
+func (a *Agent) UpdatePorts(ports []PortConfig, systemName string) {
+	a.Lock()
+	defer a.Unlock()
+	if a.ports == nil {
+		a.ports = make(map[string]*portAgent)
+	}
+	var changed bool
+	newPorts := make(map[string]*portAgent)
+	for _, config := range ports {
+		prevPort := a.ports[config.IfName]
+		if prevPort != nil && prevPort.config == config &&
+			prevPort.systemName == systemName {
+			newPorts[config.IfName] = prevPort
+			continue
+		}
+		port := &portAgent{
+			config:     config,
+			systemName: systemName,
+			neighbors:  make(map[neighborKey]neighborEntry),
+		}
+		if prevPort != nil {
+			a.stopPort(prevPort)
+			// Neighbors remain valid.
+			port.neighbors = prevPort.neighbors
+		}
+		newPorts[config.IfName] = port
+		a.startPort(port)
+	}
+	for ifName, port := range a.ports {
+		if _, keep := newPorts[ifName]; !keep {
+			a.stopPort(port)
+			if len(port.neighbors) > 0 {
+				changed = true
+			}
+		}
+	}
+	a.ports = newPorts
+	if changed {
+		a.notify()
+	}
+}
+
+// GetNeighbors returns neighbors currently known for the given port.
+func (a *Agent) GetNeighbors(ifName string) (neighbors []types.PortNeighbor) {
+	a.Lock()
+	defer a.Unlock()
+	port := a.ports[ifName]
+	if port == nil {
+		return nil
+	}
+	now := time.Now()
+	for _, entry := range port.neighbors {
+		if now.Before(entry.expiresAt) {
+			neighbors = append(neighbors, entry.neighbor)
+		}
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Protocol != neighbors[j].Protocol {
+			return neighbors[i].Protocol < neighbors[j].Protocol
+		}
+		if neighbors[i].ChassisID != neighbors[j].ChassisID {
+			return neighbors[i].ChassisID < neighbors[j].ChassisID
+		}
+		return neighbors[i].PortID < neighbors[j].PortID
+	})
+	return neighbors
+}
+
+// startPort must be called with Agent locked.
+func (a *Agent) startPort(port *portAgent) {
+	if a.ctx == nil {
+		// Not watching yet.
+		return
+	}
+	port.ctx, port.cancel = context.WithCancel(a.ctx)
+	go a.runPort(port)
+}
+
+// stopPort must be called with Agent locked.
+func (a *Agent) stopPort(port *portAgent) {
+	if port.cancel != nil {
+		port.cancel()
+	}
+}
+
+// notify must be called with Agent locked.
+func (a *Agent) notify() {
+	if a.events == nil {
+		return
+	}
+	select {
+	case a.events <- struct{}{}:
+	default:
+		// Notification is already pending.
+	}
+}
+
+func (a *Agent) runPort(port *portAgent) {
+	ifName := port.config.IfName
+	var lastErr string
+	for {
+		sock, err := openRawSocket(captureIfName(ifName), readTimeout)
+		if err == nil {
+			a.Log.Functionf("Started LLDP/CDP neighbor discovery on port %s", ifName)
+			err = a.servePort(port, sock)
+			sock.close()
+		}
+		if port.ctx.Err() != nil {
+			a.Log.Functionf("Stopped LLDP/CDP neighbor discovery on port %s", ifName)
+			return
+		}
+		if err != nil && err.Error() != lastErr {
+			a.Log.Warnf("LLDP/CDP neighbor discovery on port %s failed: %v",
+				ifName, err)
+			lastErr = err.Error()
+		}
+		select {
+		case <-port.ctx.Done():
+			return
+		case <-time.After(reopenInterval):
+		}
+	}
+}
+
+func (a *Agent) servePort(port *portAgent, sock *rawSocket) error {
+	buf := make([]byte, maxFrameSize)
+	var nextTx time.Time
+	for port.ctx.Err() == nil {
+		frame, timeout, err := sock.read(buf)
+		if err != nil {
+			return err
+		}
+		if !timeout {
+			if neighbor, ttl, ok := ParseFrame(frame); ok {
+				a.learnNeighbor(port, neighbor, ttl)
+			}
+		}
+		a.expireNeighbors(port)
+		if port.config.Transmit && !time.Now().Before(nextTx) {
+			if err = a.transmit(port, sock); err != nil {
+				a.Log.Warnf("Failed to send LLDP advertisement from port %s: %v",
+					port.config.IfName, err)
+			}
+			nextTx = time.Now().Add(txInterval)
+		}
+	}
+	return nil
+}
+
+func (a *Agent) learnNeighbor(port *portAgent, neighbor types.PortNeighbor,
+	ttl time.Duration) {
+	a.Lock()
+	defer a.Unlock()
+	if port.ctx.Err() != nil {
+		// Port was reconfigured or removed in the meantime.
+		return
+	}
+	key := neighborKey{
+		protocol:  neighbor.Protocol,
+		chassisID: neighbor.ChassisID,
+		portID:    neighbor.PortID,
+	}
+	prevEntry, known := port.neighbors[key]
+	if ttl == 0 {
+		// LLDP shutdown advertisement.
+		if known {
+			a.Log.Noticef("%s neighbor %s (port %s) of port %s went away",
+				neighbor.Protocol, neighbor.ChassisID, neighbor.PortID,
+				port.config.IfName)
+			delete(port.neighbors, key)
+			a.notify()
+		}
+		return
+	}
+	port.neighbors[key] = neighborEntry{
+		neighbor:  neighbor,
+		expiresAt: time.Now().Add(ttl),
+	}
+	if !known {
+		a.Log.Noticef("Discovered %s neighbor %s (port %s) on port %s",
+			neighbor.Protocol, neighbor.ChassisID, neighbor.PortID,
+			port.config.IfName)
+	}
+	if !known || !reflect.DeepEqual(prevEntry.neighbor, neighbor) {
+		a.notify()
+	}
+}
+
+func (a *Agent) expireNeighbors(port *portAgent) {
+	a.Lock()
+	defer a.Unlock()
+	if port.ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+	for key, entry := range port.neighbors {
+		if now.Before(entry.expiresAt) {
+			continue
+		}
+		a.Log.Noticef("%s neighbor %s (port %s) of port %s expired",
+			key.protocol, key.chassisID, key.portID, port.config.IfName)
+		delete(port.neighbors, key)
+		a.notify()
+	}
+}
+
+func (a *Agent) transmit(port *portAgent, sock *rawSocket) error {
+	link, err := net.InterfaceByName(port.config.IfName)
+	if err != nil {
+		return err
+	}
+	if len(link.HardwareAddr) != 6 {
+		return fmt.Errorf("unexpected MAC address: %v", link.HardwareAddr)
+	}
+	adv := advertisement{
+		srcMAC:          link.HardwareAddr,
+		ifName:          port.config.IfName,
+		ifIndex:         link.Index,
+		portDescription: port.config.LogicalLabel,
+		systemName:      port.systemName,
+		ttl:             txTTL,
+	}
+	if adv.systemName == "" {
+		adv.systemName, _ = os.Hostname()
+	}
+	addrs, err := link.Addrs()
+	if err == nil {
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok || !ipNet.IP.IsGlobalUnicast() {
+				continue
+			}
+			adv.mgmtAddrs = append(adv.mgmtAddrs, ipNet.IP)
+		}
+	}
+	frame, err := buildLLDPFrame(adv)
+	if err != nil {
+		return err
+	}
+	return sock.write(frame, LLDPMulticastMAC)
+}
+
+// captureIfName returns the name of the interface to open the socket for.
+// When a port is bridged, the physical interface is renamed to "k" + ifName
+// and the bridge (taking the original name) does not receive link-local
+// frames like LLDP.
+func captureIfName(ifName string) string {
+	if _, err := os.Stat(filepath.Join("/sys/class/net", ifName, "bridge")); err != nil {
+		return ifName
+	}
+	kernIfName := "k" + ifName
+	if _, err := os.Stat(filepath.Join("/sys/class/net", kernIfName)); err != nil {
+		return ifName
+	}
+	return kernIfName
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lldp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+	"unicode"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var (
+	// LLDPMulticastMAC : destination MAC address of LLDP frames
+	// (nearest bridge group address, never forwarded by 802.1D bridges).
+	LLDPMulticastMAC = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+	// CDPMulticastMAC : destination MAC address of CDP frames.
+	CDPMulticastMAC = net.HardwareAddr{0x01, 0x00, 0x0c, 0xcc, 0xcc, 0xcc}
+)
+
+// ParseFrame decodes LLDP or CDP advertisement carried by the given Ethernet frame.
+// Returns ok=false if the frame does not carry a valid advertisement.
+// ttl is the time for which the advertised information remains valid.
+// Zero TTL is used by LLDP agents to announce that the neighbor is going away.
+func ParseFrame(frame []byte) (neighbor types.PortNeighbor, ttl time.Duration, ok bool) {
+	packet := gopacket.NewPacket(frame, layers.LayerTypeEthernet, gopacket.NoCopy)
+	if lldpLayer := packet.Layer(layers.LayerTypeLinkLayerDiscovery); lldpLayer != nil {
+		return parseLLDP(packet, lldpLayer.(*layers.LinkLayerDiscovery))
+	}
+	if cdpLayer := packet.Layer(layers.LayerTypeCiscoDiscovery); cdpLayer != nil {
+		return parseCDP(packet, cdpLayer.(*layers.CiscoDiscovery))
+	}
+	return neighbor, 0, false
+}
+
+func parseLLDP(packet gopacket.Packet,
+	lldp *layers.LinkLayerDiscovery) (neighbor types.PortNeighbor, ttl time.Duration, ok bool) {
+	neighbor.Protocol = types.NeighborDiscoveryProtocolLLDP
+	switch lldp.ChassisID.Subtype {
+	case layers.LLDPChassisIDSubTypeMACAddr:
+		neighbor.ChassisID = formatMAC(lldp.ChassisID.ID)
+	case layers.LLDPChassisIDSubTypeNetworkAddr:
+		neighbor.ChassisID = formatNetworkAddr(lldp.ChassisID.ID)
+	default:
+		neighbor.ChassisID = formatID(lldp.ChassisID.ID)
+	}
+	switch lldp.PortID.Subtype {
+	case layers.LLDPPortIDSubtypeMACAddr:
+		neighbor.PortID = formatMAC(lldp.PortID.ID)
+	case layers.LLDPPortIDSubtypeNetworkAddr:
+		neighbor.PortID = formatNetworkAddr(lldp.PortID.ID)
+	default:
+		neighbor.PortID = formatID(lldp.PortID.ID)
+	}
+	if neighbor.ChassisID == "" {
+		return neighbor, 0, false
+	}
+	if infoLayer := packet.Layer(layers.LayerTypeLinkLayerDiscoveryInfo); infoLayer != nil {
+		info := infoLayer.(*layers.LinkLayerDiscoveryInfo)
+		neighbor.PortDescription = info.PortDescription
+		neighbor.SystemName = info.SysName
+		if info8021, err := info.Decode8021(); err == nil {
+			neighbor.VlanID = info8021.PVID
+		}
+	}
+	// gopacket keeps only the last Management Address TLV, but there can be
+	// multiple of them (e.g. IPv4 and IPv6 address).
+	for _, value := range lldp.Values {
+		if value.Type != layers.LLDPTLVMgmtAddress {
+			continue
+		}
+		if ip := parseLLDPMgmtAddr(value.Value); ip != nil {
+			neighbor.MgmtAddrs = append(neighbor.MgmtAddrs, ip)
+		}
+	}
+	return neighbor, time.Duration(lldp.TTL) * time.Second, true
+}
+
+// parseLLDPMgmtAddr returns IP address carried by the value of the Management
+// Address TLV. Returns nil if the TLV is malformed or carries a different
+// type of address.
+func parseLLDPMgmtAddr(value []byte) net.IP {
+	if len(value) < 2 {
+		return nil
+	}
+	addrStrLen := int(value[0]) // includes address subtype
+	if addrStrLen < 1 || len(value) < 1+addrStrLen {
+		return nil
+	}
+	addr := value[2 : 1+addrStrLen]
+	switch layers.IANAAddressFamily(value[1]) {
+	case layers.IANAAddressFamilyIPV4:
+		if len(addr) == net.IPv4len {
+			return net.IP(addr).To16()
+		}
+	case layers.IANAAddressFamilyIPV6:
+		if len(addr) == net.IPv6len {
+			return append(net.IP{}, addr...)
+		}
+	}
+	return nil
+}
+
+func parseCDP(packet gopacket.Packet,
+	cdp *layers.CiscoDiscovery) (neighbor types.PortNeighbor, ttl time.Duration, ok bool) {
+	infoLayer := packet.Layer(layers.LayerTypeCiscoDiscoveryInfo)
+	if infoLayer == nil {
+		return neighbor, 0, false
+	}
+	info := infoLayer.(*layers.CiscoDiscoveryInfo)
+	if info.DeviceID == "" {
+		return neighbor, 0, false
+	}
+	neighbor.Protocol = types.NeighborDiscoveryProtocolCDP
+	neighbor.ChassisID = info.DeviceID
+	neighbor.PortID = info.PortID
+	neighbor.SystemName = info.SysName
+	if neighbor.SystemName == "" {
+		neighbor.SystemName = info.DeviceID
+	}
+	neighbor.VlanID = info.NativeVLAN
+	mgmtAddrs := info.MgmtAddresses
+	if len(mgmtAddrs) == 0 {
+		mgmtAddrs = info.Addresses
+	}
+	for _, ip := range mgmtAddrs {
+		if ip = ip.To16(); ip != nil {
+			neighbor.MgmtAddrs = append(neighbor.MgmtAddrs, ip)
+		}
+	}
+	return neighbor, time.Duration(cdp.TTL) * time.Second, true
+}
+
+func formatMAC(id []byte) string {
+	if len(id) != 6 {
+		return formatID(id)
+	}
+	return net.HardwareAddr(id).String()
+}
+
+func formatNetworkAddr(id []byte) string {
+	if ip := parseLLDPMgmtAddr(append([]byte{byte(len(id))}, id...)); ip != nil {
+		return ip.String()
+	}
+	return formatID(id)
+}
+
+// formatID returns ID as a string if it is printable, otherwise as a hex string.
+func formatID(id []byte) string {
+	for _, r := range string(id) {
+		if !unicode.IsPrint(r) {
+			return hex.EncodeToString(id)
+		}
+	}
+	return string(id)
+}
+
+// advertisement : content of the LLDP frame sent by the device from a port.
+type advertisement struct {
+	srcMAC          net.HardwareAddr
+	ifName          string
+	ifIndex         int
+	portDescription string
+	systemName      string
+	mgmtAddrs       []net.IP
+	ttl             time.Duration
+}
+
+// buildLLDPFrame returns Ethernet frame with LLDP advertisement.
+func buildLLDPFrame(adv advertisement) ([]byte, error) {
+	lldp := &layers.LinkLayerDiscovery{
+		ChassisID: layers.LLDPChassisID{
+			Subtype: layers.LLDPChassisIDSubTypeMACAddr,
+			ID:      adv.srcMAC,
+		},
+		PortID: layers.LLDPPortID{
+			Subtype: layers.LLDPPortIDSubtypeIfaceName,
+			ID:      []byte(adv.ifName),
+		},
+		TTL: uint16(adv.ttl / time.Second),
+	}
+	addStringTLV := func(tlvType layers.LLDPTLVType, value string) {
+		if value == "" {
+			return
+		}
+		lldp.Values = append(lldp.Values, layers.LinkLayerDiscoveryValue{
+			Type:   tlvType,
+			Length: uint16(len(value)),
+			Value:  []byte(value),
+		})
+	}
+	addStringTLV(layers.LLDPTLVPortDescription, adv.portDescription)
+	addStringTLV(layers.LLDPTLVSysName, adv.systemName)
+	addStringTLV(layers.LLDPTLVSysDescription, "EVE-OS")
+	for _, ip := range adv.mgmtAddrs {
+		family := layers.IANAAddressFamilyIPV6
+		addr := ip.To16()
+		if ip4 := ip.To4(); ip4 != nil {
+			family = layers.IANAAddressFamilyIPV4
+			addr = ip4
+		}
+		value := []byte{byte(len(addr) + 1), byte(family)}
+		value = append(value, addr...)
+		// Interface numbering subtype: ifIndex
+		ifNumber := make([]byte, 5)
+		ifNumber[0] = byte(layers.LLDPInterfaceSubtypeifIndex)
+		binary.BigEndian.PutUint32(ifNumber[1:], uint32(adv.ifIndex))
+		value = append(value, ifNumber...)
+		value = append(value, 0) // OID string length
+		lldp.Values = append(lldp.Values, layers.LinkLayerDiscoveryValue{
+			Type:   layers.LLDPTLVMgmtAddress,
+			Length: uint16(len(value)),
+			Value:  value,
+		})
+	}
+	eth := &layers.Ethernet{
+		SrcMAC:       adv.srcMAC,
+		DstMAC:       LLDPMulticastMAC,
+		EthernetType: layers.EthernetTypeLinkLayerDiscovery,
+	}
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, eth, lldp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize LLDP frame: %w", err)
+	}
+	return buf.Bytes(), nil
+}
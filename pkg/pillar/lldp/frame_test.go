@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lldp
+
+import (
+	"encoding/binary"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var testMAC = net.HardwareAddr{0x02, 0x16, 0x3e, 0x01, 0x02, 0x03}
+
+func TestLLDPRoundTrip(t *testing.T) {
+	frame, err := buildLLDPFrame(advertisement{
+		srcMAC:          testMAC,
+		ifName:          "eth0",
+		ifIndex:         2,
+		portDescription: "uplink",
+		systemName:      "edge-node",
+		mgmtAddrs:       []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("2001:db8::10")},
+		ttl:             txTTL,
+	})
+	if err != nil {
+		t.Fatalf("buildLLDPFrame failed: %v", err)
+	}
+	neighbor, ttl, ok := ParseFrame(frame)
+	if !ok {
+		t.Fatalf("failed to parse LLDP frame")
+	}
+	if ttl != txTTL {
+		t.Errorf("unexpected TTL: %v", ttl)
+	}
+	expected := types.PortNeighbor{
+		Protocol:        types.NeighborDiscoveryProtocolLLDP,
+		ChassisID:       testMAC.String(),
+		PortID:          "eth0",
+		PortDescription: "uplink",
+		SystemName:      "edge-node",
+		MgmtAddrs:       []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("2001:db8::10")},
+	}
+	if !reflect.DeepEqual(neighbor, expected) {
+		t.Errorf("unexpected neighbor: %+v", neighbor)
+	}
+}
+
+func TestLLDPPortVLAN(t *testing.T) {
+	pvid := make([]byte, 6)
+	copy(pvid, []byte{0x00, 0x80, 0xc2, layers.LLDP8021SubtypePortVLANID})
+	binary.BigEndian.PutUint16(pvid[4:], 100)
+	lldp := &layers.LinkLayerDiscovery{
+		ChassisID: layers.LLDPChassisID{
+			Subtype: layers.LLDPChassisIDSubTypeLocal,
+			ID:      []byte("switch-1"),
+		},
+		PortID: layers.LLDPPortID{
+			Subtype: layers.LLDPPortIDSubtypeMACAddr,
+			ID:      testMAC,
+		},
+		TTL: 120,
+		Values: []layers.LinkLayerDiscoveryValue{
+			{Type: layers.LLDPTLVOrgSpecific, Length: uint16(len(pvid)), Value: pvid},
+		},
+	}
+	eth := &layers.Ethernet{
+		SrcMAC:       testMAC,
+		DstMAC:       LLDPMulticastMAC,
+		EthernetType: layers.EthernetTypeLinkLayerDiscovery,
+	}
+	buf := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, eth, lldp); err != nil {
+		t.Fatal(err)
+	}
+	neighbor, _, ok := ParseFrame(buf.Bytes())
+	if !ok {
+		t.Fatalf("failed to parse LLDP frame")
+	}
+	if neighbor.ChassisID != "switch-1" || neighbor.PortID != testMAC.String() ||
+		neighbor.VlanID != 100 {
+		t.Errorf("unexpected neighbor: %+v", neighbor)
+	}
+}
+
+func TestLLDPShutdown(t *testing.T) {
+	frame, err := buildLLDPFrame(advertisement{srcMAC: testMAC, ifName: "eth1"})
+	if err != nil {
+		t.Fatalf("buildLLDPFrame failed: %v", err)
+	}
+	_, ttl, ok := ParseFrame(frame)
+	if !ok || ttl != 0 {
+		t.Errorf("expected shutdown advertisement, got ok=%t ttl=%v", ok, ttl)
+	}
+}
+
+// cdpFrame builds CDPv2 frame with the given TLVs.
+func cdpFrame(ttl byte, tlvs map[uint16][]byte) []byte {
+	payload := []byte{
+		0xaa, 0xaa, 0x03, // LLC
+		0x00, 0x00, 0x0c, 0x20, 0x00, // SNAP: Cisco OUI, CDP
+		0x02, ttl, 0x00, 0x00, // CDP version, TTL, checksum
+	}
+	// Use fixed order to produce deterministic output.
+	for _, tlvType := range []uint16{0x0001, 0x0003, 0x000a, 0x0014, 0x0016} {
+		value, ok := tlvs[tlvType]
+		if !ok {
+			continue
+		}
+		tlv := make([]byte, 4)
+		binary.BigEndian.PutUint16(tlv, tlvType)
+		binary.BigEndian.PutUint16(tlv[2:], uint16(len(value)+4))
+		payload = append(payload, append(tlv, value...)...)
+	}
+	frame := append([]byte{}, CDPMulticastMAC...)
+	frame = append(frame, testMAC...)
+	length := make([]byte, 2)
+	binary.BigEndian.PutUint16(length, uint16(len(payload)))
+	frame = append(frame, length...)
+	return append(frame, payload...)
+}
+
+func TestCDP(t *testing.T) {
+	mgmtAddr := []byte{
+		0x00, 0x00, 0x00, 0x01, // number of addresses
+		0x01, 0x01, 0xcc, // protocol type NLPID, length 1, IP
+		0x00, 0x04, 192, 168, 1, 1, // address length, address
+	}
+	frame := cdpFrame(180, map[uint16][]byte{
+		0x0001: []byte("switch-1.example.com"),
+		0x0003: []byte("GigabitEthernet0/1"),
+		0x000a: {0x00, 0x0a},
+		0x0016: mgmtAddr,
+	})
+	neighbor, ttl, ok := ParseFrame(frame)
+	if !ok {
+		t.Fatalf("failed to parse CDP frame")
+	}
+	if ttl != 180*time.Second {
+		t.Errorf("unexpected TTL: %v", ttl)
+	}
+	expected := types.PortNeighbor{
+		Protocol:   types.NeighborDiscoveryProtocolCDP,
+		ChassisID:  "switch-1.example.com",
+		PortID:     "GigabitEthernet0/1",
+		SystemName: "switch-1.example.com",
+		VlanID:     10,
+		MgmtAddrs:  []net.IP{net.IPv4(192, 168, 1, 1)},
+	}
+	if !reflect.DeepEqual(neighbor, expected) {
+		t.Errorf("unexpected neighbor: %+v", neighbor)
+	}
+}
+
+func TestParseOtherFrame(t *testing.T) {
+	eth := &layers.Ethernet{
+		SrcMAC:       testMAC,
+		DstMAC:       net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		EthernetType: layers.EthernetTypeARP,
+	}
+	arp := &layers.ARP{
+		AddrType:          layers.LinkTypeEthernet,
+		Protocol:          layers.EthernetTypeIPv4,
+		HwAddressSize:     6,
+		ProtAddressSize:   4,
+		Operation:         layers.ARPRequest,
+		SourceHwAddress:   testMAC,
+		SourceProtAddress: []byte{192, 168, 1, 10},
+		DstHwAddress:      make([]byte, 6),
+		DstProtAddress:    []byte{192, 168, 1, 1},
+	}
+	buf := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, eth, arp); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := ParseFrame(buf.Bytes()); ok {
+		t.Errorf("ARP frame should not be parsed as a neighbor advertisement")
+	}
+}
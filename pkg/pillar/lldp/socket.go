@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package lldp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ethTypeLLDP  = 0x88cc
+	maxFrameSize = 1518
+)
+
+// rawSocket : AF_PACKET socket receiving LLDP and CDP frames from a single
+// interface and allowing to send raw Ethernet frames.
+type rawSocket struct {
+	fd      int
+	ifIndex int
+}
+
+// htons converts a short (uint16) from host-to-network byte order.
+func htons(i uint16) uint16 {
+	return (i<<8)&0xff00 | i>>8
+}
+
+// openRawSocket opens AF_PACKET socket bound to the given interface
+// with a BPF filter letting through only LLDP and CDP frames.
+func openRawSocket(ifName string, readTimeout time.Duration) (*rawSocket, error) {
+	link, err := net.InterfaceByName(ifName)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC,
+		int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open AF_PACKET socket: %w", err)
+	}
+	sock := &rawSocket{fd: fd, ifIndex: link.Index}
+	if err = sock.setup(readTimeout); err != nil {
+		sock.close()
+		return nil, fmt.Errorf("failed to setup AF_PACKET socket for %s: %w", ifName, err)
+	}
+	return sock, nil
+}
+
+func (s *rawSocket) setup(readTimeout time.Duration) error {
+	// Attach the filter before binding to avoid receiving unrelated traffic.
+	filter, err := bpf.Assemble([]bpf.Instruction{
+		// LLDP: EtherType 0x88cc
+		bpf.LoadAbsolute{Off: 12, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: ethTypeLLDP, SkipTrue: 4},
+		// CDP: destination MAC 01:00:0c:cc:cc:cc
+		bpf.LoadAbsolute{Off: 0, Size: 4},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x01000ccc, SkipFalse: 3},
+		bpf.LoadAbsolute{Off: 4, Size: 2},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0xcccc, SkipFalse: 1},
+		bpf.RetConstant{Val: maxFrameSize},
+		bpf.RetConstant{Val: 0},
+	})
+	if err != nil {
+		return err
+	}
+	sockFilter := make([]unix.SockFilter, len(filter))
+	for i, instr := range filter {
+		sockFilter[i] = unix.SockFilter{
+			Code: instr.Op,
+			Jt:   instr.Jt,
+			Jf:   instr.Jf,
+			K:    instr.K,
+		}
+	}
+	err = unix.SetsockoptSockFprog(s.fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER,
+		&unix.SockFprog{Len: uint16(len(sockFilter)), Filter: &sockFilter[0]})
+	if err != nil {
+		return fmt.Errorf("failed to attach BPF filter: %w", err)
+	}
+	err = unix.Bind(s.fd, &unix.SockaddrLinklayer{
+		Protocol: htons(unix.ETH_P_ALL),
+		Ifindex:  s.ifIndex,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bind: %w", err)
+	}
+	// Make sure that the NIC does not filter out frames destined
+	// to LLDP and CDP multicast addresses.
+	for _, mac := range []net.HardwareAddr{LLDPMulticastMAC, CDPMulticastMAC} {
+		mreq := &unix.PacketMreq{
+			Ifindex: int32(s.ifIndex),
+			Type:    unix.PACKET_MR_MULTICAST,
+			Alen:    uint16(len(mac)),
+		}
+		copy(mreq.Address[:], mac)
+		err = unix.SetsockoptPacketMreq(s.fd, unix.SOL_PACKET,
+			unix.PACKET_ADD_MEMBERSHIP, mreq)
+		if err != nil {
+			return fmt.Errorf("failed to add membership for %s: %w", mac, err)
+		}
+	}
+	tv := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	err = unix.SetsockoptTimeval(s.fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv)
+	if err != nil {
+		return fmt.Errorf("failed to set read timeout: %w", err)
+	}
+	return nil
+}
+
+// read returns the next frame received from the interface.
+// Returns timeout=true if no frame arrived within the read timeout.
+// Frames sent by the device itself are skipped.
+func (s *rawSocket) read(buf []byte) (frame []byte, timeout bool, err error) {
+	for {
+		n, from, err := unix.Recvfrom(s.fd, buf, 0)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				return nil, true, nil
+			}
+			return nil, false, err
+		}
+		if ll, ok := from.(*unix.SockaddrLinklayer); ok &&
+			ll.Pkttype == unix.PACKET_OUTGOING {
+			continue
+		}
+		return buf[:n], false, nil
+	}
+}
+
+// write sends Ethernet frame (including the Ethernet header) out of the interface.
+func (s *rawSocket) write(frame []byte, dstMAC net.HardwareAddr) error {
+	addr := &unix.SockaddrLinklayer{
+		Protocol: htons(ethTypeLLDP),
+		Ifindex:  s.ifIndex,
+		Halen:    uint8(len(dstMAC)),
+	}
+	copy(addr.Addr[:], dstMAC)
+	return unix.Sendto(s.fd, frame, 0, addr)
+}
+
+func (s *rawSocket) close() {
+	_ = unix.Close(s.fd)
+}
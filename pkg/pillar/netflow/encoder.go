@@ -0,0 +1,335 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package netflow
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	versionNetFlowV9 = 9
+	versionIPFIX     = 10
+
+	netflowV9HeaderLen = 20
+	ipfixHeaderLen     = 16
+	setHeaderLen       = 4
+
+	// Set IDs used to carry templates.
+	netflowV9TemplateSetID        = 0
+	netflowV9OptionsTemplateSetID = 1
+	ipfixTemplateSetID            = 2
+	ipfixOptionsTemplateSetID     = 3
+
+	// Template IDs (data set IDs must be >= 256).
+	templateIDFlowIPv4 = 256
+	templateIDFlowIPv6 = 257
+	templateIDSampling = 258
+
+	// Keep messages small enough to avoid IP fragmentation.
+	maxMessageLen = 1400
+
+	// IPFIX: length of the variable-length Information Element.
+	ipfixVarLen = 0xffff
+	// NetFlow v9 does not support variable-length fields.
+	netflowV9HostnameLen = 64
+	// Longest host name that can be exported.
+	maxHostnameLen = 253
+)
+
+// Information elements (IANA IPFIX registry; numbers below 128 are shared
+// with NetFlow v9).
+const (
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	ieSamplingInterval         = 34
+	ieSamplingAlgorithm        = 35
+	ieForwardingStatus         = 89
+	ieApplicationName          = 96
+	ieObservationDomainID      = 149
+	ieFlowStartMilliseconds    = 152
+	ieFlowEndMilliseconds      = 153
+	ieBiflowDirection          = 239
+
+	// NetFlow v9 scope field type "System".
+	netflowV9ScopeSystem = 1
+)
+
+// Values of selected information elements.
+const (
+	biflowDirectionInitiator        = 1
+	biflowDirectionReverseInitiator = 2
+
+	forwardingStatusUnknown   = 0
+	forwardingStatusForwarded = 64
+	forwardingStatusDropped   = 128
+
+	samplingAlgorithmDeterministic = 1
+)
+
+type fieldSpec struct {
+	id     uint16
+	length uint16
+}
+
+// flowRecord : unidirectional flow record as exported to the collector.
+type flowRecord struct {
+	srcIP            net.IP
+	dstIP            net.IP
+	srcPort          uint16
+	dstPort          uint16
+	proto            uint8
+	octets           uint64
+	packets          uint64
+	start            time.Time
+	end              time.Time
+	biflowDirection  uint8
+	forwardingStatus uint8
+	// Host name of the remote endpoint (from DNS correlation or from
+	// the layer-7 inspection), exported as applicationName.
+	hostname string
+}
+
+func (r flowRecord) isIPv4() bool {
+	return r.srcIP.To4() != nil && r.dstIP.To4() != nil
+}
+
+// encoder encodes flow records into IPFIX or NetFlow v9 messages.
+type encoder struct {
+	protocol     types.FlowExportProtocol
+	domainID     uint32
+	samplingRate uint32
+	bootTime     time.Time
+	// NetFlow v9: sequence number of the export packet.
+	// IPFIX: number of data records sent so far.
+	sequence uint32
+}
+
+func newEncoder(protocol types.FlowExportProtocol, domainID,
+	samplingRate uint32) *encoder {
+	if protocol == types.FlowExportProtocolUnspecified {
+		protocol = types.FlowExportProtocolIPFIX
+	}
+	return &encoder{
+		protocol:     protocol,
+		domainID:     domainID,
+		samplingRate: samplingRate,
+		bootTime:     time.Now(),
+	}
+}
+
+func (e *encoder) isIPFIX() bool {
+	return e.protocol != types.FlowExportProtocolNetFlowV9
+}
+
+func (e *encoder) headerLen() int {
+	if e.isIPFIX() {
+		return ipfixHeaderLen
+	}
+	return netflowV9HeaderLen
+}
+
+func (e *encoder) flowFields(ipv4 bool) []fieldSpec {
+	var fields []fieldSpec
+	if ipv4 {
+		fields = append(fields,
+			fieldSpec{ieSourceIPv4Address, 4}, fieldSpec{ieDestinationIPv4Address, 4})
+	} else {
+		fields = append(fields,
+			fieldSpec{ieSourceIPv6Address, 16}, fieldSpec{ieDestinationIPv6Address, 16})
+	}
+	hostnameLen := uint16(netflowV9HostnameLen)
+	if e.isIPFIX() {
+		hostnameLen = ipfixVarLen
+	}
+	return append(fields,
+		fieldSpec{ieSourceTransportPort, 2},
+		fieldSpec{ieDestinationTransportPort, 2},
+		fieldSpec{ieProtocolIdentifier, 1},
+		fieldSpec{ieOctetDeltaCount, 8},
+		fieldSpec{iePacketDeltaCount, 8},
+		fieldSpec{ieFlowStartMilliseconds, 8},
+		fieldSpec{ieFlowEndMilliseconds, 8},
+		fieldSpec{ieBiflowDirection, 1},
+		fieldSpec{ieForwardingStatus, 1},
+		fieldSpec{ieApplicationName, hostnameLen},
+	)
+}
+
+// encodeTemplates returns message with templates (and with sampling options
+// if flow records are sampled).
+func (e *encoder) encodeTemplates(now time.Time) []byte {
+	var sets []byte
+	var records, dataRecords int
+	// Template set.
+	var set []byte
+	for _, templateID := range []uint16{templateIDFlowIPv4, templateIDFlowIPv6} {
+		fields := e.flowFields(templateID == templateIDFlowIPv4)
+		set = appendUint16(set, templateID)
+		set = appendUint16(set, uint16(len(fields)))
+		for _, field := range fields {
+			set = appendUint16(set, field.id)
+			set = appendUint16(set, field.length)
+		}
+		records++
+	}
+	setID := uint16(netflowV9TemplateSetID)
+	if e.isIPFIX() {
+		setID = ipfixTemplateSetID
+	}
+	sets = append(sets, makeSet(setID, set)...)
+	if e.samplingRate > 1 {
+		// Options template describing the sampling.
+		set = appendUint16(nil, templateIDSampling)
+		if e.isIPFIX() {
+			setID = ipfixOptionsTemplateSetID
+			set = appendUint16(set, 3) // field count
+			set = appendUint16(set, 1) // scope field count
+			set = appendUint16(set, ieObservationDomainID)
+			set = appendUint16(set, 4)
+		} else {
+			setID = netflowV9OptionsTemplateSetID
+			set = appendUint16(set, 4) // scope length in bytes
+			set = appendUint16(set, 8) // options length in bytes
+			set = appendUint16(set, netflowV9ScopeSystem)
+			set = appendUint16(set, 4)
+		}
+		set = appendUint16(set, ieSamplingInterval)
+		set = appendUint16(set, 4)
+		set = appendUint16(set, ieSamplingAlgorithm)
+		set = appendUint16(set, 1)
+		sets = append(sets, makeSet(setID, set)...)
+		records++
+		// Options data record.
+		set = appendUint32(nil, e.domainID)
+		set = appendUint32(set, e.samplingRate)
+		set = append(set, samplingAlgorithmDeterministic)
+		sets = append(sets, makeSet(templateIDSampling, set)...)
+		records++
+		dataRecords++
+	}
+	return e.makeMessage(now, sets, records, dataRecords)
+}
+
+// encodeFlows returns messages with the given flow records.
+func (e *encoder) encodeFlows(now time.Time, records []flowRecord) (msgs [][]byte) {
+	maxSetLen := maxMessageLen - e.headerLen() - setHeaderLen
+	for _, ipv4 := range []bool{true, false} {
+		templateID := uint16(templateIDFlowIPv6)
+		if ipv4 {
+			templateID = templateIDFlowIPv4
+		}
+		var set []byte
+		var count int
+		for _, record := range records {
+			if record.isIPv4() != ipv4 {
+				continue
+			}
+			encoded := e.encodeFlowRecord(record)
+			if len(set)+len(encoded) > maxSetLen && count > 0 {
+				msgs = append(msgs,
+					e.makeMessage(now, makeSet(templateID, set), count, count))
+				set = nil
+				count = 0
+			}
+			set = append(set, encoded...)
+			count++
+		}
+		if count > 0 {
+			msgs = append(msgs,
+				e.makeMessage(now, makeSet(templateID, set), count, count))
+		}
+	}
+	return msgs
+}
+
+func (e *encoder) encodeFlowRecord(record flowRecord) (data []byte) {
+	if record.isIPv4() {
+		data = append(data, record.srcIP.To4()...)
+		data = append(data, record.dstIP.To4()...)
+	} else {
+		data = append(data, record.srcIP.To16()...)
+		data = append(data, record.dstIP.To16()...)
+	}
+	data = appendUint16(data, record.srcPort)
+	data = appendUint16(data, record.dstPort)
+	data = append(data, record.proto)
+	data = appendUint64(data, record.octets)
+	data = appendUint64(data, record.packets)
+	data = appendUint64(data, uint64(record.start.UnixMilli()))
+	data = appendUint64(data, uint64(record.end.UnixMilli()))
+	data = append(data, record.biflowDirection)
+	data = append(data, record.forwardingStatus)
+	hostname := record.hostname
+	if len(hostname) > maxHostnameLen {
+		hostname = hostname[:maxHostnameLen]
+	}
+	if e.isIPFIX() {
+		// Variable-length encoding (length always fits into a single byte).
+		data = append(data, byte(len(hostname)))
+		data = append(data, hostname...)
+	} else {
+		field := make([]byte, netflowV9HostnameLen)
+		copy(field, hostname)
+		data = append(data, field...)
+	}
+	return data
+}
+
+// makeMessage prepends message header to the given sets.
+// records is the total number of records (incl. template records),
+// dataRecords is the number of data records.
+func (e *encoder) makeMessage(now time.Time, sets []byte,
+	records, dataRecords int) []byte {
+	var msg []byte
+	if e.isIPFIX() {
+		msg = appendUint16(msg, versionIPFIX)
+		msg = appendUint16(msg, uint16(ipfixHeaderLen+len(sets)))
+		msg = appendUint32(msg, uint32(now.Unix()))
+		msg = appendUint32(msg, e.sequence)
+		msg = appendUint32(msg, e.domainID)
+		e.sequence += uint32(dataRecords)
+	} else {
+		msg = appendUint16(msg, versionNetFlowV9)
+		msg = appendUint16(msg, uint16(records))
+		msg = appendUint32(msg, uint32(now.Sub(e.bootTime).Milliseconds()))
+		msg = appendUint32(msg, uint32(now.Unix()))
+		msg = appendUint32(msg, e.sequence)
+		msg = appendUint32(msg, e.domainID)
+		e.sequence++
+	}
+	return append(msg, sets...)
+}
+
+// makeSet prepends set header to the given records and pads the set
+// to a 32-bit boundary.
+func makeSet(setID uint16, records []byte) []byte {
+	padding := (4 - (setHeaderLen+len(records))%4) % 4
+	set := appendUint16(nil, setID)
+	set = appendUint16(set, uint16(setHeaderLen+len(records)+padding))
+	set = append(set, records...)
+	return append(set, make([]byte, padding)...)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return binary.BigEndian.AppendUint16(b, v)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return binary.BigEndian.AppendUint32(b, v)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(b, v)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package netflow
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type decodedSet struct {
+	id   uint16
+	data []byte
+}
+
+func decodeMessage(t *testing.T, msg []byte, ipfix bool) (sets []decodedSet) {
+	version := binary.BigEndian.Uint16(msg)
+	headerLen := netflowV9HeaderLen
+	if ipfix {
+		headerLen = ipfixHeaderLen
+		if version != versionIPFIX {
+			t.Fatalf("unexpected IPFIX version: %d", version)
+		}
+		if msgLen := binary.BigEndian.Uint16(msg[2:]); int(msgLen) != len(msg) {
+			t.Fatalf("IPFIX message length %d does not match %d", msgLen, len(msg))
+		}
+	} else if version != versionNetFlowV9 {
+		t.Fatalf("unexpected NetFlow version: %d", version)
+	}
+	if len(msg) > maxMessageLen {
+		t.Errorf("message is too long: %d", len(msg))
+	}
+	data := msg[headerLen:]
+	for len(data) > 0 {
+		if len(data) < setHeaderLen {
+			t.Fatalf("truncated set header")
+		}
+		setID := binary.BigEndian.Uint16(data)
+		setLen := int(binary.BigEndian.Uint16(data[2:]))
+		if setLen%4 != 0 || setLen > len(data) || setLen < setHeaderLen {
+			t.Fatalf("invalid set length: %d", setLen)
+		}
+		sets = append(sets, decodedSet{id: setID, data: data[setHeaderLen:setLen]})
+		data = data[setLen:]
+	}
+	return sets
+}
+
+func testFlow() types.IPFlow {
+	now := time.Now()
+	return types.IPFlow{
+		Flows: []types.FlowRec{
+			{
+				Flow: types.IPTuple{
+					Src:     net.ParseIP("10.1.0.2"),
+					Dst:     net.ParseIP("93.184.216.34"),
+					SrcPort: 40000,
+					DstPort: 443,
+					Proto:   6,
+				},
+				Action:    types.ACLActionAccept,
+				StartTime: now.Add(-time.Minute).UnixNano(),
+				StopTime:  now.UnixNano(),
+				TxBytes:   1000,
+				TxPkts:    10,
+				RxBytes:   5000,
+				RxPkts:    8,
+			},
+			{
+				Flow: types.IPTuple{
+					Src:     net.ParseIP("fd00::2"),
+					Dst:     net.ParseIP("2001:db8::1"),
+					SrcPort: 40001,
+					DstPort: 80,
+					Proto:   6,
+				},
+				Action:    types.ACLActionDrop,
+				StartTime: now.Add(-time.Minute).UnixNano(),
+				StopTime:  now.UnixNano(),
+				TxBytes:   60,
+				TxPkts:    1,
+				Hostname:  "ipv6.example.com",
+			},
+		},
+		DNSReqs: []types.DNSReq{
+			{
+				HostName: "example.com",
+				Addrs:    []net.IP{net.ParseIP("93.184.216.34")},
+			},
+		},
+	}
+}
+
+func TestFlowRecords(t *testing.T) {
+	records := flowRecords(testFlow())
+	if len(records) != 3 {
+		t.Fatalf("expected 3 unidirectional records, got %d", len(records))
+	}
+	// App -> remote.
+	if !records[0].srcIP.Equal(net.ParseIP("10.1.0.2")) || records[0].dstPort != 443 ||
+		records[0].packets != 10 || records[0].biflowDirection != biflowDirectionInitiator ||
+		records[0].forwardingStatus != forwardingStatusForwarded {
+		t.Errorf("unexpected record: %+v", records[0])
+	}
+	// Remote -> app.
+	if !records[1].srcIP.Equal(net.ParseIP("93.184.216.34")) || records[1].srcPort != 443 ||
+		records[1].octets != 5000 ||
+		records[1].biflowDirection != biflowDirectionReverseInitiator {
+		t.Errorf("unexpected record: %+v", records[1])
+	}
+	// Host name correlated from DNS request.
+	if records[0].hostname != "example.com" || records[1].hostname != "example.com" {
+		t.Errorf("unexpected hostnames: %q, %q", records[0].hostname, records[1].hostname)
+	}
+	// Host name obtained by L7 inspection takes precedence.
+	if records[2].hostname != "ipv6.example.com" ||
+		records[2].forwardingStatus != forwardingStatusDropped {
+		t.Errorf("unexpected record: %+v", records[2])
+	}
+}
+
+func TestEncodeIPFIX(t *testing.T) {
+	enc := newEncoder(types.FlowExportProtocolUnspecified, 7, 10)
+	now := time.Now()
+	sets := decodeMessage(t, enc.encodeTemplates(now), true)
+	if len(sets) != 3 || sets[0].id != ipfixTemplateSetID ||
+		sets[1].id != ipfixOptionsTemplateSetID || sets[2].id != templateIDSampling {
+		t.Fatalf("unexpected template sets: %+v", sets)
+	}
+	if interval := binary.BigEndian.Uint32(sets[2].data[4:]); interval != 10 {
+		t.Errorf("unexpected sampling interval: %d", interval)
+	}
+	msgs := enc.encodeFlows(now, flowRecords(testFlow()))
+	if len(msgs) != 2 {
+		t.Fatalf("expected IPv4 and IPv6 message, got %d", len(msgs))
+	}
+	// Sequence number counts data records, including the options record.
+	if seq := binary.BigEndian.Uint32(msgs[1][8:]); seq != 3 {
+		t.Errorf("unexpected IPFIX sequence number: %d", seq)
+	}
+	if domain := binary.BigEndian.Uint32(msgs[0][12:]); domain != 7 {
+		t.Errorf("unexpected observation domain: %d", domain)
+	}
+	sets = decodeMessage(t, msgs[0], true)
+	if len(sets) != 1 || sets[0].id != templateIDFlowIPv4 {
+		t.Fatalf("unexpected data sets: %+v", sets)
+	}
+	// First record: fixed-length fields are followed by the host name.
+	const fixedLen = 4 + 4 + 2 + 2 + 1 + 8 + 8 + 8 + 8 + 1 + 1
+	record := sets[0].data
+	if !net.IP(record[:4]).Equal(net.ParseIP("10.1.0.2")) {
+		t.Errorf("unexpected source IP: %v", net.IP(record[:4]))
+	}
+	nameLen := int(record[fixedLen])
+	if name := string(record[fixedLen+1 : fixedLen+1+nameLen]); name != "example.com" {
+		t.Errorf("unexpected application name: %q", name)
+	}
+}
+
+func TestEncodeNetFlowV9(t *testing.T) {
+	enc := newEncoder(types.FlowExportProtocolNetFlowV9, 1, 0)
+	now := time.Now()
+	tmpl := enc.encodeTemplates(now)
+	if count := binary.BigEndian.Uint16(tmpl[2:]); count != 2 {
+		t.Errorf("expected 2 templates, got %d", count)
+	}
+	sets := decodeMessage(t, tmpl, false)
+	if len(sets) != 1 || sets[0].id != netflowV9TemplateSetID {
+		t.Fatalf("unexpected template sets: %+v", sets)
+	}
+	var flow types.IPFlow
+	for i := 0; i < 100; i++ {
+		flow.Flows = append(flow.Flows, testFlow().Flows[0])
+	}
+	msgs := enc.encodeFlows(now, flowRecords(flow))
+	if len(msgs) < 2 {
+		t.Fatalf("expected records to be split into multiple messages")
+	}
+	var total int
+	for i, msg := range msgs {
+		if seq := binary.BigEndian.Uint32(msg[12:]); seq != uint32(i+1) {
+			t.Errorf("unexpected NetFlow sequence number: %d", seq)
+		}
+		count := int(binary.BigEndian.Uint16(msg[2:]))
+		sets = decodeMessage(t, msg, false)
+		if len(sets) != 1 || sets[0].id != templateIDFlowIPv4 {
+			t.Fatalf("unexpected data sets: %+v", sets)
+		}
+		const recordLen = 4 + 4 + 2 + 2 + 1 + 8 + 8 + 8 + 8 + 1 + 1 + netflowV9HostnameLen
+		if len(sets[0].data)/recordLen != count {
+			t.Errorf("record count %d does not match set length %d",
+				count, len(sets[0].data))
+		}
+		total += count
+	}
+	if total != 200 {
+		t.Errorf("expected 200 records, got %d", total)
+	}
+}
+
+func TestCollectorAddress(t *testing.T) {
+	tests := []struct {
+		config   types.FlowExportConfig
+		expected string
+		fail     bool
+	}{
+		{config: types.FlowExportConfig{Collector: "10.0.0.1"}, expected: "10.0.0.1:4739"},
+		{config: types.FlowExportConfig{Collector: "collector.local:9995"},
+			expected: "collector.local:9995"},
+		{config: types.FlowExportConfig{Collector: "2001:db8::1",
+			Protocol: types.FlowExportProtocolNetFlowV9}, expected: "[2001:db8::1]:2055"},
+		{config: types.FlowExportConfig{Collector: "[2001:db8::1]:4740"},
+			expected: "[2001:db8::1]:4740"},
+		{config: types.FlowExportConfig{Collector: ""}, fail: true},
+		{config: types.FlowExportConfig{Collector: "10.0.0.1:http"}, fail: true},
+	}
+	for _, test := range tests {
+		addr, err := CollectorAddress(test.config)
+		if test.fail {
+			if err == nil {
+				t.Errorf("expected error for %q", test.config.Collector)
+			}
+			continue
+		}
+		if err != nil || addr != test.expected {
+			t.Errorf("CollectorAddress(%q) = %q, %v; expected %q",
+				test.config.Collector, addr, err, test.expected)
+		}
+	}
+}
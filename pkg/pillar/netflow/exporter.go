@@ -0,0 +1,253 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package netflow implements exporter of application flow records
+// (as collected by zedrouter for every network instance) to an external
+// IPFIX (RFC 7011) or NetFlow v9 (RFC 3954) collector.
+package netflow
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// DefaultIPFIXPort : default UDP port of IPFIX collectors.
+	DefaultIPFIXPort = 4739
+	// DefaultNetFlowV9Port : default UDP port of NetFlow v9 collectors.
+	DefaultNetFlowV9Port = 2055
+	// DefaultTemplateRefreshInterval : default period for re-sending templates.
+	DefaultTemplateRefreshInterval = 600 * time.Second
+
+	// Maximum number of IPFlow batches waiting to be exported.
+	queueLen = 64
+)
+
+// CollectorAddress returns UDP address ("<host>:<port>") of the flow collector.
+// Default port of the selected protocol is used if the configured collector
+// address does not specify the port.
+func CollectorAddress(config types.FlowExportConfig) (string, error) {
+	if config.Collector == "" {
+		return "", errors.New("collector address is not specified")
+	}
+	defaultPort := DefaultIPFIXPort
+	if config.Protocol == types.FlowExportProtocolNetFlowV9 {
+		defaultPort = DefaultNetFlowV9Port
+	}
+	host, port, err := net.SplitHostPort(config.Collector)
+	if err != nil {
+		// Port is not specified (possibly IPv6 address without brackets).
+		host = config.Collector
+		if len(host) > 1 && host[0] == '[' && host[len(host)-1] == ']' {
+			host = host[1 : len(host)-1]
+		}
+		port = strconv.Itoa(defaultPort)
+	}
+	if host == "" {
+		return "", fmt.Errorf("collector host is missing in %q", config.Collector)
+	}
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNum == 0 {
+		return "", fmt.Errorf("invalid collector port %q", port)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// Exporter exports flow records of a single network instance.
+// Export is asynchronous, non-blocking and runs independently of
+// the controller connectivity.
+type Exporter struct {
+	log    *base.LogObject
+	niID   uuid.UUID
+	config types.FlowExportConfig
+	queue  chan types.IPFlow
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewExporter creates and starts flow exporter for the given network instance.
+// domainID is used as the IPFIX Observation Domain ID / NetFlow v9 Source ID
+// and should be unique among network instances of the device.
+func NewExporter(log *base.LogObject, niID uuid.UUID, domainID uint32,
+	config types.FlowExportConfig) (*Exporter, error) {
+	collector, err := CollectorAddress(config)
+	if err != nil {
+		return nil, err
+	}
+	e := &Exporter{
+		log:    log,
+		niID:   niID,
+		config: config,
+		queue:  make(chan types.IPFlow, queueLen),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go e.run(collector, newEncoder(config.Protocol, domainID, config.SamplingRate))
+	log.Noticef("Started %s flow export for NI %s to %s",
+		config.Protocol, niID, collector)
+	return e, nil
+}
+
+// Config returns configuration of the exporter.
+func (e *Exporter) Config() types.FlowExportConfig {
+	return e.config
+}
+
+// Export queues flow records for export. Never blocks - if the queue is full,
+// flow records are dropped.
+func (e *Exporter) Export(flow types.IPFlow) {
+	select {
+	case e.queue <- flow:
+	default:
+		e.log.Warnf("Flow export queue for NI %s is full, dropping %d flow records",
+			e.niID, len(flow.Flows))
+	}
+}
+
+// Stop stops the exporter. Records that are still queued are dropped.
+func (e *Exporter) Stop() {
+	close(e.stop)
+	<-e.done
+	e.log.Noticef("Stopped flow export for NI %s", e.niID)
+}
+
+func (e *Exporter) run(collector string, enc *encoder) {
+	defer close(e.done)
+	refreshInterval := DefaultTemplateRefreshInterval
+	if e.config.TemplateRefreshInterval > 0 {
+		refreshInterval = time.Duration(e.config.TemplateRefreshInterval) * time.Second
+	}
+	refreshTicker := time.NewTicker(refreshInterval)
+	defer refreshTicker.Stop()
+	var (
+		conn     net.Conn
+		lastErr  string
+		sampling uint64
+	)
+	closeConn := func() {
+		if conn != nil {
+			_ = conn.Close()
+			conn = nil
+		}
+	}
+	defer closeConn()
+	send := func(msgs ...[]byte) {
+		var err error
+		if conn == nil {
+			// Templates are sent with every new connection.
+			conn, err = net.Dial("udp", collector)
+			if err == nil {
+				msgs = append([][]byte{enc.encodeTemplates(time.Now())}, msgs...)
+			}
+		}
+		for i := 0; err == nil && i < len(msgs); i++ {
+			_, err = conn.Write(msgs[i])
+		}
+		if err != nil {
+			if err.Error() != lastErr {
+				e.log.Warnf("Failed to export flow records for NI %s to %s: %v",
+					e.niID, collector, err)
+				lastErr = err.Error()
+			}
+			// Reconnect (and re-send templates) with the next export.
+			closeConn()
+			return
+		}
+		lastErr = ""
+	}
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-refreshTicker.C:
+			// Re-resolve collector address and re-send templates.
+			closeConn()
+			send()
+		case flow := <-e.queue:
+			var records []flowRecord
+			for _, record := range flowRecords(flow) {
+				sampling++
+				if e.config.SamplingRate > 1 &&
+					sampling%uint64(e.config.SamplingRate) != 1 {
+					continue
+				}
+				records = append(records, record)
+			}
+			if len(records) > 0 {
+				send(enc.encodeFlows(time.Now(), records)...)
+			}
+		}
+	}
+}
+
+// flowRecords converts bidirectional flows captured by zedrouter into
+// unidirectional flow records.
+func flowRecords(flow types.IPFlow) (records []flowRecord) {
+	// Correlate remote IP addresses with host names resolved by the app.
+	hostnames := make(map[string]string)
+	for _, dnsReq := range flow.DNSReqs {
+		for _, addr := range dnsReq.Addrs {
+			hostnames[addr.String()] = dnsReq.HostName
+		}
+	}
+	for _, rec := range flow.Flows {
+		hostname := rec.Hostname
+		if hostname == "" {
+			hostname = hostnames[rec.Flow.Dst.String()]
+		}
+		forwardingStatus := uint8(forwardingStatusUnknown)
+		switch rec.Action {
+		case types.ACLActionAccept:
+			forwardingStatus = forwardingStatusForwarded
+		case types.ACLActionDrop:
+			forwardingStatus = forwardingStatusDropped
+		}
+		appDirection := uint8(biflowDirectionInitiator)
+		remoteDirection := uint8(biflowDirectionReverseInitiator)
+		if rec.Inbound {
+			appDirection, remoteDirection = remoteDirection, appDirection
+		}
+		start := time.Unix(0, rec.StartTime)
+		end := time.Unix(0, rec.StopTime)
+		if rec.TxPkts > 0 {
+			records = append(records, flowRecord{
+				srcIP:            rec.Flow.Src,
+				dstIP:            rec.Flow.Dst,
+				srcPort:          uint16(rec.Flow.SrcPort),
+				dstPort:          uint16(rec.Flow.DstPort),
+				proto:            uint8(rec.Flow.Proto),
+				octets:           uint64(rec.TxBytes),
+				packets:          uint64(rec.TxPkts),
+				start:            start,
+				end:              end,
+				biflowDirection:  appDirection,
+				forwardingStatus: forwardingStatus,
+				hostname:         hostname,
+			})
+		}
+		if rec.RxPkts > 0 {
+			records = append(records, flowRecord{
+				srcIP:            rec.Flow.Dst,
+				dstIP:            rec.Flow.Src,
+				srcPort:          uint16(rec.Flow.DstPort),
+				dstPort:          uint16(rec.Flow.SrcPort),
+				proto:            uint8(rec.Flow.Proto),
+				octets:           uint64(rec.RxBytes),
+				packets:          uint64(rec.RxPkts),
+				start:            start,
+				end:              end,
+				biflowDirection:  remoteDirection,
+				forwardingStatus: forwardingStatus,
+				hostname:         hostname,
+			})
+		}
+	}
+	return records
+}
@@ -0,0 +1,650 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+// This Go file implements Items (libs/depgraph) and Configurators (libs/reconciler)
+// to be used for the reconciliation of the nftables configuration.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+const (
+	// ChainTypename : typename for a single nftables chain (incl. its rules).
+	ChainTypename = "Nftables-Chain"
+	// RuleTypename : typename for a single nftables rule inserted into a chain
+	// which is created elsewhere.
+	RuleTypename = "Nftables-Rule"
+	// SetTypename : typename for a single nftables named set.
+	SetTypename = "Nftables-Set"
+)
+
+// Used as a constant.
+var builtinChains = []string{"INPUT", "OUTPUT", "FORWARD", "PREROUTING", "POSTROUTING"}
+
+// RegisterItems : add Items and their Configurators into the provided registry.
+func RegisterItems(log *base.LogObject, registry *reconciler.DefaultRegistry) error {
+	type configurator struct {
+		c reconciler.Configurator
+		t string
+	}
+	configurators := []configurator{
+		{c: &ChainConfigurator{Log: log}, t: ChainTypename},
+		{c: &RuleConfigurator{Log: log}, t: RuleTypename},
+		{c: &SetConfigurator{Log: log}, t: SetTypename},
+	}
+	for _, configurator := range configurators {
+		err := registry.Register(configurator.c, configurator.t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Chain : single nftables chain, translated from iptables chain.
+// This structure implements Item interface (libs/depgraph) and is used as the input
+// to ChainConfigurator.
+// Unlike iptables.Chain, this structure also contains all the chain rules,
+// which are always (re)applied together using a single nftables transaction.
+// The only exception are PreCreated chains, which are created elsewhere
+// and are filled with separate Rule items.
+type Chain struct {
+	Family Family
+	// Table : iptables table that the chain was translated from.
+	// One of: raw, filter, nat, mangle, security.
+	Table string
+	// IptablesChain : name of the iptables chain.
+	// For built-in iptables chains (e.g. PREROUTING), nftables base chain
+	// is created with hook and priority corresponding to the iptables table.
+	IptablesChain string
+	// PreCreated : a custom chain which already exists.
+	PreCreated bool
+	// Rules : ordered list of chain rules.
+	Rules []RuleSpec
+}
+
+// ChainName returns the name of the nftables chain.
+func (ch Chain) ChainName() string {
+	return ChainName(ch.Table, ch.IptablesChain)
+}
+
+// Name returns unique identifier for an nftables chain.
+func (ch Chain) Name() string {
+	return fmt.Sprintf("%s/%s", ch.Family, ch.ChainName())
+}
+
+// Label is not defined.
+func (ch Chain) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (ch Chain) Type() string {
+	return ChainTypename
+}
+
+// Equal compares two nftables chains.
+func (ch Chain) Equal(other depgraph.Item) bool {
+	ch2, isChain := other.(Chain)
+	if !isChain {
+		return false
+	}
+	if len(ch.Rules) != len(ch2.Rules) {
+		return false
+	}
+	for i := range ch.Rules {
+		if !ch.Rules[i].Equal(ch2.Rules[i]) {
+			return false
+		}
+	}
+	return ch.Family == ch2.Family &&
+		ch.Table == ch2.Table &&
+		ch.IptablesChain == ch2.IptablesChain &&
+		ch.PreCreated == ch2.PreCreated
+}
+
+// External returns true if the chain is created outside ChainConfigurator.
+func (ch Chain) External() bool {
+	return ch.PreCreated
+}
+
+// String describes nftables chain.
+func (ch Chain) String() string {
+	var rules []string
+	for _, rule := range ch.Rules {
+		rules = append(rules, fmt.Sprintf("\n    %s comment \"%s\"",
+			rule.Expr, rule.Label))
+	}
+	return fmt.Sprintf("nftables chain %s %s %s%s {%s\n}",
+		ch.Family, TableName, ch.ChainName(), ch.baseChainSpec(),
+		strings.Join(rules, ""))
+}
+
+// Dependencies for an nftables chain are chains and sets referenced by its rules.
+func (ch Chain) Dependencies() (deps []depgraph.Dependency) {
+	return ruleDependencies(ch.Family, ch.Rules)
+}
+
+func ruleDependencies(family Family, rules []RuleSpec) (deps []depgraph.Dependency) {
+	jumps := make(map[string]struct{})
+	sets := make(map[string]struct{})
+	for _, rule := range rules {
+		if rule.Jump != "" {
+			if _, duplicate := jumps[rule.Jump]; !duplicate {
+				jumps[rule.Jump] = struct{}{}
+				deps = append(deps, depgraph.Dependency{
+					RequiredItem: depgraph.ItemRef{
+						ItemType: ChainTypename,
+						ItemName: fmt.Sprintf("%s/%s", family, rule.Jump),
+					},
+					Description: "target chain must exist",
+				})
+			}
+		}
+		for _, set := range rule.Sets {
+			if _, duplicate := sets[set]; !duplicate {
+				sets[set] = struct{}{}
+				deps = append(deps, depgraph.Dependency{
+					RequiredItem: depgraph.Reference(Set{
+						Family:  family,
+						SetName: set,
+					}),
+					Description: "matched set must exist",
+				})
+			}
+		}
+	}
+	return deps
+}
+
+func (ch Chain) isBaseChain() bool {
+	for _, builtin := range builtinChains {
+		if ch.IptablesChain == builtin {
+			return true
+		}
+	}
+	return false
+}
+
+// baseChainSpec returns nftables base chain specification (type, hook, priority)
+// corresponding to the built-in iptables chain.
+// Returns empty string for a regular chain.
+func (ch Chain) baseChainSpec() string {
+	if !ch.isBaseChain() {
+		return ""
+	}
+	chainType := "filter"
+	var priority int
+	switch ch.Table {
+	case "raw":
+		priority = -300
+	case "mangle":
+		priority = -150
+		if ch.IptablesChain == "OUTPUT" {
+			// Allows to re-route packets with modified mark.
+			chainType = "route"
+		}
+	case "nat":
+		chainType = "nat"
+		priority = -100
+		if ch.IptablesChain == "INPUT" || ch.IptablesChain == "POSTROUTING" {
+			priority = 100
+		}
+	case "security":
+		priority = 50
+	}
+	return fmt.Sprintf(" { type %s hook %s priority %d; policy accept; }",
+		chainType, strings.ToLower(ch.IptablesChain), priority)
+}
+
+// Rule : single nftables rule inserted into a chain created elsewhere
+// (typically a PreCreated chain).
+// This structure implements Item interface (libs/depgraph) and is used as the input
+// to RuleConfigurator.
+type Rule struct {
+	Family Family
+	// Table : iptables table that the rule was translated from.
+	Table string
+	// IptablesChain : name of the iptables chain where the rule is inserted into.
+	IptablesChain string
+	// AppliedBefore : List of labels of rules that should be inserted BELOW this
+	// rule in the destination chain.
+	AppliedBefore []string
+	// Spec : translated rule.
+	Spec RuleSpec
+	// Description : optionally describe the rule.
+	Description string
+}
+
+// ChainName returns the name of the destination nftables chain.
+func (r Rule) ChainName() string {
+	return ChainName(r.Table, r.IptablesChain)
+}
+
+// Name returns unique identifier for an nftables rule.
+func (r Rule) Name() string {
+	label := strings.ReplaceAll(r.Spec.Label, " ", "-")
+	return fmt.Sprintf("%s/%s/%s", r.Family, r.ChainName(), label)
+}
+
+// Label returns rule label.
+func (r Rule) Label() string {
+	return r.Spec.Label
+}
+
+// Type of the item.
+func (r Rule) Type() string {
+	return RuleTypename
+}
+
+// Equal compares two nftables rules.
+func (r Rule) Equal(other depgraph.Item) bool {
+	r2, isRule := other.(Rule)
+	if !isRule {
+		return false
+	}
+	return r.Family == r2.Family &&
+		r.Table == r2.Table &&
+		r.IptablesChain == r2.IptablesChain &&
+		utils.EqualSets(r.AppliedBefore, r2.AppliedBefore) &&
+		r.Spec.Equal(r2.Spec) &&
+		r.Description == r2.Description
+}
+
+// External returns false.
+func (r Rule) External() bool {
+	return false
+}
+
+// String describes nftables rule.
+func (r Rule) String() string {
+	var descr string
+	if r.Description != "" {
+		descr = fmt.Sprintf(" (%s)", r.Description)
+	}
+	return fmt.Sprintf("nftables rule: insert rule %s %s %s %s comment \"%s\"%s",
+		r.Family, TableName, r.ChainName(), r.Spec.Expr, r.Spec.Label, descr)
+}
+
+// Dependencies for an nftables rule are:
+//   - destination chain
+//   - the rules referenced in AppliedBefore that this rule precedes
+//     (rules are added by inserting at the top of the chain, hence we start
+//     with the bottom ones)
+//   - referenced target chain
+//   - matched sets
+func (r Rule) Dependencies() (deps []depgraph.Dependency) {
+	if len(r.AppliedBefore) == 0 {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(Chain{
+				Family:        r.Family,
+				Table:         r.Table,
+				IptablesChain: r.IptablesChain,
+			}),
+			Description: "destination chain must exist",
+		})
+	}
+	for _, r2 := range r.AppliedBefore {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(Rule{
+				Family:        r.Family,
+				Table:         r.Table,
+				IptablesChain: r.IptablesChain,
+				Spec:          RuleSpec{Label: r2},
+			}),
+			Description: "inserted into the chain above the referenced rule",
+		})
+	}
+	return append(deps, ruleDependencies(r.Family, []RuleSpec{r.Spec})...)
+}
+
+// Set : nftables named set of IP addresses or subnets.
+// This structure implements Item interface (libs/depgraph) and is used as the input
+// to SetConfigurator.
+type Set struct {
+	Family  Family
+	SetName string
+	// Interval : set contains subnets (instead of only IP addresses).
+	Interval bool
+	// Elements : IP addresses or subnets.
+	Elements []string
+}
+
+// Name returns unique identifier for an nftables set.
+func (s Set) Name() string {
+	return fmt.Sprintf("%s/%s", s.Family, s.SetName)
+}
+
+// Label is not defined.
+func (s Set) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (s Set) Type() string {
+	return SetTypename
+}
+
+// Equal compares two nftables sets.
+func (s Set) Equal(other depgraph.Item) bool {
+	s2, isSet := other.(Set)
+	if !isSet {
+		return false
+	}
+	return s.Family == s2.Family &&
+		s.SetName == s2.SetName &&
+		s.Interval == s2.Interval &&
+		utils.EqualSets(s.Elements, s2.Elements)
+}
+
+// External returns false.
+func (s Set) External() bool {
+	return false
+}
+
+// String describes nftables set.
+func (s Set) String() string {
+	return fmt.Sprintf("nftables set: {family: %s, setName: %s, interval: %t, "+
+		"elements: %v}", s.Family, s.SetName, s.Interval, s.Elements)
+}
+
+// Dependencies returns no dependencies.
+func (s Set) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
+
+func (s Set) elemType() string {
+	if s.Family == FamilyIPv6 {
+		return "ipv6_addr"
+	}
+	return "ipv4_addr"
+}
+
+func addTableCmd(family Family) string {
+	return fmt.Sprintf("add table %s %s", family, TableName)
+}
+
+func quote(str string) string {
+	return "\"" + strings.ReplaceAll(str, "\"", "'") + "\""
+}
+
+// addRuleCmds returns commands to add (or insert) rule into a chain,
+// including the bridge-family rule tagging the matched bridge port (if any).
+func addRuleCmds(family Family, chain string, rule RuleSpec, insert bool) (
+	cmds []string, err error) {
+	if rule.Err != "" {
+		return nil, errors.New(rule.Err)
+	}
+	if tag := rule.PortTag; tag != nil {
+		hook := "prerouting"
+		if !tag.Ingress {
+			hook = "forward"
+		}
+		cmds = append(cmds, addTableCmd(FamilyBridge),
+			fmt.Sprintf("add chain %s %s %s { type filter hook %s priority %d; "+
+				"policy accept; }", FamilyBridge, TableName, tag.bridgeChain(), hook,
+				bridgeTagPriority),
+			fmt.Sprintf("add rule %s %s %s %s meta mark set 0x%x comment %s",
+				FamilyBridge, TableName, tag.bridgeChain(), tag.ifNameMatch(), tag.Mark,
+				quote(portTagComment(family, chain, rule))))
+	}
+	op := "add"
+	if insert {
+		op = "insert"
+	}
+	cmds = append(cmds, fmt.Sprintf("%s rule %s %s %s %s comment %s", op, family,
+		TableName, quote(chain), rule.Expr, quote(rule.Label)))
+	return cmds, nil
+}
+
+// delPortTagCmds returns commands to delete bridge-family rules tagging bridge
+// ports for the given rules.
+func delPortTagCmds(log *base.LogObject, family Family, chain string,
+	rules []RuleSpec) (cmds []string, err error) {
+	handles := make(map[string]uint64)
+	for _, bridgeChain := range []string{bridgeTagInChain, bridgeTagOutChain} {
+		var hasTag bool
+		for _, rule := range rules {
+			hasTag = hasTag || (rule.PortTag != nil &&
+				rule.PortTag.bridgeChain() == bridgeChain)
+		}
+		if !hasTag {
+			continue
+		}
+		listed, err := ListRules(log, FamilyBridge, bridgeChain)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range listed {
+			handles[rule.Chain+"/"+rule.Comment] = rule.Handle
+		}
+	}
+	for _, rule := range rules {
+		if rule.PortTag == nil {
+			continue
+		}
+		bridgeChain := rule.PortTag.bridgeChain()
+		handle, found := handles[bridgeChain+"/"+portTagComment(family, chain, rule)]
+		if !found {
+			continue
+		}
+		cmds = append(cmds, fmt.Sprintf("delete rule %s %s %s handle %d",
+			FamilyBridge, TableName, bridgeChain, handle))
+	}
+	return cmds, nil
+}
+
+// ChainConfigurator implements Configurator interface (libs/reconciler)
+// for nftables chains.
+type ChainConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates nftables chain with all its rules in one transaction.
+func (c *ChainConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	chain, isChain := item.(Chain)
+	if !isChain {
+		return errors.New("invalid item type")
+	}
+	cmds := []string{addTableCmd(chain.Family),
+		fmt.Sprintf("add chain %s %s %s%s", chain.Family, TableName,
+			quote(chain.ChainName()), chain.baseChainSpec())}
+	if len(chain.Rules) > 0 {
+		// Make sure we start with empty content.
+		// Chain without rules may be filled by other components (as PreCreated)
+		// and therefore we never flush it.
+		cmds = append(cmds, fmt.Sprintf("flush chain %s %s %s", chain.Family,
+			TableName, quote(chain.ChainName())))
+	}
+	for _, rule := range chain.Rules {
+		ruleCmds, err := addRuleCmds(chain.Family, chain.ChainName(), rule, false)
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, ruleCmds...)
+	}
+	return ApplyScript(c.Log, cmds)
+}
+
+// Modify atomically replaces the chain content.
+func (c *ChainConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	oldChain, isChain := oldItem.(Chain)
+	if !isChain {
+		return errors.New("invalid item type")
+	}
+	newChain, isChain := newItem.(Chain)
+	if !isChain {
+		return errors.New("invalid item type")
+	}
+	cmds, err := delPortTagCmds(c.Log, oldChain.Family, oldChain.ChainName(),
+		oldChain.Rules)
+	if err != nil {
+		return err
+	}
+	cmds = append(cmds, fmt.Sprintf("flush chain %s %s %s", newChain.Family,
+		TableName, quote(newChain.ChainName())))
+	for _, rule := range newChain.Rules {
+		ruleCmds, err := addRuleCmds(newChain.Family, newChain.ChainName(), rule, false)
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, ruleCmds...)
+	}
+	return ApplyScript(c.Log, cmds)
+}
+
+// Delete flushes the chain content and removes it.
+func (c *ChainConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	chain, isChain := item.(Chain)
+	if !isChain {
+		return errors.New("invalid item type")
+	}
+	cmds, err := delPortTagCmds(c.Log, chain.Family, chain.ChainName(), chain.Rules)
+	if err != nil {
+		return err
+	}
+	cmds = append(cmds,
+		fmt.Sprintf("flush chain %s %s %s", chain.Family, TableName,
+			quote(chain.ChainName())),
+		fmt.Sprintf("delete chain %s %s %s", chain.Family, TableName,
+			quote(chain.ChainName())))
+	return ApplyScript(c.Log, cmds)
+}
+
+// NeedsRecreate returns false - chain content is replaced by Modify.
+// Chain type and hook are given by the chain name and therefore cannot change.
+func (c *ChainConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return false
+}
+
+// RuleConfigurator implements Configurator interface (libs/reconciler)
+// for nftables rules.
+type RuleConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create inserts nftables rule into the destination chain.
+// Rules are added by inserting them at the top of the chain. AppliedBefore dependencies
+// ensure we start with the bottom ones.
+func (c *RuleConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	rule, isRule := item.(Rule)
+	if !isRule {
+		return errors.New("invalid item type")
+	}
+	cmds, err := addRuleCmds(rule.Family, rule.ChainName(), rule.Spec, true)
+	if err != nil {
+		return err
+	}
+	return ApplyScript(c.Log, append([]string{addTableCmd(rule.Family)}, cmds...))
+}
+
+// Modify is not implemented. Rules are modified through re-creation.
+func (c *RuleConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes the nftables rule.
+func (c *RuleConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	rule, isRule := item.(Rule)
+	if !isRule {
+		return errors.New("invalid item type")
+	}
+	handle, found, err := findRuleHandle(c.Log, rule.Family, rule.ChainName(),
+		rule.Spec.Label)
+	if err != nil {
+		return err
+	}
+	cmds, err := delPortTagCmds(c.Log, rule.Family, rule.ChainName(),
+		[]RuleSpec{rule.Spec})
+	if err != nil {
+		return err
+	}
+	if found {
+		cmds = append(cmds, fmt.Sprintf("delete rule %s %s %s handle %d",
+			rule.Family, TableName, quote(rule.ChainName()), handle))
+	}
+	return ApplyScript(c.Log, cmds)
+}
+
+// NeedsRecreate returns true, nftables rule can be replaced only by handle
+// and it is simpler to re-create it.
+func (c *RuleConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
+
+// SetConfigurator implements Configurator interface (libs/reconciler)
+// for nftables sets.
+type SetConfigurator struct {
+	Log *base.LogObject
+}
+
+func (c *SetConfigurator) addElementsCmd(set Set) []string {
+	if len(set.Elements) == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("add element %s %s %s { %s }", set.Family,
+		TableName, set.SetName, strings.Join(set.Elements, ", "))}
+}
+
+// Create creates nftables set.
+func (c *SetConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	set, isSet := item.(Set)
+	if !isSet {
+		return errors.New("invalid item type")
+	}
+	var flags string
+	if set.Interval {
+		flags = " flags interval;"
+	}
+	cmds := []string{addTableCmd(set.Family),
+		fmt.Sprintf("add set %s %s %s { type %s;%s }", set.Family, TableName,
+			set.SetName, set.elemType(), flags),
+		fmt.Sprintf("flush set %s %s %s", set.Family, TableName, set.SetName)}
+	cmds = append(cmds, c.addElementsCmd(set)...)
+	return ApplyScript(c.Log, cmds)
+}
+
+// Modify atomically replaces the set content.
+func (c *SetConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	set, isSet := newItem.(Set)
+	if !isSet {
+		return errors.New("invalid item type")
+	}
+	cmds := []string{fmt.Sprintf("flush set %s %s %s",
+		set.Family, TableName, set.SetName)}
+	cmds = append(cmds, c.addElementsCmd(set)...)
+	return ApplyScript(c.Log, cmds)
+}
+
+// Delete removes nftables set.
+func (c *SetConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	set, isSet := item.(Set)
+	if !isSet {
+		return errors.New("invalid item type")
+	}
+	return ApplyScript(c.Log, []string{fmt.Sprintf("delete set %s %s %s",
+		set.Family, TableName, set.SetName)})
+}
+
+// NeedsRecreate returns true if the set type has changed.
+func (c *SetConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldSet, isSet := oldItem.(Set)
+	if !isSet {
+		return true
+	}
+	newSet, isSet := newItem.(Set)
+	if !isSet {
+		return true
+	}
+	return oldSet.Interval != newSet.Interval
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/vishvananda/netlink"
+)
+
+// IPSetSync copies IP addresses added into ipsets at run-time into nftables sets
+// mirroring these ipsets (see LowerGraph). This is needed for ipsets filled
+// by dnsmasq with IPs of resolved host names (used to implement host-based ACLs),
+// because dnsmasq shipped with EVE is not able to fill nftables sets directly.
+// Only adding is supported, dnsmasq never removes IPs from ipsets.
+type IPSetSync struct {
+	log *base.LogObject
+	// Replaced in unit tests.
+	listIPSet   func(setName string) (*netlink.IPSetResult, error)
+	applyScript func(log *base.LogObject, commands []string) error
+	// IPs already copied into nftables sets, key is the ipset name.
+	synced map[string]map[string]struct{}
+}
+
+// NewIPSetSync is a constructor for IPSetSync.
+func NewIPSetSync(log *base.LogObject) *IPSetSync {
+	return &IPSetSync{
+		log:         log,
+		listIPSet:   netlink.IpsetList,
+		applyScript: ApplyScript,
+		synced:      make(map[string]map[string]struct{}),
+	}
+}
+
+// Reset forgets which IPs were already copied. Call this whenever the mirroring
+// nftables sets might have been (re)created or flushed, for example by SetConfigurator.
+func (s *IPSetSync) Reset() {
+	s.synced = make(map[string]map[string]struct{})
+}
+
+// Sync copies IPs found in the given ipsets, which are not part of the static
+// ipset content (IPSet.Entries), into the corresponding nftables sets.
+// All new IPs are added using one nftables transaction.
+func (s *IPSetSync) Sync(ipsets []linuxitems.IPSet) error {
+	var cmds []string
+	newIPs := make(map[string][]string)
+	for _, ipset := range ipsets {
+		result, err := s.listIPSet(ipset.SetName)
+		if err != nil {
+			// The ipset may not be created yet.
+			s.log.Warnf("IPSetSync: failed to list ipset %s: %v", ipset.SetName, err)
+			continue
+		}
+		static := make(map[string]struct{})
+		for _, entry := range ipset.Entries {
+			static[entry] = struct{}{}
+		}
+		synced := s.synced[ipset.SetName]
+		for _, entry := range result.Entries {
+			if entry.IP == nil {
+				continue
+			}
+			ip := entry.IP.String()
+			if _, isStatic := static[ip]; isStatic {
+				continue
+			}
+			if _, isSynced := synced[ip]; isSynced {
+				continue
+			}
+			newIPs[ipset.SetName] = append(newIPs[ipset.SetName], ip)
+		}
+		if ips := newIPs[ipset.SetName]; len(ips) > 0 {
+			sort.Strings(ips)
+			family := FamilyIPv4
+			if ipset.AddrFamily == syscall.AF_INET6 {
+				family = FamilyIPv6
+			}
+			cmds = append(cmds, fmt.Sprintf("add element %s %s %s { %s }", family,
+				TableName, SetName(ipset.SetName), strings.Join(ips, ", ")))
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	if err := s.applyScript(s.log, cmds); err != nil {
+		return fmt.Errorf("IPSetSync: failed to add IPs into nftables sets: %w", err)
+	}
+	for setName, ips := range newIPs {
+		if s.synced[setName] == nil {
+			s.synced[setName] = make(map[string]struct{})
+		}
+		for _, ip := range ips {
+			s.synced[setName][ip] = struct{}{}
+		}
+	}
+	return nil
+}
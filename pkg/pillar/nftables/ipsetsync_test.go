@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+)
+
+func TestIPSetSync(t *testing.T) {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	ipsetContent := map[string][]string{
+		"ipv4.ieee.org": {"140.98.193.152"},
+		"ipv6.ieee.org": {},
+	}
+	var applied [][]string
+	var applyErr error
+	s := NewIPSetSync(log)
+	s.listIPSet = func(setName string) (*netlink.IPSetResult, error) {
+		ips, exists := ipsetContent[setName]
+		if !exists {
+			return nil, errors.New("no such ipset")
+		}
+		result := &netlink.IPSetResult{}
+		for _, ip := range ips {
+			result.Entries = append(result.Entries, netlink.IPSetEntry{IP: net.ParseIP(ip)})
+		}
+		return result, nil
+	}
+	s.applyScript = func(log *base.LogObject, commands []string) error {
+		if applyErr != nil {
+			return applyErr
+		}
+		applied = append(applied, commands)
+		return nil
+	}
+	ipsets := []linuxitems.IPSet{
+		{SetName: "ipv4.ieee.org", TypeName: "hash:ip", AddrFamily: syscall.AF_INET,
+			Entries: []string{"10.0.0.1"}},
+		{SetName: "ipv6.ieee.org", TypeName: "hash:ip", AddrFamily: syscall.AF_INET6},
+		{SetName: "ipv4.missing", TypeName: "hash:ip", AddrFamily: syscall.AF_INET},
+	}
+	expectApplied := func(expected ...[]string) {
+		t.Helper()
+		if !reflect.DeepEqual(applied, expected) {
+			t.Errorf("unexpected nftables commands: %v, expected: %v", applied, expected)
+		}
+		applied = nil
+	}
+
+	// Missing ipset is skipped.
+	if err := s.Sync(ipsets); err != nil {
+		t.Fatal(err)
+	}
+	expectApplied([]string{"add element ip eve ipv4.ieee.org { 140.98.193.152 }"})
+
+	// Nothing new, static entries are not copied.
+	ipsetContent["ipv4.ieee.org"] = append(ipsetContent["ipv4.ieee.org"], "10.0.0.1")
+	if err := s.Sync(ipsets); err != nil {
+		t.Fatal(err)
+	}
+	expectApplied()
+
+	// Only new IPs are added, all sets in one transaction.
+	ipsetContent["ipv4.ieee.org"] = append(ipsetContent["ipv4.ieee.org"], "140.98.193.153")
+	ipsetContent["ipv6.ieee.org"] = []string{"2001:db8::2", "2001:db8::1"}
+	if err := s.Sync(ipsets); err != nil {
+		t.Fatal(err)
+	}
+	expectApplied([]string{
+		"add element ip eve ipv4.ieee.org { 140.98.193.153 }",
+		"add element ip6 eve ipv6.ieee.org { 2001:db8::1, 2001:db8::2 }"})
+
+	// Failed transaction is retried.
+	ipsetContent["ipv4.ieee.org"] = append(ipsetContent["ipv4.ieee.org"], "140.98.193.154")
+	applyErr = errors.New("nft failed")
+	if err := s.Sync(ipsets); err == nil {
+		t.Error("expected error")
+	}
+	applyErr = nil
+	if err := s.Sync(ipsets); err != nil {
+		t.Fatal(err)
+	}
+	expectApplied([]string{"add element ip eve ipv4.ieee.org { 140.98.193.154 }"})
+
+	// After reset all IPs are copied again.
+	s.Reset()
+	if err := s.Sync(ipsets); err != nil {
+		t.Fatal(err)
+	}
+	expectApplied([]string{
+		"add element ip eve ipv4.ieee.org " +
+			"{ 140.98.193.152, 140.98.193.153, 140.98.193.154 }",
+		"add element ip6 eve ipv6.ieee.org { 2001:db8::1, 2001:db8::2 }"})
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"sort"
+	"syscall"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+)
+
+type chainKey struct {
+	forIPv6 bool
+	table   string
+	chain   string
+}
+
+type graphItem struct {
+	item  dg.Item
+	state dg.ItemState
+	path  dg.SubGraphPath
+}
+
+// LowerGraph replaces iptables chains and rules present in the graph (incl. all
+// subgraphs) with nftables items and for every ipset adds nftables set
+// with the same content (the ipset itself is preserved, other items may depend on it).
+//   - every chain defined in the graph (and not PreCreated) is replaced with a single
+//     Chain item containing all the chain rules found in the graph. The same is done
+//     for built-in iptables chains, which are translated to nftables base chains.
+//   - PreCreated chain is replaced with PreCreated (external) Chain item
+//   - rule inserted into a chain which is not defined in the graph is replaced with
+//     a separate Rule item
+//
+// Item state data are preserved.
+func LowerGraph(graph dg.Graph) {
+	chains := make(map[chainKey]graphItem)
+	rules := make(map[chainKey][]graphItem)
+	var ipsets []graphItem
+	iter := graph.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		switch typedItem := item.(type) {
+		case iptables.Chain:
+			key := chainKey{forIPv6: typedItem.ForIPv6, table: typedItem.Table,
+				chain: typedItem.ChainName}
+			chains[key] = newGraphItem(graph, item, state)
+		case iptables.Rule:
+			key := chainKey{forIPv6: typedItem.ForIPv6, table: typedItem.Table,
+				chain: typedItem.ChainName}
+			rules[key] = append(rules[key], newGraphItem(graph, item, state))
+		case linuxitems.IPSet:
+			ipsets = append(ipsets, newGraphItem(graph, item, state))
+		}
+	}
+	for key, chain := range chains {
+		iptChain := chain.item.(iptables.Chain)
+		dg.DelItemFrom(graph, dg.Reference(iptChain), chain.path)
+		if iptChain.PreCreated {
+			dg.PutItemInto(graph, Chain{
+				Family:        FamilyForIPv6(key.forIPv6),
+				Table:         key.table,
+				IptablesChain: key.chain,
+				PreCreated:    true,
+			}, chain.state, chain.path)
+			continue
+		}
+		putChainWithRules(graph, key, chain, rules[key])
+		delete(rules, key)
+	}
+	for key, chainRules := range rules {
+		if isBuiltinChain(key.chain) {
+			// Built-in chain is not defined as an item but nftables base chain
+			// needs to be created.
+			putChainWithRules(graph, key, chainRules[0], chainRules)
+			continue
+		}
+		for _, rule := range chainRules {
+			iptRule := rule.item.(iptables.Rule)
+			dg.DelItemFrom(graph, dg.Reference(iptRule), rule.path)
+			dg.PutItemInto(graph, Rule{
+				Family:        FamilyForIPv6(key.forIPv6),
+				Table:         key.table,
+				IptablesChain: key.chain,
+				AppliedBefore: iptRule.AppliedBefore,
+				Spec:          TranslateRule(iptRule),
+				Description:   iptRule.Description,
+			}, rule.state, rule.path)
+		}
+	}
+	for _, ipset := range ipsets {
+		set := ipset.item.(linuxitems.IPSet)
+		family := FamilyIPv4
+		if set.AddrFamily == syscall.AF_INET6 {
+			family = FamilyIPv6
+		}
+		dg.PutItemInto(graph, Set{
+			Family:   family,
+			SetName:  SetName(set.SetName),
+			Interval: set.TypeName == "hash:net",
+			Elements: set.Entries,
+		}, ipset.state, ipset.path)
+	}
+}
+
+func newGraphItem(graph dg.GraphR, item dg.Item, state dg.ItemState) graphItem {
+	_, _, path, _ := graph.Item(dg.Reference(item))
+	return graphItem{item: item, state: state, path: path}
+}
+
+func isBuiltinChain(chain string) bool {
+	for _, builtin := range builtinChains {
+		if chain == builtin {
+			return true
+		}
+	}
+	return false
+}
+
+// putChainWithRules replaces iptables rules with a single nftables chain.
+// The chain is put into the same subgraph as the given chain location.
+func putChainWithRules(graph dg.Graph, key chainKey, location graphItem,
+	chainRules []graphItem) {
+	var iptRules []iptables.Rule
+	for _, rule := range chainRules {
+		iptRule := rule.item.(iptables.Rule)
+		dg.DelItemFrom(graph, dg.Reference(iptRule), rule.path)
+		iptRules = append(iptRules, iptRule)
+	}
+	chain := Chain{
+		Family:        FamilyForIPv6(key.forIPv6),
+		Table:         key.table,
+		IptablesChain: key.chain,
+	}
+	for _, iptRule := range orderRules(iptRules) {
+		chain.Rules = append(chain.Rules, TranslateRule(iptRule))
+	}
+	dg.PutItemInto(graph, chain, location.state, location.path)
+}
+
+// orderRules orders rules of a chain based on AppliedBefore (from top to bottom).
+// Rules without mutual ordering constraints are sorted by label.
+func orderRules(rules []iptables.Rule) (ordered []iptables.Rule) {
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].RuleLabel < rules[j].RuleLabel
+	})
+	byLabel := make(map[string]int)
+	for i, rule := range rules {
+		byLabel[rule.RuleLabel] = i
+	}
+	// Number of rules which must be above the given rule.
+	above := make([]int, len(rules))
+	for _, rule := range rules {
+		for _, below := range rule.AppliedBefore {
+			if idx, exists := byLabel[below]; exists {
+				above[idx]++
+			}
+		}
+	}
+	added := make([]bool, len(rules))
+	for len(ordered) < len(rules) {
+		next := -1
+		for i := range rules {
+			if !added[i] && above[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			// Cyclic AppliedBefore (should not happen), just append
+			// the remaining rules.
+			for i := range rules {
+				if !added[i] {
+					ordered = append(ordered, rules[i])
+				}
+			}
+			break
+		}
+		added[next] = true
+		ordered = append(ordered, rules[next])
+		for _, below := range rules[next].AppliedBefore {
+			if idx, exists := byLabel[below]; exists {
+				above[idx]--
+			}
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"syscall"
+	"testing"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+)
+
+func TestLowerGraph(t *testing.T) {
+	graph := dg.New(dg.InitArgs{Name: "ACLs"})
+	appSG := dg.New(dg.InitArgs{Name: "App"})
+	graph.PutSubGraph(appSG)
+	graph.PutItem(iptables.Chain{
+		ChainName:  "FORWARD-apps",
+		Table:      "filter",
+		PreCreated: true,
+	}, nil)
+	graph.PutItem(iptables.Rule{
+		RuleLabel: "Traverse device-wide ACLs",
+		Table:     "filter",
+		ChainName: "INPUT",
+		Target:    "INPUT-device",
+	}, nil)
+	graph.PutItem(iptables.Chain{
+		ChainName: "INPUT-device",
+		Table:     "filter",
+	}, nil)
+	appSG.PutItem(iptables.Chain{
+		ChainName: "FORWARD-nbu1x1",
+		Table:     "filter",
+	}, nil)
+	// Rules are intentionally not submitted in order.
+	appSG.PutItem(iptables.Rule{
+		RuleLabel: "A: Default drop",
+		Table:     "filter",
+		ChainName: "FORWARD-nbu1x1",
+		Target:    "DROP",
+	}, nil)
+	appSG.PutItem(iptables.Rule{
+		RuleLabel:     "C: Allow DNS",
+		Table:         "filter",
+		ChainName:     "FORWARD-nbu1x1",
+		AppliedBefore: []string{"B: Allow host"},
+		MatchOpts:     []string{"-p", "udp", "--sport", "domain"},
+		Target:        "ACCEPT",
+	}, nil)
+	appSG.PutItem(iptables.Rule{
+		RuleLabel:     "B: Allow host",
+		Table:         "filter",
+		ChainName:     "FORWARD-nbu1x1",
+		AppliedBefore: []string{"A: Default drop"},
+		MatchOpts:     []string{"-m", "set", "--match-set", "ipv4.example.com", "src"},
+		Target:        "ACCEPT",
+	}, nil)
+	appSG.PutItem(iptables.Rule{
+		RuleLabel: "Traverse VIF nbu1x1",
+		Table:     "filter",
+		ChainName: "FORWARD-apps",
+		MatchOpts: []string{"-o", "bn1"},
+		Target:    "FORWARD-nbu1x1",
+	}, nil)
+	appSG.PutItem(linuxitems.IPSet{
+		SetName:    "ipv4.example.com",
+		TypeName:   "hash:ip",
+		AddrFamily: syscall.AF_INET,
+	}, nil)
+
+	LowerGraph(graph)
+
+	iter := graph.Items(true)
+	for iter.Next() {
+		item, _ := iter.Item()
+		switch item.(type) {
+		case iptables.Chain, iptables.Rule:
+			t.Errorf("iptables item was not lowered: %s", item)
+		}
+	}
+	checkItem := func(item dg.Item, expPath dg.SubGraphPath) {
+		t.Helper()
+		graphItem, _, path, found := graph.Item(dg.Reference(item))
+		if !found {
+			t.Errorf("item %s/%s not found", item.Type(), item.Name())
+			return
+		}
+		if path.Compare(expPath) != 0 {
+			t.Errorf("item %s/%s has unexpected location %v", item.Type(),
+				item.Name(), path)
+		}
+		if !graphItem.Equal(item) {
+			t.Errorf("item %s/%s differs from the expected state:\n%s\nvs.\n%s",
+				item.Type(), item.Name(), graphItem, item)
+		}
+	}
+	checkItem(Chain{
+		Family:        FamilyIPv4,
+		Table:         "filter",
+		IptablesChain: "FORWARD-apps",
+		PreCreated:    true,
+	}, dg.NewSubGraphPath())
+	checkItem(Chain{
+		Family:        FamilyIPv4,
+		Table:         "filter",
+		IptablesChain: "INPUT",
+		Rules: []RuleSpec{
+			{
+				Label: "Traverse device-wide ACLs",
+				Expr:  "counter jump \"filter-INPUT-device\"",
+				Jump:  "filter-INPUT-device",
+			},
+		},
+	}, dg.NewSubGraphPath())
+	checkItem(Chain{
+		Family:        FamilyIPv4,
+		Table:         "filter",
+		IptablesChain: "INPUT-device",
+	}, dg.NewSubGraphPath())
+	checkItem(Chain{
+		Family:        FamilyIPv4,
+		Table:         "filter",
+		IptablesChain: "FORWARD-nbu1x1",
+		Rules: []RuleSpec{
+			{
+				Label: "C: Allow DNS",
+				Expr:  "meta l4proto udp th sport 53 counter accept",
+			},
+			{
+				Label: "B: Allow host",
+				Expr:  "ip saddr @ipv4.example.com counter accept",
+				Sets:  []string{"ipv4.example.com"},
+			},
+			{
+				Label: "A: Default drop",
+				Expr:  "counter drop",
+			},
+		},
+	}, dg.NewSubGraphPath("App"))
+	checkItem(Rule{
+		Family:        FamilyIPv4,
+		Table:         "filter",
+		IptablesChain: "FORWARD-apps",
+		Spec: RuleSpec{
+			Label: "Traverse VIF nbu1x1",
+			Expr:  "oifname \"bn1\" counter jump \"filter-FORWARD-nbu1x1\"",
+			Jump:  "filter-FORWARD-nbu1x1",
+		},
+	}, dg.NewSubGraphPath("App"))
+	checkItem(Set{
+		Family:  FamilyIPv4,
+		SetName: "ipv4.example.com",
+	}, dg.NewSubGraphPath("App"))
+	// IPSet is preserved.
+	_, _, _, found := graph.Item(dg.Reference(linuxitems.IPSet{
+		SetName: "ipv4.example.com"}))
+	if !found {
+		t.Errorf("IPSet was removed from the graph")
+	}
+}
+
+func TestParseListedRules(t *testing.T) {
+	output := `{"nftables": [{"metainfo": {"version": "1.0.5", "json_schema_version": 1}},
+{"chain": {"family": "ip", "table": "eve", "name": "filter-FORWARD-nbu1x1", "handle": 5}},
+{"rule": {"family": "ip", "table": "eve", "chain": "filter-FORWARD-nbu1x1", "handle": 7,
+  "comment": "Rate limit", "expr": [
+  {"match": {"op": "==", "left": {"meta": {"key": "l4proto"}}, "right": "icmp"}},
+  {"limit": {"rate": 4, "burst": 8, "per": "second"}},
+  {"counter": {"packets": 12, "bytes": 1008}}, {"accept": null}]}},
+{"rule": {"family": "ip", "table": "eve", "chain": "filter-FORWARD-nbu1x1", "handle": 8,
+  "comment": "Default drop", "expr": [{"counter": {"packets": 3, "bytes": 180}},
+  {"drop": null}]}}]}`
+	rules, err := parseListedRules(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if rules[0].Handle != 7 || rules[0].Comment != "Rate limit" ||
+		rules[0].Packets != 12 || rules[0].Bytes != 1008 ||
+		!rules[0].HasStatement("limit") || !rules[0].HasStatement("accept") ||
+		rules[0].HasStatement("match") {
+		t.Errorf("unexpected first rule: %+v", rules[0])
+	}
+	if rules[1].Handle != 8 || rules[1].Packets != 3 || !rules[1].HasStatement("drop") {
+		t.Errorf("unexpected second rule: %+v", rules[1])
+	}
+}
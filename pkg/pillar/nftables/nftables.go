@@ -0,0 +1,256 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nftables implements an alternative firewall backend, which applies
+// firewall configuration using nftables instead of iptables.
+//
+// Both NIM (dpcreconciler) and zedrouter (nireconciler) continue to build
+// their intended firewall configuration from iptables.Chain, iptables.Rule
+// and IPSet items. With the nftables backend selected, the intended state
+// is "lowered" (see LowerGraph) into nftables items before it is reconciled:
+//   - chain, which is fully defined within the reconciled graph (incl. all
+//     of its rules), is represented by a single Chain item and the chain
+//     content is always (re)applied using one atomic nftables transaction
+//   - rule, which is inserted into a chain created elsewhere, is represented
+//     by a separate Rule item
+//   - ipset is mirrored by an nftables named set; IPs added into ipsets
+//     by dnsmasq at run-time are copied by IPSetSync
+//
+// Every lowered rule includes a counter. All EVE chains and sets are placed
+// inside tables named "eve" (one table for IPv4, one for IPv6 and one for
+// the bridge family). Chains are named <iptables-table>-<iptables-chain>.
+package nftables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+const (
+	// TableName : name of the nftables tables used by EVE.
+	TableName = "eve"
+	// BootArg : kernel command-line argument used to select the firewall backend.
+	// Use "eve_firewall=nftables" to enable this backend. The default is iptables.
+	BootArg = "eve_firewall"
+
+	nftCmd         = "nft"
+	kernelCmdline  = "/proc/cmdline"
+	nftScriptsDir  = "/run/nftables"
+	backendNftName = "nftables"
+)
+
+// Family : nftables address family.
+type Family string
+
+const (
+	// FamilyIPv4 : nftables "ip" family.
+	FamilyIPv4 Family = "ip"
+	// FamilyIPv6 : nftables "ip6" family.
+	FamilyIPv6 Family = "ip6"
+	// FamilyBridge : nftables "bridge" family.
+	FamilyBridge Family = "bridge"
+)
+
+// FamilyForIPv6 returns nftables family corresponding to iptables (forIPv6=false)
+// or ip6tables (forIPv6=true).
+func FamilyForIPv6(forIPv6 bool) Family {
+	if forIPv6 {
+		return FamilyIPv6
+	}
+	return FamilyIPv4
+}
+
+// ChainName returns the name under which iptables chain from the given iptables
+// table is created in nftables.
+func ChainName(iptablesTable, iptablesChain string) string {
+	return iptablesTable + "-" + iptablesChain
+}
+
+var (
+	backendOnce   sync.Once
+	nftablesInUse bool
+)
+
+// Enabled returns true if nftables was selected as the firewall backend
+// using the kernel command-line argument (see BootArg).
+// The selection cannot change without reboot.
+func Enabled() bool {
+	backendOnce.Do(func() {
+		data, err := os.ReadFile(kernelCmdline)
+		if err != nil {
+			return
+		}
+		var backend string
+		for _, arg := range strings.Fields(string(data)) {
+			if strings.HasPrefix(arg, BootArg+"=") {
+				backend = strings.TrimPrefix(arg, BootArg+"=")
+			}
+		}
+		nftablesInUse = backend == backendNftName
+	})
+	return nftablesInUse
+}
+
+// NftCmdOut runs nft command with the given arguments and returns the output.
+// Logs the command string if log is set.
+func NftCmdOut(log *base.LogObject, args ...string) (string, error) {
+	var out []byte
+	var err error
+	if log != nil {
+		log.Functionf("Calling command %s %v\n", nftCmd, args)
+		out, err = base.Exec(log, nftCmd, args...).CombinedOutput()
+	} else {
+		out, err = base.Exec(log, nftCmd, args...).Output()
+	}
+	if err != nil {
+		outStr := strings.TrimSpace(string(out))
+		outStr = strings.ReplaceAll(outStr, "\n", "; ")
+		errStr := fmt.Sprintf("nft command %s failed with err '%s' and output: %s",
+			args, err, outStr)
+		if log != nil {
+			log.Errorln(errStr)
+		}
+		return "", errors.New(errStr)
+	}
+	return string(out), nil
+}
+
+// ApplyScript submits a list of nftables commands as one atomic transaction.
+// Either all the commands are applied or none of them.
+func ApplyScript(log *base.LogObject, commands []string) error {
+	if len(commands) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(nftScriptsDir, 0700); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", nftScriptsDir, err)
+	}
+	file, err := os.CreateTemp(nftScriptsDir, "transaction-*.nft")
+	if err != nil {
+		return fmt.Errorf("failed to create nft script file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(strings.Join(commands, "\n") + "\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write nft script file: %w", err)
+	}
+	if _, err = NftCmdOut(log, "-f", file.Name()); err != nil {
+		return fmt.Errorf("nft transaction (%s) failed: %w",
+			strings.Join(commands, "; "), err)
+	}
+	return nil
+}
+
+// ListedRule : rule as listed by "nft --json list".
+type ListedRule struct {
+	Family  Family
+	Table   string
+	Chain   string
+	Handle  uint64
+	Comment string
+	// Counter values. Zero if the rule has no counter.
+	Packets uint64
+	Bytes   uint64
+	// Statements used by the rule (e.g. "accept", "drop", "log", "limit", "jump").
+	// Match expressions are omitted.
+	Statements []string
+}
+
+// HasStatement returns true if the rule contains statement of the given type.
+func (r ListedRule) HasStatement(stmt string) bool {
+	for _, s := range r.Statements {
+		if s == stmt {
+			return true
+		}
+	}
+	return false
+}
+
+// ListRules returns all rules of the given table or only of the given chain
+// if chain is not empty.
+func ListRules(log *base.LogObject, family Family, chain string) ([]ListedRule, error) {
+	args := []string{"--json", "list"}
+	if chain != "" {
+		args = append(args, "chain", string(family), TableName, chain)
+	} else {
+		args = append(args, "table", string(family), TableName)
+	}
+	out, err := NftCmdOut(log, args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseListedRules(out)
+}
+
+func parseListedRules(output string) (rules []ListedRule, err error) {
+	var listed struct {
+		Nftables []struct {
+			Rule *struct {
+				Family  Family                       `json:"family"`
+				Table   string                       `json:"table"`
+				Chain   string                       `json:"chain"`
+				Handle  uint64                       `json:"handle"`
+				Comment string                       `json:"comment"`
+				Expr    []map[string]json.RawMessage `json:"expr"`
+			} `json:"rule"`
+		} `json:"nftables"`
+	}
+	if err = json.Unmarshal([]byte(output), &listed); err != nil {
+		return nil, fmt.Errorf("failed to parse nft JSON output: %w", err)
+	}
+	for _, obj := range listed.Nftables {
+		if obj.Rule == nil {
+			continue
+		}
+		rule := ListedRule{
+			Family:  obj.Rule.Family,
+			Table:   obj.Rule.Table,
+			Chain:   obj.Rule.Chain,
+			Handle:  obj.Rule.Handle,
+			Comment: obj.Rule.Comment,
+		}
+		for _, expr := range obj.Rule.Expr {
+			for stmt, value := range expr {
+				switch stmt {
+				case "match":
+					continue
+				case "counter":
+					var counter struct {
+						Packets uint64 `json:"packets"`
+						Bytes   uint64 `json:"bytes"`
+					}
+					if err = json.Unmarshal(value, &counter); err == nil {
+						rule.Packets = counter.Packets
+						rule.Bytes = counter.Bytes
+					}
+				}
+				rule.Statements = append(rule.Statements, stmt)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// findRuleHandle returns handle of the rule with the given comment.
+func findRuleHandle(log *base.LogObject, family Family, chain,
+	comment string) (handle uint64, found bool, err error) {
+	rules, err := ListRules(log, family, chain)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, rule := range rules {
+		if rule.Comment == comment {
+			return rule.Handle, true, nil
+		}
+	}
+	return 0, false, nil
+}
@@ -0,0 +1,518 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+)
+
+// RuleSpec : single nftables rule translated from iptables.Rule.
+type RuleSpec struct {
+	// Label of the original iptables rule. Stored as the rule comment.
+	Label string
+	// Expr : rule expression (matches, counter and statements, including verdict).
+	Expr string
+	// Jump : name of the (nftables) chain that this rule jumps to (if any).
+	Jump string
+	// Sets : names of (nftables) sets referenced by this rule.
+	Sets []string
+	// PortTag : set if the rule matches bridge port (iptables physdev match).
+	PortTag *PortTag
+	// Err : non-empty if the iptables rule could not be translated.
+	// Applying the rule will fail with this error.
+	Err string
+}
+
+// Equal compares two rule specs.
+func (r RuleSpec) Equal(r2 RuleSpec) bool {
+	if (r.PortTag == nil) != (r2.PortTag == nil) {
+		return false
+	}
+	if r.PortTag != nil && *r.PortTag != *r2.PortTag {
+		return false
+	}
+	return r.Label == r2.Label &&
+		r.Expr == r2.Expr &&
+		r.Jump == r2.Jump &&
+		equalStrings(r.Sets, r2.Sets) &&
+		r.Err == r2.Err
+}
+
+// PortTag : nftables does not have an equivalent of the iptables physdev match
+// (for the ip/ip6 families). Instead, packet entering (or leaving) through
+// a given bridge port is tagged with a mark by a rule installed inside the bridge
+// family table. The mark is then matched by the ip/ip6 rule.
+// Tagging is done in bridge hooks with priority lower than that of br_netfilter,
+// i.e. before the packet enters ip/ip6 hooks. The rule which matches the tag
+// clears it: the original mark is restored (zero on ingress, connection mark
+// on egress) before the rule statements are applied.
+type PortTag struct {
+	// Ingress is true for --physdev-in, false for --physdev-out.
+	Ingress bool
+	// IfName : bridge port name (nftables wildcard "*" is allowed).
+	IfName string
+	// Mark used to tag packets.
+	Mark uint32
+}
+
+const (
+	bridgeTagInChain  = "vif-tags-in"
+	bridgeTagOutChain = "vif-tags-out"
+	// Bridge hooks with this priority are processed before br_netfilter
+	// passes bridged packets to ip/ip6 hooks.
+	bridgeTagPriority = -200
+)
+
+func (t PortTag) bridgeChain() string {
+	if t.Ingress {
+		return bridgeTagInChain
+	}
+	return bridgeTagOutChain
+}
+
+func (t PortTag) ifNameMatch() string {
+	if t.Ingress {
+		return fmt.Sprintf("iifname \"%s\"", t.IfName)
+	}
+	return fmt.Sprintf("oifname \"%s\"", t.IfName)
+}
+
+// Mark values used to tag bridge ports.
+// Interface names of bridge ports are reused (e.g. "nbu1x1"), therefore
+// the number of allocated tags remains bounded and they are never released.
+var (
+	portTagsLock sync.Mutex
+	portTags     = make(map[PortTag]uint32)
+)
+
+func getPortTag(ingress bool, ifName string) *PortTag {
+	portTagsLock.Lock()
+	defer portTagsLock.Unlock()
+	key := PortTag{Ingress: ingress, IfName: ifName}
+	mark, allocated := portTags[key]
+	if !allocated {
+		aceID := uint32(iptables.BridgePortTagAceIDBase + len(portTags))
+		mark = iptables.GetConnmark(0, aceID, false)
+		portTags[key] = mark
+	}
+	key.Mark = mark
+	return &key
+}
+
+// portTagComment returns comment used for the bridge-family rule tagging bridge
+// port for the given rule.
+func portTagComment(family Family, chain string, rule RuleSpec) string {
+	h := fnv.New64a()
+	h.Write([]byte(string(family) + "/" + chain + "/" + rule.Label))
+	return fmt.Sprintf("tag-%x", h.Sum64())
+}
+
+// Known service names used by EVE iptables rules.
+// nftables supports service names as well, but this way we do not depend
+// on the content of /etc/services.
+var serviceNames = map[string]string{
+	"bootps":        "67",
+	"bootpc":        "68",
+	"domain":        "53",
+	"http":          "80",
+	"https":         "443",
+	"dhcpv6-client": "546",
+	"dhcpv6-server": "547",
+}
+
+// SetName converts ipset name to a valid nftables set name.
+func SetName(ipsetName string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, ipsetName)
+}
+
+// TranslateRule translates iptables rule into nftables rule.
+func TranslateRule(rule iptables.Rule) RuleSpec {
+	spec := RuleSpec{Label: rule.RuleLabel}
+	family := FamilyForIPv6(rule.ForIPv6)
+	var (
+		exprs    []string
+		limit    []string
+		negate   bool
+		matchMod string
+		cmpOp    string
+		err      error
+	)
+	opts := rule.MatchOpts
+	i := 0
+	nextToken := func() (string, error) {
+		if i+1 >= len(opts) {
+			return "", fmt.Errorf("missing value for %s", opts[i])
+		}
+		i++
+		return opts[i], nil
+	}
+	for ; i < len(opts) && err == nil; i++ {
+		opt := opts[i]
+		if opt == "!" {
+			negate = true
+			continue
+		}
+		cmpOp = ""
+		if negate {
+			cmpOp = "!= "
+		}
+		var value string
+		switch opt {
+		case "-m", "--match":
+			matchMod, err = nextToken()
+			continue
+		case "-p", "--protocol":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			value = strings.ToLower(value)
+			if value == "all" {
+				break
+			}
+			if value == "ipv6-icmp" {
+				value = "icmpv6"
+			}
+			exprs = append(exprs, "meta l4proto "+cmpOp+value)
+		case "--dport", "--sport", "--dports", "--sports":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			exprs = append(exprs, fmt.Sprintf("th %s %s%s",
+				strings.TrimSuffix(strings.TrimPrefix(opt, "--"), "s"),
+				cmpOp, translatePorts(value)))
+		case "-s", "--source", "-d", "--destination":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			field := "saddr"
+			if opt == "-d" || opt == "--destination" {
+				field = "daddr"
+			}
+			exprs = append(exprs, fmt.Sprintf("%s %s %s%s", family, field, cmpOp, value))
+		case "-i", "--in-interface", "-o", "--out-interface":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			field := "iifname"
+			if opt == "-o" || opt == "--out-interface" {
+				field = "oifname"
+			}
+			exprs = append(exprs, fmt.Sprintf("%s %s\"%s\"", field, cmpOp,
+				translateIfName(value)))
+		case "--match-set":
+			var dir string
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			if dir, err = nextToken(); err != nil {
+				break
+			}
+			field := "daddr"
+			if dir == "src" {
+				field = "saddr"
+			} else if dir != "dst" {
+				err = fmt.Errorf("unsupported ipset match direction: %s", dir)
+				break
+			}
+			setName := SetName(value)
+			spec.Sets = append(spec.Sets, setName)
+			exprs = append(exprs, fmt.Sprintf("%s %s %s@%s", family, field, cmpOp, setName))
+		case "--physdev-in", "--physdev-out":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			if negate {
+				err = fmt.Errorf("negated %s is not supported", opt)
+				break
+			}
+			spec.PortTag = getPortTag(opt == "--physdev-in", translateIfName(value))
+			exprs = append(exprs, fmt.Sprintf("meta mark 0x%x", spec.PortTag.Mark))
+		case "--mark":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			key := "meta mark"
+			if matchMod == "connmark" {
+				key = "ct mark"
+			}
+			var mark, mask string
+			if mark, mask, err = parseMark(value); err != nil {
+				break
+			}
+			if mask != "" {
+				key += " and " + mask
+			}
+			exprs = append(exprs, fmt.Sprintf("%s %s%s", key, cmpOp, mark))
+		case "--limit":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			var rate string
+			if rate, err = translateLimitRate(value); err != nil {
+				break
+			}
+			limit = append([]string{"limit rate " + rate}, limit...)
+		case "--limit-burst":
+			if value, err = nextToken(); err != nil {
+				break
+			}
+			limit = append(limit, "burst "+value+" packets")
+		default:
+			err = fmt.Errorf("unsupported iptables match option: %s", opt)
+		}
+		negate = false
+	}
+	if err == nil && len(limit) > 0 {
+		if !strings.HasPrefix(limit[0], "limit rate") {
+			// Default rate used by iptables.
+			limit = append([]string{"limit rate 3/hour"}, limit...)
+		}
+		exprs = append(exprs, strings.Join(limit, " "))
+	}
+	if spec.PortTag != nil && err == nil {
+		// Restore the original mark (see PortTag).
+		if spec.PortTag.Ingress {
+			exprs = append(exprs, "meta mark set 0x0")
+		} else {
+			exprs = append(exprs, "meta mark set ct mark")
+		}
+	}
+	exprs = append(exprs, "counter")
+	if err == nil {
+		var stmt string
+		stmt, spec.Jump, err = translateTarget(rule, family)
+		if stmt != "" {
+			exprs = append(exprs, stmt)
+		}
+	}
+	if err != nil {
+		spec.Err = fmt.Sprintf("failed to translate iptables rule %s: %v",
+			rule.RuleLabel, err)
+	}
+	spec.Expr = strings.Join(exprs, " ")
+	return spec
+}
+
+func translateTarget(rule iptables.Rule, family Family) (stmt, jump string, err error) {
+	opts := rule.TargetOpts
+	optValue := func(name string) string {
+		for i := 0; i < len(opts)-1; i++ {
+			if opts[i] == name {
+				return opts[i+1]
+			}
+		}
+		return ""
+	}
+	hasOpt := func(name string) bool {
+		for _, opt := range opts {
+			if opt == name {
+				return true
+			}
+		}
+		return false
+	}
+	switch rule.Target {
+	case "":
+		return "", "", nil
+	case "ACCEPT":
+		return "accept", "", nil
+	case "DROP":
+		return "drop", "", nil
+	case "RETURN":
+		return "return", "", nil
+	case "REJECT":
+		if optValue("--reject-with") == "tcp-reset" {
+			return "reject with tcp reset", "", nil
+		}
+		return "reject", "", nil
+	case "LOG":
+		stmt = "log"
+		if prefix := optValue("--log-prefix"); prefix != "" {
+			stmt += fmt.Sprintf(" prefix \"%s\"", prefix)
+		}
+		if level := optValue("--log-level"); level != "" {
+			stmt += " level " + translateLogLevel(level)
+		}
+		return stmt, "", nil
+	case "DNAT":
+		addr := optValue("--to-destination")
+		if addr == "" {
+			return "", "", fmt.Errorf("DNAT without destination")
+		}
+		// EVE always specifies DNAT target port.
+		return "dnat to " + translateNATAddr(addr, family, true), "", nil
+	case "SNAT":
+		addr := optValue("--to")
+		if addr == "" {
+			addr = optValue("--to-source")
+		}
+		if addr == "" {
+			return "", "", fmt.Errorf("SNAT without source")
+		}
+		return "snat to " + translateNATAddr(addr, family, false), "", nil
+	case "MASQUERADE":
+		return "masquerade", "", nil
+	case "MARK", "CONNMARK":
+		key := "meta mark"
+		if rule.Target == "CONNMARK" {
+			key = "ct mark"
+		}
+		switch {
+		case hasOpt("--restore-mark"):
+			return "meta mark set ct mark", "", nil
+		case hasOpt("--save-mark"):
+			return "ct mark set meta mark", "", nil
+		case optValue("--set-mark") != "":
+			mark, mask, err := parseMark(optValue("--set-mark"))
+			if err != nil {
+				return "", "", err
+			}
+			if mask == "" {
+				return fmt.Sprintf("%s set %s", key, mark), "", nil
+			}
+			maskVal, _ := strconv.ParseUint(mask, 0, 32)
+			return fmt.Sprintf("%s set %s and 0x%x or %s", key, key,
+				^uint32(maskVal), mark), "", nil
+		}
+		return "", "", fmt.Errorf("unsupported %s options: %v", rule.Target, opts)
+	case "DSCP":
+		dscp := optValue("--set-dscp")
+		if dscp == "" {
+			return "", "", fmt.Errorf("unsupported DSCP options: %v", opts)
+		}
+		return fmt.Sprintf("%s dscp set %s", family, dscp), "", nil
+	case "NFQUEUE":
+		num := optValue("--queue-num")
+		if num == "" {
+			num = "0"
+		}
+		return "queue num " + num, "", nil
+	}
+	jump = ChainName(rule.Table, rule.Target)
+	return fmt.Sprintf("jump \"%s\"", jump), jump, nil
+}
+
+// translatePorts translates iptables port or port range (or comma-separated
+// list of those) into nftables syntax.
+func translatePorts(ports string) string {
+	var values []string
+	for _, port := range strings.Split(ports, ",") {
+		bounds := strings.Split(port, ":")
+		for i := range bounds {
+			if number, known := serviceNames[bounds[i]]; known {
+				bounds[i] = number
+			}
+		}
+		values = append(values, strings.Join(bounds, "-"))
+	}
+	if len(values) == 1 {
+		return values[0]
+	}
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+// translateIfName translates iptables interface name, which may end with "+"
+// as a wildcard, into nftables syntax.
+func translateIfName(ifName string) string {
+	if strings.HasSuffix(ifName, "+") {
+		return strings.TrimSuffix(ifName, "+") + "*"
+	}
+	return ifName
+}
+
+// parseMark parses iptables mark, optionally with mask ("value[/mask]").
+// Returned values are in hexadecimal format.
+func parseMark(value string) (mark, mask string, err error) {
+	parts := strings.SplitN(value, "/", 2)
+	markVal, err := strconv.ParseUint(parts[0], 0, 32)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid mark %s: %w", value, err)
+	}
+	mark = fmt.Sprintf("0x%x", markVal)
+	if len(parts) == 2 {
+		maskVal, err := strconv.ParseUint(parts[1], 0, 32)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid mark mask %s: %w", value, err)
+		}
+		mask = fmt.Sprintf("0x%x", maskVal)
+	}
+	return mark, mask, nil
+}
+
+// translateLimitRate translates iptables limit rate (e.g. "4/s" or "10/minute")
+// into nftables syntax.
+func translateLimitRate(rate string) (string, error) {
+	parts := strings.SplitN(rate, "/", 2)
+	if _, err := strconv.ParseUint(parts[0], 10, 32); err != nil {
+		return "", fmt.Errorf("invalid limit rate %s: %w", rate, err)
+	}
+	unit := "second"
+	if len(parts) == 2 && parts[1] != "" {
+		switch parts[1][0] {
+		case 's':
+			unit = "second"
+		case 'm':
+			unit = "minute"
+		case 'h':
+			unit = "hour"
+		case 'd':
+			unit = "day"
+		default:
+			return "", fmt.Errorf("invalid limit rate unit: %s", rate)
+		}
+	}
+	return parts[0] + "/" + unit, nil
+}
+
+// translateLogLevel translates numeric syslog level into the nftables level name.
+func translateLogLevel(level string) string {
+	levels := []string{"emerg", "alert", "crit", "err", "warn", "notice", "info", "debug"}
+	if n, err := strconv.Atoi(level); err == nil && n >= 0 && n < len(levels) {
+		return levels[n]
+	}
+	return level
+}
+
+// translateNATAddr translates NAT address (with port if withPort is true)
+// into nftables syntax. IPv6 address with port is enclosed in square brackets.
+func translateNATAddr(addr string, family Family, withPort bool) string {
+	if family != FamilyIPv6 || strings.HasPrefix(addr, "[") || !withPort {
+		return addr
+	}
+	sep := strings.LastIndex(addr, ":")
+	if sep < 0 {
+		return addr
+	}
+	host, port := addr[:sep], addr[sep+1:]
+	if net.ParseIP(host) == nil {
+		return addr
+	}
+	return "[" + host + "]:" + port
+}
+
+func equalStrings(list1, list2 []string) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i] != list2[i] {
+			return false
+		}
+	}
+	return true
+}
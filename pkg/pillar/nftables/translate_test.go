@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+)
+
+func TestTranslateRule(t *testing.T) {
+	tests := []struct {
+		rule iptables.Rule
+		expr string
+		jump string
+		sets []string
+	}{
+		{
+			rule: iptables.Rule{
+				RuleLabel:  "Port 8080",
+				Table:      "filter",
+				ChainName:  "INPUT-device",
+				MatchOpts:  []string{"-p", "tcp", "--dport", "8080"},
+				Target:     "REJECT",
+				TargetOpts: []string{"--reject-with", "tcp-reset"},
+			},
+			expr: "meta l4proto tcp th dport 8080 counter reject with tcp reset",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "SSH and Guacamole mark",
+				Table:     "mangle",
+				ChainName: "PREROUTING-device",
+				MatchOpts: []string{"-p", "tcp", "--match", "multiport", "--dports",
+					"22,4822"},
+				Target:     "CONNMARK",
+				TargetOpts: []string{"--set-mark", "1"},
+			},
+			expr: "meta l4proto tcp th dport { 22, 4822 } counter ct mark set 0x1",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "DHCP",
+				Table:     "filter",
+				ChainName: "FORWARD-nbu1x1",
+				MatchOpts: []string{"-p", "udp", "--dport", "bootps:bootpc"},
+				Target:    "ACCEPT",
+			},
+			expr: "meta l4proto udp th dport 67-68 counter accept",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "Accept marked",
+				Table:     "mangle",
+				ChainName: "drop-ingress",
+				ForIPv6:   true,
+				MatchOpts: []string{"-m", "mark", "!", "--mark", "0"},
+				Target:    "ACCEPT",
+			},
+			expr: "meta mark != 0x0 counter accept",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel:  "Set DSCP for VIF nbu1x1 egress",
+				Table:      "mangle",
+				ChainName:  "PREROUTING-nbu1x1",
+				ForIPv6:    true,
+				MatchOpts:  []string{"-i", "bn1"},
+				Target:     "DSCP",
+				TargetOpts: []string{"--set-dscp", "46"},
+			},
+			expr: "iifname \"bn1\" counter ip6 dscp set 46",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "Drop blackholed traffic",
+				Table:     "mangle",
+				ChainName: "POSTROUTING-apps",
+				MatchOpts: []string{"--match", "connmark", "--mark", "8388608/8388608",
+					"!", "-o", "blackhole"},
+				Target: "DROP",
+			},
+			expr: "ct mark and 0x800000 0x800000 oifname != \"blackhole\" counter drop",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "User-configured ACL rule 2",
+				Table:     "raw",
+				ChainName: "PREROUTING-nbu1x1",
+				MatchOpts: []string{"-m", "set", "--match-set", "ipv4.my-host.com",
+					"dst", "-p", "icmp", "-m", "limit", "--limit", "4/s",
+					"--limit-burst", "8"},
+				Target: "ACCEPT",
+			},
+			expr: "ip daddr @ipv4.my_host.com meta l4proto icmp " +
+				"limit rate 4/second burst 8 packets counter accept",
+			sets: []string{"ipv4.my_host.com"},
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel:  "Port map",
+				Table:      "nat",
+				ChainName:  "PREROUTING-nbu1x1",
+				ForIPv6:    true,
+				MatchOpts:  []string{"-i", "eth0", "-p", "tcp", "-d", "fd00::1", "--dport", "8080"},
+				Target:     "DNAT",
+				TargetOpts: []string{"--to-destination", "fd01::5:80"},
+			},
+			expr: "iifname \"eth0\" meta l4proto tcp ip6 daddr fd00::1 th dport 8080 " +
+				"counter dnat to [fd01::5]:80",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel:  "Log",
+				Table:      "filter",
+				ChainName:  "FORWARD-nbu1x1",
+				Target:     "LOG",
+				TargetOpts: []string{"--log-prefix", "FORWARD:TO:", "--log-level", "3"},
+			},
+			expr: "counter log prefix \"FORWARD:TO:\" level err",
+		},
+		{
+			rule: iptables.Rule{
+				RuleLabel: "Traverse VIF",
+				Table:     "filter",
+				ChainName: "FORWARD-apps",
+				MatchOpts: []string{"-o", "bn1"},
+				Target:    "FORWARD-nbu1x1",
+			},
+			expr: "oifname \"bn1\" counter jump \"filter-FORWARD-nbu1x1\"",
+			jump: "filter-FORWARD-nbu1x1",
+		},
+	}
+	for _, test := range tests {
+		spec := TranslateRule(test.rule)
+		if spec.Err != "" {
+			t.Errorf("rule %s: unexpected error: %s", test.rule.RuleLabel, spec.Err)
+			continue
+		}
+		if spec.Expr != test.expr {
+			t.Errorf("rule %s: expected expression %q, got %q",
+				test.rule.RuleLabel, test.expr, spec.Expr)
+		}
+		if spec.Jump != test.jump {
+			t.Errorf("rule %s: expected jump %q, got %q",
+				test.rule.RuleLabel, test.jump, spec.Jump)
+		}
+		if !equalStrings(spec.Sets, test.sets) {
+			t.Errorf("rule %s: expected sets %v, got %v",
+				test.rule.RuleLabel, test.sets, spec.Sets)
+		}
+	}
+}
+
+func TestTranslatePhysdev(t *testing.T) {
+	ingress := TranslateRule(iptables.Rule{
+		RuleLabel: "Traverse VIF nbu1x1 ingress",
+		Table:     "raw",
+		ChainName: "PREROUTING-apps",
+		MatchOpts: []string{"-i", "bn1", "-m", "physdev", "--physdev-in", "nbu1x1+"},
+		Target:    "PREROUTING-nbu1x1",
+	})
+	if ingress.PortTag == nil || !ingress.PortTag.Ingress ||
+		ingress.PortTag.IfName != "nbu1x1*" {
+		t.Fatalf("unexpected port tag: %+v", ingress.PortTag)
+	}
+	_, aceID, _ := iptables.ParseConnmark(ingress.PortTag.Mark)
+	if aceID < iptables.BridgePortTagAceIDBase {
+		t.Errorf("unexpected port tag mark: %#x", ingress.PortTag.Mark)
+	}
+	expExpr := fmt.Sprintf("iifname \"bn1\" meta mark 0x%x meta mark set 0x0 counter "+
+		"jump \"raw-PREROUTING-nbu1x1\"", ingress.PortTag.Mark)
+	if ingress.Expr != expExpr {
+		t.Errorf("expected expression %q, got %q", expExpr, ingress.Expr)
+	}
+	egress := TranslateRule(iptables.Rule{
+		RuleLabel: "Traverse VIF nbu1x1 egress",
+		Table:     "filter",
+		ChainName: "FORWARD-apps",
+		MatchOpts: []string{"-o", "bn1", "-m", "physdev", "--physdev-out", "nbu1x1+"},
+		Target:    "FORWARD-nbu1x1",
+	})
+	if egress.PortTag == nil || egress.PortTag.Ingress ||
+		egress.PortTag.Mark == ingress.PortTag.Mark {
+		t.Fatalf("unexpected port tag: %+v", egress.PortTag)
+	}
+	// Tag allocation is stable.
+	again := TranslateRule(iptables.Rule{
+		RuleLabel: "Block metadata server",
+		Table:     "filter",
+		ChainName: "INPUT-apps",
+		MatchOpts: []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+		Target:    "DROP",
+	})
+	if again.PortTag == nil || again.PortTag.Mark != ingress.PortTag.Mark {
+		t.Errorf("expected the same port tag, got: %+v", again.PortTag)
+	}
+}
+
+func TestTranslateRuleError(t *testing.T) {
+	spec := TranslateRule(iptables.Rule{
+		RuleLabel: "Unsupported",
+		Table:     "filter",
+		ChainName: "INPUT-device",
+		MatchOpts: []string{"-m", "state", "--state", "NEW"},
+		Target:    "ACCEPT",
+	})
+	if spec.Err == "" {
+		t.Errorf("expected translation error for rule: %+v", spec)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/lf-edge/eve/libs/reconciler"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
 	"github.com/sirupsen/logrus"
 )
@@ -104,6 +105,15 @@ type DNSServer struct {
 	// StaticEntries : list of hostname->IP entries statically configured
 	// for the DNS server.
 	StaticEntries []HostnameToIP
+	// LocalDomain : domain served only from StaticEntries, i.e. queries for names
+	// from this domain are never forwarded to upstream servers.
+	// Optional argument, leave empty to disable.
+	LocalDomain string
+	// Forwarders : per-domain upstream servers (split DNS).
+	// These take precedence over UpstreamServers.
+	Forwarders []types.DNSForwarder
+	// Filter : filtering of DNS queries (blocked queries are answered with NXDOMAIN).
+	Filter types.DNSFilter
 	// LinuxIPSets : netfilter ipsets which dnsmasq will automatically fill with
 	// resolved IPs.
 	// Feature specific to Linux network stack. In zedrouter used for ACLs with hostnames.
@@ -115,8 +125,10 @@ type DNSServer struct {
 // String describes DNSServer config.
 func (d DNSServer) String() string {
 	return fmt.Sprintf("DNSServer: {listenIP: %s, uplinkIf: %s, upstreamServers: %v, "+
-		"staticEntries: %v, linuxIPSets: %v}",
-		d.ListenIP, d.UplinkIf.IfName, d.UpstreamServers, d.StaticEntries, d.LinuxIPSets)
+		"staticEntries: %v, localDomain: %s, forwarders: %+v, filter: %+v, "+
+		"linuxIPSets: %v}",
+		d.ListenIP, d.UplinkIf.IfName, d.UpstreamServers, d.StaticEntries,
+		d.LocalDomain, d.Forwarders, d.Filter, d.LinuxIPSets)
 }
 
 // Equal compares two DNSServer instances
@@ -124,6 +136,10 @@ func (d DNSServer) Equal(d2 DNSServer, withStaticEntries bool) bool {
 	return utils.EqualIPs(d.ListenIP, d2.ListenIP) &&
 		d.UplinkIf == d2.UplinkIf &&
 		utils.EqualSetsFn(d.UpstreamServers, d2.UpstreamServers, utils.EqualIPs) &&
+		d.LocalDomain == d2.LocalDomain &&
+		utils.EqualSetsFn(d.Forwarders, d2.Forwarders, equalDNSForwarder) &&
+		d.Filter.Mode == d2.Filter.Mode &&
+		utils.EqualSets(d.Filter.Domains, d2.Filter.Domains) &&
 		utils.EqualSetsFn(d.LinuxIPSets, d2.LinuxIPSets, equalLinuxIPSet) &&
 		(!withStaticEntries ||
 			utils.EqualSetsFn(d.StaticEntries, d2.StaticEntries, equalHostnameToIP))
@@ -161,6 +177,11 @@ func equalHostnameToIP(a, b HostnameToIP) bool {
 		utils.EqualIPs(a.IP, b.IP)
 }
 
+func equalDNSForwarder(a, b types.DNSForwarder) bool {
+	return a.Domain == b.Domain &&
+		utils.EqualSetsFn(a.Servers, b.Servers, utils.EqualIPs)
+}
+
 // LinuxIPSet : see https://www.netfilter.org/projects/ipset/index.html
 type LinuxIPSet struct {
 	// Domains : list of domains whose resolved IPs will be added to Sets.
@@ -473,6 +494,10 @@ func (c *DnsmasqConfigurator) CreateDnsmasqConfig(buffer io.Writer, dnsmasq Dnsm
 		}
 	}
 
+	if err := c.writeDNSRoutingConfig(buffer, dnsmasq.DNSServer); err != nil {
+		return writeErr(err)
+	}
+
 	for _, ipset := range dnsmasq.DNSServer.LinuxIPSets {
 		if _, err := io.WriteString(buffer,
 			fmt.Sprintf("ipset=/%s/%s\n",
@@ -630,6 +655,70 @@ func (c *DnsmasqConfigurator) CreateDnsmasqConfig(buffer io.Writer, dnsmasq Dnsm
 	return nil
 }
 
+// writeDNSRoutingConfig writes config lines for the local domain, split DNS
+// and DNS filtering.
+func (c *DnsmasqConfigurator) writeDNSRoutingConfig(buffer io.Writer,
+	dnsServer DNSServer) error {
+	uplink := dnsServer.UplinkIf.IfName
+	serverAddr := func(server net.IP) string {
+		if uplink == "" {
+			return server.String()
+		}
+		return fmt.Sprintf("%s@%s", server, uplink)
+	}
+	if dnsServer.LocalDomain != "" {
+		if _, err := io.WriteString(buffer,
+			fmt.Sprintf("local=/%s/\n", dnsServer.LocalDomain)); err != nil {
+			return err
+		}
+	}
+	for _, forwarder := range dnsServer.Forwarders {
+		for _, server := range forwarder.Servers {
+			if _, err := io.WriteString(buffer, fmt.Sprintf("server=/%s/%s\n",
+				forwarder.Domain, serverAddr(server))); err != nil {
+				return err
+			}
+		}
+	}
+	switch dnsServer.Filter.Mode {
+	case types.DNSFilterModeBlocklist:
+		// Address without IP is answered with NXDOMAIN.
+		for _, domain := range dnsServer.Filter.Domains {
+			if _, err := io.WriteString(buffer,
+				fmt.Sprintf("address=/%s/\n", domain)); err != nil {
+				return err
+			}
+		}
+	case types.DNSFilterModeAllowlist:
+		// Answer every query with NXDOMAIN unless a more specific server
+		// is configured for the queried domain.
+		if _, err := io.WriteString(buffer, "address=/#/\n"); err != nil {
+			return err
+		}
+		if uplink == "" {
+			// No upstream servers to forward allowed queries to.
+			break
+		}
+		for _, domain := range dnsServer.Filter.Domains {
+			if len(dnsServer.UpstreamServers) == 0 {
+				// "#" stands for servers from /etc/resolv.conf
+				if _, err := io.WriteString(buffer,
+					fmt.Sprintf("server=/%s/#\n", domain)); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, server := range dnsServer.UpstreamServers {
+				if _, err := io.WriteString(buffer, fmt.Sprintf("server=/%s/%s\n",
+					domain, serverAddr(server))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // CreateDHCPv4RangeConfig prepares a DHCPv4 range config line.
 // The method is exported just to be exercised by unit tests.
 func (c *DnsmasqConfigurator) CreateDHCPv4RangeConfig(start, end net.IP) (string, error) {
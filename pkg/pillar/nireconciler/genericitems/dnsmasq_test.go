@@ -11,6 +11,7 @@ import (
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -192,6 +193,78 @@ func TestCreateDnsmasqConfigWithDisabledAllOnesNetmask(t *testing.T) {
 	}
 }
 
+func TestCreateDnsmasqConfigWithSplitDNSAndBlocklist(t *testing.T) {
+	t.Parallel()
+
+	dnsmasq := exampleDnsmasqParams()
+	dnsmasq.DNSServer.LocalDomain = "edge.local"
+	dnsmasq.DNSServer.Forwarders = []types.DNSForwarder{
+		{
+			Domain:  "corp.example.com",
+			Servers: []net.IP{{10, 10, 0, 53}, {10, 10, 1, 53}},
+		},
+	}
+	dnsmasq.DNSServer.Filter = types.DNSFilter{
+		Mode:    types.DNSFilterModeBlocklist,
+		Domains: []string{"ads.example.net", "tracker.example.org"},
+	}
+	config := createDnsmasqConfig(dnsmasq)
+
+	for _, rex := range []string{
+		"(?m)^local=/edge.local/$",
+		"(?m)^server=/corp.example.com/10.10.0.53@eth0$",
+		"(?m)^server=/corp.example.com/10.10.1.53@eth0$",
+		"(?m)^address=/ads.example.net/$",
+		"(?m)^address=/tracker.example.org/$",
+	} {
+		ok, err := regexp.MatchString(rex, config)
+		if err != nil {
+			panic(err)
+		}
+		if !ok {
+			t.Fatalf("expected to match '%s', but got '%s'", rex, config)
+		}
+	}
+}
+
+func TestCreateDnsmasqConfigWithAllowlist(t *testing.T) {
+	t.Parallel()
+
+	dnsmasq := exampleDnsmasqParams()
+	dnsmasq.DNSServer.UpstreamServers = []net.IP{{1, 1, 1, 1}}
+	dnsmasq.DNSServer.Filter = types.DNSFilter{
+		Mode:    types.DNSFilterModeAllowlist,
+		Domains: []string{"example.com"},
+	}
+	config := createDnsmasqConfig(dnsmasq)
+
+	for _, rex := range []string{
+		"(?m)^address=/#/$",
+		"(?m)^server=/example.com/1.1.1.1@eth0$",
+	} {
+		ok, err := regexp.MatchString(rex, config)
+		if err != nil {
+			panic(err)
+		}
+		if !ok {
+			t.Fatalf("expected to match '%s', but got '%s'", rex, config)
+		}
+	}
+
+	// Without explicit upstream servers, allowed queries are resolved
+	// using servers from resolv.conf.
+	dnsmasq.DNSServer.UpstreamServers = nil
+	config = createDnsmasqConfig(dnsmasq)
+	rex := "(?m)^server=/example.com/#$"
+	ok, err := regexp.MatchString(rex, config)
+	if err != nil {
+		panic(err)
+	}
+	if !ok {
+		t.Fatalf("expected to match '%s', but got '%s'", rex, config)
+	}
+}
+
 func TestRunDnsmasqInvalidDhcpRange(t *testing.T) {
 	t.Parallel()
 	line, err := configurator.CreateDHCPv4RangeConfig(nil, nil)
@@ -0,0 +1,312 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package genericitems
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Hostapd : user space daemon for WiFi access points (https://w1.fi/hostapd/).
+// It puts the WiFi interface into the access point mode and bridges it into
+// the network instance.
+type Hostapd struct {
+	// InstanceName : logical name for the hostapd instance.
+	InstanceName string
+	// WlanIfName : name of the WiFi interface operated in the access point mode.
+	WlanIfName string
+	// BridgeIf : bridge into which hostapd should put the WiFi interface.
+	BridgeIf NetworkIf
+	// AccessPoint : access point configuration.
+	AccessPoint WifiAccessPoint
+}
+
+// WifiAccessPoint : configuration for WiFi access point.
+type WifiAccessPoint struct {
+	SSID       string
+	HiddenSSID bool
+	Security   types.WifiAPSecurity
+	// Passphrase : (decrypted) pre-shared key for WPA2 or password for WPA3.
+	Passphrase  string
+	Band        types.WifiBand
+	Channel     uint16
+	CountryCode string
+	MaxClients  uint32
+}
+
+// String describes WifiAccessPoint without revealing the passphrase.
+func (ap WifiAccessPoint) String() string {
+	return fmt.Sprintf("{SSID: %s, hidden: %t, security: %s, band: %d, "+
+		"channel: %d, countryCode: %s, maxClients: %d}", ap.SSID, ap.HiddenSSID,
+		ap.Security, ap.Band, ap.Channel, ap.CountryCode, ap.MaxClients)
+}
+
+// Name returns the logical label assigned to the hostapd instance.
+func (h Hostapd) Name() string {
+	return h.InstanceName
+}
+
+// Label for the hostapd instance.
+func (h Hostapd) Label() string {
+	return h.InstanceName + " (hostapd)"
+}
+
+// Type of the item.
+func (h Hostapd) Type() string {
+	return HostapdTypename
+}
+
+// Equal compares two Hostapd instances
+func (h Hostapd) Equal(other dg.Item) bool {
+	h2 := other.(Hostapd)
+	return h.InstanceName == h2.InstanceName &&
+		h.WlanIfName == h2.WlanIfName &&
+		h.BridgeIf == h2.BridgeIf &&
+		h.AccessPoint == h2.AccessPoint
+}
+
+// External returns false.
+func (h Hostapd) External() bool {
+	return false
+}
+
+// String describes the hostapd instance.
+func (h Hostapd) String() string {
+	return fmt.Sprintf("Hostapd: {instanceName: %s, wlanIfName: %s, "+
+		"bridgeIf: %s, accessPoint: %v}", h.InstanceName, h.WlanIfName,
+		h.BridgeIf.IfName, h.AccessPoint)
+}
+
+// Dependencies returns the bridge into which the WiFi interface is put
+// as the only dependency.
+func (h Hostapd) Dependencies() (deps []dg.Dependency) {
+	deps = append(deps, dg.Dependency{
+		RequiredItem: h.BridgeIf.ItemRef,
+		Description:  "bridge for WiFi access point must exist",
+	})
+	return deps
+}
+
+const (
+	hostapdStartTimeout = 10 * time.Second
+	hostapdStopTimeout  = 10 * time.Second
+	// Default channels used when not specified by the user.
+	hostapdDefaultChannel2GHz = 6
+	hostapdDefaultChannel5GHz = 36
+)
+
+// HostapdConfigurator implements Configurator interface (libs/reconciler) for hostapd.
+type HostapdConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create starts hostapd.
+func (c *HostapdConfigurator) Create(ctx context.Context, item dg.Item) error {
+	hostapd, isHostapd := item.(Hostapd)
+	if !isHostapd {
+		return fmt.Errorf("invalid item type %T, expected Hostapd", item)
+	}
+	if err := c.createHostapdConfigFile(hostapd); err != nil {
+		return err
+	}
+	done := reconciler.ContinueInBackground(ctx)
+	go func() {
+		err := c.startHostapd(ctx, hostapd.InstanceName)
+		done(err)
+	}()
+	return nil
+}
+
+// Modify is not implemented.
+func (c *HostapdConfigurator) Modify(ctx context.Context, oldItem, newItem dg.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete stops hostapd.
+func (c *HostapdConfigurator) Delete(ctx context.Context, item dg.Item) error {
+	hostapd, isHostapd := item.(Hostapd)
+	if !isHostapd {
+		return fmt.Errorf("invalid item type %T, expected Hostapd", item)
+	}
+	done := reconciler.ContinueInBackground(ctx)
+	go func() {
+		err := c.stopHostapd(ctx, hostapd.InstanceName)
+		if err == nil {
+			// Ignore errors from here.
+			_ = c.removeHostapdConfigFile(hostapd.InstanceName)
+			_ = c.removeHostapdPidFile(hostapd.InstanceName)
+		}
+		done(err)
+	}()
+	return nil
+}
+
+// NeedsRecreate always returns true - Modify is not implemented.
+func (c *HostapdConfigurator) NeedsRecreate(oldItem, newItem dg.Item) (recreate bool) {
+	return true
+}
+
+func (c *HostapdConfigurator) hostapdConfigPath(instanceName string) string {
+	return filepath.Join(zedrouterRunDir, "hostapd."+instanceName+".conf")
+}
+
+func (c *HostapdConfigurator) hostapdPidFile(instanceName string) string {
+	return filepath.Join(zedrouterRunDir, "hostapd."+instanceName+".pid")
+}
+
+func (c *HostapdConfigurator) createHostapdConfigFile(hostapd Hostapd) error {
+	cfgPath := c.hostapdConfigPath(hostapd.InstanceName)
+	// Config file contains the passphrase - make it readable only by root.
+	file, err := os.OpenFile(cfgPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		err = fmt.Errorf("failed to create hostapd config file %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	defer file.Close()
+	return c.CreateHostapdConfig(file, hostapd)
+}
+
+// CreateHostapdConfig builds configuration for hostapd and writes it to the given buffer.
+// The method is exported just to be exercised by unit tests.
+func (c *HostapdConfigurator) CreateHostapdConfig(buffer io.Writer, hostapd Hostapd) error {
+	ap := hostapd.AccessPoint
+	if ap.SSID == "" {
+		err := errors.New("missing SSID for WiFi access point")
+		c.Log.Error(err)
+		return err
+	}
+	// Passphrase is written into the config file as is, i.e. without quotes.
+	// WPA2-PSK (incl. the WPA2/WPA3 transition mode) requires 8 to 63 printable
+	// ASCII characters. SAE password does not have such limits.
+	if ap.Security == types.WifiAPSecurityWPA3SAE {
+		if ap.Passphrase == "" {
+			err := fmt.Errorf("missing password for WiFi access point %s", ap.SSID)
+			c.Log.Error(err)
+			return err
+		}
+	} else if len(ap.Passphrase) < 8 || len(ap.Passphrase) > 63 {
+		err := fmt.Errorf("invalid passphrase length (%d) for WiFi access point %s, "+
+			"expected 8..63 characters", len(ap.Passphrase), ap.SSID)
+		c.Log.Error(err)
+		return err
+	}
+	if strings.ContainsAny(ap.Passphrase, "\r\n") {
+		err := fmt.Errorf("invalid passphrase for WiFi access point %s", ap.SSID)
+		c.Log.Error(err)
+		return err
+	}
+	var sb strings.Builder
+	sb.WriteString("# Automatically generated by zedrouter\n")
+	sb.WriteString(fmt.Sprintf("interface=%s\n", hostapd.WlanIfName))
+	sb.WriteString(fmt.Sprintf("bridge=%s\n", hostapd.BridgeIf.IfName))
+	sb.WriteString("driver=nl80211\n")
+	// Control interface is used by zedrouter to obtain the list of associated clients.
+	sb.WriteString(fmt.Sprintf("ctrl_interface=%s\n", devicenetwork.HostapdCtrlDir))
+	sb.WriteString("ctrl_interface_group=0\n")
+	// SSID is hex-encoded to avoid any escaping issues.
+	sb.WriteString(fmt.Sprintf("ssid2=%s\n", hex.EncodeToString([]byte(ap.SSID))))
+	sb.WriteString("utf8_ssid=1\n")
+	if ap.HiddenSSID {
+		sb.WriteString("ignore_broadcast_ssid=1\n")
+	}
+	if ap.CountryCode != "" {
+		sb.WriteString(fmt.Sprintf("country_code=%s\n", strings.ToUpper(ap.CountryCode)))
+		sb.WriteString("ieee80211d=1\n")
+	}
+	channel := ap.Channel
+	switch ap.Band {
+	case types.WifiBand5GHz:
+		if channel == 0 {
+			channel = hostapdDefaultChannel5GHz
+		}
+		sb.WriteString("hw_mode=a\n")
+		sb.WriteString("ieee80211ac=1\n")
+	default:
+		if channel == 0 {
+			channel = hostapdDefaultChannel2GHz
+		}
+		sb.WriteString("hw_mode=g\n")
+	}
+	sb.WriteString(fmt.Sprintf("channel=%d\n", channel))
+	sb.WriteString("ieee80211n=1\n")
+	sb.WriteString("wmm_enabled=1\n")
+	if ap.MaxClients != 0 {
+		sb.WriteString(fmt.Sprintf("max_num_sta=%d\n", ap.MaxClients))
+	}
+	sb.WriteString("auth_algs=1\n")
+	sb.WriteString("wpa=2\n")
+	sb.WriteString("rsn_pairwise=CCMP\n")
+	switch ap.Security {
+	case types.WifiAPSecurityWPA3SAE:
+		sb.WriteString("wpa_key_mgmt=SAE\n")
+		// Management frame protection is mandatory with WPA3.
+		sb.WriteString("ieee80211w=2\n")
+		sb.WriteString(fmt.Sprintf("sae_password=%s\n", ap.Passphrase))
+	case types.WifiAPSecurityWPA2WPA3:
+		sb.WriteString("wpa_key_mgmt=WPA-PSK SAE\n")
+		sb.WriteString("ieee80211w=1\n")
+		sb.WriteString(fmt.Sprintf("wpa_passphrase=%s\n", ap.Passphrase))
+		sb.WriteString(fmt.Sprintf("sae_password=%s\n", ap.Passphrase))
+	default:
+		sb.WriteString("wpa_key_mgmt=WPA-PSK\n")
+		sb.WriteString(fmt.Sprintf("wpa_passphrase=%s\n", ap.Passphrase))
+	}
+	if _, err := io.WriteString(buffer, sb.String()); err != nil {
+		err = fmt.Errorf("failed to write hostapd config: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Start hostapd as a daemon process.
+func (c *HostapdConfigurator) startHostapd(ctx context.Context, instanceName string) error {
+	cmd := "hostapd"
+	pidFile := c.hostapdPidFile(instanceName)
+	args := []string{
+		"-B",
+		"-P", pidFile,
+		c.hostapdConfigPath(instanceName),
+	}
+	return startProcess(ctx, c.Log, cmd, args, pidFile, hostapdStartTimeout, true)
+}
+
+func (c *HostapdConfigurator) stopHostapd(ctx context.Context, instanceName string) error {
+	pidFile := c.hostapdPidFile(instanceName)
+	return stopProcess(ctx, c.Log, pidFile, hostapdStopTimeout)
+}
+
+func (c *HostapdConfigurator) removeHostapdConfigFile(instanceName string) error {
+	cfgPath := c.hostapdConfigPath(instanceName)
+	if err := os.Remove(cfgPath); err != nil {
+		err = fmt.Errorf("failed to remove hostapd config %s: %w", cfgPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *HostapdConfigurator) removeHostapdPidFile(instanceName string) error {
+	pidPath := c.hostapdPidFile(instanceName)
+	if err := os.Remove(pidPath); err != nil {
+		err = fmt.Errorf("failed to remove hostapd PID file %s: %w", pidPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package genericitems_test
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func exampleHostapdParams() genericitems.Hostapd {
+	var hostapd genericitems.Hostapd
+	hostapd.InstanceName = "bn1"
+	hostapd.WlanIfName = "wlan0"
+	hostapd.BridgeIf.IfName = "bn1"
+	hostapd.AccessPoint = genericitems.WifiAccessPoint{
+		SSID:        "Kiosk",
+		Security:    types.WifiAPSecurityWPA2PSK,
+		Passphrase:  "secret-passphrase",
+		CountryCode: "de",
+		MaxClients:  10,
+	}
+	return hostapd
+}
+
+func createHostapdConfig(hostapd genericitems.Hostapd) (string, error) {
+	var buf bytes.Buffer
+	configurator := &genericitems.HostapdConfigurator{Log: log}
+	err := configurator.CreateHostapdConfig(&buf, hostapd)
+	return buf.String(), err
+}
+
+func TestCreateHostapdConfigWPA2(t *testing.T) {
+	t.Parallel()
+
+	hostapd := exampleHostapdParams()
+	config, err := createHostapdConfig(hostapd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configExpected := `# Automatically generated by zedrouter
+interface=wlan0
+bridge=bn1
+driver=nl80211
+ctrl_interface=/run/hostapd
+ctrl_interface_group=0
+ssid2=4b696f736b
+utf8_ssid=1
+country_code=DE
+ieee80211d=1
+hw_mode=g
+channel=6
+ieee80211n=1
+wmm_enabled=1
+max_num_sta=10
+auth_algs=1
+wpa=2
+rsn_pairwise=CCMP
+wpa_key_mgmt=WPA-PSK
+wpa_passphrase=secret-passphrase
+`
+	if configExpected != config {
+		t.Fatalf("expected '%s', but got '%s'", configExpected, config)
+	}
+}
+
+func TestCreateHostapdConfigWPA3(t *testing.T) {
+	t.Parallel()
+
+	hostapd := exampleHostapdParams()
+	hostapd.AccessPoint.Security = types.WifiAPSecurityWPA3SAE
+	hostapd.AccessPoint.Band = types.WifiBand5GHz
+	hostapd.AccessPoint.HiddenSSID = true
+	config, err := createHostapdConfig(hostapd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rex := range []string{
+		"(?m)^hw_mode=a$",
+		"(?m)^channel=36$",
+		"(?m)^ignore_broadcast_ssid=1$",
+		"(?m)^wpa_key_mgmt=SAE$",
+		"(?m)^ieee80211w=2$",
+		"(?m)^sae_password=secret-passphrase$",
+	} {
+		ok, err := regexp.MatchString(rex, config)
+		if err != nil {
+			panic(err)
+		}
+		if !ok {
+			t.Fatalf("expected to match '%s', but got '%s'", rex, config)
+		}
+	}
+	if regexp.MustCompile("(?m)^wpa_passphrase=").MatchString(config) {
+		t.Fatalf("unexpected WPA2 passphrase in WPA3-only config '%s'", config)
+	}
+}
+
+func TestCreateHostapdConfigInvalidPassphrase(t *testing.T) {
+	t.Parallel()
+
+	hostapd := exampleHostapdParams()
+	hostapd.AccessPoint.Passphrase = "short"
+	if _, err := createHostapdConfig(hostapd); err == nil {
+		t.Fatal("expected error for too short passphrase")
+	}
+	hostapd.AccessPoint.Passphrase = "secret\nwpa=0"
+	if _, err := createHostapdConfig(hostapd); err == nil {
+		t.Fatal("expected error for passphrase with newline")
+	}
+}
+
+func TestCreateHostapdConfigSAEPassword(t *testing.T) {
+	t.Parallel()
+
+	// SAE password is not limited to 8..63 characters.
+	hostapd := exampleHostapdParams()
+	hostapd.AccessPoint.Security = types.WifiAPSecurityWPA3SAE
+	for _, password := range []string{"short", strings.Repeat("a", 100)} {
+		hostapd.AccessPoint.Passphrase = password
+		config, err := createHostapdConfig(hostapd)
+		if err != nil {
+			t.Fatalf("unexpected error for SAE password of length %d: %v",
+				len(password), err)
+		}
+		if !strings.Contains(config, "sae_password="+password+"\n") {
+			t.Fatalf("missing SAE password in config '%s'", config)
+		}
+	}
+	hostapd.AccessPoint.Passphrase = ""
+	if _, err := createHostapdConfig(hostapd); err == nil {
+		t.Fatal("expected error for empty SAE password")
+	}
+
+	// Transition mode uses the same passphrase also for WPA2-PSK.
+	hostapd.AccessPoint.Security = types.WifiAPSecurityWPA2WPA3
+	hostapd.AccessPoint.Passphrase = "short"
+	if _, err := createHostapdConfig(hostapd); err == nil {
+		t.Fatal("expected error for too short passphrase in transition mode")
+	}
+}
@@ -20,6 +20,7 @@ func RegisterItems(log *base.LogObject, logger *logrus.Logger,
 		{c: &DnsmasqConfigurator{Log: log, Logger: logger}, t: DnsmasqTypename},
 		{c: &HTTPServerConfigurator{Log: log, Logger: logger}, t: HTTPServerTypename},
 		{c: &RadvdConfigurator{Log: log}, t: RadvdTypename},
+		{c: &HostapdConfigurator{Log: log}, t: HostapdTypename},
 	}
 	for _, configurator := range configurators {
 		err := registry.Register(configurator.c, configurator.t)
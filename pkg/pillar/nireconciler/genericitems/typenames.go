@@ -23,6 +23,8 @@ const (
 	DnsmasqTypename = "Dnsmasq"
 	// RadvdTypename : typename for radvd program (router advertisement daemon).
 	RadvdTypename = "Radvd"
+	// HostapdTypename : typename for hostapd program (WiFi access point daemon).
+	HostapdTypename = "Hostapd"
 	// IPSetTypename : typename for Linux IP set (from netfilter).
 	// Implemented in linuxitems.
 	// Type definition is here because it is referenced by dnsmasq
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
 	dg "github.com/lf-edge/eve/libs/depgraph"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/l7acl"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
 	uuid "github.com/satori/go.uuid"
@@ -46,6 +49,15 @@ type userACLRule struct {
 	drop bool
 	// ALLOW | DROP | LIMIT | PORTMAP
 	actionLabel string
+	// Non-nil if this is a host-based rule enforced at the layer 7 for TCP
+	// connections (see types.HostACLEnforcementL7).
+	l7HostRule *l7acl.HostRule
+	// iptables arguments to match egress TCP traffic (from app) subject
+	// to the layer-7 inspection (nil if l7HostRule is nil)
+	l7EgressMatch []string
+	// iptables arguments to match ingress TCP traffic (to app) subject
+	// to the layer-7 inspection (nil if l7HostRule is nil)
+	l7IngressMatch []string
 }
 
 // Port-forwarding ACL rule.
@@ -241,8 +253,10 @@ func getEssentialIPv6Protos(niType types.NetworkInstanceType,
 }
 
 // Return errors without LogAndErrPrefix - it is prepended inside callers.
+// Enable l7HostACLs to have host-based rules enforced at the layer 7
+// (for TCP traffic).
 func parseUserACLRule(log *base.LogObject, aclRule types.ACE,
-	niType types.NetworkInstanceType, vif vifInfo,
+	niType types.NetworkInstanceType, l7HostACLs bool, vif vifInfo,
 	forIPv6 bool) (parsedRule userACLRule, skip bool, err error) {
 	if len(aclRule.Actions) > 1 {
 		return parsedRule, true, fmt.Errorf(
@@ -295,6 +309,7 @@ func parseUserACLRule(log *base.LogObject, aclRule types.ACE,
 	var (
 		ipWithPrefix *net.IPNet
 		ipsetName    string
+		hostname     string
 		protocol     string
 		lport        string
 		fport        string
@@ -341,6 +356,7 @@ func parseUserACLRule(log *base.LogObject, aclRule types.ACE,
 					"is not supported (%+v)", aclRule)
 				return parsedRule, true, err
 			}
+			hostname = match.Value
 			ipsetBasename := HostIPSetBasename(match.Value)
 			if forIPv6 {
 				ipsetName = ipsetNamePrefixV4 + ipsetBasename
@@ -428,6 +444,35 @@ func parseUserACLRule(log *base.LogObject, aclRule types.ACE,
 				ingressMatch...)
 		}
 	}
+	if hostname != "" && l7HostACLs && !parsedRule.isLimitRule &&
+		(protocol == "" || protocol == "tcp" || protocol == "6") {
+		// TCP connections matched by this rule are inspected at the layer 7.
+		// The ipset-based rule (below) remains in effect for other protocols
+		// (the layer-7 rule is applied first).
+		hostRule := &l7acl.HostRule{
+			RuleID: aclRule.RuleID,
+			Host:   hostname,
+			Drop:   parsedRule.drop,
+		}
+		if fport != "" {
+			hostRule.MinPort, hostRule.MaxPort, err = parsePortRange(fport)
+			if err != nil {
+				err = fmt.Errorf("ACL rule (%+v) with invalid fport: %w", aclRule, err)
+				return parsedRule, true, err
+			}
+		}
+		parsedRule.l7HostRule = hostRule
+		parsedRule.l7EgressMatch = []string{"-p", "tcp"}
+		parsedRule.l7IngressMatch = []string{"-p", "tcp"}
+		if fport != "" {
+			parsedRule.l7EgressMatch = append(parsedRule.l7EgressMatch, "--dport", fport)
+			parsedRule.l7IngressMatch = append(parsedRule.l7IngressMatch, "--sport", fport)
+		}
+		if lport != "" {
+			parsedRule.l7EgressMatch = append(parsedRule.l7EgressMatch, "--sport", lport)
+			parsedRule.l7IngressMatch = append(parsedRule.l7IngressMatch, "--dport", lport)
+		}
+	}
 	if ipsetName != "" {
 		parsedRule.egressMatch = append(parsedRule.egressMatch,
 			"-m", "set", "--match-set", ipsetName, "dst")
@@ -525,9 +570,46 @@ func (r *LinuxNIReconciler) getIntendedAppConnACLs(niID uuid.UUID,
 			intendedAppConnACLs.PutItem(item, nil)
 		}
 	}
+	if l7ACL := r.getIntendedAppConnL7ACL(vif, ul); l7ACL != nil {
+		intendedAppConnACLs.PutItem(l7ACL, nil)
+	}
 	return intendedAppConnACLs
 }
 
+// Host-based ACL rules enforced at the layer 7 by l7acl.Inspector.
+func (r *LinuxNIReconciler) getIntendedAppConnL7ACL(vif vifInfo,
+	ul types.UnderlayNetworkConfig) dg.Item {
+	ni := r.nis[vif.NI]
+	app := r.apps[vif.App]
+	if !ni.l7HostACLs() || vif.GuestIP == nil {
+		return nil
+	}
+	var hostRules []l7acl.HostRule
+	for _, aclRule := range ul.ACLs {
+		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type,
+			true, vif, ni.config.IsIPv6())
+		if err != nil || skip {
+			// Error is already logged when generating iptables rules.
+			continue
+		}
+		if parsedRule.l7HostRule != nil {
+			hostRules = append(hostRules, *parsedRule.l7HostRule)
+		}
+	}
+	if len(hostRules) == 0 {
+		return nil
+	}
+	return linux.L7ACL{
+		VIFRules: l7acl.VIFRules{
+			HostIfName: vif.hostIfName,
+			AppNum:     uint8(app.appNum),
+			GuestIP:    vif.GuestIP,
+			Rules:      hostRules,
+		},
+		Inspector: r.l7Inspector,
+	}
+}
+
 // Table RAW, chain PREROUTING is used to:
 //   - LOG to-be-dropped traffic *coming out* from local NIs (dropped during routing phase)
 //   - Apply rate-limit ACL rules (DROP extra egress packets)
@@ -581,7 +663,8 @@ func (r *LinuxNIReconciler) getIntendedAppConnRawIptables(vif vifInfo,
 	}
 	// 3. User-configured ACL rules
 	for _, aclRule := range ul.ACLs {
-		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type, vif, ipv6)
+		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type,
+			ni.l7HostACLs(), vif, ipv6)
 		if err != nil {
 			r.log.Errorf("%s: parseUserACLRule failed: %v", LogAndErrPrefix, err)
 			continue
@@ -589,6 +672,15 @@ func (r *LinuxNIReconciler) getIntendedAppConnRawIptables(vif vifInfo,
 		if skip {
 			continue
 		}
+		if parsedRule.l7HostRule != nil {
+			// Allow or drop is decided by the layer-7 inspection.
+			aclRules = append(aclRules, iptables.Rule{
+				RuleLabel: fmt.Sprintf("User-configured %s ACL rule %d "+
+					"for L7 inspection", parsedRule.actionLabel, aclRule.RuleID),
+				MatchOpts: parsedRule.l7EgressMatch,
+				Target:    "ACCEPT",
+			})
+		}
 		iptablesRule := iptables.Rule{
 			RuleLabel: fmt.Sprintf("User-configured %s ACL rule %d",
 				parsedRule.actionLabel, aclRule.RuleID),
@@ -719,7 +811,8 @@ func (r *LinuxNIReconciler) getIntendedAppConnFilterIptables(vif vifInfo,
 	}
 	// 2. User-configured ACL rules
 	for _, aclRule := range ul.ACLs {
-		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type, vif, ipv6)
+		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type,
+			ni.l7HostACLs(), vif, ipv6)
 		if err != nil {
 			r.log.Errorf("%s: parseUserACLRule failed: %v", LogAndErrPrefix, err)
 			continue
@@ -727,6 +820,15 @@ func (r *LinuxNIReconciler) getIntendedAppConnFilterIptables(vif vifInfo,
 		if skip {
 			continue
 		}
+		if parsedRule.l7HostRule != nil {
+			// Allow or drop is decided by the layer-7 inspection.
+			aclRules = append(aclRules, iptables.Rule{
+				RuleLabel: fmt.Sprintf("User-configured %s ACL rule %d "+
+					"for L7 inspection", parsedRule.actionLabel, aclRule.RuleID),
+				MatchOpts: parsedRule.l7IngressMatch,
+				Target:    "ACCEPT",
+			})
+		}
 		iptablesRule := iptables.Rule{
 			RuleLabel: fmt.Sprintf("User-configured %s ACL rule %d",
 				parsedRule.actionLabel, aclRule.RuleID),
@@ -883,6 +985,7 @@ func (r *LinuxNIReconciler) getIntendedAppConnNATIptables(vif vifInfo,
 
 // Table MANGLE, chain PREROUTING is used to:
 //   - mark connections with the ID of the applied ACL rule
+//   - set DSCP of packets sent by the application (if enabled)
 func (r *LinuxNIReconciler) getIntendedAppConnMangleIptables(vif vifInfo,
 	ul types.UnderlayNetworkConfig, ipv6 bool, uplinkIPs []*net.IPNet) (items []dg.Item) {
 	ni := r.nis[vif.NI]
@@ -893,6 +996,9 @@ func (r *LinuxNIReconciler) getIntendedAppConnMangleIptables(vif vifInfo,
 	}
 	markChainPrefix := fmt.Sprintf("%s-%s-", ni.brIfName, vif.hostIfName)
 	addedMarkChains := make(map[string]struct{})
+	l7InspectChain := markChainPrefix + "l7"
+	l7InspectMark := iptables.GetConnmark(
+		uint8(app.appNum), iptables.L7InspectAceID, false)
 	var essentialProtos []essentialProto
 	if ipv6 {
 		essentialProtos = getEssentialIPv6Protos(ni.config.Type, bridgeIP)
@@ -942,6 +1048,10 @@ func (r *LinuxNIReconciler) getIntendedAppConnMangleIptables(vif vifInfo,
 		AppliedBefore: []string{ingressTraversal.RuleLabel},
 	}
 	items = append(items, ingressTraversal, egressTraversal)
+	if ul.QoS.MarkDSCP {
+		items = append(items, getDSCPMarkingRule(ni.brIfName, vif, ul.QoS.DSCP,
+			ipv6, egressTraversal.RuleLabel))
+	}
 
 	// 1. Add ingress ACL rules
 	// Matched by input interface and possibly also by dst IP address.
@@ -970,7 +1080,8 @@ func (r *LinuxNIReconciler) getIntendedAppConnMangleIptables(vif vifInfo,
 	}
 	// 1.2. User-configured ACL rules
 	for _, aclRule := range ul.ACLs {
-		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type, vif, ipv6)
+		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type,
+			ni.l7HostACLs(), vif, ipv6)
 		if err != nil {
 			r.log.Errorf("%s: parseUserACLRule failed: %v", LogAndErrPrefix, err)
 			continue
@@ -1069,7 +1180,8 @@ mangleEgress:
 	}
 	// 2.3. User-configured ACL rules
 	for _, aclRule := range ul.ACLs {
-		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type, vif, ipv6)
+		parsedRule, skip, err := parseUserACLRule(r.log, aclRule, ni.config.Type,
+			ni.l7HostACLs(), vif, ipv6)
 		if err != nil {
 			r.log.Errorf("%s: parseUserACLRule failed: %v", LogAndErrPrefix, err)
 			continue
@@ -1081,6 +1193,20 @@ mangleEgress:
 			// Initiated from outside hence marked by the ingress rule.
 			continue
 		}
+		if parsedRule.l7HostRule != nil {
+			// Mark TCP connection as pending the layer-7 inspection.
+			if _, alreadyAdded := addedMarkChains[l7InspectChain]; !alreadyAdded {
+				items = append(items,
+					getMarkingChainCfg(l7InspectChain, ipv6, markToString(l7InspectMark))...)
+				addedMarkChains[l7InspectChain] = struct{}{}
+			}
+			egressRules = append(egressRules, iptables.Rule{
+				RuleLabel: fmt.Sprintf("User-configured %s ACL rule %d "+
+					"for L7 inspection", parsedRule.actionLabel, aclRule.RuleID),
+				MatchOpts: parsedRule.l7EgressMatch,
+				Target:    l7InspectChain,
+			})
+		}
 		markChain := markChainPrefix + strconv.Itoa(int(aclRule.RuleID))
 		mark := iptables.GetConnmark(
 			uint8(app.appNum), uint32(aclRule.RuleID), parsedRule.drop)
@@ -1116,6 +1242,19 @@ mangleEgress:
 		egressRules = append(egressRules, defaultDropMark)
 	}
 
+	// 2.4. Packets of connections pending the layer-7 inspection are passed
+	// to l7acl.Inspector, which then replaces the connection mark.
+	if _, hasL7Rules := addedMarkChains[l7InspectChain]; hasL7Rules {
+		queueRule := iptables.Rule{
+			RuleLabel: "Queue connections pending L7 inspection",
+			MatchOpts: []string{"-m", "connmark", "--mark", markToString(l7InspectMark)},
+			Target:    "NFQUEUE",
+			TargetOpts: []string{"--queue-num",
+				strconv.Itoa(int(l7acl.QueueNum))},
+		}
+		egressRules = append([]iptables.Rule{queueRule}, egressRules...)
+	}
+
 	// Finally, put all rules together.
 	for i, rule := range ingressRules {
 		rule.ChainName = ingressVifChain("PREROUTING", vif)
@@ -1170,6 +1309,27 @@ func collectPortMapRules(acls []types.ACE) (portMapRules []portMap) {
 	return
 }
 
+// parsePortRange parses port number or range of ports in the iptables format
+// ("<min>:<max>").
+func parsePortRange(portRange string) (minPort, maxPort uint16, err error) {
+	minStr, maxStr, isRange := strings.Cut(portRange, ":")
+	if !isRange {
+		maxStr = minStr
+	}
+	minPort64, err := strconv.ParseUint(minStr, 10, 16)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxPort64, err := strconv.ParseUint(maxStr, 10, 16)
+	if err != nil {
+		return 0, 0, err
+	}
+	if minPort64 > maxPort64 {
+		return 0, 0, fmt.Errorf("invalid port range %s", portRange)
+	}
+	return uint16(minPort64), uint16(maxPort64), nil
+}
+
 func markToString(mark uint32) string {
 	return strconv.FormatUint(uint64(mark), 10)
 }
@@ -689,6 +689,9 @@ func (r *LinuxNIReconciler) getIntendedNIServices(niID uuid.UUID) dg.Graph {
 	for _, item := range r.getIntendedRadvdCfg(niID) {
 		intendedServices.PutItem(item, nil)
 	}
+	for _, item := range r.getIntendedHostapdCfg(niID) {
+		intendedServices.PutItem(item, nil)
+	}
 	return intendedServices
 }
 
@@ -795,12 +798,18 @@ func (r *LinuxNIReconciler) getIntendedDnsmasqCfg(niID uuid.UUID) (items []dg.It
 		ntpServers = append(ntpServers, ni.config.NtpServer)
 	}
 	ntpServers = utils.FilterDuplicatesFn(ntpServers, utils.EqualIPs)
+	// With WiFi access point, dynamic IP allocation is used for WiFi clients
+	// and it is limited to a sub-range not used for applications.
+	dhcpRange := ni.config.DhcpRange
+	if ni.config.WifiAPLogicalLabel != "" {
+		dhcpRange = ni.config.WifiAPClientIPRange()
+	}
 	dhcpCfg := generic.DHCPServer{
 		Subnet:         subnet,
 		AllOnesNetmask: !r.disableAllOnesNetmask,
 		IPRange: generic.IPRange{
-			FromIP: ni.config.DhcpRange.Start,
-			ToIP:   ni.config.DhcpRange.End,
+			FromIP: dhcpRange.Start,
+			ToIP:   dhcpRange.End,
 		},
 		GatewayIP:  gateway,
 		DomainName: ni.config.DomainName,
@@ -837,6 +846,9 @@ func (r *LinuxNIReconciler) getIntendedDnsmasqCfg(niID uuid.UUID) (items []dg.It
 		ListenIP:        listenIP,
 		UplinkIf:        uplinkIf,
 		UpstreamServers: ni.bridge.Uplink.DNSServers,
+		LocalDomain:     ni.config.DomainName,
+		Forwarders:      ni.config.DNSForwarders,
+		Filter:          ni.config.DNSFilter,
 	}
 	for _, staticEntry := range ni.config.DnsNameToIPList {
 		for _, ip := range staticEntry.IPs {
@@ -867,6 +879,15 @@ func (r *LinuxNIReconciler) getIntendedDnsmasqCfg(niID uuid.UUID) (items []dg.It
 						Hostname: app.config.DisplayName,
 						IP:       vif.GuestIP,
 					})
+				// Make app resolvable as <appname>.<ni-domain>.
+				hostLabel := appDNSLabel(app.config.DisplayName)
+				if ni.config.DomainName != "" && hostLabel != "" {
+					dnsCfg.StaticEntries = append(dnsCfg.StaticEntries,
+						generic.HostnameToIP{
+							Hostname: hostLabel + "." + ni.config.DomainName,
+							IP:       vif.GuestIP,
+						})
+				}
 			}
 		}
 	}
@@ -922,6 +943,22 @@ func (r *LinuxNIReconciler) getIntendedDnsmasqCfg(niID uuid.UUID) (items []dg.It
 	return items
 }
 
+// appDNSLabel converts application display name into a valid DNS label
+// (RFC 1123): lower-case letters, digits and hyphens, at most 63 characters.
+// Returns empty string if nothing usable remains.
+func appDNSLabel(displayName string) string {
+	label := []byte(strings.ToLower(displayName))
+	for i, c := range label {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			label[i] = '-'
+		}
+	}
+	if len(label) > 63 {
+		label = label[:63]
+	}
+	return strings.Trim(string(label), "-")
+}
+
 func (r *LinuxNIReconciler) getIntendedRadvdCfg(niID uuid.UUID) (items []dg.Item) {
 	ni := r.nis[niID]
 	if !ni.config.IsIPv6() {
@@ -941,6 +978,35 @@ func (r *LinuxNIReconciler) getIntendedRadvdCfg(niID uuid.UUID) (items []dg.Item
 	return items
 }
 
+func (r *LinuxNIReconciler) getIntendedHostapdCfg(niID uuid.UUID) (items []dg.Item) {
+	ni := r.nis[niID]
+	wifiAP := ni.bridge.WifiAP
+	if wifiAP == nil || wifiAP.IfName == "" {
+		return nil
+	}
+	items = append(items, generic.Hostapd{
+		// Use bridge interface name as the hostapd instance name.
+		// There is at most one WiFi access point bridged into every NI.
+		InstanceName: ni.brIfName,
+		WlanIfName:   wifiAP.IfName,
+		BridgeIf: generic.NetworkIf{
+			IfName:  ni.brIfName,
+			ItemRef: dg.Reference(linux.Bridge{IfName: ni.brIfName}),
+		},
+		AccessPoint: generic.WifiAccessPoint{
+			SSID:        wifiAP.Config.SSID,
+			HiddenSSID:  wifiAP.Config.HiddenSSID,
+			Security:    wifiAP.Config.Security,
+			Passphrase:  wifiAP.Passphrase,
+			Band:        wifiAP.Config.Band,
+			Channel:     wifiAP.Config.Channel,
+			CountryCode: wifiAP.Config.CountryCode,
+			MaxClients:  wifiAP.Config.MaxClients,
+		},
+	})
+	return items
+}
+
 func (r *LinuxNIReconciler) getIntendedAppConnCfg(niID uuid.UUID,
 	vif vifInfo, ul types.UnderlayNetworkConfig) dg.Graph {
 	ni := r.nis[vif.NI]
@@ -1001,6 +1067,9 @@ func (r *LinuxNIReconciler) getIntendedAppConnCfg(niID uuid.UUID,
 	}
 	intendedAppConnCfg.PutItem(ipv4Eids, nil)
 	intendedAppConnCfg.PutItem(ipv6Eids, nil)
+	for _, item := range getIntendedAppConnQoS(vif, ul) {
+		intendedAppConnCfg.PutItem(item, nil)
+	}
 	intendedAppConnCfg.PutSubGraph(r.getIntendedAppConnACLs(niID, vif, ul))
 	return intendedAppConnCfg
 }
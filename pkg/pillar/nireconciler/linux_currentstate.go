@@ -12,6 +12,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	generic "github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
 	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -137,6 +138,9 @@ func (r *LinuxNIReconciler) updateCurrentGlobalState(uplinksOnly bool) (changed
 				}
 			}
 		}
+		if r.useNftables {
+			nftables.LowerGraph(currentACLRoot)
+		}
 		prevACLRoot := globalSG.SubGraph(ACLRootChainsSG)
 		if prevACLRoot == nil || len(prevACLRoot.DiffItems(currentACLRoot)) > 0 {
 			globalSG.PutSubGraph(currentACLRoot)
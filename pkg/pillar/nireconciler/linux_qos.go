@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"fmt"
+	"strconv"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// getIntendedAppConnQoS returns traffic shapers configured for the application VIF.
+// Note that directions are from the application point of view, i.e. egress
+// shaping limits traffic sent by the application, which is received by the VIF.
+func getIntendedAppConnQoS(vif vifInfo, ul types.UnderlayNetworkConfig) (items []dg.Item) {
+	if ul.QoS.Egress.Enabled() {
+		items = append(items, linux.TrafficShaper{
+			VIFIfName:  vif.hostIfName,
+			Egress:     true,
+			RateBps:    ul.QoS.Egress.RateKbps * 1000,
+			BurstBytes: ul.QoS.Egress.BurstBytes,
+		})
+	}
+	if ul.QoS.Ingress.Enabled() {
+		items = append(items, linux.TrafficShaper{
+			VIFIfName:  vif.hostIfName,
+			Egress:     false,
+			RateBps:    ul.QoS.Ingress.RateKbps * 1000,
+			BurstBytes: ul.QoS.Ingress.BurstBytes,
+		})
+	}
+	return items
+}
+
+// getDSCPMarkingRule returns mangle rule setting DSCP of all packets sent
+// by the application over the given VIF.
+// The rule is applied before egress ACLs (which may also drop packets), so that
+// the ACL marking and DSCP marking do not interfere.
+func getDSCPMarkingRule(brIfName string, vif vifInfo, dscp uint8, ipv6 bool,
+	egressTraversal string) iptables.Rule {
+	return iptables.Rule{
+		RuleLabel: fmt.Sprintf("Set DSCP for VIF %s egress", vif.hostIfName),
+		Table:     "mangle",
+		ChainName: vifChain("PREROUTING", vif),
+		ForIPv6:   ipv6,
+		MatchOpts: []string{"-i", brIfName,
+			"-m", "physdev", "--physdev-in", matchVifIfName(vif)},
+		Target:        "DSCP",
+		TargetOpts:    []string{"--set-dscp", strconv.Itoa(int(dscp))},
+		AppliedBefore: []string{egressTraversal},
+	}
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/conntrack"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/l7acl"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	generic "github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
 	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -40,6 +42,8 @@ const (
 const (
 	vifIfNamePrefix    = "nbu"
 	bridgeIfNamePrefix = "bn"
+	// How often to copy IPs added by dnsmasq into ipsets to nftables sets.
+	ipsetSyncPeriod = time.Second
 )
 
 var emptyUUID = uuid.UUID{} // used as a constant
@@ -53,6 +57,13 @@ type LinuxNIReconciler struct {
 	logger          *logrus.Logger
 	netMonitor      netmonitor.NetworkMonitor
 	metadataHandler http.Handler
+	// Inspector applying host-based ACL rules to TCP connections at the layer 7.
+	l7Inspector *l7acl.Inspector
+	// Firewall is configured using nftables instead of iptables.
+	useNftables bool
+	// With nftables, copies IPs added by dnsmasq into ipsets used for host ACLs
+	// to the nftables sets mirroring these ipsets.
+	ipsetSync *nftables.IPSetSync
 
 	exportCurrentState  bool
 	exportIntendedState bool
@@ -109,6 +120,13 @@ type niInfo struct {
 	status   NIReconcileStatus
 }
 
+// l7HostACLs returns true if host-based ACL rules of applications connected
+// to this network instance should be enforced at the layer 7.
+func (ni *niInfo) l7HostACLs() bool {
+	return ni.config.Type == types.NetworkInstanceTypeLocal &&
+		ni.config.HostACLEnforcement == types.HostACLEnforcementL7
+}
+
 type appInfo struct {
 	config    types.AppNetworkConfig
 	appNum    int
@@ -127,14 +145,21 @@ type vifInfo struct {
 // on every change.
 // Enable exportIntendedState to have the intended state exported to intendedStateFile
 // on every change.
+// l7Inspector is used for network instances with host-based ACL rules enforced
+// at the layer 7 (see types.HostACLEnforcementL7).
+// Enable useNftables to have firewall rules applied using nftables instead
+// of iptables (see pkg/pillar/nftables).
 func NewLinuxNIReconciler(log *base.LogObject, logger *logrus.Logger,
 	netMonitor netmonitor.NetworkMonitor, metadataHandler http.Handler,
+	l7Inspector *l7acl.Inspector, useNftables bool,
 	exportCurrentState, exportIntendedState bool) *LinuxNIReconciler {
 	return &LinuxNIReconciler{
 		log:                 log,
 		logger:              logger,
 		netMonitor:          netMonitor,
 		metadataHandler:     metadataHandler,
+		l7Inspector:         l7Inspector,
+		useNftables:         useNftables,
 		exportCurrentState:  exportCurrentState,
 		exportIntendedState: exportIntendedState,
 	}
@@ -168,12 +193,18 @@ func (r *LinuxNIReconciler) init() (startWatcher func()) {
 	if err := iptables.RegisterItems(r.log, registry); err != nil {
 		r.log.Fatal(err)
 	}
+	if err := nftables.RegisterItems(r.log, registry); err != nil {
+		r.log.Fatal(err)
+	}
 	r.registry = registry
 	r.currentState = r.initialDepGraph()
 	r.intendedState = r.initialDepGraph()
 	r.nis = make(map[uuid.UUID]*niInfo)
 	r.apps = make(map[uuid.UUID]*appInfo)
 	r.pendingReconcile = make(map[string]pendingReconcile)
+	if r.useNftables {
+		r.ipsetSync = nftables.NewIPSetSync(r.log)
+	}
 	r.wakeupPublisher = make(chan bool, 1)
 	go r.runPublisher()
 	r.watcherControl = make(chan watcherCtrl, 10)
@@ -235,8 +266,19 @@ func (r *LinuxNIReconciler) runWatcher(netEvents <-chan netmonitor.Event) {
 	}
 	r.reconcileMu.Lock()
 	defer r.reconcileMu.Unlock()
+	var ipsetSyncTicker <-chan time.Time
+	if r.ipsetSync != nil {
+		ticker := time.NewTicker(ipsetSyncPeriod)
+		defer ticker.Stop()
+		ipsetSyncTicker = ticker.C
+	}
 	for {
 		select {
+		case <-ipsetSyncTicker:
+			if err := r.ipsetSync.Sync(r.getHostIPSets()); err != nil {
+				r.log.Errorf("%s: %v", LogAndErrPrefix, err)
+			}
+
 		case subgraph := <-r.resumeAsync:
 			reconcileReason := "async op finalized"
 			if subgraph == GlobalSG {
@@ -394,6 +436,38 @@ func (r *LinuxNIReconciler) pauseWatcher() (cont func()) {
 	}
 }
 
+// getHostIPSets returns ipsets which are currently created and filled by dnsmasq
+// with IPs of resolved host names, and which are mirrored by nftables sets.
+func (r *LinuxNIReconciler) getHostIPSets() (ipsets []linux.IPSet) {
+	iter := r.currentState.Items(true)
+	for iter.Next() {
+		item, _ := iter.Item()
+		dnsmasq, isDnsmasq := item.(generic.Dnsmasq)
+		if !isDnsmasq {
+			continue
+		}
+		for _, hostIPSet := range dnsmasq.DNSServer.LinuxIPSets {
+			for _, setName := range hostIPSet.Sets {
+				item, _, _, found := r.currentState.Item(
+					dg.Reference(linux.IPSet{SetName: setName}))
+				if !found {
+					continue
+				}
+				ipset := item.(linux.IPSet)
+				set := nftables.Set{
+					Family:  nftables.FamilyForIPv6(ipset.AddrFamily == unix.AF_INET6),
+					SetName: nftables.SetName(setName),
+				}
+				if _, _, _, found = r.currentState.Item(dg.Reference(set)); !found {
+					continue
+				}
+				ipsets = append(ipsets, ipset)
+			}
+		}
+	}
+	return ipsets
+}
+
 // reconcile the current state of network instances and application connectivity with
 // the intended state.
 func (r *LinuxNIReconciler) reconcile(ctx context.Context) (updates []ReconcilerUpdate) {
@@ -425,6 +499,9 @@ func (r *LinuxNIReconciler) reconcile(ctx context.Context) (updates []Reconciler
 				r.updateCurrentNIState(pReconcile.forNI)
 			}
 		}
+		if r.useNftables {
+			nftables.LowerGraph(intSG)
+		}
 		r.intendedState.PutSubGraph(intSG)
 		currSG := r.currentState.SubGraph(sgName) // non-nil at this point
 
@@ -442,9 +519,25 @@ func (r *LinuxNIReconciler) reconcile(ctx context.Context) (updates []Reconciler
 			// Clear UDP flows if any NAT ACL rule has changed.
 			var natV4RuleChanged, natV6RuleChanged bool
 			for _, log := range rs.OperationLog {
-				rule, isRule := log.Item.(iptables.Rule)
-				if isRule && rule.Table == "nat" {
-					if rule.ForIPv6 {
+				var natRuleForIPv6 *bool
+				switch item := log.Item.(type) {
+				case iptables.Rule:
+					if item.Table == "nat" {
+						natRuleForIPv6 = &item.ForIPv6
+					}
+				case nftables.Chain:
+					if item.Table == "nat" {
+						forIPv6 := item.Family == nftables.FamilyIPv6
+						natRuleForIPv6 = &forIPv6
+					}
+				case nftables.Rule:
+					if item.Table == "nat" {
+						forIPv6 := item.Family == nftables.FamilyIPv6
+						natRuleForIPv6 = &forIPv6
+					}
+				}
+				if natRuleForIPv6 != nil {
+					if *natRuleForIPv6 {
 						natV6RuleChanged = true
 					} else {
 						natV4RuleChanged = true
@@ -542,6 +635,12 @@ func (r *LinuxNIReconciler) reconcile(ctx context.Context) (updates []Reconciler
 		}
 	}
 
+	// Reconciliation may have (re)created or flushed nftables sets.
+	// IPs added by dnsmasq into the ipsets have to be copied again.
+	if r.ipsetSync != nil {
+		r.ipsetSync.Reset()
+	}
+
 	// Output the intended state into a file for troubleshooting purposes.
 	if r.exportIntendedState {
 		dotExporter := &dg.DotExporter{CheckDeps: true}
@@ -18,6 +18,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	nirec "github.com/lf-edge/eve/pkg/pillar/nireconciler"
 	"github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
 	"github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
@@ -34,6 +35,10 @@ var (
 )
 
 func initTest(test *testing.T) *GomegaWithT {
+	return initTestWithFirewall(test, false)
+}
+
+func initTestWithFirewall(test *testing.T, useNftables bool) *GomegaWithT {
 	t := NewGomegaWithT(test)
 	t.SetDefaultEventuallyTimeout(5 * time.Second)
 	t.SetDefaultConsistentlyDuration(5 * time.Second)
@@ -44,7 +49,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		MainRT: unix.RT_TABLE_MAIN,
 	}
 	niReconciler = nirec.NewLinuxNIReconciler(log, logger, networkMonitor, nil,
-		false, false)
+		nil, useNftables, false, false)
 	return t
 }
 
@@ -2008,3 +2013,186 @@ func TestAirGappedLocalAndSwitchNIs(test *testing.T) {
 	app2VIF2.Attrs.MasterIfIndex = 4
 	app2VIF3.Attrs.MasterIfIndex = 4
 }
+
+func TestWifiAPInSwitchNI(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.AddOrUpdateInterface(eth1)
+	var routes []netmonitor.Route
+	routes = append(routes, eth0Routes...)
+	routes = append(routes, eth1Routes...)
+	networkMonitor.UpdateRoutes(routes)
+	ctx := reconciler.MockRun(context.Background())
+	updatesCh := niReconciler.WatchReconcilerUpdates()
+	niReconciler.RunInitialReconcile(ctx)
+
+	// Create switch network instance with WiFi access point.
+	niConfig := ni2Config
+	niConfig.WifiAPLogicalLabel = "wlan-ap"
+	niBridge := ni2Bridge
+	niBridge.WifiAP = &nirec.WifiAP{
+		LogicalLabel: "wlan-ap",
+		IfName:       "wlan0",
+		Config: types.WifiAPConfig{
+			SSID:     "kiosk",
+			Security: types.WifiAPSecurityWPA2PSK,
+		},
+		Passphrase: "secret-passphrase",
+	}
+	niStatus, err := niReconciler.AddNI(ctx, niConfig, niBridge)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Expect(niStatus.BrIfName).To(Equal("eth1"))
+	t.Expect(niStatus.FailedItems).To(BeEmpty())
+
+	var recUpdate nirec.ReconcilerUpdate
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.NIReconcileStatusChanged))
+
+	// WiFi interface is put by hostapd into the bridge of the switch NI.
+	hostapd := genericitems.Hostapd{InstanceName: "eth1"}
+	t.Expect(itemIsCreated(dg.Reference(hostapd))).To(BeTrue())
+	t.Expect(itemDescription(dg.Reference(hostapd))).To(
+		ContainSubstring("wlanIfName: wlan0, bridgeIf: eth1"))
+	t.Expect(itemDescription(dg.Reference(hostapd))).ToNot(
+		ContainSubstring("secret-passphrase"))
+	// No DHCP server is run by EVE for the switch NI.
+	t.Expect(itemCountWithType(genericitems.DnsmasqTypename)).To(BeZero())
+
+	// Delete network instance
+	niStatus, err = niReconciler.DelNI(ctx, ni2UUID.UUID)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Expect(niStatus.Deleted).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(hostapd))).To(BeFalse())
+}
+
+func TestHostACLWithNftables(test *testing.T) {
+	t := initTestWithFirewall(test, true)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+	ctx := reconciler.MockRun(context.Background())
+	updatesCh := niReconciler.WatchReconcilerUpdates()
+	niReconciler.RunInitialReconcile(ctx)
+
+	_, err := niReconciler.AddNI(ctx, ni1Config, ni1Bridge)
+	t.Expect(err).ToNot(HaveOccurred())
+	var recUpdate nirec.ReconcilerUpdate
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.NIReconcileStatusChanged))
+	networkMonitor.AddOrUpdateInterface(ni1BridgeIf)
+
+	// app1 has ACL with "host" match for ieee.org.
+	_, err = niReconciler.ConnectApp(ctx, app1NetConfig, app1Num, app1VIFs)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.AppConnReconcileStatusChanged))
+
+	// Host ipsets are preserved and mirrored by nftables sets.
+	ipsetBasename := nirec.HostIPSetBasename("ieee.org")
+	v4IPSet := linuxitems.IPSet{SetName: "ipv4." + ipsetBasename}
+	v6IPSet := linuxitems.IPSet{SetName: "ipv6." + ipsetBasename}
+	v4Set := nftables.Set{Family: nftables.FamilyIPv4,
+		SetName: nftables.SetName(v4IPSet.SetName)}
+	v6Set := nftables.Set{Family: nftables.FamilyIPv6,
+		SetName: nftables.SetName(v6IPSet.SetName)}
+	t.Expect(itemIsCreated(dg.Reference(v4IPSet))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(v6IPSet))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(v4Set))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(v6Set))).To(BeTrue())
+
+	// Dnsmasq fills the ipsets (IPs are copied into nftables sets by IPSetSync).
+	dnsmasq := genericitems.Dnsmasq{InstanceName: "bn1"}
+	item, _, _, found := niReconciler.GetCurrentState().Item(dg.Reference(dnsmasq))
+	t.Expect(found).To(BeTrue())
+	dnsServer := item.(genericitems.Dnsmasq).DNSServer
+	t.Expect(dnsServer.LinuxIPSets).To(HaveLen(1))
+	t.Expect(dnsServer.LinuxIPSets[0].Domains).To(Equal([]string{"ieee.org"}))
+	t.Expect(dnsServer.LinuxIPSets[0].Sets).To(ConsistOf(
+		v4IPSet.SetName, v6IPSet.SetName))
+
+	// Disconnect the application and delete the network instance.
+	_, err = niReconciler.DisconnectApp(ctx, app1UUID.UUID)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Expect(itemIsCreated(dg.Reference(v4Set))).To(BeFalse())
+	t.Expect(itemIsCreated(dg.Reference(v6Set))).To(BeFalse())
+	_, err = niReconciler.DelNI(ctx, ni1UUID.UUID)
+	t.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestAppConnQoS(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(eth0)
+	networkMonitor.UpdateRoutes(eth0Routes)
+	ctx := reconciler.MockRun(context.Background())
+	updatesCh := niReconciler.WatchReconcilerUpdates()
+	niReconciler.RunInitialReconcile(ctx)
+
+	_, err := niReconciler.AddNI(ctx, ni1Config, ni1Bridge)
+	t.Expect(err).ToNot(HaveOccurred())
+	var recUpdate nirec.ReconcilerUpdate
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.NIReconcileStatusChanged))
+	networkMonitor.AddOrUpdateInterface(ni1BridgeIf)
+
+	// Connect app1 with traffic shaping enabled in both directions.
+	appConfig := app1NetConfig
+	appConfig.UnderlayNetworkList = []types.UnderlayNetworkConfig{
+		app1NetConfig.UnderlayNetworkList[0]}
+	appConfig.UnderlayNetworkList[0].QoS = types.AppInterfaceQoS{
+		Egress:  types.TrafficShaping{RateKbps: 1000, BurstBytes: 10000},
+		Ingress: types.TrafficShaping{RateKbps: 2000},
+	}
+	_, err = niReconciler.ConnectApp(ctx, appConfig, app1Num, app1VIFs)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.AppConnReconcileStatusChanged))
+
+	// Traffic shaping is applied once the VIF exists.
+	egressShaper := linuxitems.TrafficShaper{VIFIfName: "nbu1x1", Egress: true}
+	ingressShaper := linuxitems.TrafficShaper{VIFIfName: "nbu1x1", Egress: false}
+	t.Expect(itemIsCreated(dg.Reference(egressShaper))).To(BeFalse())
+	t.Expect(itemIsCreated(dg.Reference(ingressShaper))).To(BeFalse())
+	networkMonitor.AddOrUpdateInterface(app1VIF1)
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.CurrentStateChanged))
+	niReconciler.ResumeReconcile(ctx)
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.AppConnReconcileStatusChanged))
+	t.Expect(recUpdate.AppConnStatus.VIFs[0].Ready).To(BeTrue())
+	t.Expect(recUpdate.AppConnStatus.VIFs[0].FailedItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(egressShaper))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(ingressShaper))).To(BeTrue())
+	t.Expect(itemCountWithType(linuxitems.TrafficShaperTypename)).To(Equal(2))
+	t.Expect(itemDescription(dg.Reference(egressShaper))).To(ContainSubstring(
+		"rateBps: 1000000, burstBytes: 10000"))
+	t.Expect(itemDescription(dg.Reference(ingressShaper))).To(ContainSubstring(
+		"rateBps: 2000000, burstBytes: 0"))
+
+	// Change the egress rate and disable ingress shaping.
+	appConfig.UnderlayNetworkList[0].QoS = types.AppInterfaceQoS{
+		Egress: types.TrafficShaping{RateKbps: 500},
+	}
+	_, err = niReconciler.ReconnectApp(ctx, appConfig, app1VIFs)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.AppConnReconcileStatusChanged))
+	t.Expect(recUpdate.AppConnStatus.VIFs[0].FailedItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(egressShaper))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(ingressShaper))).To(BeFalse())
+	t.Expect(itemDescription(dg.Reference(egressShaper))).To(ContainSubstring(
+		"rateBps: 500000, burstBytes: 0"))
+
+	// Traffic shaping is removed together with the application connection.
+	_, err = niReconciler.DisconnectApp(ctx, app1UUID.UUID)
+	t.Expect(err).ToNot(HaveOccurred())
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.AppConnReconcileStatusChanged))
+	t.Expect(itemCountWithType(linuxitems.TrafficShaperTypename)).To(Equal(0))
+	networkMonitor.DelInterface(app1VIF1.Attrs.IfName)
+	t.Eventually(updatesCh).Should(Receive(&recUpdate))
+	t.Expect(recUpdate.UpdateType).To(Equal(nirec.CurrentStateChanged))
+	niReconciler.ResumeReconcile(ctx)
+
+	_, err = niReconciler.DelNI(ctx, ni1UUID.UUID)
+	t.Expect(err).ToNot(HaveOccurred())
+	networkMonitor.DelInterface(ni1BridgeIf.Attrs.IfName)
+}
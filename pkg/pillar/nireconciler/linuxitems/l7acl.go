@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/l7acl"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+// L7ACL : host-based ACL rules of an application VIF, enforced at the layer 7
+// by l7acl.Inspector for TCP connections queued using the NFQUEUE iptables target.
+type L7ACL struct {
+	l7acl.VIFRules
+	// Inspector : inspector of queued connections, which should apply the rules.
+	Inspector *l7acl.Inspector
+}
+
+// Name returns the host-side name of the VIF.
+func (a L7ACL) Name() string {
+	return a.HostIfName
+}
+
+// Label for the L7ACL.
+func (a L7ACL) Label() string {
+	return a.HostIfName + " (L7 ACL)"
+}
+
+// Type of the item.
+func (a L7ACL) Type() string {
+	return L7ACLTypename
+}
+
+// Equal compares two L7ACL instances.
+// Inspector is not compared - it is the same instance for all VIFs.
+func (a L7ACL) Equal(other dg.Item) bool {
+	a2, isL7ACL := other.(L7ACL)
+	if !isL7ACL {
+		return false
+	}
+	return a.HostIfName == a2.HostIfName &&
+		a.AppNum == a2.AppNum &&
+		utils.EqualIPs(a.GuestIP, a2.GuestIP) &&
+		utils.EqualLists(a.Rules, a2.Rules)
+}
+
+// External returns false.
+func (a L7ACL) External() bool {
+	return false
+}
+
+// String describes L7ACL.
+func (a L7ACL) String() string {
+	return fmt.Sprintf("L7ACL: {hostIfName: %s, appNum: %d, guestIP: %s, rules: %+v}",
+		a.HostIfName, a.AppNum, a.GuestIP, a.Rules)
+}
+
+// Dependencies returns no dependencies.
+// Packets of connections from a VIF are only queued when the corresponding
+// iptables rules are installed, and rules of unknown VIFs are not applied
+// by the inspector.
+func (a L7ACL) Dependencies() (deps []dg.Dependency) {
+	return nil
+}
+
+// L7ACLConfigurator implements Configurator interface (libs/reconciler)
+// for L7ACL.
+type L7ACLConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create passes VIF rules to the inspector.
+func (c *L7ACLConfigurator) Create(ctx context.Context, item dg.Item) error {
+	acl, isL7ACL := item.(L7ACL)
+	if !isL7ACL {
+		return fmt.Errorf("invalid item type %T, expected L7ACL", item)
+	}
+	if acl.Inspector == nil {
+		return fmt.Errorf("missing L7 inspector for VIF %s", acl.HostIfName)
+	}
+	acl.Inspector.UpdateVIFRules(acl.VIFRules)
+	return nil
+}
+
+// Modify updates VIF rules applied by the inspector.
+func (c *L7ACLConfigurator) Modify(ctx context.Context, oldItem, newItem dg.Item) error {
+	return c.Create(ctx, newItem)
+}
+
+// Delete removes VIF rules from the inspector.
+func (c *L7ACLConfigurator) Delete(ctx context.Context, item dg.Item) error {
+	acl, isL7ACL := item.(L7ACL)
+	if !isL7ACL {
+		return fmt.Errorf("invalid item type %T, expected L7ACL", item)
+	}
+	if acl.Inspector == nil {
+		return fmt.Errorf("missing L7 inspector for VIF %s", acl.HostIfName)
+	}
+	acl.Inspector.RemoveVIFRules(acl.HostIfName)
+	return nil
+}
+
+// NeedsRecreate returns false - Modify is able to apply any change.
+func (c *L7ACLConfigurator) NeedsRecreate(oldItem, newItem dg.Item) (recreate bool) {
+	return false
+}
@@ -0,0 +1,471 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	generic "github.com/lf-edge/eve/pkg/pillar/nireconciler/genericitems"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Traffic shaping is implemented using HTB qdisc with the following classes:
+//
+//	1:   HTB root qdisc (default class 1:20)
+//	└── 1:1   rate=limit, ceil=limit
+//	    ├── 1:10  control traffic, prio 0, rate=10% of limit, ceil=limit
+//	    │         └── fq_codel
+//	    └── 1:20  other traffic, prio 1, rate=90% of limit, ceil=limit
+//	              └── fq_codel
+//
+// Traffic sent towards the application is shaped by the HTB qdisc attached
+// as the root qdisc of the VIF. Traffic sent by the application is received
+// by the VIF, therefore it has to be first redirected into an IFB interface,
+// where the HTB qdisc is attached.
+//
+// Traffic shaping is never modified in place. Any change (e.g. of the rate)
+// is applied by removing the shaping and creating it again (see NeedsRecreate),
+// which for the egress direction includes the IFB interface. For a short moment
+// the traffic is therefore not shaped.
+const (
+	htbRootHandleMajor  = 1
+	htbParentClassMinor = 1
+	htbControlMinor     = 0x10
+	htbDefaultMinor     = 0x20
+	// Control traffic is guaranteed 10% of the rate.
+	controlRatePercent = 10
+	// Minimal rate guaranteed for each class.
+	minClassRate = 8000 // bits per second
+	// IFB interface name prefix.
+	ifbPrefix = "ifb"
+	// Maximum length of interface name in Linux.
+	ifNameMaxLen = 15
+)
+
+// TrafficShaper : traffic shaping applied to one direction of VIF traffic.
+type TrafficShaper struct {
+	// VIFIfName : name of the VIF (on the host side) whose traffic is shaped.
+	VIFIfName string
+	// Egress : if true, traffic sent by the application is shaped, otherwise
+	// traffic sent towards the application.
+	Egress bool
+	// RateBps : maximum rate in bits per second.
+	RateBps uint64
+	// BurstBytes : maximum burst size. Zero means that a minimal burst suitable
+	// for the given rate is used.
+	BurstBytes uint32
+}
+
+// Name returns VIF name with the traffic direction.
+func (s TrafficShaper) Name() string {
+	if s.Egress {
+		return s.VIFIfName + "/egress"
+	}
+	return s.VIFIfName + "/ingress"
+}
+
+// Label for TrafficShaper.
+func (s TrafficShaper) Label() string {
+	return s.Name() + " (traffic shaper)"
+}
+
+// Type of the item.
+func (s TrafficShaper) Type() string {
+	return TrafficShaperTypename
+}
+
+// Equal compares two TrafficShaper instances.
+func (s TrafficShaper) Equal(other dg.Item) bool {
+	s2, isTrafficShaper := other.(TrafficShaper)
+	if !isTrafficShaper {
+		return false
+	}
+	return s == s2
+}
+
+// External returns false.
+func (s TrafficShaper) External() bool {
+	return false
+}
+
+// String describes TrafficShaper.
+func (s TrafficShaper) String() string {
+	return fmt.Sprintf("TrafficShaper: {vifIfName: %s, egress: %t, "+
+		"ifbIfName: %s, rateBps: %d, burstBytes: %d}", s.VIFIfName, s.Egress,
+		s.IFBIfName(), s.RateBps, s.BurstBytes)
+}
+
+// Dependencies returns VIF as the only dependency.
+func (s TrafficShaper) Dependencies() (deps []dg.Dependency) {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: generic.VIFTypename,
+				ItemName: s.VIFIfName,
+			},
+			Description: "VIF must exist",
+			Attributes: dg.DependencyAttributes{
+				// Qdisc attached to VIF is removed together with the VIF.
+				// But IFB interface used for egress has to be removed explicitly.
+				AutoDeletedByExternal: !s.Egress,
+			},
+		},
+	}
+}
+
+// IFBIfName returns the name of the IFB interface used to shape egress traffic.
+// Returns empty string for ingress.
+// The name is derived from a hash of the VIF name. Simply prefixing the VIF name
+// could exceed the maximum interface name length and truncated names of different
+// VIFs could collide.
+func (s TrafficShaper) IFBIfName() string {
+	if !s.Egress {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(s.VIFIfName))
+	return ifbPrefix + hex.EncodeToString(hash[:])[:ifNameMaxLen-len(ifbPrefix)]
+}
+
+// TrafficShaperConfigurator implements Configurator interface (libs/reconciler)
+// for VIF traffic shaping.
+type TrafficShaperConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create applies traffic shaping.
+func (c *TrafficShaperConfigurator) Create(ctx context.Context, item dg.Item) error {
+	shaper, isTrafficShaper := item.(TrafficShaper)
+	if !isTrafficShaper {
+		return fmt.Errorf("invalid item type %T, expected TrafficShaper", item)
+	}
+	vifLink, err := netlink.LinkByName(shaper.VIFIfName)
+	if err != nil {
+		err = fmt.Errorf("failed to get link for VIF %s: %w", shaper.VIFIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	shapedLink := vifLink
+	if shaper.Egress {
+		shapedLink, err = c.redirectToIFB(vifLink, shaper.IFBIfName())
+		if err != nil {
+			c.Log.Error(err)
+			return err
+		}
+	}
+	if err = c.installHTB(shapedLink, shaper); err != nil {
+		err = fmt.Errorf("failed to install HTB for %s: %w", shaper.Name(), err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// redirectToIFB creates IFB interface and redirects all traffic received
+// by the VIF into it.
+func (c *TrafficShaperConfigurator) redirectToIFB(
+	vifLink netlink.Link, ifbName string) (netlink.Link, error) {
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = ifbName
+	attrs.TxQLen = 1000
+	ifb := &netlink.Ifb{LinkAttrs: attrs}
+	if err := netlink.LinkAdd(ifb); err != nil {
+		return nil, fmt.Errorf("failed to add IFB interface %s: %w", ifbName, err)
+	}
+	ifbLink, err := netlink.LinkByName(ifbName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link for IFB %s: %w", ifbName, err)
+	}
+	if err = netlink.LinkSetUp(ifbLink); err != nil {
+		return nil, fmt.Errorf("failed to set IFB interface %s UP: %w", ifbName, err)
+	}
+	ingress, redirect := ifbRedirect(vifLink.Attrs().Index, ifbLink.Attrs().Index)
+	if err = netlink.QdiscReplace(ingress); err != nil {
+		return nil, fmt.Errorf("failed to add ingress qdisc for VIF %s: %w",
+			vifLink.Attrs().Name, err)
+	}
+	if err = netlink.FilterAdd(redirect); err != nil {
+		return nil, fmt.Errorf("failed to redirect VIF %s traffic into IFB %s: %w",
+			vifLink.Attrs().Name, ifbName, err)
+	}
+	return ifbLink, nil
+}
+
+// ifbRedirect returns ingress qdisc and filter redirecting all traffic
+// received by the VIF into the IFB interface.
+func ifbRedirect(vifIndex, ifbIndex int) (*netlink.Ingress, *netlink.MatchAll) {
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: vifIndex,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	redirect := &netlink.MatchAll{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: vifIndex,
+			Parent:    netlink.MakeHandle(0xffff, 0),
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{netlink.NewMirredAction(ifbIndex)},
+	}
+	return ingress, redirect
+}
+
+// htbConfig : HTB qdiscs, classes and filters implementing traffic shaping
+// for one interface. Each list should be applied in the given order.
+type htbConfig struct {
+	rootQdisc  *netlink.Htb
+	classes    []*netlink.HtbClass
+	leafQdiscs []*netlink.FqCodel
+	filters    []*netlink.U32
+}
+
+// getHTBConfig returns HTB configuration (see the diagram at the top of the file)
+// shaping traffic transmitted by the given interface.
+func getHTBConfig(linkIndex int, shaper TrafficShaper) (config htbConfig) {
+	config.rootQdisc = netlink.NewHtb(netlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    netlink.MakeHandle(htbRootHandleMajor, 0),
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	config.rootQdisc.Defcls = htbDefaultMinor
+	rate := shaper.RateBps
+	controlRate := rate * controlRatePercent / 100
+	if controlRate < minClassRate {
+		controlRate = minClassRate
+	}
+	defaultRate := minClassRate
+	if rate > controlRate+minClassRate {
+		defaultRate = int(rate - controlRate)
+	}
+	classes := []struct {
+		minor  uint16
+		parent uint16
+		rate   uint64
+		prio   uint32
+	}{
+		{minor: htbParentClassMinor, rate: rate},
+		{minor: htbControlMinor, parent: htbParentClassMinor, rate: controlRate, prio: 0},
+		{minor: htbDefaultMinor, parent: htbParentClassMinor, rate: uint64(defaultRate),
+			prio: 1},
+	}
+	for _, class := range classes {
+		parent := netlink.MakeHandle(htbRootHandleMajor, class.parent)
+		if class.parent == 0 {
+			parent = netlink.MakeHandle(htbRootHandleMajor, 0)
+		}
+		config.classes = append(config.classes, netlink.NewHtbClass(netlink.ClassAttrs{
+			LinkIndex: linkIndex,
+			Parent:    parent,
+			Handle:    netlink.MakeHandle(htbRootHandleMajor, class.minor),
+		}, netlink.HtbClassAttrs{
+			Rate:    class.rate,
+			Ceil:    rate,
+			Buffer:  shaper.BurstBytes,
+			Cbuffer: shaper.BurstBytes,
+			Prio:    class.prio,
+		}))
+		if class.parent == 0 {
+			continue
+		}
+		config.leafQdiscs = append(config.leafQdiscs, netlink.NewFqCodel(netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    netlink.MakeHandle(class.minor, 0),
+			Parent:    netlink.MakeHandle(htbRootHandleMajor, class.minor),
+		}))
+	}
+	for i, filter := range controlTrafficFilters() {
+		u32 := &netlink.U32{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: linkIndex,
+				Parent:    netlink.MakeHandle(htbRootHandleMajor, 0),
+				Priority:  uint16(i + 1),
+				Protocol:  filter.protocol,
+			},
+			ClassId: netlink.MakeHandle(htbRootHandleMajor, htbControlMinor),
+		}
+		if len(filter.keys) > 0 {
+			u32.Sel = &netlink.TcU32Sel{
+				Flags: netlink.TC_U32_TERMINAL,
+				Keys:  filter.keys,
+			}
+		}
+		config.filters = append(config.filters, u32)
+	}
+	return config
+}
+
+func (c *TrafficShaperConfigurator) installHTB(
+	link netlink.Link, shaper TrafficShaper) error {
+	config := getHTBConfig(link.Attrs().Index, shaper)
+	if err := netlink.QdiscReplace(config.rootQdisc); err != nil {
+		return fmt.Errorf("failed to add HTB qdisc: %w", err)
+	}
+	for _, class := range config.classes {
+		if err := netlink.ClassReplace(class); err != nil {
+			return fmt.Errorf("failed to add HTB class %s: %w",
+				netlink.HandleStr(class.Handle), err)
+		}
+	}
+	for _, leaf := range config.leafQdiscs {
+		if err := netlink.QdiscReplace(leaf); err != nil {
+			return fmt.Errorf("failed to add fq_codel qdisc for class %s: %w",
+				netlink.HandleStr(leaf.Parent), err)
+		}
+	}
+	for _, filter := range config.filters {
+		if err := netlink.FilterAdd(filter); err != nil {
+			return fmt.Errorf("failed to add filter for control traffic: %w", err)
+		}
+	}
+	return nil
+}
+
+type u32Filter struct {
+	protocol uint16
+	keys     []netlink.TcU32Key // ANDed, empty to match all
+}
+
+// controlTrafficFilters returns u32 filters selecting control traffic:
+// ARP, ICMP, DHCP, DNS and packets with DSCP set to EF, CS6 or CS7.
+// Offsets are relative to the network header. For simplicity, IPv4 header
+// is assumed to be without options and IPv6 header without extension headers.
+func controlTrafficFilters() (filters []u32Filter) {
+	const (
+		dscpEF     = 46
+		dscpCS6    = 48
+		ipv4HdrLen = 20
+		ipv6HdrLen = 40
+	)
+	ipv4Proto := func(proto uint32) netlink.TcU32Key {
+		return netlink.TcU32Key{Off: 8, Mask: 0x00ff0000, Val: proto << 16}
+	}
+	ipv6NextHdr := func(proto uint32) netlink.TcU32Key {
+		return netlink.TcU32Key{Off: 4, Mask: 0x0000ff00, Val: proto << 8}
+	}
+	srcPort := func(hdrLen int32, port uint32) netlink.TcU32Key {
+		return netlink.TcU32Key{Off: hdrLen, Mask: 0xffff0000, Val: port << 16}
+	}
+	dstPort := func(hdrLen int32, port uint32) netlink.TcU32Key {
+		return netlink.TcU32Key{Off: hdrLen, Mask: 0x0000ffff, Val: port}
+	}
+	filters = append(filters,
+		u32Filter{protocol: unix.ETH_P_ARP},
+		u32Filter{protocol: unix.ETH_P_IP, keys: []netlink.TcU32Key{
+			ipv4Proto(unix.IPPROTO_ICMP)}},
+		// IPv4 TOS byte: DSCP is stored in the upper 6 bits.
+		u32Filter{protocol: unix.ETH_P_IP, keys: []netlink.TcU32Key{
+			{Off: 0, Mask: 0x00fc0000, Val: dscpEF << 18}}},
+		u32Filter{protocol: unix.ETH_P_IP, keys: []netlink.TcU32Key{
+			{Off: 0, Mask: 0x00c00000, Val: dscpCS6 << 18}}},
+		u32Filter{protocol: unix.ETH_P_IPV6, keys: []netlink.TcU32Key{
+			ipv6NextHdr(unix.IPPROTO_ICMPV6)}},
+		// IPv6 traffic class follows the 4-bit version field.
+		u32Filter{protocol: unix.ETH_P_IPV6, keys: []netlink.TcU32Key{
+			{Off: 0, Mask: 0x0fc00000, Val: dscpEF << 22}}},
+		u32Filter{protocol: unix.ETH_P_IPV6, keys: []netlink.TcU32Key{
+			{Off: 0, Mask: 0x0c000000, Val: dscpCS6 << 22}}},
+	)
+	type l4Port struct {
+		proto uint32
+		port  uint32
+	}
+	ipv4Ports := []l4Port{{unix.IPPROTO_UDP, 53}, {unix.IPPROTO_TCP, 53},
+		{unix.IPPROTO_UDP, 67}, {unix.IPPROTO_UDP, 68}}
+	ipv6Ports := []l4Port{{unix.IPPROTO_UDP, 53}, {unix.IPPROTO_TCP, 53},
+		{unix.IPPROTO_UDP, 546}, {unix.IPPROTO_UDP, 547}}
+	for _, p := range ipv4Ports {
+		for _, portKey := range []netlink.TcU32Key{
+			srcPort(ipv4HdrLen, p.port), dstPort(ipv4HdrLen, p.port)} {
+			filters = append(filters, u32Filter{protocol: unix.ETH_P_IP,
+				keys: []netlink.TcU32Key{ipv4Proto(p.proto), portKey}})
+		}
+	}
+	for _, p := range ipv6Ports {
+		for _, portKey := range []netlink.TcU32Key{
+			srcPort(ipv6HdrLen, p.port), dstPort(ipv6HdrLen, p.port)} {
+			filters = append(filters, u32Filter{protocol: unix.ETH_P_IPV6,
+				keys: []netlink.TcU32Key{ipv6NextHdr(p.proto), portKey}})
+		}
+	}
+	return filters
+}
+
+// Modify is not implemented - traffic shaping is always recreated.
+func (c *TrafficShaperConfigurator) Modify(ctx context.Context, oldItem, newItem dg.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes traffic shaping.
+func (c *TrafficShaperConfigurator) Delete(ctx context.Context, item dg.Item) error {
+	shaper, isTrafficShaper := item.(TrafficShaper)
+	if !isTrafficShaper {
+		return fmt.Errorf("invalid item type %T, expected TrafficShaper", item)
+	}
+	// VIF may be already removed (by hypervisor) together with its qdiscs.
+	vifLink, vifErr := netlink.LinkByName(shaper.VIFIfName)
+	if shaper.Egress {
+		if vifErr == nil {
+			ingress := &netlink.Ingress{
+				QdiscAttrs: netlink.QdiscAttrs{
+					LinkIndex: vifLink.Attrs().Index,
+					Handle:    netlink.MakeHandle(0xffff, 0),
+					Parent:    netlink.HANDLE_INGRESS,
+				},
+			}
+			if err := netlink.QdiscDel(ingress); err != nil {
+				err = fmt.Errorf("failed to remove ingress qdisc from VIF %s: %w",
+					shaper.VIFIfName, err)
+				c.Log.Error(err)
+				return err
+			}
+		}
+		ifbLink, err := netlink.LinkByName(shaper.IFBIfName())
+		if err != nil {
+			if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+				return nil
+			}
+			err = fmt.Errorf("failed to get link for IFB %s: %w",
+				shaper.IFBIfName(), err)
+			c.Log.Error(err)
+			return err
+		}
+		if err = netlink.LinkDel(ifbLink); err != nil {
+			err = fmt.Errorf("failed to delete IFB interface %s: %w",
+				shaper.IFBIfName(), err)
+			c.Log.Error(err)
+			return err
+		}
+		return nil
+	}
+	if vifErr != nil {
+		return nil
+	}
+	htb := netlink.NewHtb(netlink.QdiscAttrs{
+		LinkIndex: vifLink.Attrs().Index,
+		Handle:    netlink.MakeHandle(htbRootHandleMajor, 0),
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	if err := netlink.QdiscDel(htb); err != nil {
+		err = fmt.Errorf("failed to remove HTB qdisc from VIF %s: %w",
+			shaper.VIFIfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate always returns true - Modify is not implemented.
+// Every change of the traffic shaping is applied by Delete followed by Create.
+func (c *TrafficShaperConfigurator) NeedsRecreate(oldItem, newItem dg.Item) (recreate bool) {
+	return true
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func TestTrafficShaperIFBIfName(t *testing.T) {
+	t.Parallel()
+	ingress := TrafficShaper{VIFIfName: "nbu1x1"}
+	if ifName := ingress.IFBIfName(); ifName != "" {
+		t.Errorf("unexpected IFB for ingress shaping: %s", ifName)
+	}
+	// VIF names which would collide if truncated after prefixing.
+	vifs := []string{"nbu1x1", "nbu1x1.1", "vif-app1-eth0a", "vif-app1-eth0b",
+		"vif-app1-eth0c1", "vif-app1-eth0c2"}
+	ifbs := make(map[string]string)
+	for _, vif := range vifs {
+		shaper := TrafficShaper{VIFIfName: vif, Egress: true}
+		ifName := shaper.IFBIfName()
+		if len(ifName) == 0 || len(ifName) > ifNameMaxLen {
+			t.Errorf("invalid IFB name %q for VIF %s", ifName, vif)
+		}
+		if ifName != shaper.IFBIfName() {
+			t.Errorf("IFB name for VIF %s is not stable", vif)
+		}
+		if other, duplicate := ifbs[ifName]; duplicate {
+			t.Errorf("VIFs %s and %s share IFB name %s", vif, other, ifName)
+		}
+		ifbs[ifName] = vif
+	}
+}
+
+func TestTrafficShaperDependencies(t *testing.T) {
+	t.Parallel()
+	for _, egress := range []bool{false, true} {
+		shaper := TrafficShaper{VIFIfName: "nbu1x1", Egress: egress}
+		deps := shaper.Dependencies()
+		if len(deps) != 1 || deps[0].RequiredItem.ItemName != "nbu1x1" {
+			t.Fatalf("unexpected dependencies: %+v", deps)
+		}
+		// IFB interface is not removed together with the VIF.
+		if deps[0].Attributes.AutoDeletedByExternal == egress {
+			t.Errorf("unexpected AutoDeletedByExternal for egress=%t", egress)
+		}
+	}
+}
+
+func TestIFBRedirect(t *testing.T) {
+	t.Parallel()
+	ingress, redirect := ifbRedirect(10, 20)
+	if ingress.LinkIndex != 10 || ingress.Parent != netlink.HANDLE_INGRESS {
+		t.Errorf("unexpected ingress qdisc: %+v", ingress.QdiscAttrs)
+	}
+	if redirect.LinkIndex != 10 || redirect.Parent != ingress.Handle ||
+		redirect.Protocol != unix.ETH_P_ALL {
+		t.Errorf("unexpected redirect filter: %+v", redirect.FilterAttrs)
+	}
+	if len(redirect.Actions) != 1 {
+		t.Fatalf("unexpected redirect actions: %+v", redirect.Actions)
+	}
+	mirred, isMirred := redirect.Actions[0].(*netlink.MirredAction)
+	if !isMirred || mirred.Ifindex != 20 || mirred.MirredAction != netlink.TCA_EGRESS_REDIR {
+		t.Errorf("unexpected redirect action: %+v", redirect.Actions[0])
+	}
+}
+
+func TestGetHTBConfig(t *testing.T) {
+	t.Parallel()
+	shaper := TrafficShaper{VIFIfName: "nbu1x1", RateBps: 10000000, BurstBytes: 15000}
+	config := getHTBConfig(10, shaper)
+
+	root := config.rootQdisc
+	if root.LinkIndex != 10 || root.Handle != netlink.MakeHandle(1, 0) ||
+		root.Parent != netlink.HANDLE_ROOT || root.Defcls != htbDefaultMinor {
+		t.Errorf("unexpected root qdisc: %+v", root)
+	}
+
+	// Note that netlink stores HTB rates in bytes per second
+	// and bursts as transmission time.
+	type classParams struct {
+		handle, parent uint32
+		rate, ceil     uint64
+		prio           uint32
+	}
+	expClasses := []classParams{
+		{handle: netlink.MakeHandle(1, 1), parent: netlink.MakeHandle(1, 0),
+			rate: 10000000 / 8, ceil: 10000000 / 8},
+		{handle: netlink.MakeHandle(1, 0x10), parent: netlink.MakeHandle(1, 1),
+			rate: 1000000 / 8, ceil: 10000000 / 8, prio: 0},
+		{handle: netlink.MakeHandle(1, 0x20), parent: netlink.MakeHandle(1, 1),
+			rate: 9000000 / 8, ceil: 10000000 / 8, prio: 1},
+	}
+	if len(config.classes) != len(expClasses) {
+		t.Fatalf("unexpected number of HTB classes: %d", len(config.classes))
+	}
+	for i, class := range config.classes {
+		exp := expClasses[i]
+		params := classParams{handle: class.Handle, parent: class.Parent,
+			rate: class.Rate, ceil: class.Ceil, prio: class.Prio}
+		if params != exp {
+			t.Errorf("unexpected HTB class %s: %+v, expected: %+v",
+				netlink.HandleStr(class.Handle), params, exp)
+		}
+		if class.LinkIndex != 10 {
+			t.Errorf("HTB class %s attached to wrong link %d",
+				netlink.HandleStr(class.Handle), class.LinkIndex)
+		}
+		if class.Buffer != netlink.Xmittime(class.Rate, 15000) ||
+			class.Cbuffer != netlink.Xmittime(class.Ceil, 15000) {
+			t.Errorf("unexpected burst of HTB class %s: %d/%d",
+				netlink.HandleStr(class.Handle), class.Buffer, class.Cbuffer)
+		}
+	}
+
+	if len(config.leafQdiscs) != 2 {
+		t.Fatalf("unexpected number of leaf qdiscs: %d", len(config.leafQdiscs))
+	}
+	for i, minor := range []uint16{htbControlMinor, htbDefaultMinor} {
+		leaf := config.leafQdiscs[i]
+		if leaf.Parent != netlink.MakeHandle(1, minor) ||
+			leaf.Handle != netlink.MakeHandle(minor, 0) {
+			t.Errorf("unexpected leaf qdisc: %+v", leaf.QdiscAttrs)
+		}
+	}
+
+	if len(config.filters) != len(controlTrafficFilters()) {
+		t.Fatalf("unexpected number of filters: %d", len(config.filters))
+	}
+	priorities := make(map[uint16]struct{})
+	for _, filter := range config.filters {
+		if filter.ClassId != netlink.MakeHandle(1, htbControlMinor) ||
+			filter.Parent != netlink.MakeHandle(1, 0) {
+			t.Errorf("filter not selecting control class: %+v", filter)
+		}
+		if _, duplicate := priorities[filter.Priority]; duplicate {
+			t.Errorf("duplicate filter priority %d", filter.Priority)
+		}
+		priorities[filter.Priority] = struct{}{}
+	}
+	// ARP is matched as a whole.
+	if arp := config.filters[0]; arp.Protocol != unix.ETH_P_ARP || arp.Sel != nil {
+		t.Errorf("unexpected ARP filter: %+v", arp)
+	}
+}
+
+func TestGetHTBConfigLowRate(t *testing.T) {
+	t.Parallel()
+	// Both classes are guaranteed at least the minimal rate.
+	config := getHTBConfig(10, TrafficShaper{RateBps: 10000})
+	if rate := config.classes[1].Rate; rate != minClassRate/8 {
+		t.Errorf("unexpected rate of the control class: %d", rate)
+	}
+	if rate := config.classes[2].Rate; rate != minClassRate/8 {
+		t.Errorf("unexpected rate of the default class: %d", rate)
+	}
+	for _, class := range config.classes {
+		if class.Ceil != 10000/8 {
+			t.Errorf("unexpected ceil of class %s: %d",
+				netlink.HandleStr(class.Handle), class.Ceil)
+		}
+	}
+}
+
+func TestControlTrafficFilters(t *testing.T) {
+	t.Parallel()
+	hasFilter := func(protocol uint16, keys ...netlink.TcU32Key) bool {
+		for _, filter := range controlTrafficFilters() {
+			if filter.protocol != protocol || len(filter.keys) != len(keys) {
+				continue
+			}
+			match := true
+			for i := range keys {
+				if filter.keys[i] != keys[i] {
+					match = false
+					break
+				}
+			}
+			if match {
+				return true
+			}
+		}
+		return false
+	}
+	ipv4UDP := netlink.TcU32Key{Off: 8, Mask: 0x00ff0000, Val: unix.IPPROTO_UDP << 16}
+	ipv6UDP := netlink.TcU32Key{Off: 4, Mask: 0x0000ff00, Val: unix.IPPROTO_UDP << 8}
+	tests := []struct {
+		name     string
+		protocol uint16
+		keys     []netlink.TcU32Key
+	}{
+		{name: "ICMP", protocol: unix.ETH_P_IP,
+			keys: []netlink.TcU32Key{{Off: 8, Mask: 0x00ff0000, Val: unix.IPPROTO_ICMP << 16}}},
+		{name: "ICMPv6", protocol: unix.ETH_P_IPV6,
+			keys: []netlink.TcU32Key{{Off: 4, Mask: 0x0000ff00, Val: unix.IPPROTO_ICMPV6 << 8}}},
+		{name: "IPv4 DSCP EF", protocol: unix.ETH_P_IP,
+			keys: []netlink.TcU32Key{{Off: 0, Mask: 0x00fc0000, Val: 0x00b80000}}},
+		{name: "IPv6 DSCP EF", protocol: unix.ETH_P_IPV6,
+			keys: []netlink.TcU32Key{{Off: 0, Mask: 0x0fc00000, Val: 0x0b800000}}},
+		{name: "DNS request", protocol: unix.ETH_P_IP,
+			keys: []netlink.TcU32Key{ipv4UDP, {Off: 20, Mask: 0x0000ffff, Val: 53}}},
+		{name: "DNS response", protocol: unix.ETH_P_IP,
+			keys: []netlink.TcU32Key{ipv4UDP, {Off: 20, Mask: 0xffff0000, Val: 53 << 16}}},
+		{name: "DHCP request", protocol: unix.ETH_P_IP,
+			keys: []netlink.TcU32Key{ipv4UDP, {Off: 20, Mask: 0x0000ffff, Val: 67}}},
+		{name: "DHCPv6 request", protocol: unix.ETH_P_IPV6,
+			keys: []netlink.TcU32Key{ipv6UDP, {Off: 40, Mask: 0x0000ffff, Val: 547}}},
+	}
+	for _, test := range tests {
+		if !hasFilter(test.protocol, test.keys...) {
+			t.Errorf("missing filter for %s", test.name)
+		}
+	}
+}
@@ -14,4 +14,8 @@ const (
 	VLANBridgeTypename = "VLANBridge"
 	// VLANPortTypename : typename for bridged port with configured VLAN(s).
 	VLANPortTypename = "VLANPort"
+	// L7ACLTypename : typename for host-based ACL rules enforced at the layer 7.
+	L7ACLTypename = "L7ACL"
+	// TrafficShaperTypename : typename for traffic shaping applied to VIF.
+	TrafficShaperTypename = "TrafficShaper"
 )
@@ -97,6 +97,18 @@ type NIBridge struct {
 	// Uplink interface selected for this network instance.
 	// Zero value if network instance is air-gapped.
 	Uplink Uplink
+	// WifiAP : WiFi adapter operated as an access point and bridged into
+	// the network instance. Nil if WiFi access point is not used.
+	WifiAP *WifiAP
+}
+
+// WifiAP : WiFi adapter operated in the access point mode.
+type WifiAP struct {
+	LogicalLabel string
+	IfName       string
+	Config       types.WifiAPConfig
+	// Passphrase : decrypted pre-shared key (WPA2) or password (WPA3).
+	Passphrase string
 }
 
 // Uplink used by a network instance to provide external connectivity for applications.
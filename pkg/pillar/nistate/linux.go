@@ -29,13 +29,15 @@ const (
 // LinuxCollector implements state data collecting for network instances
 // configured inside the Linux network stack (using the Linux bridge).
 type LinuxCollector struct {
-	mu  sync.Mutex
-	log *base.LogObject
-	nis map[uuid.UUID]*niInfo
+	mu          sync.Mutex
+	log         *base.LogObject
+	nis         map[uuid.UUID]*niInfo
+	useNftables bool
 
 	ipLeaseWatcher   *fsnotify.Watcher
 	flowWatchers     []chan types.IPFlow
 	ipAssignWatchers []chan []VIFAddrsUpdate
+	wifiAPWatchers   []chan []WifiAPClientsUpdate
 	capturedPackets  chan capturedPacket
 }
 
@@ -47,14 +49,19 @@ type niInfo struct {
 	cancelPCAP  context.CancelFunc
 	ipv4DNSReqs []dnsReq
 	ipv6DNSReqs []dnsReq
+	// Clients associated with the WiFi access point bridged into the NI.
+	wifiAPClients []types.WifiAPClient
 }
 
 // NewLinuxCollector is a constructor for LinuxCollector.
-func NewLinuxCollector(log *base.LogObject) *LinuxCollector {
+// Set useNftables if firewall is configured using nftables instead of iptables
+// (affects how ACL counters are collected).
+func NewLinuxCollector(log *base.LogObject, useNftables bool) *LinuxCollector {
 	var err error
 	sc := &LinuxCollector{
-		log: log,
-		nis: make(map[uuid.UUID]*niInfo),
+		log:         log,
+		nis:         make(map[uuid.UUID]*niInfo),
+		useNftables: useNftables,
 	}
 	sc.capturedPackets = make(chan capturedPacket, 100)
 	sc.ipLeaseWatcher, err = fsnotify.NewWatcher()
@@ -104,7 +111,7 @@ func (lc *LinuxCollector) StartCollectingForNI(
 // Note that not every change in network instance config is supported. For example,
 // network instance type (switch / local) cannot change.
 func (lc *LinuxCollector) UpdateCollectingForNI(
-	niConfig types.NetworkInstanceConfig, vifs []AppVIF) error {
+	niConfig types.NetworkInstanceConfig, br NIBridge, vifs []AppVIF) error {
 	lc.mu.Lock()
 	defer lc.mu.Unlock()
 	if _, exists := lc.nis[niConfig.UUID]; !exists {
@@ -112,6 +119,9 @@ func (lc *LinuxCollector) UpdateCollectingForNI(
 	}
 	ni := lc.nis[niConfig.UUID]
 	ni.config = niConfig
+	// Bridge interface does not change, but WiFi access point may be added
+	// or removed.
+	ni.bridge.WifiAPIfName = br.WifiAPIfName
 	// Preserve already known IP assignments.
 	prevVIFs := ni.vifs
 	ni.vifs = nil
@@ -177,8 +187,13 @@ func (lc *LinuxCollector) GetNetworkMetrics() (types.NetworkMetrics, error) {
 			LogAndErrPrefix, err)
 		return types.NetworkMetrics{}, err
 	}
-	// Call iptables once to get counters
-	ac := lc.fetchIptablesCounters()
+	// Call iptables (or nft) once to get counters
+	var ac []aclCounters
+	if lc.useNftables {
+		ac = lc.fetchNftablesCounters()
+	} else {
+		ac = lc.fetchIptablesCounters()
+	}
 
 	// If we have both ethN and kethN then rename ethN to eethN ('e' for EVE)
 	// and kethN to ethN (the actual port).
@@ -273,6 +288,16 @@ func (lc *LinuxCollector) WatchFlows() <-chan types.IPFlow {
 	return watcherCh
 }
 
+// WatchWifiAPClients : watch for changes in the set of clients associated with
+// WiFi access points bridged into network instances.
+func (lc *LinuxCollector) WatchWifiAPClients() <-chan []WifiAPClientsUpdate {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	watcherCh := make(chan []WifiAPClientsUpdate)
+	lc.wifiAPWatchers = append(lc.wifiAPWatchers, watcherCh)
+	return watcherCh
+}
+
 // Run periodic and on-change state data collecting for network instances
 // from a separate Go routine.
 func (lc *LinuxCollector) runStateCollecting() {
@@ -280,6 +305,7 @@ func (lc *LinuxCollector) runStateCollecting() {
 	fmax := float64(flowCollectInterval)
 	fmin := fmax * 0.9
 	flowCollectTimer := flextimer.NewRangeTicker(time.Duration(fmin), time.Duration(fmax))
+	wifiAPPollTicker := time.NewTicker(wifiAPPollInterval)
 
 	for {
 		select {
@@ -339,6 +365,16 @@ func (lc *LinuxCollector) runStateCollecting() {
 					}
 				}
 			}
+		case <-wifiAPPollTicker.C:
+			updates := lc.pollWifiAPClients()
+			lc.mu.Lock()
+			watchers := lc.wifiAPWatchers
+			lc.mu.Unlock()
+			if len(updates) != 0 {
+				for _, watcherCh := range watchers {
+					watcherCh <- updates
+				}
+			}
 		case capPacket := <-lc.capturedPackets:
 			lc.mu.Lock()
 			addrChanges := lc.processCapturedPacket(capPacket)
@@ -711,7 +711,13 @@ func (lc *LinuxCollector) processDNSPacketInfo(
 		return
 	}
 	dns := dnsLayer.(*layers.DNS)
-	if len(dns.Questions) == 0 || len(dns.Answers) == 0 {
+	if len(dns.Questions) == 0 {
+		return
+	}
+	// Queries blocked by the DNS filter are answered by dnsmasq with NXDOMAIN.
+	blocked := dns.ResponseCode == layers.DNSResponseCodeNXDomain &&
+		isBlockedByDNSFilter(niInfo.config, string(dns.Questions[0].Name))
+	if len(dns.Answers) == 0 && !blocked {
 		return
 	}
 	ipLayer := packet.Layer(layers.LayerTypeIPv4)
@@ -734,9 +740,18 @@ func (lc *LinuxCollector) processDNSPacketInfo(
 		DNSReq: types.DNSReq{
 			HostName:    string(dnsQ.Name),
 			RequestTime: time.Now().UnixNano(),
+			Blocked:     blocked,
 		},
 		appIP: dstIP,
 	}
+	if blocked {
+		if dstIP.To4() != nil {
+			niInfo.ipv4DNSReqs = append(niInfo.ipv4DNSReqs, dnsReq)
+		} else {
+			niInfo.ipv6DNSReqs = append(niInfo.ipv6DNSReqs, dnsReq)
+		}
+		return
+	}
 	for _, dnsA := range dns.Answers {
 		if dnsA.Type != layers.DNSTypeA && dnsA.Type != layers.DNSTypeAAAA {
 			continue
@@ -759,6 +774,28 @@ func (lc *LinuxCollector) processDNSPacketInfo(
 	}
 }
 
+// isBlockedByDNSFilter returns true if DNS query for the given host name
+// is blocked by the DNS filter of the network instance.
+// Domains with conditional forwarding are never blocked by the allowlist.
+func isBlockedByDNSFilter(niConfig types.NetworkInstanceConfig, hostname string) bool {
+	filter := niConfig.DNSFilter
+	if !filter.Blocks(hostname) {
+		return false
+	}
+	if filter.Mode == types.DNSFilterModeAllowlist {
+		for _, forwarder := range niConfig.DNSForwarders {
+			allowed := types.DNSFilter{
+				Mode:    types.DNSFilterModeAllowlist,
+				Domains: []string{forwarder.Domain},
+			}
+			if !allowed.Blocks(hostname) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func isAddrPresent(list []net.IP, addr net.IP) bool {
 	for i := 0; i < len(list); i++ {
 		if addr.Equal(list[i]) {
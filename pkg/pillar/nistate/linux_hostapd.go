@@ -0,0 +1,251 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nistate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// How often are clients associated with WiFi access points listed.
+	wifiAPPollInterval = 10 * time.Second
+	// Timeout for a request sent to the hostapd control interface.
+	hostapdCtrlTimeout = 3 * time.Second
+)
+
+// Used to generate unique names for local sockets of hostapd control connections.
+var hostapdCtrlConnCounter uint32
+
+// Station associated with a WiFi access point, as reported by hostapd.
+type hostapdStation struct {
+	macAddr       net.HardwareAddr
+	connectedTime time.Duration
+	rssi          int32
+}
+
+// getWifiAPStations obtains the list of stations associated with the access point
+// operated by hostapd on the given WiFi interface.
+// Communicates with hostapd over its control interface using STA-FIRST
+// and STA-NEXT commands (same as "hostapd_cli all_sta").
+func getWifiAPStations(wlanIfName string) (stations []hostapdStation, err error) {
+	ctrlSock := devicenetwork.HostapdCtrlSocketPath(wlanIfName)
+	if _, err = os.Stat(ctrlSock); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// hostapd is not running (yet), no clients.
+			return nil, nil
+		}
+		return nil, err
+	}
+	localSock := filepath.Join(os.TempDir(), fmt.Sprintf("nistate-hostapd-%d-%d",
+		os.Getpid(), atomic.AddUint32(&hostapdCtrlConnCounter, 1)))
+	conn, err := net.DialUnix("unixgram",
+		&net.UnixAddr{Name: localSock, Net: "unixgram"},
+		&net.UnixAddr{Name: ctrlSock, Net: "unixgram"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to hostapd control socket %s: %w",
+			ctrlSock, err)
+	}
+	defer os.Remove(localSock)
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(hostapdCtrlTimeout)); err != nil {
+		return nil, err
+	}
+	request := "STA-FIRST"
+	buf := make([]byte, 4096)
+	for {
+		if _, err = conn.Write([]byte(request)); err != nil {
+			return nil, fmt.Errorf("failed to send %s to hostapd: %w", request, err)
+		}
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s response from hostapd: %w",
+				request, err)
+		}
+		station, ok := parseHostapdStation(string(buf[:n]))
+		if !ok {
+			// No more stations.
+			return stations, nil
+		}
+		stations = append(stations, station)
+		request = "STA-NEXT " + station.macAddr.String()
+	}
+}
+
+// parseHostapdStation parses response to STA-FIRST/STA-NEXT command.
+// The first line contains MAC address of the station, the rest are key=value pairs.
+func parseHostapdStation(response string) (station hostapdStation, ok bool) {
+	lines := strings.Split(strings.TrimSpace(response), "\n")
+	if len(lines) == 0 {
+		return station, false
+	}
+	macAddr, err := net.ParseMAC(strings.TrimSpace(lines[0]))
+	if err != nil {
+		// Empty response or "FAIL".
+		return station, false
+	}
+	station.macAddr = macAddr
+	for _, line := range lines[1:] {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "connected_time":
+			if secs, err := strconv.ParseUint(value, 10, 32); err == nil {
+				station.connectedTime = time.Duration(secs) * time.Second
+			}
+		case "signal":
+			if rssi, err := strconv.ParseInt(value, 10, 32); err == nil {
+				station.rssi = int32(rssi)
+			}
+		}
+	}
+	return station, true
+}
+
+// pollWifiAPClients lists stations of all WiFi access points bridged into network
+// instances and returns updates for network instances with changed client list.
+func (lc *LinuxCollector) pollWifiAPClients() (updates []WifiAPClientsUpdate) {
+	lc.mu.Lock()
+	wlanIfNames := make(map[uuid.UUID]string)
+	for niID, ni := range lc.nis {
+		if ni.bridge.WifiAPIfName != "" {
+			wlanIfNames[niID] = ni.bridge.WifiAPIfName
+		}
+	}
+	lc.mu.Unlock()
+
+	// Do not hold the lock while communicating with hostapd.
+	niStations := make(map[uuid.UUID][]hostapdStation)
+	for niID, wlanIfName := range wlanIfNames {
+		stations, err := getWifiAPStations(wlanIfName)
+		if err != nil {
+			lc.log.Warnf("%s: failed to get stations of WiFi AP %s (NI %s): %v",
+				LogAndErrPrefix, wlanIfName, niID, err)
+			continue
+		}
+		niStations[niID] = stations
+	}
+
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	now := time.Now()
+	for niID, ni := range lc.nis {
+		stations, polled := niStations[niID]
+		if !polled && ni.bridge.WifiAPIfName != "" {
+			// Failed to poll stations, keep the previous state.
+			continue
+		}
+		var clients []types.WifiAPClient
+		for _, station := range stations {
+			client := types.WifiAPClient{
+				MACAddr:      station.macAddr,
+				AssociatedAt: now.Add(-station.connectedTime).Truncate(time.Second),
+				RSSI:         station.rssi,
+			}
+			// Preserve association time to avoid jitter.
+			for _, prevClient := range ni.wifiAPClients {
+				if bytes.Equal(prevClient.MACAddr, client.MACAddr) {
+					client.AssociatedAt = prevClient.AssociatedAt
+					break
+				}
+			}
+			for _, lease := range ni.ipLeases {
+				if bytes.Equal(lease.macAddr, client.MACAddr) && lease.removedAt.IsZero() {
+					client.IPAddrs = append(client.IPAddrs, lease.ipAddr)
+					client.Hostname = lease.hostname
+				}
+			}
+			clients = append(clients, client)
+		}
+		if equalWifiAPClients(ni.wifiAPClients, clients) {
+			// Only RSSI may have changed, which is not worth publishing.
+			ni.wifiAPClients = clients
+			continue
+		}
+		lc.logWifiAPClientChanges(niID, ni.bridge.WifiAPIfName, ni.wifiAPClients, clients)
+		ni.wifiAPClients = clients
+		updates = append(updates, WifiAPClientsUpdate{
+			NI:      niID,
+			Clients: clients,
+		})
+	}
+	return updates
+}
+
+func (lc *LinuxCollector) logWifiAPClientChanges(niID uuid.UUID, wlanIfName string,
+	prevClients, newClients []types.WifiAPClient) {
+	for _, client := range newClients {
+		var prevClient *types.WifiAPClient
+		for i := range prevClients {
+			if bytes.Equal(prevClients[i].MACAddr, client.MACAddr) {
+				prevClient = &prevClients[i]
+				break
+			}
+		}
+		if prevClient == nil {
+			lc.log.Noticef("%s: WiFi client %s associated with AP %s (NI %s)",
+				LogAndErrPrefix, client.MACAddr, wlanIfName, niID)
+		} else if !equalWifiAPClient(*prevClient, client) {
+			lc.log.Noticef("%s: WiFi client %s of AP %s (NI %s) changed, "+
+				"IPs: %v, hostname: %s", LogAndErrPrefix, client.MACAddr, wlanIfName,
+				niID, client.IPAddrs, client.Hostname)
+		}
+	}
+	for _, prevClient := range prevClients {
+		var found bool
+		for _, client := range newClients {
+			if bytes.Equal(prevClient.MACAddr, client.MACAddr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			lc.log.Noticef("%s: WiFi client %s disassociated from AP %s (NI %s)",
+				LogAndErrPrefix, prevClient.MACAddr, wlanIfName, niID)
+		}
+	}
+}
+
+// Compare two WiFi clients, ignoring RSSI.
+func equalWifiAPClient(c1, c2 types.WifiAPClient) bool {
+	if !bytes.Equal(c1.MACAddr, c2.MACAddr) ||
+		!c1.AssociatedAt.Equal(c2.AssociatedAt) ||
+		c1.Hostname != c2.Hostname ||
+		len(c1.IPAddrs) != len(c2.IPAddrs) {
+		return false
+	}
+	for i := range c1.IPAddrs {
+		if !c1.IPAddrs[i].Equal(c2.IPAddrs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare two lists of WiFi clients, ignoring RSSI.
+func equalWifiAPClients(list1, list2 []types.WifiAPClient) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if !equalWifiAPClient(list1[i], list2[i]) {
+			return false
+		}
+	}
+	return true
+}
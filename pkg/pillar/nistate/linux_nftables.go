@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nistate
+
+import (
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
+)
+
+// fetchNftablesCounters is used instead of fetchIptablesCounters when
+// the nftables firewall backend is enabled.
+// Rule counters of both IPv4 and IPv6 tables are obtained using a single nft
+// command per table.
+func (lc *LinuxCollector) fetchNftablesCounters() []aclCounters {
+	// Note that ACLs are split into per-VIF chains
+	// (see nireconciler/linux_acl.go, function vifChain).
+	type vifChain struct {
+		table  string
+		chain  string
+		ifName string
+		bridge string
+	}
+	chains := make(map[string]vifChain) // key: nftables chain name
+	for _, niState := range lc.nis {
+		for _, niVif := range niState.vifs {
+			for _, c := range []vifChain{
+				{table: "filter", chain: "FORWARD"},
+				{table: "raw", chain: "PREROUTING"},
+			} {
+				c.ifName = niVif.VIF.HostIfName
+				c.bridge = niState.bridge.BrIfName
+				chains[nftables.ChainName(c.table, c.chain+"-"+c.ifName)] = c
+			}
+		}
+	}
+	var counters []aclCounters
+	for ipVer, family := range map[int]nftables.Family{
+		4: nftables.FamilyIPv4,
+		6: nftables.FamilyIPv6,
+	} {
+		rules, err := nftables.ListRules(nil, family, "")
+		if err != nil {
+			lc.log.Errorf("%s: fetchNftablesCounters: nft list failed: %v",
+				LogAndErrPrefix, err)
+			continue
+		}
+		for _, rule := range rules {
+			c, isVifChain := chains[rule.Chain]
+			if !isVifChain {
+				continue
+			}
+			ac := aclCounters{
+				table:  c.table,
+				chain:  c.chain,
+				ipVer:  ipVer,
+				log:    rule.HasStatement("log"),
+				drop:   rule.HasStatement("drop"),
+				limit:  rule.HasStatement("limit"),
+				accept: rule.HasStatement("accept"),
+				bytes:  rule.Bytes,
+				pkts:   rule.Packets,
+			}
+			if c.chain == "FORWARD" {
+				ac.outIf = c.bridge
+				ac.pOutIf = c.ifName
+			} else {
+				ac.inIf = c.bridge
+				ac.pInIf = c.ifName
+			}
+			counters = append(counters, ac)
+		}
+	}
+	return counters
+}
@@ -40,7 +40,7 @@ type Collector interface {
 	// Note that not every change in network instance config is supported. For example,
 	// network instance type (switch / local) cannot change.
 	UpdateCollectingForNI(
-		niConfig types.NetworkInstanceConfig, vifs []AppVIF) error
+		niConfig types.NetworkInstanceConfig, br NIBridge, vifs []AppVIF) error
 
 	// StopCollectingForNI : stop collecting state data for network instance.
 	// It is called by zedrouter whenever a network instance is about to be deleted.
@@ -67,6 +67,11 @@ type Collector interface {
 	// applications and remote endpoints.
 	WatchFlows() <-chan types.IPFlow
 
+	// WatchWifiAPClients : watch for changes in the set of clients associated with
+	// WiFi access points bridged into network instances.
+	// Note that a change in RSSI alone is not reported.
+	WatchWifiAPClients() <-chan []WifiAPClientsUpdate
+
 	// TODO: Maybe add something like GetVIFHealth(), returning info like:
 	//           - interface exists?
 	//           - is bridged?
@@ -97,6 +102,9 @@ type NIBridge struct {
 	// BrIfMAC : MAC address assigned to the bridge.
 	// MAC address is generated by zedrouter from BrNum.
 	BrIfMAC net.HardwareAddr
+	// WifiAPIfName : name of the WiFi interface operated in the access point mode
+	// and bridged into the network instance. Empty if WiFi AP is not used.
+	WifiAPIfName string
 }
 
 // AppVIF : describes interface created to connect application with network instance.
@@ -184,6 +192,13 @@ type VIFAddrsUpdate struct {
 	New  VIFAddrs
 }
 
+// WifiAPClientsUpdate lists clients currently associated with the WiFi access point
+// bridged into a given network instance. This is provided to zedrouter by Collector.
+type WifiAPClientsUpdate struct {
+	NI      uuid.UUID
+	Clients []types.WifiAPClient
+}
+
 // ErrUnknownNI is returned for requests targeting network instances not known
 // to the state collector.
 type ErrUnknownNI struct {
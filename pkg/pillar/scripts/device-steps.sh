@@ -18,7 +18,7 @@ ZTMPDIR=/run/global
 DPCDIR=$ZTMPDIR/DevicePortConfig
 FIRSTBOOTFILE=$ZTMPDIR/first-boot
 FIRSTBOOT=
-AGENTS="diag zedagent ledmanager nim nodeagent domainmgr loguploader tpmmgr vaultmgr zedmanager zedrouter downloader verifier baseosmgr wstunnelclient volumemgr watcher zfsmanager"
+AGENTS="diag zedagent ledmanager nim nodeagent domainmgr loguploader tpmmgr vaultmgr zedmanager zedrouter downloader verifier baseosmgr wstunnelclient volumemgr watcher zfsmanager timesync"
 TPM_DEVICE_PATH="/dev/tpmrm0"
 PATH=$BINDIR:$PATH
 TPMINFOTEMPFILE=/var/tmp/tpminfo.txt
@@ -253,13 +253,16 @@ if [ ! -s "$DEVICE_CERT_NAME" ] || [ $RTC = 0 ] || [ -n "$FIRSTBOOT" ]; then
         /usr/sbin/ntpd -q -n -g -p "$NTPSERVER"
         ret_code=$?
         echo "$(date -Ins -u) ntpd: $ret_code"
-        # Run ntpd to keep it in sync.
-        echo "$(date -Ins -u) ntpd -p $NTPSERVER"
-        /usr/sbin/ntpd -p "$NTPSERVER"
-        ret_code=$?
-        echo "$(date -Ins -u) ntpd: $ret_code"
-        # Add ndpd to watchdog
-        touch "$WATCHDOG_PID/ntpd.pid"
+        # Keep the clock in sync. If chronyd is available this is done
+        # by timesync agent.
+        if [ ! -f /usr/sbin/chronyd ]; then
+            echo "$(date -Ins -u) ntpd -p $NTPSERVER"
+            /usr/sbin/ntpd -p "$NTPSERVER"
+            ret_code=$?
+            echo "$(date -Ins -u) ntpd: $ret_code"
+            # Add ndpd to watchdog
+            touch "$WATCHDOG_PID/ntpd.pid"
+        fi
     else
         echo "$(date -Ins -u) No ntpd"
     fi
@@ -283,7 +286,9 @@ else
     # If DHCP isn't done we don't get a server here. So we recheck
     # at the end of device-steps.sh
     NTPSERVER=$(get_ntp_server)
-    if [ -f /usr/sbin/ntpd ]; then
+    if [ -f /usr/sbin/chronyd ]; then
+        echo "$(date -Ins -u) Clock is synchronized by timesync"
+    elif [ -f /usr/sbin/ntpd ]; then
         # Run ntpd to keep it in sync. Allow a large initial jump in case clock
         # had drifted more than 1000 seconds while the device was powered off
         echo "$(date -Ins -u) ntpd -g -p $NTPSERVER"
@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// AppContainerType : role of an additional container of an app instance.
+// Must match the definition in appconfig.proto
+type AppContainerType uint8
+
+const (
+	// AppContainerTypeUnspecified : treated as AppContainerSidecar
+	AppContainerTypeUnspecified AppContainerType = iota
+	// AppContainerSidecar : runs alongside the main container
+	AppContainerSidecar
+	// AppContainerInit : runs to completion before the main container is started
+	AppContainerInit
+)
+
+// String returns the container type as a string.
+func (t AppContainerType) String() string {
+	switch t {
+	case AppContainerTypeUnspecified:
+		return "unspecified"
+	case AppContainerSidecar:
+		return "sidecar"
+	case AppContainerInit:
+		return "init"
+	default:
+		return fmt.Sprintf("unknown container type %d", t)
+	}
+}
+
+// AppContainerRestartPolicy : when to restart an additional container
+// which has exited.
+// Must match the definition in appconfig.proto
+type AppContainerRestartPolicy uint8
+
+const (
+	// AppContainerRestartUnspecified : Always for sidecars, OnFailure
+	// for init containers
+	AppContainerRestartUnspecified AppContainerRestartPolicy = iota
+	// AppContainerRestartAlways : restart whenever the container exits
+	AppContainerRestartAlways
+	// AppContainerRestartOnFailure : restart if the container exits
+	// with a non-zero status
+	AppContainerRestartOnFailure
+	// AppContainerRestartNever : never restart the container
+	AppContainerRestartNever
+)
+
+// String returns the restart policy as a string.
+func (p AppContainerRestartPolicy) String() string {
+	switch p {
+	case AppContainerRestartUnspecified:
+		return "unspecified"
+	case AppContainerRestartAlways:
+		return "always"
+	case AppContainerRestartOnFailure:
+		return "on-failure"
+	case AppContainerRestartNever:
+		return "never"
+	default:
+		return fmt.Sprintf("unknown restart policy %d", p)
+	}
+}
+
+// ShouldRestart returns true if a container which exited with the given
+// status should be restarted.
+func (p AppContainerRestartPolicy) ShouldRestart(exitCode int) bool {
+	switch p {
+	case AppContainerRestartAlways:
+		return true
+	case AppContainerRestartOnFailure:
+		return exitCode != 0
+	default:
+		return false
+	}
+}
+
+// AppContainerConfig : additional container (sidecar or init container)
+// of a container app instance. It shares namespaces and volumes
+// with the main container created from the first volume.
+type AppContainerConfig struct {
+	// Name is unique within the app instance.
+	Name string
+	// VolumeID references the volume with the container image.
+	VolumeID uuid.UUID
+	// VolumeKey is the key of the VolumeRefStatus of the volume;
+	// set by zedmanager in DomainConfig.
+	VolumeKey     string
+	Type          AppContainerType
+	RestartPolicy AppContainerRestartPolicy
+	// Command overrides ENTRYPOINT and CMD of the image if not empty.
+	Command []string
+	Env     map[string]string
+}
+
+// AppContainerStatus : status of an additional container of an app instance.
+type AppContainerStatus struct {
+	Name  string
+	Type  AppContainerType
+	State SwState
+	// RestartCount is the number of restarts made according
+	// to the restart policy.
+	RestartCount uint32
+	// ExitCode of the last run of the container.
+	ExitCode int
+}
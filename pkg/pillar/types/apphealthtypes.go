@@ -0,0 +1,266 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/info"
+)
+
+// Defaults for AppProbeConfig and AppHealthConfig fields left unset
+// by the controller.
+const (
+	DefaultAppProbePeriod           = 10 * time.Second
+	DefaultAppProbeTimeout          = time.Second
+	DefaultAppProbeFailureThreshold = 3
+	DefaultAppProbeSuccessThreshold = 1
+	DefaultAppHealthBackoffInitial  = 10 * time.Second
+	DefaultAppHealthBackoffMax      = 5 * time.Minute
+)
+
+// AppProbeType : method used by a health probe to check an application.
+// Must match the definition in appconfig.proto
+type AppProbeType uint8
+
+const (
+	// AppProbeNone : probe is not configured
+	AppProbeNone AppProbeType = iota
+	// AppProbeHTTP : HTTP GET request
+	AppProbeHTTP
+	// AppProbeTCP : TCP connect
+	AppProbeTCP
+	// AppProbeExec : command executed inside the application container
+	AppProbeExec
+	// AppProbeGuestAgent : guest-ping sent to qemu-guest-agent
+	AppProbeGuestAgent
+)
+
+// String returns the probe type as a string.
+func (t AppProbeType) String() string {
+	switch t {
+	case AppProbeNone:
+		return "none"
+	case AppProbeHTTP:
+		return "http"
+	case AppProbeTCP:
+		return "tcp"
+	case AppProbeExec:
+		return "exec"
+	case AppProbeGuestAgent:
+		return "guest-agent"
+	default:
+		return fmt.Sprintf("unknown probe type %d", t)
+	}
+}
+
+// AppHealthAction : action taken when the liveness probe of an application fails.
+// Must match the definition in appconfig.proto
+type AppHealthAction uint8
+
+const (
+	// AppHealthActionNone : only report the failure
+	AppHealthActionNone AppHealthAction = iota
+	// AppHealthActionRestart : restart the application
+	AppHealthActionRestart
+	// AppHealthActionPurge : purge the application
+	AppHealthActionPurge
+	// AppHealthActionRollback : roll back the application to the latest snapshot
+	AppHealthActionRollback
+)
+
+// String returns the action as a string.
+func (a AppHealthAction) String() string {
+	switch a {
+	case AppHealthActionNone:
+		return "none"
+	case AppHealthActionRestart:
+		return "restart"
+	case AppHealthActionPurge:
+		return "purge"
+	case AppHealthActionRollback:
+		return "rollback"
+	default:
+		return fmt.Sprintf("unknown action %d", a)
+	}
+}
+
+// ZInfoAppHealthAction converts the action to the corresponding info enum value.
+func (a AppHealthAction) ZInfoAppHealthAction() info.AppHealthActionType {
+	switch a {
+	case AppHealthActionRestart:
+		return info.AppHealthActionType_APP_HEALTH_ACTION_TYPE_RESTART
+	case AppHealthActionPurge:
+		return info.AppHealthActionType_APP_HEALTH_ACTION_TYPE_PURGE
+	case AppHealthActionRollback:
+		return info.AppHealthActionType_APP_HEALTH_ACTION_TYPE_ROLLBACK
+	default:
+		return info.AppHealthActionType_APP_HEALTH_ACTION_TYPE_NONE
+	}
+}
+
+// AppProbeConfig : configuration of a single application health probe.
+type AppProbeConfig struct {
+	Type AppProbeType
+	// Port and Path are used by HTTP and TCP probes.
+	Port  uint16
+	Path  string
+	HTTPS bool
+	// Command is used by the exec probe.
+	Command []string
+	// AdapterIndex selects the application network adapter whose IP address
+	// is probed by HTTP and TCP probes.
+	AdapterIndex     int
+	InitialDelay     time.Duration
+	Period           time.Duration
+	Timeout          time.Duration
+	FailureThreshold uint32
+	SuccessThreshold uint32
+}
+
+// Enabled returns true if the probe is configured.
+func (probe AppProbeConfig) Enabled() bool {
+	return probe.Type != AppProbeNone
+}
+
+// AppHealthConfig : health checking of an application instance.
+type AppHealthConfig struct {
+	Liveness       AppProbeConfig
+	Readiness      AppProbeConfig
+	Action         AppHealthAction
+	BackoffInitial time.Duration
+	BackoffMax     time.Duration
+}
+
+// Enabled returns true if at least one probe is configured.
+func (config AppHealthConfig) Enabled() bool {
+	return config.Liveness.Enabled() || config.Readiness.Enabled()
+}
+
+// NextBackoff returns the backoff to apply after the next action given
+// the current backoff.
+func (config AppHealthConfig) NextBackoff(current time.Duration) time.Duration {
+	if current == 0 {
+		return config.BackoffInitial
+	}
+	next := 2 * current
+	if next > config.BackoffMax {
+		next = config.BackoffMax
+	}
+	return next
+}
+
+// AppProbeState : result of an application health probe.
+type AppProbeState uint8
+
+const (
+	// AppProbeStateUnspecified : probe is not configured
+	AppProbeStateUnspecified AppProbeState = iota
+	// AppProbeStatePending : application is not running or the probe
+	// has not yet concluded
+	AppProbeStatePending
+	// AppProbeStateSuccess : probe succeeds
+	AppProbeStateSuccess
+	// AppProbeStateFailure : probe fails
+	AppProbeStateFailure
+)
+
+// String returns the probe state as a string.
+func (s AppProbeState) String() string {
+	switch s {
+	case AppProbeStateUnspecified:
+		return "unspecified"
+	case AppProbeStatePending:
+		return "pending"
+	case AppProbeStateSuccess:
+		return "success"
+	case AppProbeStateFailure:
+		return "failure"
+	default:
+		return fmt.Sprintf("unknown probe state %d", s)
+	}
+}
+
+// ZInfoAppProbeState converts the probe state to the corresponding info enum value.
+func (s AppProbeState) ZInfoAppProbeState() info.AppProbeState {
+	switch s {
+	case AppProbeStatePending:
+		return info.AppProbeState_APP_PROBE_STATE_PENDING
+	case AppProbeStateSuccess:
+		return info.AppProbeState_APP_PROBE_STATE_SUCCESS
+	case AppProbeStateFailure:
+		return info.AppProbeState_APP_PROBE_STATE_FAILURE
+	default:
+		return info.AppProbeState_APP_PROBE_STATE_UNSPECIFIED
+	}
+}
+
+// AppProbeStatus : status of a single application health probe.
+type AppProbeStatus struct {
+	State AppProbeState
+	// LastError is the error returned by the last failed probe.
+	LastError           string
+	LastTransitionTime  time.Time
+	ConsecutiveFailures uint32
+}
+
+// AppHealthStatus : health of an application instance as determined
+// by the probes from AppHealthConfig.
+type AppHealthStatus struct {
+	Liveness  AppProbeStatus
+	Readiness AppProbeStatus
+	// Ready is set when the application is running and the readiness probe
+	// (if configured) succeeds.
+	Ready bool
+	// ActionCount is the number of actions taken because the liveness
+	// probe failed.
+	ActionCount    uint32
+	LastAction     AppHealthAction
+	LastActionTime time.Time
+	// Backoff is the minimum time between the last and the next action.
+	Backoff time.Duration
+	// PurgeRequestedAt is set by zedmanager to ask zedagent to purge
+	// the application (purge requires re-creating volumes, which is driven
+	// by zedagent).
+	PurgeRequestedAt time.Time
+}
+
+// Enabled returns true if the health of the application is being checked.
+func (status AppHealthStatus) Enabled() bool {
+	return status.Liveness.State != AppProbeStateUnspecified ||
+		status.Readiness.State != AppProbeStateUnspecified
+}
+
+// IsReady returns true if the application instance is running and ready
+// to serve. Applications without a readiness probe are ready as soon as
+// they are running.
+func (status AppInstanceStatus) IsReady() bool {
+	if status.State != RUNNING {
+		return false
+	}
+	if status.Health.Readiness.State == AppProbeStateUnspecified {
+		return true
+	}
+	return status.Health.Ready
+}
+
+// GetAdapterIP returns the IP address of the application network adapter
+// with the given index (IPv4 is preferred).
+func (status AppInstanceStatus) GetAdapterIP(index int) net.IP {
+	if index < 0 || index >= len(status.UnderlayNetworks) {
+		return nil
+	}
+	ul := status.UnderlayNetworks[index]
+	if ul.AllocatedIPv4Addr != nil && !ul.AllocatedIPv4Addr.IsUnspecified() {
+		return ul.AllocatedIPv4Addr
+	}
+	for _, ip := range ul.AllocatedIPv6List {
+		if ip != nil && !ip.IsUnspecified() {
+			return ip
+		}
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
+	"regexp"
 
 	"github.com/google/go-cmp/cmp"
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -144,4 +146,58 @@ type EncryptionBlock struct {
 	WifiUserName      string // If the authentication type is EAP
 	WifiPassword      string
 	ProtectedUserData string
+	// Cellular network credentials and SIM PIN.
+	CellularNetUsername string
+	CellularNetPassword string
+	CellularSimPin      string
+	// Credentials for 802.1X (PEAP) authentication of a wired port.
+	PortAuthUsername string
+	PortAuthPassword string
+	// Credentials for authentication with a network proxy.
+	ProxyUsername string
+	ProxyPassword string
+	// Secrets of an app instance.
+	AppSecrets []AppSecret
+}
+
+// AppSecret : named secret of an app instance
+type AppSecret struct {
+	Name  string
+	Value []byte
+	// EnvName is the environment variable of containers set to the secret
+	EnvName string
+}
+
+var (
+	appSecretNameRe    = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9._-]*$`)
+	appSecretEnvNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// ValidateAppSecrets checks that the secrets have unique names usable
+// as file names and valid environment variable names (if any).
+func ValidateAppSecrets(secrets []AppSecret) error {
+	names := make(map[string]struct{}, len(secrets))
+	envNames := make(map[string]struct{})
+	for _, secret := range secrets {
+		if len(secret.Name) > 255 || !appSecretNameRe.MatchString(secret.Name) {
+			return fmt.Errorf("invalid secret name %q", secret.Name)
+		}
+		if _, exists := names[secret.Name]; exists {
+			return fmt.Errorf("duplicate secret name %q", secret.Name)
+		}
+		names[secret.Name] = struct{}{}
+		if secret.EnvName == "" {
+			continue
+		}
+		if !appSecretEnvNameRe.MatchString(secret.EnvName) {
+			return fmt.Errorf("invalid environment variable %q of secret %q",
+				secret.EnvName, secret.Name)
+		}
+		if _, exists := envNames[secret.EnvName]; exists {
+			return fmt.Errorf("duplicate environment variable %q of secret %q",
+				secret.EnvName, secret.Name)
+		}
+		envNames[secret.EnvName] = struct{}{}
+	}
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -54,6 +55,18 @@ type DomainConfig struct {
 	// once the version is changed cloud-init tool restarts in a guest.
 	// See getCloudInitVersion() and createCloudInitISO() for details.
 	CloudInitVersion uint32
+
+	// PurgeCounter is incremented when the app instance is purged.
+	// Used to discard the state of the virtual TPM.
+	PurgeCounter uint32
+
+	// Containers are additional containers (sidecars and init containers)
+	// of a container domain. Only supported by tasks running containers
+	// without hypervisor.
+	Containers []AppContainerConfig
+
+	// SecretsCipherBlock, for encrypted secrets (EncryptionBlock.AppSecrets)
+	SecretsCipherBlock CipherBlockStatus
 }
 
 // MetaDataType of metadata service for app
@@ -66,6 +79,9 @@ const (
 	MetaDataNone
 	MetaDataOpenStack
 	MetaDataDriveMultipart // Process multipart MIME for application
+	MetaDataIgnition       // Ignition config in fw_cfg and meta-data server
+	MetaDataNoCloud        // NoCloud ISO image
+	MetaDataConfigDrive    // OpenStack ConfigDrive v2 ISO image
 )
 
 // String returns the string name
@@ -79,6 +95,12 @@ func (metaDataType MetaDataType) String() string {
 		return "MetaDataOpenStack"
 	case MetaDataDriveMultipart:
 		return "MetaDataDriveMultipart"
+	case MetaDataIgnition:
+		return "MetaDataIgnition"
+	case MetaDataNoCloud:
+		return "MetaDataNoCloud"
+	case MetaDataConfigDrive:
+		return "MetaDataConfigDrive"
 	default:
 		return fmt.Sprintf("Unknown MetaDataType %d", metaDataType)
 	}
@@ -227,6 +249,47 @@ type VmConfig struct {
 	VncPasswd          string
 	CPUsPinned         bool
 	VMMMaxMem          int // in kbytes
+	Limits             VmResourceLimits
+	VTPM               VmTPMModel
+}
+
+// VmTPMModel : virtual TPM 2.0 device attached to a VM.
+// Must match the definition in vm.proto
+type VmTPMModel uint8
+
+const (
+	// VmTPMNone : no virtual TPM
+	VmTPMNone VmTPMModel = iota
+	// VmTPMCRB : TPM with Command Response Buffer interface
+	VmTPMCRB
+	// VmTPMTIS : TPM with TPM Interface Specification interface
+	VmTPMTIS
+)
+
+// AppVTPMStateDir returns the directory holding the state of the
+// virtual TPM of the app instance.
+func AppVTPMStateDir(appUUID uuid.UUID) string {
+	return filepath.Join(AppVTPMStateDirname, appUUID.String())
+}
+
+// AppSecretsDir returns the tmpfs directory holding the secrets of
+// the app instance, which is mounted into its containers.
+func AppSecretsDir(appUUID uuid.UUID) string {
+	return filepath.Join(AppSecretsDirname, appUUID.String())
+}
+
+// VmResourceLimits : cgroup limits of CPU, disk I/O and number of processes
+// of a domain. Zero values mean no limit or the default.
+// For VMs the limits apply to the hypervisor processes running the VM.
+type VmResourceLimits struct {
+	CPUMaxMilliCPUs uint32 // CPU bandwidth in thousandths of a CPU
+	CPUWeight       uint32 // 1-10000, default 100
+	PidsMax         uint32
+	// Disk limits applied to every block device holding volumes of the domain
+	IOReadBps   uint64
+	IOWriteBps  uint64
+	IOReadIOPS  uint32
+	IOWriteIOPS uint32
 }
 
 type VmMode uint8
@@ -282,6 +345,20 @@ type DomainStatus struct {
 	EnvVariables   map[string]string // List of environment variables to be set in container
 	VmConfig                         // From DomainConfig
 	Service        bool
+	// Status of the additional containers from DomainConfig.Containers
+	ContainerStatusList []AppContainerStatus
+	// Information reported by the guest agent; nil if there is no agent
+	GuestAgent *GuestAgentInfo
+	// SecretsDir is the directory with secrets mounted into containers;
+	// empty if the domain has no secrets
+	SecretsDir string
+	// SecretEnvNames maps environment variables of containers to the names
+	// of the secrets in SecretsDir. The values are read from SecretsDir only
+	// when the OCI spec is created, the status never holds them.
+	SecretEnvNames map[string]string
+	// IgnitionFile is the Ignition config passed to the domain in
+	// the firmware config (MetaDataIgnition); empty if there is none
+	IgnitionFile string
 }
 
 func (status DomainStatus) Key() string {
@@ -428,6 +505,21 @@ type DomainMetric struct {
 	UsedMemoryPercent float64
 	LastHeard         time.Time
 	Activated         bool
+	Cgroup            DomainCgroupMetric
+}
+
+// DomainCgroupMetric : resource usage and throttling of the cgroup
+// of a domain, see VmResourceLimits. Counters are cumulative.
+type DomainCgroupMetric struct {
+	CPUPeriods          uint64 // CPU bandwidth enforcement periods elapsed
+	CPUThrottledPeriods uint64 // periods in which the cgroup was throttled
+	CPUThrottledNs      uint64 // total time the cgroup was throttled
+	Pids                uint64 // current number of processes and threads
+	PidsMax             uint64 // 0 if not limited
+	IOReadBytes         uint64
+	IOWriteBytes        uint64
+	IOReadOps           uint64
+	IOWriteOps          uint64
 }
 
 // Key returns the key for pubsub
@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -239,6 +240,12 @@ const (
 	AllowLogFastupload GlobalSettingKey = "newlog.allow.fastupload"
 	// EnableARPSnoopOnNI global setting key
 	EnableARPSnoop GlobalSettingKey = "network.switch.enable.arpsnoop"
+	// NetworkLLDPTransmit : advertise the device to the directly connected
+	// network devices by sending LLDP frames from every physical port.
+	NetworkLLDPTransmit GlobalSettingKey = "network.lldp.transmit"
+	// NetworkEncryptedDNSFallback : allow to fall back to plain DNS if none
+	// of the encrypted DNS resolvers is available.
+	NetworkEncryptedDNSFallback GlobalSettingKey = "network.dns.encrypted.fallback"
 
 	// TriState Items
 	// NetworkFallbackAnyEth global setting key
@@ -256,6 +263,17 @@ const (
 	DefaultLogLevel GlobalSettingKey = "debug.default.loglevel"
 	// DefaultRemoteLogLevel global setting key
 	DefaultRemoteLogLevel GlobalSettingKey = "debug.default.remote.loglevel"
+	// NetworkEncryptedDNSProtocol : protocol used to encrypt DNS queries sent
+	// by EVE to resolve the controller and datastore domain names.
+	// One of "none", "dot" (DNS-over-TLS) or "doh" (DNS-over-HTTPS).
+	NetworkEncryptedDNSProtocol GlobalSettingKey = "network.dns.encrypted.protocol"
+	// NetworkEncryptedDNSServers : comma-separated list of encrypted DNS resolvers.
+	// If empty, built-in public resolvers are used.
+	NetworkEncryptedDNSServers GlobalSettingKey = "network.dns.encrypted.servers"
+	// NetworkCaptivePortalProbeURL : HTTP endpoint used to detect captive portals
+	// on management ports. The endpoint is expected to return "204 No Content".
+	// Empty value disables captive portal detection.
+	NetworkCaptivePortalProbeURL GlobalSettingKey = "network.captive.portal.probe.url"
 
 	// XXX Temporary flag to disable RFC 3442 classless static route usage
 	DisableDHCPAllOnesNetMask GlobalSettingKey = "debug.disable.dhcp.all-ones.netmask"
@@ -285,6 +303,9 @@ const (
 	NetDumpDownloaderPCAP GlobalSettingKey = "netdump.downloader.with.pcap"
 )
 
+// DefaultCaptivePortalProbeURL : default HTTP endpoint used to detect captive portals.
+const DefaultCaptivePortalProbeURL = "http://connectivitycheck.gstatic.com/generate_204"
+
 // AgentSettingKey - keys for per-agent settings
 type AgentSettingKey string
 
@@ -855,6 +876,8 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddBoolItem(ProcessCloudInitMultiPart, false)
 	configItemSpecMap.AddBoolItem(ConsoleAccess, true) // Controller likely default to false
 	configItemSpecMap.AddBoolItem(EnableARPSnoop, true)
+	configItemSpecMap.AddBoolItem(NetworkLLDPTransmit, false)
+	configItemSpecMap.AddBoolItem(NetworkEncryptedDNSFallback, true)
 
 	// Add TriState Items
 	configItemSpecMap.AddTriStateItem(NetworkFallbackAnyEth, TS_DISABLED)
@@ -864,6 +887,11 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddStringItem(SSHAuthorizedKeys, "", blankValidator)
 	configItemSpecMap.AddStringItem(DefaultLogLevel, "info", parseLevel)
 	configItemSpecMap.AddStringItem(DefaultRemoteLogLevel, "info", parseLevel)
+	configItemSpecMap.AddStringItem(NetworkEncryptedDNSProtocol, "none",
+		parseEncryptedDNSProtocol)
+	configItemSpecMap.AddStringItem(NetworkEncryptedDNSServers, "", blankValidator)
+	configItemSpecMap.AddStringItem(NetworkCaptivePortalProbeURL,
+		DefaultCaptivePortalProbeURL, validateCaptivePortalProbeURL)
 
 	// Add Agent Settings
 	configItemSpecMap.AddAgentSettingStringItem(LogLevel, "info", parseLevel)
@@ -887,6 +915,30 @@ func parseLevel(level string) error {
 	return err
 }
 
+// parseEncryptedDNSProtocol - Wrapper that ignores the protocol output
+// of ParseEncryptedDNSProtocol
+func parseEncryptedDNSProtocol(protocol string) error {
+	_, err := ParseEncryptedDNSProtocol(protocol)
+	return err
+}
+
+// validateCaptivePortalProbeURL - Probe URL must be empty or an HTTP URL
+// (the probe relies on captive portals intercepting plain HTTP).
+func validateCaptivePortalProbeURL(probeURL string) error {
+	if probeURL == "" {
+		return nil
+	}
+	u, err := url.Parse(probeURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" || u.Host == "" {
+		return fmt.Errorf("captive portal probe URL %q is not a valid HTTP URL",
+			probeURL)
+	}
+	return nil
+}
+
 // blankValidator - A validator that accepts any string
 func blankValidator(s string) error {
 	return nil
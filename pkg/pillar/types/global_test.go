@@ -188,6 +188,8 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		IgnoreDiskCheckForApps,
 		AllowLogFastupload,
 		EnableARPSnoop,
+		NetworkLLDPTransmit,
+		NetworkEncryptedDNSFallback,
 		// TriState Items
 		NetworkFallbackAnyEth,
 		MaintenanceMode,
@@ -195,6 +197,9 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		SSHAuthorizedKeys,
 		DefaultLogLevel,
 		DefaultRemoteLogLevel,
+		NetworkEncryptedDNSProtocol,
+		NetworkEncryptedDNSServers,
+		NetworkCaptivePortalProbeURL,
 		DisableDHCPAllOnesNetMask,
 		ProcessCloudInitMultiPart,
 		EdgeViewToken,
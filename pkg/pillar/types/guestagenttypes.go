@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"net"
+	"time"
+)
+
+// GuestAgentInfo : information about the guest OS reported by the agent
+// running inside of a VM (qemu-guest-agent).
+type GuestAgentInfo struct {
+	OSName        string
+	OSVersion     string
+	KernelRelease string
+	Hostname      string
+	Interfaces    []GuestInterface
+	Filesystems   []GuestFilesystem
+	// LastUpdate is the time when the information was received from the agent
+	LastUpdate time.Time
+}
+
+// GuestInterface : network interface as seen by the guest OS
+type GuestInterface struct {
+	Name    string
+	MacAddr net.HardwareAddr
+	IPAddrs []net.IP
+}
+
+// GuestFilesystem : mounted filesystem as seen by the guest OS
+type GuestFilesystem struct {
+	MountPoint string
+	Type       string
+	TotalBytes uint64
+	UsedBytes  uint64
+}
+
+// LookupIPAddrs returns the addresses the guest OS has assigned to the
+// interface with the given MAC address.
+func (info *GuestAgentInfo) LookupIPAddrs(macAddr net.HardwareAddr) []net.IP {
+	if info == nil || len(macAddr) == 0 {
+		return nil
+	}
+	for _, intf := range info.Interfaces {
+		if intf.MacAddr.String() == macAddr.String() {
+			return intf.IPAddrs
+		}
+	}
+	return nil
+}
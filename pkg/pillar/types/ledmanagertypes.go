@@ -36,6 +36,8 @@ const (
 	LedBlinkInvalidAuthContainer
 	// LedBlinkInvalidBootstrapConfig - LED indication of bootstrap configuration (bootstrap-config.pb) not being valid.
 	LedBlinkInvalidBootstrapConfig
+	// LedBlinkCaptivePortal - LED indication of management port(s) being behind a captive portal.
+	LedBlinkCaptivePortal
 )
 
 // String returns human-readable description of the state indicated by the particular LED blinking count.
@@ -65,6 +67,8 @@ func (c LedBlinkCount) String() string {
 		return "Response has invalid controller signature"
 	case LedBlinkInvalidBootstrapConfig:
 		return "Invalid Bootstrap configuration"
+	case LedBlinkCaptivePortal:
+		return "Captive portal is blocking access to EV Controller"
 	default:
 		return fmt.Sprintf("Unsupported LED counter (%d)", c)
 	}
@@ -76,11 +80,15 @@ type LedBlinkCounter struct {
 
 // Merge the 1/2 values based on having usable addresses or not, with
 // the value we get based on access to zedcloud or errors.
-func DeriveLedCounter(ledCounter LedBlinkCount, usableAddressCount int, radioSilence bool) LedBlinkCount {
+// Captive portal is indicated only while the device is not connected to the controller.
+func DeriveLedCounter(ledCounter LedBlinkCount, usableAddressCount int,
+	radioSilence, captivePortal bool) LedBlinkCount {
 	if radioSilence {
 		return LedBlinkRadioSilence
 	} else if usableAddressCount == 0 {
 		return LedBlinkWaitingForIP
+	} else if captivePortal && ledCounter < LedBlinkConnectedToController {
+		return LedBlinkCaptivePortal
 	} else if ledCounter < LedBlinkConnectingToController {
 		return LedBlinkConnectingToController
 	} else {
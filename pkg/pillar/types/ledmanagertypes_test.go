@@ -11,6 +11,7 @@ func TestDeriveLedCounter(t *testing.T) {
 		ledBlinkCount      LedBlinkCount
 		usableAddressCount int
 		radioSilence       bool
+		captivePortal      bool
 		expectedValue      LedBlinkCount
 	}{
 		"usableAddressCount is 0": {
@@ -45,11 +46,24 @@ func TestDeriveLedCounter(t *testing.T) {
 			radioSilence:       true,
 			expectedValue:      LedBlinkRadioSilence,
 		},
+		"captive portal is detected (not connected)": {
+			ledBlinkCount:      LedBlinkConnectingToController,
+			usableAddressCount: 1,
+			captivePortal:      true,
+			expectedValue:      LedBlinkCaptivePortal,
+		},
+		"captive portal is detected (connected via another port)": {
+			ledBlinkCount:      LedBlinkOnboarded,
+			usableAddressCount: 2,
+			captivePortal:      true,
+			expectedValue:      LedBlinkOnboarded,
+		},
 	}
 
 	for testname, test := range testMatrix {
 		t.Logf("Running test case %s", testname)
-		output := DeriveLedCounter(test.ledBlinkCount, test.usableAddressCount, test.radioSilence)
+		output := DeriveLedCounter(test.ledBlinkCount, test.usableAddressCount,
+			test.radioSilence, test.captivePortal)
 		assert.Equal(t, test.expectedValue, output)
 	}
 }
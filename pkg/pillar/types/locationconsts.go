@@ -8,6 +8,9 @@ import "strings"
 const (
 	// TmpDirname - used for files fed into pubsub as global subscriptions
 	TmpDirname = "/run/global"
+	// AppSecretsDirname - tmpfs directory used to store decrypted secrets
+	// of app instances
+	AppSecretsDirname = "/run/app-secrets"
 
 	// PersistDir - Location to store persistent files.
 	PersistDir = "/persist"
@@ -21,6 +24,9 @@ const (
 	SealedDirName = PersistDir + "/vault"
 	// VolumeEncryptedDirName - sealed directory used to store volumes
 	VolumeEncryptedDirName = SealedDirName + "/volumes"
+	// AppVTPMStateDirname - sealed directory used to store the state of
+	// virtual TPMs of app instances
+	AppVTPMStateDirname = SealedDirName + "/vtpm"
 	// ClearDirName - directory which is not encrypted
 	ClearDirName = PersistDir + "/clear"
 	// VolumeClearDirName - Not encrypted directory used to store volumes
@@ -0,0 +1,220 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// DefaultNetDumpDuration : default duration of an on-demand netdump request.
+	DefaultNetDumpDuration = 30 * time.Second
+	// MaxNetDumpDuration : maximum allowed duration of an on-demand netdump request.
+	MaxNetDumpDuration = 10 * time.Minute
+)
+
+// NetDumpSource : source of an on-demand netdump request.
+// Integer values are in-sync with proto enum info.NetDumpSource.
+type NetDumpSource uint8
+
+const (
+	// NetDumpSourceUnspecified : source is not known.
+	NetDumpSourceUnspecified NetDumpSource = iota
+	// NetDumpSourceController : requested by the controller via EdgeDevConfig.
+	NetDumpSourceController
+	// NetDumpSourceLPS : requested by the Local Profile Server.
+	NetDumpSourceLPS
+	// NetDumpSourceEdgeview : requested via edge-view.
+	NetDumpSourceEdgeview
+)
+
+// String returns human-readable name of the source.
+func (s NetDumpSource) String() string {
+	switch s {
+	case NetDumpSourceController:
+		return "controller"
+	case NetDumpSourceLPS:
+		return "local-profile-server"
+	case NetDumpSourceEdgeview:
+		return "edgeview"
+	}
+	return "unspecified"
+}
+
+// NetDumpRequest : request to collect network diagnostics on demand.
+// Published by zedagent (for requests from the controller and from LPS)
+// and by edgeview. Executed by downloader, which uploads the resulting netdump
+// into the selected datastore using the same code path as used for downloads.
+type NetDumpRequest struct {
+	// RequestID : unique request ID. Each request is executed only once.
+	RequestID string
+	Source    NetDumpSource
+	// Target : <host>:<port> or HTTP(S) URL.
+	Target   string
+	Duration time.Duration
+	// Interfaces : logical labels of ports to use. Empty means all management ports.
+	Interfaces []string
+	// DatastoreID : datastore to upload the netdump into.
+	// Nil UUID means to only keep the netdump locally.
+	DatastoreID uuid.UUID
+}
+
+// Key is used for pubsub
+func (req NetDumpRequest) Key() string {
+	return req.RequestID
+}
+
+// LogCreate :
+func (req NetDumpRequest) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.NetDumpRequestLogType, "",
+		nilUUID, req.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("source", req.Source.String()).
+		AddField("target", req.Target).
+		Noticef("NetDump request create")
+}
+
+// LogModify :
+func (req NetDumpRequest) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.NetDumpRequestLogType, "",
+		nilUUID, req.LogKey())
+	oldReq, ok := old.(NetDumpRequest)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of NetDumpRequest type")
+	}
+	logObject.CloneAndAddField("diff", cmp.Diff(oldReq, req)).
+		Noticef("NetDump request modify")
+}
+
+// LogDelete :
+func (req NetDumpRequest) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.NetDumpRequestLogType, "",
+		nilUUID, req.LogKey())
+	logObject.Noticef("NetDump request delete")
+	base.DeleteLogObject(logBase, req.LogKey())
+}
+
+// LogKey :
+func (req NetDumpRequest) LogKey() string {
+	return string(base.NetDumpRequestLogType) + "-" + req.Key()
+}
+
+// GetDuration returns the request duration with the default applied
+// and capped at MaxNetDumpDuration.
+func (req NetDumpRequest) GetDuration() time.Duration {
+	switch {
+	case req.Duration <= 0:
+		return DefaultNetDumpDuration
+	case req.Duration > MaxNetDumpDuration:
+		return MaxNetDumpDuration
+	}
+	return req.Duration
+}
+
+// NetDumpState : state of an on-demand netdump request.
+// Integer values are in-sync with proto enum info.NetDumpState.
+type NetDumpState uint8
+
+const (
+	// NetDumpStateUnspecified : state is not known.
+	NetDumpStateUnspecified NetDumpState = iota
+	// NetDumpStatePending : waiting for another request to complete.
+	NetDumpStatePending
+	// NetDumpStateRunning : tracing requests and capturing packets.
+	NetDumpStateRunning
+	// NetDumpStateUploading : uploading netdump into the datastore.
+	NetDumpStateUploading
+	// NetDumpStateCompleted : netdump was published (and uploaded if requested).
+	NetDumpStateCompleted
+	// NetDumpStateFailed : request failed, see NetDumpStatus.Error.
+	NetDumpStateFailed
+)
+
+// String returns human-readable name of the state.
+func (s NetDumpState) String() string {
+	switch s {
+	case NetDumpStatePending:
+		return "pending"
+	case NetDumpStateRunning:
+		return "running"
+	case NetDumpStateUploading:
+		return "uploading"
+	case NetDumpStateCompleted:
+		return "completed"
+	case NetDumpStateFailed:
+		return "failed"
+	}
+	return "unspecified"
+}
+
+// NetDumpStatus : status of an on-demand netdump request published by downloader.
+// The publication is persistent so that requests are not repeated after reboot.
+type NetDumpStatus struct {
+	RequestID string
+	Source    NetDumpSource
+	State     NetDumpState
+	// Filename : path to the netdump archive (under /persist/netdump).
+	Filename string
+	// UploadLocation : location of the uploaded archive as returned by the datastore.
+	UploadLocation string
+	StartedAt      time.Time
+	CompletedAt    time.Time
+	ErrorAndTime
+}
+
+// Key is used for pubsub
+func (status NetDumpStatus) Key() string {
+	return status.RequestID
+}
+
+// Done returns true if the request has been processed (successfully or not).
+func (status NetDumpStatus) Done() bool {
+	return status.State == NetDumpStateCompleted || status.State == NetDumpStateFailed
+}
+
+// LogCreate :
+func (status NetDumpStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.NetDumpStatusLogType, "",
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("state", status.State.String()).
+		Noticef("NetDump status create")
+}
+
+// LogModify :
+func (status NetDumpStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.NetDumpStatusLogType, "",
+		nilUUID, status.LogKey())
+	oldStatus, ok := old.(NetDumpStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of NetDumpStatus type")
+	}
+	if oldStatus.State != status.State || oldStatus.Error != status.Error {
+		logObject.CloneAndAddField("state", status.State.String()).
+			AddField("old-state", oldStatus.State.String()).
+			AddField("error", status.Error).
+			Noticef("NetDump status modify")
+	}
+}
+
+// LogDelete :
+func (status NetDumpStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.NetDumpStatusLogType, "",
+		nilUUID, status.LogKey())
+	logObject.Noticef("NetDump status delete")
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status NetDumpStatus) LogKey() string {
+	return string(base.NetDumpStatusLogType) + "-" + status.Key()
+}
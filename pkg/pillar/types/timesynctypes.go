@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// DefaultNTSKEPort : default TCP port of the NTS Key Establishment server.
+const DefaultNTSKEPort = 4460
+
+// NTPServerConfig : NTP server configured by the controller.
+type NTPServerConfig struct {
+	// Address : hostname or IP address of the server.
+	Address string
+	// NTS : authenticate the server using Network Time Security (RFC 8915).
+	NTS bool
+	// NTSKEPort : TCP port of the NTS-KE server (zero means DefaultNTSKEPort).
+	NTSKEPort uint16
+	// Prefer : prefer this server over the others.
+	Prefer bool
+}
+
+// PTPConfig : Precision Time Protocol (IEEE 1588) configuration.
+type PTPConfig struct {
+	Enabled bool
+	// Ports : logical labels of ports to run PTP on.
+	// If empty, PTP is run on all management ports with a PTP hardware clock.
+	Ports []string
+	// Domain : PTP domain number.
+	Domain uint8
+}
+
+// TimeSyncConfig : configuration of the device clock synchronization
+// published by zedagent for timesync.
+type TimeSyncConfig struct {
+	NTPServers []NTPServerConfig
+	// UseOnlyConfiguredServers : do not use NTP servers received from DHCP
+	// or configured for network ports.
+	UseOnlyConfiguredServers bool
+	PTP                      PTPConfig
+}
+
+// Key is used for pubsub
+func (config TimeSyncConfig) Key() string {
+	return "global"
+}
+
+// Equal compares two TimeSyncConfig.
+func (config TimeSyncConfig) Equal(config2 TimeSyncConfig) bool {
+	return reflect.DeepEqual(config, config2)
+}
+
+// LogCreate :
+func (config TimeSyncConfig) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.TimeSyncConfigLogType, "",
+		nilUUID, config.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("ntp-servers", len(config.NTPServers)).
+		AddField("ptp", config.PTP.Enabled).
+		Noticef("TimeSync config create")
+}
+
+// LogModify :
+func (config TimeSyncConfig) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.TimeSyncConfigLogType, "",
+		nilUUID, config.LogKey())
+	oldConfig, ok := old.(TimeSyncConfig)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of TimeSyncConfig type")
+	}
+	logObject.CloneAndAddField("ntp-servers", len(config.NTPServers)).
+		AddField("ptp", config.PTP.Enabled).
+		AddField("old-ntp-servers", len(oldConfig.NTPServers)).
+		AddField("old-ptp", oldConfig.PTP.Enabled).
+		Noticef("TimeSync config modify")
+}
+
+// LogDelete :
+func (config TimeSyncConfig) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.TimeSyncConfigLogType, "",
+		nilUUID, config.LogKey())
+	logObject.Noticef("TimeSync config delete")
+	base.DeleteLogObject(logBase, config.LogKey())
+}
+
+// LogKey :
+func (config TimeSyncConfig) LogKey() string {
+	return string(base.TimeSyncConfigLogType) + "-" + config.Key()
+}
+
+// TimeSourceType : type of a time source.
+type TimeSourceType string
+
+const (
+	// TimeSourceUnspecified : source type is not known.
+	TimeSourceUnspecified TimeSourceType = ""
+	// TimeSourceNTP : unauthenticated NTP server.
+	TimeSourceNTP TimeSourceType = "ntp"
+	// TimeSourceNTS : NTP server authenticated with NTS.
+	TimeSourceNTS TimeSourceType = "nts"
+	// TimeSourcePTP : PTP hardware clock of a NIC synchronized using PTP.
+	TimeSourcePTP TimeSourceType = "ptp"
+)
+
+// TimeSourceState : state of a time source as determined by the time sync daemon.
+type TimeSourceState string
+
+const (
+	// TimeSourceStateUnspecified : state is not known.
+	TimeSourceStateUnspecified TimeSourceState = ""
+	// TimeSourceStateSelected : source is used to synchronize the clock.
+	TimeSourceStateSelected TimeSourceState = "selected"
+	// TimeSourceStateCandidate : source is combined with the selected source.
+	TimeSourceStateCandidate TimeSourceState = "candidate"
+	// TimeSourceStateRejected : source was rejected (falseticker, too variable, etc.).
+	TimeSourceStateRejected TimeSourceState = "rejected"
+	// TimeSourceStateUnreachable : source is not reachable.
+	TimeSourceStateUnreachable TimeSourceState = "unreachable"
+)
+
+// TimeSourceStatus : status of a single time source.
+type TimeSourceStatus struct {
+	// Address : hostname or IP address of the NTP server,
+	// or the interface name for PTP.
+	Address string          `json:"address"`
+	Type    TimeSourceType  `json:"type"`
+	State   TimeSourceState `json:"state"`
+	Stratum uint8           `json:"stratum"`
+	// Offset : offset of the device clock relative to the source,
+	// positive if the device clock is ahead of the source.
+	Offset time.Duration `json:"offset-ns"`
+	// NTSFailed : NTS is configured for the source but the authentication failed.
+	NTSFailed bool `json:"nts-failed"`
+}
+
+// TimeSyncStatus : status of the device clock synchronization
+// published by timesync.
+type TimeSyncStatus struct {
+	Synchronized bool `json:"synchronized"`
+	// Source : address of the source currently used to synchronize the clock.
+	Source     string         `json:"source"`
+	SourceType TimeSourceType `json:"source-type"`
+	Stratum    uint8          `json:"stratum"`
+	// Offset : estimated offset of the device clock from the true time.
+	Offset time.Duration `json:"offset-ns"`
+	// LastSync : time of the last clock update from the selected source.
+	LastSync time.Time          `json:"last-sync"`
+	Sources  []TimeSourceStatus `json:"sources"`
+	// Error : error reported by the time sync daemon (or by timesync itself).
+	Error string `json:"error"`
+}
+
+// Key is used for pubsub
+func (status TimeSyncStatus) Key() string {
+	return "global"
+}
+
+// LogCreate :
+func (status TimeSyncStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.TimeSyncStatusLogType, "",
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("synchronized", status.Synchronized).
+		AddField("source", status.Source).
+		AddField("error", status.Error).
+		Noticef("TimeSync status create")
+}
+
+// LogModify :
+func (status TimeSyncStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.TimeSyncStatusLogType, "",
+		nilUUID, status.LogKey())
+	oldStatus, ok := old.(TimeSyncStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of TimeSyncStatus type")
+	}
+	// Offset and LastSync change with every poll, log only significant changes.
+	if oldStatus.Synchronized != status.Synchronized ||
+		oldStatus.Source != status.Source ||
+		oldStatus.Error != status.Error {
+		logObject.CloneAndAddField("synchronized", status.Synchronized).
+			AddField("source", status.Source).
+			AddField("error", status.Error).
+			AddField("old-synchronized", oldStatus.Synchronized).
+			AddField("old-source", oldStatus.Source).
+			AddField("old-error", oldStatus.Error).
+			Noticef("TimeSync status modify")
+	} else {
+		logObject.CloneAndAddField("offset", status.Offset.String()).
+			Metricf("TimeSync status modify")
+	}
+}
+
+// LogDelete :
+func (status TimeSyncStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.TimeSyncStatusLogType, "",
+		nilUUID, status.LogKey())
+	logObject.Noticef("TimeSync status delete")
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status TimeSyncStatus) LogKey() string {
+	return string(base.TimeSyncStatusLogType) + "-" + status.Key()
+}
@@ -683,6 +683,9 @@ type LocalAppCounters struct {
 	// PurgeCounter : contains counter counting how many purge requests have been submitted
 	// via local server for this application in total (including uncompleted requests).
 	PurgeCmd AppInstanceOpsCmd
+	// HealthPurgeRequestedAt : time of the last purge requested by zedmanager
+	// because the application failed its liveness probe (see AppHealthStatus).
+	HealthPurgeRequestedAt time.Time
 }
 
 // DevCommand : application command requested to run by a local server.
@@ -696,6 +699,8 @@ const (
 	DevCommandShutdown
 	// DevCommandShutdownPoweroff : shut down all app instances + poweroff
 	DevCommandShutdownPoweroff
+	// DevCommandNetDump : collect network diagnostics (see NetDumpRequest)
+	DevCommandNetDump
 )
 
 // LOCConfig : configuration of the Local Operator Console
@@ -142,6 +142,12 @@ type AppInstanceConfig struct {
 	// CipherBlockStatus, for encrypted cloud-init data
 	CipherBlockStatus
 
+	// SecretsCipherBlock, for encrypted secrets (EncryptionBlock.AppSecrets)
+	SecretsCipherBlock CipherBlockStatus
+
+	// Prometheus endpoint scraped by zedrouter
+	Prometheus AppPrometheusConfig
+
 	MetaDataType MetaDataType
 
 	ProfileList []string
@@ -159,6 +165,51 @@ type AppInstanceConfig struct {
 	// Contains the configuration of the snapshot handling for the app instance.
 	// Meanwhile, the list of actual snapshots is stored in the AppInstanceStatus.
 	Snapshot SnapshotConfig
+	// Health probes and the action taken when the application is not live.
+	Health AppHealthConfig
+	// App instances which must satisfy the given condition before this
+	// app instance is started.
+	Dependencies []AppDependency
+	// App instances with a non-zero StartGroup are started only after all
+	// activated app instances with a lower non-zero StartGroup are ready.
+	StartGroup uint32
+	// Additional containers (sidecars and init containers) of a container
+	// app instance.
+	Containers []AppContainerConfig
+}
+
+// AppDependencyCondition : condition which a required app instance must satisfy.
+// Must match the definition in appconfig.proto
+type AppDependencyCondition uint8
+
+const (
+	// AppDependencyConditionUnspecified : treated as AppDependencyReady
+	AppDependencyConditionUnspecified AppDependencyCondition = iota
+	// AppDependencyStarted : required app instance is running
+	AppDependencyStarted
+	// AppDependencyReady : required app instance is running and ready
+	// (see AppInstanceStatus.IsReady)
+	AppDependencyReady
+)
+
+// String returns the condition as a string.
+func (c AppDependencyCondition) String() string {
+	switch c {
+	case AppDependencyConditionUnspecified:
+		return "unspecified"
+	case AppDependencyStarted:
+		return "started"
+	case AppDependencyReady:
+		return "ready"
+	default:
+		return fmt.Sprintf("unknown condition %d", c)
+	}
+}
+
+// AppDependency : dependency of an app instance on another app instance.
+type AppDependency struct {
+	AppUUID   uuid.UUID
+	Condition AppDependencyCondition
 }
 
 type AppInstanceOpsCmd struct {
@@ -283,6 +334,15 @@ type AppInstanceStatus struct {
 	StartTime time.Time
 	// Snapshot related information
 	SnapStatus SnapshottingStatus
+	// Results of health probes and actions taken
+	Health AppHealthStatus
+	// AwaitingDependencies is set while the start of the app instance
+	// is postponed until its dependencies are satisfied.
+	AwaitingDependencies bool
+	// Status of the additional containers, copied from DomainStatus
+	ContainerStatusList []AppContainerStatus
+	// Information reported by the guest agent, copied from DomainStatus
+	GuestAgent *GuestAgentInfo
 }
 
 // AppCount is uint8 and it should be sufficient for the number of apps we can support
@@ -11,6 +11,7 @@ import (
 	"os"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/eriknordmark/ipinfo"
@@ -53,6 +54,10 @@ type AppNetworkConfig struct {
 	CloudInitUserData   *string `json:"pubsub-large-CloudInitUserData"`
 	CipherBlockStatus   CipherBlockStatus
 	MetaDataType        MetaDataType
+	// SecretsCipherBlock, for encrypted secrets served by the meta-data server
+	SecretsCipherBlock CipherBlockStatus
+	// Prometheus endpoint of the app instance to scrape
+	Prometheus AppPrometheusConfig
 }
 
 func (config AppNetworkConfig) Key() string {
@@ -160,6 +165,7 @@ type AppNetworkStatus struct {
 	DisplayName    string
 	// Copy from the AppNetworkConfig; used to delete when config is gone.
 	GetStatsIPAddr       net.IP
+	Prometheus           AppPrometheusConfig
 	UnderlayNetworkList  []UnderlayNetworkStatus
 	AwaitNetworkInstance bool // If any Missing flag is set in the networks
 	// Any errors from provisioning the network
@@ -297,12 +303,113 @@ func (acMetric AppContainerMetrics) LogKey() string {
 	return string(base.AppContainerMetricsLogType) + "-" + acMetric.Key()
 }
 
+// AppPrometheusConfig - Prometheus endpoint of an app instance
+type AppPrometheusConfig struct {
+	Port uint16 // 0 if there is no endpoint
+	Path string
+	// Allowlist of metric names; names ending with '*' are prefixes
+	Allowlist []string
+}
+
+// IsAllowed returns true if the metric with the given name is in the allowlist
+func (config AppPrometheusConfig) IsAllowed(name string) bool {
+	for _, allowed := range config.Allowlist {
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// AppPrometheusMetrics - samples scraped from the Prometheus endpoint of an app
+type AppPrometheusMetrics struct {
+	UUIDandVersion UUIDandVersion // App UUID
+	CollectTime    time.Time
+	Samples        []AppCustomMetric
+}
+
+// AppCustomMetric - sample of a metric of an app instance
+type AppCustomMetric struct {
+	Name   string
+	Labels map[string]string
+	Type   MetricItemType
+	Value  float64
+}
+
+// Key - key for AppPrometheusMetrics
+func (apMetric AppPrometheusMetrics) Key() string {
+	return apMetric.UUIDandVersion.UUID.String()
+}
+
+// LogCreate :
+func (apMetric AppPrometheusMetrics) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.AppPrometheusMetricsLogType, "",
+		apMetric.UUIDandVersion.UUID, apMetric.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("samples", len(apMetric.Samples)).
+		Metricf("App prometheus metric create")
+}
+
+// LogModify :
+func (apMetric AppPrometheusMetrics) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.AppPrometheusMetricsLogType, "",
+		apMetric.UUIDandVersion.UUID, apMetric.LogKey())
+
+	if _, ok := old.(AppPrometheusMetrics); !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of AppPrometheusMetrics type")
+	}
+	logObject.CloneAndAddField("samples", len(apMetric.Samples)).
+		Metricf("App prometheus metric modify")
+}
+
+// LogDelete :
+func (apMetric AppPrometheusMetrics) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.AppPrometheusMetricsLogType, "",
+		apMetric.UUIDandVersion.UUID, apMetric.LogKey())
+	logObject.Metricf("App prometheus metric delete")
+
+	base.DeleteLogObject(logBase, apMetric.LogKey())
+}
+
+// LogKey :
+func (apMetric AppPrometheusMetrics) LogKey() string {
+	return string(base.AppPrometheusMetricsLogType) + "-" + apMetric.Key()
+}
+
 // IntfStatusMap - Used to return per-interface test results (success and failures)
 //
 //	ifName is used as the key
 type IntfStatusMap struct {
 	// StatusMap -> Key: ifname, Value: TestResults
 	StatusMap map[string]TestResults
+	// DNSResolvers -> Key: ifname, Value: DNS resolver used during the test
+	DNSResolvers map[string]DNSResolverStatus
+	// CaptivePortals -> Key: ifname, Value: result of captive portal detection
+	CaptivePortals map[string]CaptivePortalStatus
+}
+
+// RecordCaptivePortal records the result of captive portal detection for the ifName
+func (intfMap *IntfStatusMap) RecordCaptivePortal(ifName string,
+	portal CaptivePortalStatus) {
+	if intfMap.CaptivePortals == nil {
+		intfMap.CaptivePortals = make(map[string]CaptivePortalStatus)
+	}
+	intfMap.CaptivePortals[ifName] = portal
+}
+
+// RecordDNSResolver records the DNS resolver used for the ifName
+func (intfMap *IntfStatusMap) RecordDNSResolver(ifName string,
+	resolver DNSResolverStatus) {
+	if intfMap.DNSResolvers == nil {
+		intfMap.DNSResolvers = make(map[string]DNSResolverStatus)
+	}
+	intfMap.DNSResolvers[ifName] = resolver
 }
 
 // RecordSuccess records a success for the ifName
@@ -325,6 +432,16 @@ func (intfMap *IntfStatusMap) RecordFailure(ifName string, errStr string) {
 	intfMap.StatusMap[ifName] = tr
 }
 
+// RecordProxyAuthFailure records a proxy authentication failure for the ifName
+func (intfMap *IntfStatusMap) RecordProxyAuthFailure(ifName string, errStr string) {
+	tr, ok := intfMap.StatusMap[ifName]
+	if !ok {
+		tr = TestResults{}
+	}
+	tr.RecordProxyAuthFailure(errStr)
+	intfMap.StatusMap[ifName] = tr
+}
+
 // SetOrUpdateFromMap - Set all the entries from the given per-interface map
 // Entries which are not in the source are not modified
 func (intfMap *IntfStatusMap) SetOrUpdateFromMap(
@@ -337,12 +454,20 @@ func (intfMap *IntfStatusMap) SetOrUpdateFromMap(
 		tr.Update(src)
 		intfMap.StatusMap[intf] = tr
 	}
+	for intf, resolver := range source.DNSResolvers {
+		intfMap.RecordDNSResolver(intf, resolver)
+	}
+	for intf, portal := range source.CaptivePortals {
+		intfMap.RecordCaptivePortal(intf, portal)
+	}
 }
 
 // NewIntfStatusMap - Create a new instance of IntfStatusMap
 func NewIntfStatusMap() *IntfStatusMap {
 	intfStatusMap := IntfStatusMap{}
 	intfStatusMap.StatusMap = make(map[string]TestResults)
+	intfStatusMap.DNSResolvers = make(map[string]DNSResolverStatus)
+	intfStatusMap.CaptivePortals = make(map[string]CaptivePortalStatus)
 	return &intfStatusMap
 }
 
@@ -654,6 +779,9 @@ type TestResults struct {
 	LastFailed    time.Time
 	LastSucceeded time.Time
 	LastError     string // Set when LastFailed is updated
+	// ProxyAuthFailed is set when the last failure was caused by the proxy
+	// rejecting the configured credentials (or requiring some when none are set).
+	ProxyAuthFailed bool
 }
 
 // RecordSuccess records a success
@@ -661,6 +789,7 @@ type TestResults struct {
 func (trPtr *TestResults) RecordSuccess() {
 	trPtr.LastSucceeded = time.Now()
 	trPtr.LastError = ""
+	trPtr.ProxyAuthFailed = false
 }
 
 // RecordFailure records a failure
@@ -671,6 +800,13 @@ func (trPtr *TestResults) RecordFailure(errStr string) {
 	}
 	trPtr.LastFailed = time.Now()
 	trPtr.LastError = errStr
+	trPtr.ProxyAuthFailed = false
+}
+
+// RecordProxyAuthFailure records a failure caused by proxy authentication.
+func (trPtr *TestResults) RecordProxyAuthFailure(errStr string) {
+	trPtr.RecordFailure(errStr)
+	trPtr.ProxyAuthFailed = true
 }
 
 // HasError returns true if there is an error
@@ -685,12 +821,14 @@ func (trPtr *TestResults) Update(src TestResults) {
 	if src.HasError() {
 		trPtr.LastFailed = src.LastFailed
 		trPtr.LastError = src.LastError
+		trPtr.ProxyAuthFailed = src.ProxyAuthFailed
 		if src.LastSucceeded.After(trPtr.LastSucceeded) {
 			trPtr.LastSucceeded = src.LastSucceeded
 		}
 	} else {
 		trPtr.LastSucceeded = src.LastSucceeded
 		trPtr.LastError = ""
+		trPtr.ProxyAuthFailed = false
 		if src.LastFailed.After(trPtr.LastFailed) {
 			trPtr.LastFailed = src.LastFailed
 		}
@@ -702,6 +840,7 @@ func (trPtr *TestResults) Clear() {
 	trPtr.LastFailed = time.Time{}
 	trPtr.LastSucceeded = time.Time{}
 	trPtr.LastError = ""
+	trPtr.ProxyAuthFailed = false
 }
 
 type DevicePortConfigVersion uint32
@@ -856,7 +995,8 @@ func (config *DevicePortConfig) MostlyEqual(config2 *DevicePortConfig) bool {
 		}
 		if !reflect.DeepEqual(p1.DhcpConfig, p2.DhcpConfig) ||
 			!reflect.DeepEqual(p1.ProxyConfig, p2.ProxyConfig) ||
-			!reflect.DeepEqual(p1.WirelessCfg, p2.WirelessCfg) {
+			!reflect.DeepEqual(p1.WirelessCfg, p2.WirelessCfg) ||
+			!reflect.DeepEqual(p1.PortAuth, p2.PortAuth) {
 			return false
 		}
 	}
@@ -973,6 +1113,54 @@ type ProxyConfig struct {
 	WpadURL            string // The URL determined from DNS
 	// List of certs which will be added to TLS trust
 	ProxyCertPEM [][]byte `json:"pubsub-large-ProxyCertPEM"`
+	// Method used to authenticate with the proxies.
+	AuthMethod ProxyAuthMethod
+	// Encrypted proxy credentials.
+	AuthCipherBlock CipherBlockStatus
+	// Decrypted proxy credentials. Filled in by NIM only inside DeviceNetworkStatus.
+	Credentials *ProxyCredentials
+}
+
+// ProxyAuthMethod : method used to authenticate with a proxy.
+// Values of these definitions should match the values of zconfig.ProxyAuthMethod
+type ProxyAuthMethod uint8
+
+const (
+	// ProxyAuthAuto : select the strongest method offered by the proxy.
+	ProxyAuthAuto ProxyAuthMethod = iota
+	// ProxyAuthBasic : HTTP Basic authentication.
+	ProxyAuthBasic
+	// ProxyAuthNTLM : NTLMv2 authentication (always tunneled using CONNECT).
+	ProxyAuthNTLM
+	// ProxyAuthNegotiate : SPNEGO authentication (always tunneled using CONNECT).
+	// Only NTLM tokens are supported, not Kerberos.
+	ProxyAuthNegotiate
+)
+
+// String returns the name of the proxy authentication method.
+func (m ProxyAuthMethod) String() string {
+	switch m {
+	case ProxyAuthAuto:
+		return "auto"
+	case ProxyAuthBasic:
+		return "basic"
+	case ProxyAuthNTLM:
+		return "ntlm"
+	case ProxyAuthNegotiate:
+		return "negotiate"
+	}
+	return fmt.Sprintf("unknown(%d)", m)
+}
+
+// ProxyCredentials : decrypted credentials used to authenticate with a proxy.
+type ProxyCredentials struct {
+	Username string
+	Password string
+}
+
+// String redacts the password so that the credentials do not leak into logs.
+func (c ProxyCredentials) String() string {
+	return fmt.Sprintf("{Username:%s Password:<redacted>}", c.Username)
 }
 
 type DhcpConfig struct {
@@ -1001,13 +1189,110 @@ type WifiConfig struct {
 	CipherBlockStatus
 }
 
+// WifiAPSecurity : security (authentication and key management) used
+// by WiFi access point.
+type WifiAPSecurity uint8
+
+const (
+	// WifiAPSecurityWPA2PSK : WPA2 with pre-shared key (default).
+	WifiAPSecurityWPA2PSK WifiAPSecurity = iota
+	// WifiAPSecurityWPA3SAE : WPA3 with Simultaneous Authentication of Equals.
+	WifiAPSecurityWPA3SAE
+	// WifiAPSecurityWPA2WPA3 : WPA2/WPA3 transition mode, allowing clients
+	// without WPA3 support to connect using WPA2-PSK.
+	WifiAPSecurityWPA2WPA3
+)
+
+// String returns the name of the security type.
+func (s WifiAPSecurity) String() string {
+	switch s {
+	case WifiAPSecurityWPA2PSK:
+		return "WPA2-PSK"
+	case WifiAPSecurityWPA3SAE:
+		return "WPA3-SAE"
+	case WifiAPSecurityWPA2WPA3:
+		return "WPA2-PSK/WPA3-SAE"
+	}
+	return fmt.Sprintf("Unknown WiFi AP security (%d)", s)
+}
+
+// WifiBand : frequency band used by WiFi access point.
+type WifiBand uint8
+
+const (
+	// WifiBand2GHz : 2.4GHz band (default).
+	WifiBand2GHz WifiBand = iota
+	// WifiBand5GHz : 5GHz band.
+	WifiBand5GHz
+)
+
+// WifiAPConfig : configuration for WiFi adapter operating in the access point mode.
+type WifiAPConfig struct {
+	SSID       string
+	HiddenSSID bool
+	Security   WifiAPSecurity
+	Band       WifiBand
+	// Zero means default channel for the band.
+	Channel uint16
+	// ISO/IEC 3166-1 alpha2 country code.
+	CountryCode string
+	// Zero means no limit.
+	MaxClients uint32
+
+	// CipherBlockStatus, for encrypted passphrase (EncryptionBlock.WifiPassword)
+	CipherBlockStatus
+}
+
+// WifiAPClient : client associated with WiFi access point operated by EVE.
+type WifiAPClient struct {
+	MACAddr net.HardwareAddr
+	// IP addresses leased to the client by dnsmasq (empty if not known).
+	IPAddrs  []net.IP
+	Hostname string
+	// AssociatedAt : when the client associated with the access point.
+	AssociatedAt time.Time
+	// RSSI in dBm of the last frame received from the client.
+	RSSI int32
+}
+
 // CellConfig - Cellular part of the configure
 type CellConfig struct {
-	APN          string // LTE APN
+	APN          string // LTE APN (ignored if AccessPoints are defined)
 	ProbeAddr    string
 	DisableProbe bool
 	// Enable to get location info from the GNSS receiver of the LTE modem.
 	LocationTracking bool
+	// AccessPoints : parameters for connecting to cellular networks, configured
+	// separately for every SIM card. Ordered by preference.
+	AccessPoints []CellularAccessPoint
+	// ActivatedSimSlot : SIM slot to activate (0 - leave the currently activated).
+	ActivatedSimSlot uint8
+	// SimFailover : move to the next access point (and its SIM slot) when
+	// the connection cannot be established or the probe keeps failing.
+	SimFailover bool
+}
+
+// CellularAccessPoint contains config parameters for connecting to a cellular network.
+type CellularAccessPoint struct {
+	// SIM slot to which this configuration applies.
+	// 0 - unspecified (apply to currently activated or the only available)
+	SIMSlot uint8
+	// Access Point Network to connect into.
+	APN string
+	// Authentication protocol used by the network.
+	AuthProtocol WwanAuthProtocol
+	// IP version(s) to request for the data bearer.
+	IPType WwanIPType
+	// PLMN codes of preferred network operators ("MCC-MNC").
+	PreferredPLMNs []string
+	// If true, then modem will avoid connecting to networks with roaming.
+	ForbidRoaming bool
+	// Preferred Radio Access Technologies, from the most preferred.
+	PreferredRATs []WwanRAT
+
+	// CipherBlockStatus, for encrypted username, password and SIM PIN
+	// (EncryptionBlock.CellularNetUsername, CellularNetPassword and CellularSimPin).
+	CipherBlockStatus
 }
 
 // WirelessConfig - wireless structure
@@ -1015,13 +1300,339 @@ type WirelessConfig struct {
 	WType    WirelessType // Wireless Type
 	Cellular []CellConfig // LTE APN
 	Wifi     []WifiConfig // Wifi Config params
+	// AccessPoint is non-nil if WiFi adapter should operate in the access point
+	// mode (instead of connecting to networks from Wifi).
+	AccessPoint *WifiAPConfig
+}
+
+// IsWifiAccessPoint returns true if WiFi adapter is configured to operate
+// in the access point mode.
+func (wc WirelessConfig) IsWifiAccessPoint() bool {
+	return wc.WType == WirelessTypeWifi && wc.AccessPoint != nil
 }
 
 // WirelessStatus : state information for a single wireless device
 type WirelessStatus struct {
 	WType    WirelessType
 	Cellular WwanNetworkStatus
-	// TODO: Wifi status
+	Wifi     WifiNetworkStatus
+}
+
+// MostlyEqual compares two instances of WirelessStatus but skips WiFi scan
+// results, which are refreshed periodically and have no effect on the device
+// connectivity.
+func (ws WirelessStatus) MostlyEqual(ws2 WirelessStatus) bool {
+	if ws.WType != ws2.WType ||
+		!reflect.DeepEqual(ws.Cellular, ws2.Cellular) {
+		return false
+	}
+	wifi1, wifi2 := ws.Wifi, ws2.Wifi
+	wifi1.ScanResults, wifi2.ScanResults = nil, nil
+	wifi1.LastScan, wifi2.LastScan = time.Time{}, time.Time{}
+	return reflect.DeepEqual(wifi1, wifi2)
+}
+
+// EAPMethod : EAP method used for 802.1X port authentication.
+type EAPMethod uint8
+
+const (
+	// EAPMethodNone : EAP method is not specified.
+	EAPMethodNone EAPMethod = iota
+	// EAPMethodTLS : EAP-TLS using the device certificate and the device key.
+	EAPMethodTLS
+	// EAPMethodPEAP : PEAP with MSCHAPv2 as the inner method.
+	EAPMethodPEAP
+)
+
+// String returns the name of the EAP method.
+func (m EAPMethod) String() string {
+	switch m {
+	case EAPMethodNone:
+		return ""
+	case EAPMethodTLS:
+		return "TLS"
+	case EAPMethodPEAP:
+		return "PEAP"
+	}
+	return fmt.Sprintf("Unknown EAP method (%d)", m)
+}
+
+// PortAuthConfig : IEEE 802.1X authentication of a wired port.
+type PortAuthConfig struct {
+	Enabled   bool
+	EAPMethod EAPMethod
+	// Identity presented to the authenticator. With EAP-TLS it defaults
+	// to the common name of the device certificate. With PEAP it is used
+	// as the outer (anonymous) identity.
+	Identity string
+	// CACertPEM is used to verify the authentication server.
+	CACertPEM string
+	// ServerDomainSuffix, if not empty, must match the domain name
+	// of the authentication server.
+	ServerDomainSuffix string
+	// CipherBlockStatus, for encrypted PEAP credentials
+	CipherBlockStatus
+}
+
+// PortAuthState : state of the 802.1X port authentication.
+type PortAuthState uint8
+
+const (
+	// PortAuthStateUnknown : state is not known.
+	PortAuthStateUnknown PortAuthState = iota
+	// PortAuthStateDown : supplicant is not running.
+	PortAuthStateDown
+	// PortAuthStateAuthenticating : waiting for the authenticator
+	// or exchanging EAP messages.
+	PortAuthStateAuthenticating
+	// PortAuthStateAuthenticated : port is authorized.
+	PortAuthStateAuthenticated
+	// PortAuthStateFailed : authentication failed, supplicant is holding off
+	// before the next attempt.
+	PortAuthStateFailed
+	// PortAuthStateUnauthenticated : supplicant is disconnected or logged off.
+	PortAuthStateUnauthenticated
+)
+
+// String returns the name of the port authentication state.
+func (s PortAuthState) String() string {
+	switch s {
+	case PortAuthStateUnknown:
+		return "unknown"
+	case PortAuthStateDown:
+		return "down"
+	case PortAuthStateAuthenticating:
+		return "authenticating"
+	case PortAuthStateAuthenticated:
+		return "authenticated"
+	case PortAuthStateFailed:
+		return "failed"
+	case PortAuthStateUnauthenticated:
+		return "unauthenticated"
+	}
+	return fmt.Sprintf("Unknown port auth state (%d)", s)
+}
+
+// PortAuthStatus : status of the 802.1X authentication of a wired port.
+type PortAuthStatus struct {
+	// Enabled is true if 802.1X is configured for the port.
+	Enabled bool
+	State   PortAuthState
+	// EAPMethod selected by the supplicant, e.g. "EAP-TLS".
+	EAPMethod string
+	// LastChange : time of the last state change.
+	LastChange time.Time
+	// Error encountered when querying the supplicant.
+	Error string
+}
+
+// NeighborDiscoveryProtocol : link-layer protocol used to discover
+// directly connected network devices.
+type NeighborDiscoveryProtocol uint8
+
+const (
+	// NeighborDiscoveryProtocolUnknown : protocol is not known.
+	NeighborDiscoveryProtocolUnknown NeighborDiscoveryProtocol = iota
+	// NeighborDiscoveryProtocolLLDP : IEEE 802.1AB Link Layer Discovery Protocol.
+	NeighborDiscoveryProtocolLLDP
+	// NeighborDiscoveryProtocolCDP : Cisco Discovery Protocol.
+	NeighborDiscoveryProtocolCDP
+)
+
+// String returns the protocol name.
+func (p NeighborDiscoveryProtocol) String() string {
+	switch p {
+	case NeighborDiscoveryProtocolUnknown:
+		return "Unknown"
+	case NeighborDiscoveryProtocolLLDP:
+		return "LLDP"
+	case NeighborDiscoveryProtocolCDP:
+		return "CDP"
+	}
+	return fmt.Sprintf("Unknown neighbor discovery protocol (%d)", p)
+}
+
+// PortNeighbor : network device (switch, router, etc.) directly connected
+// to a device port, as learned from LLDP or CDP advertisements.
+type PortNeighbor struct {
+	Protocol NeighborDiscoveryProtocol
+	// ChassisID identifies the neighbor device (typically a MAC address).
+	ChassisID string
+	// PortID identifies the neighbor port to which the device port is connected.
+	PortID          string
+	PortDescription string
+	SystemName      string
+	// VlanID : port VLAN ID (native VLAN) of the neighbor port.
+	// Zero if not advertised.
+	VlanID uint16
+	// MgmtAddrs : management addresses advertised by the neighbor.
+	MgmtAddrs []net.IP
+}
+
+// EncryptedDNSProtocol : protocol used by EVE to encrypt DNS queries sent
+// to resolve the controller and datastore domain names.
+type EncryptedDNSProtocol uint8
+
+const (
+	// EncryptedDNSNone : plain DNS over UDP/TCP.
+	EncryptedDNSNone EncryptedDNSProtocol = iota
+	// EncryptedDNSOverTLS : DNS-over-TLS (RFC 7858).
+	EncryptedDNSOverTLS
+	// EncryptedDNSOverHTTPS : DNS-over-HTTPS (RFC 8484).
+	EncryptedDNSOverHTTPS
+)
+
+// String returns the protocol name as used in the global configuration.
+func (p EncryptedDNSProtocol) String() string {
+	switch p {
+	case EncryptedDNSNone:
+		return "none"
+	case EncryptedDNSOverTLS:
+		return "dot"
+	case EncryptedDNSOverHTTPS:
+		return "doh"
+	}
+	return fmt.Sprintf("Unknown encrypted DNS protocol (%d)", p)
+}
+
+// ParseEncryptedDNSProtocol parses protocol name as used in the global configuration.
+func ParseEncryptedDNSProtocol(protocol string) (EncryptedDNSProtocol, error) {
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case "", "none":
+		return EncryptedDNSNone, nil
+	case "dot":
+		return EncryptedDNSOverTLS, nil
+	case "doh":
+		return EncryptedDNSOverHTTPS, nil
+	}
+	return EncryptedDNSNone, fmt.Errorf("unsupported encrypted DNS protocol %q", protocol)
+}
+
+// EncryptedDNSConfig : configuration of the encrypted DNS used by EVE
+// for the management traffic.
+type EncryptedDNSConfig struct {
+	Protocol EncryptedDNSProtocol
+	// Servers : encrypted DNS resolvers to use, in the order of preference.
+	// For DNS-over-TLS: "<ip>[:<port>][#<tls-server-name>]".
+	// For DNS-over-HTTPS: "https://<host>[:<port>]/<path>[#<ip>]".
+	// If empty, built-in (bootstrap) public resolvers are used.
+	Servers []string
+	// AllowFallback : fall back to plain DNS (using nameservers received
+	// from DHCP or configured statically) if none of the encrypted DNS
+	// resolvers is available.
+	AllowFallback bool
+}
+
+// Equal compares two encrypted DNS configurations.
+func (c EncryptedDNSConfig) Equal(c2 EncryptedDNSConfig) bool {
+	if c.Protocol != c2.Protocol || c.AllowFallback != c2.AllowFallback ||
+		len(c.Servers) != len(c2.Servers) {
+		return false
+	}
+	for i := range c.Servers {
+		if c.Servers[i] != c2.Servers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DNSResolverStatus : resolver used by EVE for the most recent domain name
+// resolution done for the management traffic sent over a given port.
+type DNSResolverStatus struct {
+	// Protocol is EncryptedDNSNone if plain DNS was used.
+	Protocol EncryptedDNSProtocol
+	// Server : address of the DNS server (or URL of the DoH server) used.
+	Server string
+	// Fallback is true if plain DNS was used because encrypted DNS
+	// was enabled but none of the encrypted DNS resolvers was available.
+	Fallback bool
+	// EncryptedDNSError : the last error returned by an encrypted DNS resolver.
+	EncryptedDNSError string
+	// LastUsed : time when the resolver was last used.
+	LastUsed time.Time
+}
+
+// CaptivePortalState : state of the captive portal detection for a port.
+type CaptivePortalState uint8
+
+const (
+	// CaptivePortalUnknown : captive portal detection was not performed (yet).
+	CaptivePortalUnknown CaptivePortalState = iota
+	// CaptivePortalNotDetected : port is not behind a captive portal.
+	CaptivePortalNotDetected
+	// CaptivePortalDetected : port is behind a captive portal, which intercepts
+	// traffic until the user authenticates or accepts terms of use.
+	CaptivePortalDetected
+)
+
+// String returns human-readable description of the captive portal state.
+func (s CaptivePortalState) String() string {
+	switch s {
+	case CaptivePortalUnknown:
+		return "Unknown"
+	case CaptivePortalNotDetected:
+		return "Not detected"
+	case CaptivePortalDetected:
+		return "Detected"
+	}
+	return fmt.Sprintf("Unknown captive portal state (%d)", s)
+}
+
+// CaptivePortalEvidence : how was the captive portal detected.
+type CaptivePortalEvidence uint8
+
+const (
+	// CaptivePortalEvidenceNone : captive portal was not detected.
+	CaptivePortalEvidenceNone CaptivePortalEvidence = iota
+	// CaptivePortalEvidenceRedirect : probe request was redirected.
+	CaptivePortalEvidenceRedirect
+	// CaptivePortalEvidenceContent : probe request returned unexpected content
+	// (typically a login page).
+	CaptivePortalEvidenceContent
+	// CaptivePortalEvidenceInjectedCert : TLS connection with the controller
+	// was intercepted and presented with a certificate of an untrusted issuer,
+	// while the probe request failed.
+	CaptivePortalEvidenceInjectedCert
+)
+
+// String returns human-readable description of the captive portal evidence.
+func (e CaptivePortalEvidence) String() string {
+	switch e {
+	case CaptivePortalEvidenceNone:
+		return "None"
+	case CaptivePortalEvidenceRedirect:
+		return "HTTP redirect"
+	case CaptivePortalEvidenceContent:
+		return "Unexpected HTTP content"
+	case CaptivePortalEvidenceInjectedCert:
+		return "Injected TLS certificate"
+	}
+	return fmt.Sprintf("Unknown captive portal evidence (%d)", e)
+}
+
+// CaptivePortalStatus : result of the captive portal detection for a port.
+type CaptivePortalStatus struct {
+	State    CaptivePortalState
+	Evidence CaptivePortalEvidence
+	// URL of the captive portal (login page), if known.
+	URL string
+	// Issuer of the TLS certificate presented by the captive portal
+	// (only with CaptivePortalEvidenceInjectedCert).
+	CertIssuer string
+	// LastCheck : time of the last captive portal detection.
+	LastCheck time.Time
+}
+
+// Detected returns true if captive portal was detected.
+func (s CaptivePortalStatus) Detected() bool {
+	return s.State == CaptivePortalDetected
+}
+
+// Equal compares two captive portal states, ignoring the time of the last check.
+func (s CaptivePortalStatus) Equal(s2 CaptivePortalStatus) bool {
+	return s.State == s2.State && s.Evidence == s2.Evidence &&
+		s.URL == s2.URL && s.CertIssuer == s2.CertIssuer
 }
 
 const (
@@ -1147,6 +1758,7 @@ type NetworkPortConfig struct {
 	ProxyConfig
 	L2LinkConfig
 	WirelessCfg WirelessConfig
+	PortAuth    PortAuthConfig
 	// TestResults - Errors from parsing plus success/failure from testing
 	TestResults
 }
@@ -1172,6 +1784,13 @@ type NetworkPortStatus struct {
 	DefaultRouters []net.IP
 	WirelessCfg    WirelessConfig
 	WirelessStatus WirelessStatus
+	PortAuth       PortAuthStatus
+	// Neighbors learned from LLDP/CDP advertisements received on the port.
+	Neighbors []PortNeighbor
+	// DNSResolver : resolver used for the management traffic sent over the port.
+	DNSResolver DNSResolverStatus
+	// CaptivePortal : result of the captive portal detection for the port.
+	CaptivePortal CaptivePortalStatus
 	ProxyConfig
 	L2LinkConfig
 	// TestResults provides recording of failure and success
@@ -1210,6 +1829,7 @@ type DeviceNetworkStatus struct {
 	State        DPCState                // Details about testing state
 	CurrentIndex int                     // For logs
 	RadioSilence RadioSilence            // The actual state of the radio-silence mode
+	EncryptedDNS EncryptedDNSConfig      // DNS encryption used for management traffic
 	Ports        []NetworkPortStatus
 }
 
@@ -1371,6 +1991,9 @@ func (status DeviceNetworkStatus) MostlyEqual(status2 DeviceNetworkStatus) bool
 			p1.MacAddr != p2.MacAddr {
 			return false
 		}
+		if !p1.CaptivePortal.Equal(p2.CaptivePortal) {
+			return false
+		}
 		if len(p1.DefaultRouters) != len(p2.DefaultRouters) {
 			return false
 		}
@@ -1381,13 +2004,18 @@ func (status DeviceNetworkStatus) MostlyEqual(status2 DeviceNetworkStatus) bool
 		}
 
 		if !reflect.DeepEqual(p1.ProxyConfig, p2.ProxyConfig) ||
-			!reflect.DeepEqual(p1.WirelessStatus, p2.WirelessStatus) {
+			!p1.WirelessStatus.MostlyEqual(p2.WirelessStatus) ||
+			!reflect.DeepEqual(p1.PortAuth, p2.PortAuth) ||
+			!reflect.DeepEqual(p1.Neighbors, p2.Neighbors) {
 			return false
 		}
 	}
 	if !reflect.DeepEqual(status.RadioSilence, status2.RadioSilence) {
 		return false
 	}
+	if !status.EncryptedDNS.Equal(status2.EncryptedDNS) {
+		return false
+	}
 	return true
 }
 
@@ -1412,6 +2040,12 @@ func (status *DeviceNetworkStatus) MostlyEqualStatus(status2 DeviceNetworkStatus
 		if p1.HasError() != p2.HasError() {
 			return false
 		}
+		// Did we change the DNS resolver?
+		r1, r2 := p1.DNSResolver, p2.DNSResolver
+		if r1.Protocol != r2.Protocol || r1.Server != r2.Server ||
+			r1.Fallback != r2.Fallback || r1.EncryptedDNSError != r2.EncryptedDNSError {
+			return false
+		}
 	}
 	return true
 }
@@ -1482,6 +2116,16 @@ func (status DeviceNetworkStatus) HasErrors() bool {
 	return false
 }
 
+// CaptivePortalDetected - is any of the management ports behind a captive portal?
+func (status DeviceNetworkStatus) CaptivePortalDetected() bool {
+	for _, port := range status.Ports {
+		if port.IsMgmt && port.CaptivePortal.Detected() {
+			return true
+		}
+	}
+	return false
+}
+
 // GetPortAddrInfo returns address info for a given interface and its IP address.
 func (status DeviceNetworkStatus) GetPortAddrInfo(ifname string, addr net.IP) *AddrInfo {
 	portStatus := status.GetPortByIfName(ifname)
@@ -1975,6 +2619,12 @@ func (status *DeviceNetworkStatus) UpdatePortStatusFromIntfStatusMap(
 		if ok {
 			portPtr.TestResults.Update(tr)
 		}
+		if resolver, ok := intfStatusMap.DNSResolvers[portPtr.IfName]; ok {
+			portPtr.DNSResolver = resolver
+		}
+		if portal, ok := intfStatusMap.CaptivePortals[portPtr.IfName]; ok {
+			portPtr.CaptivePortal = portal
+		}
 		// Else - Port not tested hence no change
 	}
 }
@@ -2062,6 +2712,36 @@ type UnderlayNetworkConfig struct {
 	ACLs         []ACE
 	AccessVlanID uint32
 	IfIdx        uint32 // If we have multiple interfaces on that network, we will increase the index
+	QoS          AppInterfaceQoS
+}
+
+// AppInterfaceQoS : traffic shaping and QoS configured for an application interface.
+type AppInterfaceQoS struct {
+	// Egress : shaping of traffic sent by the application.
+	Egress TrafficShaping
+	// Ingress : shaping of traffic sent to the application.
+	Ingress TrafficShaping
+	// MarkDSCP : if enabled, DSCP field of every IP packet sent by the application
+	// is set to DSCP.
+	MarkDSCP bool
+	DSCP     uint8
+}
+
+// MaxDSCP : maximum valid value of the DSCP field (6 bits).
+const MaxDSCP = 63
+
+// TrafficShaping : bandwidth limit for one direction of application traffic.
+type TrafficShaping struct {
+	// RateKbps : maximum rate in kilobits per second. Zero disables shaping.
+	RateKbps uint64
+	// BurstBytes : maximum burst size. Zero means that a minimal burst suitable
+	// for the given rate is used.
+	BurstBytes uint32
+}
+
+// Enabled returns true if traffic shaping is enabled.
+func (ts TrafficShaping) Enabled() bool {
+	return ts.RateKbps > 0
 }
 
 type UnderlayNetworkStatus struct {
@@ -2112,6 +2792,7 @@ type NetworkXObjectConfig struct {
 	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
 	Proxy           *ProxyConfig
 	WirelessCfg     WirelessConfig
+	PortAuth        PortAuthConfig
 	// Any errors from the parser
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
@@ -2223,6 +2904,9 @@ type NetworkInstanceInfo struct {
 	VlanMap map[uint32]uint32
 	// Counts the number of trunk ports attached to this network instance
 	NumTrunkPorts uint32
+
+	// Clients associated with the WiFi access point bridged into this NI.
+	WifiAPClients []WifiAPClient
 }
 
 func (instanceInfo *NetworkInstanceInfo) IsVifInBridge(
@@ -2443,6 +3127,123 @@ const (
 	NetworkInstanceTypeLast        NetworkInstanceType = 255
 )
 
+// HostACLEnforcement : how are ACL rules matching on host names enforced.
+type HostACLEnforcement int32
+
+// The values here should be same as the ones defined in zconfig.HostACLEnforcement
+const (
+	// HostACLEnforcementDNS : traffic is allowed only towards IP addresses resolved
+	// for the host by the network instance DNS server (using ipsets).
+	HostACLEnforcementDNS HostACLEnforcement = 0
+	// HostACLEnforcementL7 : TCP connections matched by host-based ACL rules
+	// are additionally inspected and allowed only if the host name carried
+	// in the TLS SNI extension or in the HTTP Host header matches the rule.
+	HostACLEnforcementL7 HostACLEnforcement = 1
+)
+
+// DNSFilterMode : how are DNS queries made by applications filtered
+// by the network instance DNS server.
+type DNSFilterMode int32
+
+// The values here should be same as the ones defined in zconfig.DNSFilterMode
+const (
+	// DNSFilterModeNone : DNS queries are not filtered.
+	DNSFilterModeNone DNSFilterMode = 0
+	// DNSFilterModeBlocklist : queries for the listed domains (and their
+	// subdomains) are answered with NXDOMAIN.
+	DNSFilterModeBlocklist DNSFilterMode = 1
+	// DNSFilterModeAllowlist : only queries for the listed domains (and their
+	// subdomains) are resolved, all other queries are answered with NXDOMAIN.
+	DNSFilterModeAllowlist DNSFilterMode = 2
+)
+
+// String returns string representation of the DNS filter mode.
+func (m DNSFilterMode) String() string {
+	switch m {
+	case DNSFilterModeNone:
+		return "none"
+	case DNSFilterModeBlocklist:
+		return "blocklist"
+	case DNSFilterModeAllowlist:
+		return "allowlist"
+	}
+	return fmt.Sprintf("unknown(%d)", m)
+}
+
+// DNSFilter : filtering of DNS queries made by applications.
+type DNSFilter struct {
+	Mode DNSFilterMode
+	// Domains : domain names to block or allow (including all subdomains).
+	Domains []string
+}
+
+// Blocks returns true if the DNS query for the given host name is blocked
+// by the filter.
+func (f DNSFilter) Blocks(hostname string) bool {
+	var matches bool
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	for _, domain := range f.Domains {
+		domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			matches = true
+			break
+		}
+	}
+	switch f.Mode {
+	case DNSFilterModeBlocklist:
+		return matches
+	case DNSFilterModeAllowlist:
+		return !matches
+	}
+	return false
+}
+
+// DNSForwarder : conditional forwarding of DNS queries for a given domain
+// (and its subdomains) to specific DNS servers (split DNS).
+type DNSForwarder struct {
+	Domain  string
+	Servers []net.IP
+}
+
+// FlowExportProtocol : protocol used to export flow records to a collector.
+type FlowExportProtocol int32
+
+// The values here should be same as the ones defined in zconfig.FlowExportProtocol
+const (
+	// FlowExportProtocolUnspecified : IPFIX is used by default.
+	FlowExportProtocolUnspecified FlowExportProtocol = 0
+	// FlowExportProtocolIPFIX : IPFIX (RFC 7011).
+	FlowExportProtocolIPFIX FlowExportProtocol = 1
+	// FlowExportProtocolNetFlowV9 : Cisco NetFlow version 9 (RFC 3954).
+	FlowExportProtocolNetFlowV9 FlowExportProtocol = 2
+)
+
+// String returns the protocol name.
+func (p FlowExportProtocol) String() string {
+	switch p {
+	case FlowExportProtocolUnspecified, FlowExportProtocolIPFIX:
+		return "IPFIX"
+	case FlowExportProtocolNetFlowV9:
+		return "NetFlow-v9"
+	}
+	return fmt.Sprintf("Unknown flow export protocol (%d)", p)
+}
+
+// FlowExportConfig : export of application flow records (IPFlow) captured
+// by a network instance to an IPFIX/NetFlow v9 collector over UDP.
+type FlowExportConfig struct {
+	Enabled  bool
+	Protocol FlowExportProtocol
+	// Collector address: "<host>[:<port>]".
+	Collector string
+	// SamplingRate : export only 1 out of every N flow records.
+	// Zero or one means that all flow records are exported.
+	SamplingRate uint32
+	// TemplateRefreshInterval : how often (in seconds) to re-send templates.
+	// Zero means that the default interval is used.
+	TemplateRefreshInterval uint32
+}
+
 type AddressType int32
 
 // The values here should be same as the ones defined in zconfig.AddressType
@@ -2482,11 +3283,67 @@ type NetworkInstanceConfig struct {
 	DhcpRange       IpRange
 	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
 
+	// WifiAPLogicalLabel - references WiFi port operated in the access point mode,
+	// which should be bridged into this network instance.
+	// Empty if WiFi access point is not used.
+	WifiAPLogicalLabel string
+
+	// HostACLEnforcement - how are ACL rules with host matches enforced
+	// for applications connected to this (local) network instance.
+	HostACLEnforcement HostACLEnforcement
+
+	// FlowExport - export of application flow records to an IPFIX/NetFlow v9
+	// collector.
+	FlowExport FlowExportConfig
+
+	// DNSForwarders - conditional forwarding of DNS queries made by applications
+	// connected to this (local) network instance.
+	DNSForwarders []DNSForwarder
+
+	// DNSFilter - filtering of DNS queries made by applications connected
+	// to this (local) network instance.
+	DNSFilter DNSFilter
+
 	// Any errors from the parser
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
 }
 
+// AppIPRange returns the range of IP addresses from which zedrouter allocates
+// IP addresses for application VIFs. This is the entire DhcpRange, unless
+// a WiFi access point is bridged into a local network instance, in which case
+// the upper half of DhcpRange is reserved for WiFi clients
+// (see WifiAPClientIPRange).
+func (config NetworkInstanceConfig) AppIPRange() IpRange {
+	if !config.sharesDhcpRangeWithWifiAP() {
+		return config.DhcpRange
+	}
+	return IpRange{
+		Start: config.DhcpRange.Start,
+		End:   AddToIP(config.DhcpRange.Start, int(config.DhcpRange.Size()/2)),
+	}
+}
+
+// WifiAPClientIPRange returns the range of IP addresses which dnsmasq allocates
+// for clients of the WiFi access point bridged into a local network instance.
+// Returns empty range if WiFi access point is not used or if the network instance
+// is not local (clients of switch NI get IP addresses from an external DHCP server).
+func (config NetworkInstanceConfig) WifiAPClientIPRange() IpRange {
+	if !config.sharesDhcpRangeWithWifiAP() {
+		return IpRange{}
+	}
+	return IpRange{
+		Start: AddToIP(config.DhcpRange.Start, int(config.DhcpRange.Size()/2)+1),
+		End:   config.DhcpRange.End,
+	}
+}
+
+func (config NetworkInstanceConfig) sharesDhcpRangeWithWifiAP() bool {
+	return config.WifiAPLogicalLabel != "" &&
+		config.Type == NetworkInstanceTypeLocal &&
+		config.DhcpRange.Size() >= 2
+}
+
 func (config *NetworkInstanceConfig) Key() string {
 	return config.UUID.String()
 }
@@ -2849,6 +3706,9 @@ type FlowRec struct {
 	TxPkts    int64
 	RxBytes   int64
 	RxPkts    int64
+	// Hostname is obtained from TLS SNI or HTTP Host header.
+	// Only available for TCP flows inspected with HostACLEnforcementL7.
+	Hostname string
 }
 
 // DNSReq :
@@ -2857,6 +3717,8 @@ type DNSReq struct {
 	Addrs       []net.IP
 	RequestTime int64
 	ACLNum      int32
+	// Blocked is true if the query was blocked by the NI DNS filter.
+	Blocked bool
 }
 
 // IPFlow :
@@ -3081,8 +3943,97 @@ type WwanNetworkConfig struct {
 	// microservice. This is further distributed to the controller and
 	// to applications by zedagent.
 	LocationTracking bool `json:"location-tracking"`
+	// Parameters for connecting to cellular networks, ordered by preference.
+	// If non-empty, Apns is ignored by the wwan microservice.
+	AccessPoints []WwanAccessPoint `json:"access-points"`
+	// SIM slot to activate (0 - leave the currently activated).
+	// Ignored if SimFailover is enabled.
+	ActivatedSimSlot uint8 `json:"activated-sim-slot"`
+	// Automatically move to the next access point (and its SIM slot) when
+	// the connection cannot be established or the probe keeps failing.
+	SimFailover bool `json:"sim-failover"`
+}
+
+// WwanAccessPoint : parameters for connecting to a cellular network
+// using a given SIM card.
+type WwanAccessPoint struct {
+	// SIM slot to which this configuration applies (0 - the currently activated).
+	SIMSlot uint8 `json:"sim-slot"`
+	// Access Point Network to connect into.
+	APN          string           `json:"apn"`
+	AuthProtocol WwanAuthProtocol `json:"auth-protocol"`
+	IPType       WwanIPType       `json:"ip-type"`
+	// Credentials are decrypted by nim before they are passed to the wwan
+	// microservice.
+	Credentials    WwanCredentials `json:"credentials"`
+	PreferredPLMNs []string        `json:"preferred-plmns"`
+	ForbidRoaming  bool            `json:"forbid-roaming"`
+	PreferredRATs  []WwanRAT       `json:"preferred-rats"`
+}
+
+// WwanCredentials : user credentials for the cellular network and SIM card PIN.
+type WwanCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	SimPIN   string `json:"sim-pin,omitempty"`
+}
+
+// String hides the password and the PIN to avoid leaking them into logs.
+func (wc WwanCredentials) String() string {
+	mask := func(secret string) string {
+		if secret == "" {
+			return ""
+		}
+		return "<hidden>"
+	}
+	return fmt.Sprintf("{Username:%s Password:%s SimPIN:%s}",
+		wc.Username, mask(wc.Password), mask(wc.SimPIN))
 }
 
+// WwanAuthProtocol : authentication protocol used by cellular network.
+type WwanAuthProtocol string
+
+const (
+	// WwanAuthProtocolNone : no authentication.
+	WwanAuthProtocolNone WwanAuthProtocol = ""
+	// WwanAuthProtocolPAP : Password Authentication Protocol.
+	WwanAuthProtocolPAP WwanAuthProtocol = "pap"
+	// WwanAuthProtocolCHAP : Challenge-Handshake Authentication Protocol.
+	WwanAuthProtocolCHAP WwanAuthProtocol = "chap"
+	// WwanAuthProtocolPAPAndCHAP : Both PAP and CHAP.
+	WwanAuthProtocolPAPAndCHAP WwanAuthProtocol = "pap-and-chap"
+)
+
+// WwanIPType : IP version(s) requested for the cellular data bearer.
+type WwanIPType string
+
+const (
+	// WwanIPTypeUnspecified : IP type is not specified, IPv4 is used.
+	WwanIPTypeUnspecified WwanIPType = ""
+	// WwanIPTypeIPv4 : IPv4-only bearer.
+	WwanIPTypeIPv4 WwanIPType = "ipv4"
+	// WwanIPTypeIPv6 : IPv6-only bearer.
+	WwanIPTypeIPv6 WwanIPType = "ipv6"
+	// WwanIPTypeIPv4AndIPv6 : dual-stack bearer.
+	WwanIPTypeIPv4AndIPv6 WwanIPType = "ipv4v6"
+)
+
+// WwanRAT : Radio Access Technology.
+type WwanRAT string
+
+const (
+	// WwanRATUnspecified : RAT is not specified.
+	WwanRATUnspecified WwanRAT = ""
+	// WwanRATGSM : Global System for Mobile Communications (2G).
+	WwanRATGSM WwanRAT = "gsm"
+	// WwanRATUMTS : Universal Mobile Telecommunications System (3G).
+	WwanRATUMTS WwanRAT = "umts"
+	// WwanRATLTE : Long Term Evolution (4G).
+	WwanRATLTE WwanRAT = "lte"
+	// WwanRAT5GNR : 5G New Radio.
+	WwanRAT5GNR WwanRAT = "5gnr"
+)
+
 // WwanProbe : cellular connectivity verification probe.
 type WwanProbe struct {
 	Disable bool `json:"disable"`
@@ -3113,6 +4064,14 @@ func (wnc WwanNetworkConfig) Equal(wnc2 WwanNetworkConfig) bool {
 	if wnc.LocationTracking != wnc2.LocationTracking {
 		return false
 	}
+	if wnc.ActivatedSimSlot != wnc2.ActivatedSimSlot ||
+		wnc.SimFailover != wnc2.SimFailover {
+		return false
+	}
+	// Order of access points matters.
+	if !reflect.DeepEqual(wnc.AccessPoints, wnc2.AccessPoints) {
+		return false
+	}
 	if len(wnc.Apns) != len(wnc2.Apns) {
 		return false
 	}
@@ -3210,6 +4169,8 @@ type WwanNetworkStatus struct {
 	ConfigError  string         `json:"config-error"`
 	ProbeError   string         `json:"probe-error"`
 	Providers    []WwanProvider `json:"providers"`
+	// APN of the access point currently used (or being tried) to connect.
+	CurrentAPN string `json:"current-apn"`
 }
 
 // WwanCellModule contains cellular module specs.
@@ -3228,6 +4189,10 @@ type WwanSimCard struct {
 	ICCID  string `json:"iccid"`
 	IMSI   string `json:"imsi"`
 	Status string `json:"status"`
+	// SIM slot number (starting with 1, 0 if not known).
+	SlotNumber uint8 `json:"slot-number"`
+	// True if this SIM slot is activated.
+	SlotActivated bool `json:"slot-activated"`
 }
 
 // WwanProvider contains information about a cellular connectivity provider.
@@ -3455,6 +4420,162 @@ const (
 	LocReliabilityHigh LocReliability = "high"
 )
 
+// WlanStatus is published by the wlan service and consumed by nim.
+type WlanStatus struct {
+	Networks []WifiNetworkStatus `json:"networks"`
+}
+
+// LookupNetworkStatus returns status corresponding to the given WLAN interface.
+func (ws WlanStatus) LookupNetworkStatus(ifName string) (WifiNetworkStatus, bool) {
+	for _, status := range ws.Networks {
+		if ifName == status.Interface {
+			return status, true
+		}
+	}
+	return WifiNetworkStatus{}, false
+}
+
+// WifiNetworkStatus contains status information for a single WLAN adapter.
+type WifiNetworkStatus struct {
+	// Interface name of the WLAN adapter (e.g. wlan0).
+	Interface string `json:"interface"`
+	// State of the WiFi connection as reported by wpa_supplicant.
+	State WifiState `json:"state"`
+	// SSID of the currently associated network (empty if not associated).
+	SSID string `json:"ssid"`
+	// BSSID (MAC address) of the currently associated access point.
+	BSSID string `json:"bssid"`
+	// Frequency of the currently used channel in MHz.
+	Frequency uint32 `json:"frequency"`
+	// Key management protocol used for the current association (e.g. WPA2-PSK).
+	KeyMgmt string `json:"key-mgmt"`
+	// Number of authentication failures observed since the wlan service
+	// was started. Typically caused by wrong credentials.
+	AuthFailures uint32 `json:"auth-failures"`
+	// Description of the last authentication failure.
+	LastAuthFailure string `json:"last-auth-failure"`
+	// Results of the last periodic scan for WiFi networks.
+	ScanResults []WifiScanResult `json:"scan-results"`
+	// Time when the last scan was completed.
+	LastScan time.Time `json:"last-scan"`
+}
+
+// WifiScanResult describes a single access point found by the WiFi scan.
+type WifiScanResult struct {
+	SSID  string `json:"ssid"`
+	BSSID string `json:"bssid"`
+	// Frequency of the channel in MHz.
+	Frequency uint32 `json:"frequency"`
+	// Received signal strength indicator (RSSI) measured in dBm.
+	RSSI int32 `json:"rssi"`
+	// Flags advertised by the access point, such as "[WPA2-PSK-CCMP][ESS]".
+	Flags string `json:"flags"`
+}
+
+// WifiState : state of a WiFi connection.
+type WifiState string
+
+const (
+	// WifiStateUnspecified : state is not known
+	WifiStateUnspecified WifiState = ""
+	// WifiStateDisconnected : not associated with any access point
+	WifiStateDisconnected WifiState = "disconnected"
+	// WifiStateInactive : no enabled network to connect to
+	WifiStateInactive WifiState = "inactive"
+	// WifiStateScanning : searching for a network to associate with
+	WifiStateScanning WifiState = "scanning"
+	// WifiStateAuthenticating : authentication with an access point is in progress
+	WifiStateAuthenticating WifiState = "authenticating"
+	// WifiStateAssociating : association with an access point is in progress
+	WifiStateAssociating WifiState = "associating"
+	// WifiStateHandshake : associated, exchanging keys (4-way/group handshake)
+	WifiStateHandshake WifiState = "handshake"
+	// WifiStateConnected : associated and authenticated with an access point
+	WifiStateConnected WifiState = "connected"
+	// WifiStateDisabled : WLAN interface is disabled (e.g. by radio silence)
+	WifiStateDisabled WifiState = "disabled"
+)
+
+// WlanMetrics is published by the wlan service.
+type WlanMetrics struct {
+	Networks []WifiNetworkMetrics `json:"networks"`
+}
+
+// LookupNetworkMetrics returns metrics corresponding to the given WLAN interface.
+func (wm WlanMetrics) LookupNetworkMetrics(ifName string) (WifiNetworkMetrics, bool) {
+	for _, metrics := range wm.Networks {
+		if ifName == metrics.Interface {
+			return metrics, true
+		}
+	}
+	return WifiNetworkMetrics{}, false
+}
+
+// Key is used for pubsub
+func (wm WlanMetrics) Key() string {
+	return "global"
+}
+
+// LogCreate :
+func (wm WlanMetrics) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.Metricf("Wlan metrics create")
+}
+
+// LogModify :
+func (wm WlanMetrics) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+
+	oldWm, ok := old.(WlanMetrics)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object passed is not of WlanMetrics type")
+	}
+	logObject.CloneAndAddField("diff", cmp.Diff(oldWm, wm)).
+		Metricf("Wlan metrics modify")
+}
+
+// LogDelete :
+func (wm WlanMetrics) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+	logObject.Metricf("Wlan metrics delete")
+
+	base.DeleteLogObject(logBase, wm.LogKey())
+}
+
+// LogKey :
+func (wm WlanMetrics) LogKey() string {
+	return string(base.WlanMetricsLogType) + "-" + wm.Key()
+}
+
+// WifiNetworkMetrics contains metrics for a single WLAN adapter.
+type WifiNetworkMetrics struct {
+	// Interface name of the WLAN adapter (e.g. wlan0).
+	Interface string `json:"interface"`
+	// Logical label of the WLAN adapter in PhysicalIO.
+	// Not known to the wlan service, filled in by nim.
+	LogicalLabel string         `json:"logical-label"`
+	SignalInfo   WifiSignalInfo `json:"signal-info"`
+	// Transmit bitrate of the current link in kbps.
+	TxBitrate uint32 `json:"tx-bitrate"`
+}
+
+// WifiSignalInfo contains WiFi signal strength information.
+// The maximum value of int32 (0x7FFFFFFF) represents unspecified/unavailable metric.
+type WifiSignalInfo struct {
+	// Received signal strength indicator (RSSI) measured in dBm (decibel-milliwatts).
+	RSSI int32 `json:"rssi"`
+	// Noise level measured in dBm (decibel-milliwatts).
+	Noise int32 `json:"noise"`
+	// Link quality in percent (0-100).
+	LinkQuality int32 `json:"link-quality"`
+}
+
 // AppBlobsAvailable provides a list of AppCustom blobs which has been provided
 // from the cloud
 type AppBlobsAvailable struct {
@@ -4,6 +4,7 @@
 package types
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/satori/go.uuid"
@@ -1393,3 +1394,172 @@ func TestGetLocalAddrList(t *testing.T) {
 		}
 	}
 }
+
+func TestWirelessStatusMostlyEqual(t *testing.T) {
+	wifi := WifiNetworkStatus{
+		Interface: "wlan0",
+		State:     WifiStateConnected,
+		SSID:      "ssid",
+		ScanResults: []WifiScanResult{
+			{SSID: "ssid", RSSI: -50},
+		},
+		LastScan: time.Now(),
+	}
+	status1 := WirelessStatus{WType: WirelessTypeWifi, Wifi: wifi}
+
+	// New scan results should be ignored.
+	wifi.ScanResults = []WifiScanResult{{SSID: "ssid", RSSI: -60}, {SSID: "other"}}
+	wifi.LastScan = time.Now().Add(time.Minute)
+	status2 := WirelessStatus{WType: WirelessTypeWifi, Wifi: wifi}
+	assert.True(t, status1.MostlyEqual(status2))
+
+	// Change of the connection state should not be ignored.
+	wifi.State = WifiStateDisconnected
+	status2 = WirelessStatus{WType: WirelessTypeWifi, Wifi: wifi}
+	assert.False(t, status1.MostlyEqual(status2))
+}
+
+func TestWifiAPClientIPRange(t *testing.T) {
+	config := NetworkInstanceConfig{
+		Type: NetworkInstanceTypeLocal,
+		DhcpRange: IpRange{
+			Start: net.ParseIP("10.1.0.2"),
+			End:   net.ParseIP("10.1.0.101"),
+		},
+	}
+	// Without WiFi access point the entire DHCP range is used for apps.
+	assert.Equal(t, config.DhcpRange, config.AppIPRange())
+	assert.Equal(t, IpRange{}, config.WifiAPClientIPRange())
+
+	config.WifiAPLogicalLabel = "wlan0"
+	appRange := config.AppIPRange()
+	clientRange := config.WifiAPClientIPRange()
+	assert.True(t, appRange.Start.Equal(net.ParseIP("10.1.0.2")))
+	assert.True(t, appRange.End.Equal(net.ParseIP("10.1.0.51")))
+	assert.True(t, clientRange.Start.Equal(net.ParseIP("10.1.0.52")))
+	assert.True(t, clientRange.End.Equal(net.ParseIP("10.1.0.101")))
+	assert.False(t, appRange.Contains(clientRange.Start))
+
+	// DHCP range too small to be split.
+	smallConfig := config
+	smallConfig.DhcpRange.End = net.ParseIP("10.1.0.3")
+	assert.Equal(t, smallConfig.DhcpRange, smallConfig.AppIPRange())
+	assert.Equal(t, IpRange{}, smallConfig.WifiAPClientIPRange())
+
+	// WiFi clients of switch NI get IP addresses from an external DHCP server.
+	config.Type = NetworkInstanceTypeSwitch
+	assert.Equal(t, config.DhcpRange, config.AppIPRange())
+	assert.Equal(t, IpRange{}, config.WifiAPClientIPRange())
+}
+
+func TestWwanNetworkConfigAccessPoints(t *testing.T) {
+	ap := WwanAccessPoint{
+		SIMSlot:      1,
+		APN:          "iot.private",
+		AuthProtocol: WwanAuthProtocolCHAP,
+		IPType:       WwanIPTypeIPv4AndIPv6,
+		Credentials: WwanCredentials{
+			Username: "user",
+			Password: "secret-password",
+			SimPIN:   "1234",
+		},
+	}
+	config1 := WwanNetworkConfig{
+		LogicalLabel: "modem",
+		AccessPoints: []WwanAccessPoint{ap, {SIMSlot: 2, APN: "internet"}},
+		SimFailover:  true,
+	}
+	config2 := config1
+	config2.AccessPoints = []WwanAccessPoint{ap, {SIMSlot: 2, APN: "internet"}}
+	assert.True(t, config1.Equal(config2))
+
+	// Order of access points matters.
+	config2.AccessPoints = []WwanAccessPoint{config1.AccessPoints[1], ap}
+	assert.False(t, config1.Equal(config2))
+
+	// Password and PIN are not logged.
+	description := fmt.Sprintf("%+v", config1)
+	assert.Contains(t, description, "Username:user")
+	assert.NotContains(t, description, "secret-password")
+	assert.NotContains(t, description, "1234")
+}
+
+func TestDNSFilterBlocks(t *testing.T) {
+	blocklist := DNSFilter{
+		Mode:    DNSFilterModeBlocklist,
+		Domains: []string{"ads.example.com", "tracker.net."},
+	}
+	assert.True(t, blocklist.Blocks("ads.example.com"))
+	assert.True(t, blocklist.Blocks("cdn.ads.example.com."))
+	assert.True(t, blocklist.Blocks("TRACKER.net"))
+	assert.False(t, blocklist.Blocks("example.com"))
+	assert.False(t, blocklist.Blocks("badads.example.com"))
+
+	allowlist := DNSFilter{
+		Mode:    DNSFilterModeAllowlist,
+		Domains: []string{"example.com"},
+	}
+	assert.False(t, allowlist.Blocks("example.com"))
+	assert.False(t, allowlist.Blocks("www.example.com"))
+	assert.True(t, allowlist.Blocks("example.org"))
+
+	assert.False(t, DNSFilter{}.Blocks("example.org"))
+}
+
+func TestProxyAuthFailure(t *testing.T) {
+	intfMap := IntfStatusMap{StatusMap: make(map[string]TestResults)}
+	intfMap.RecordProxyAuthFailure("eth0", "proxy authentication failed")
+	intfMap.RecordFailure("eth1", "connection refused")
+
+	var port NetworkPortStatus
+	port.TestResults.Update(intfMap.StatusMap["eth0"])
+	assert.True(t, port.HasError())
+	assert.True(t, port.ProxyAuthFailed)
+
+	port.TestResults.Update(intfMap.StatusMap["eth1"])
+	assert.True(t, port.HasError())
+	assert.False(t, port.ProxyAuthFailed)
+
+	port.RecordProxyAuthFailure("proxy authentication failed")
+	assert.True(t, port.ProxyAuthFailed)
+	port.RecordSuccess()
+	assert.False(t, port.HasError())
+	assert.False(t, port.ProxyAuthFailed)
+
+	// Proxy password is not logged.
+	port.ProxyConfig.Credentials = &ProxyCredentials{
+		Username: `CORP\user`,
+		Password: "secret-password",
+	}
+	description := fmt.Sprintf("%+v", port)
+	assert.Contains(t, description, `Username:CORP\user`)
+	assert.NotContains(t, description, "secret-password")
+}
+
+func TestAppPrometheusIsAllowed(t *testing.T) {
+	config := AppPrometheusConfig{
+		Port:      9100,
+		Allowlist: []string{"http_requests_total", "node_cpu_*", "go_*"},
+	}
+	testMatrix := map[string]struct {
+		name     string
+		expected bool
+	}{
+		"exact match":          {name: "http_requests_total", expected: true},
+		"exact match extended": {name: "http_requests_total_sum", expected: false},
+		"exact match prefix":   {name: "http_requests", expected: false},
+		"prefix match":         {name: "node_cpu_seconds_total", expected: true},
+		"prefix itself":        {name: "node_cpu_", expected: true},
+		"prefix without '_'":   {name: "node_cpu", expected: false},
+		"other prefix match":   {name: "go_goroutines", expected: true},
+		"not in allowlist":     {name: "process_open_fds", expected: false},
+		"empty name":           {name: "", expected: false},
+	}
+	for testname, test := range testMatrix {
+		assert.Equal(t, test.expected, config.IsAllowed(test.name), testname)
+	}
+
+	// "*" alone allows every metric, empty allowlist none.
+	assert.True(t, AppPrometheusConfig{Allowlist: []string{"*"}}.IsAllowed("any_metric"))
+	assert.False(t, AppPrometheusConfig{}.IsAllowed("any_metric"))
+}
@@ -90,17 +90,24 @@ func backupFile(fileName string) error {
 
 // WriteRename write data to a fmpfile and then rename it to a desired name
 func WriteRename(fileName string, b []byte) error {
-	return writeRename(fileName, b, false)
+	return writeRename(fileName, b, false, 0)
+}
+
+// WriteRenameWithMode : just like WriteRename but the file is created
+// with the given permissions, which are set before the file is renamed.
+func WriteRenameWithMode(fileName string, b []byte, mode os.FileMode) error {
+	return writeRename(fileName, b, false, mode)
 }
 
 // WriteRenameWithBackup : just like WriteRename but additionally it creates
 // a backup of the original file at the same path but with the ".bak" extension
 // added.
 func WriteRenameWithBackup(fileName string, b []byte) error {
-	return writeRename(fileName, b, true)
+	return writeRename(fileName, b, true, 0)
 }
 
-func writeRename(fileName string, b []byte, withBackup bool) error {
+// writeRename keeps the permissions of the temporary file (0600) if mode is zero.
+func writeRename(fileName string, b []byte, withBackup bool, mode os.FileMode) error {
 	dirName := filepath.Dir(fileName)
 	// Do atomic rename to avoid partially written files
 	tmpfile, err := os.CreateTemp(dirName, "tmp")
@@ -130,6 +137,14 @@ func writeRename(fileName string, b []byte, withBackup bool) error {
 		return errors.New(errStr)
 	}
 
+	if mode != 0 {
+		if err := os.Chmod(tmpfile.Name(), mode); err != nil {
+			errStr := fmt.Sprintf("WriteRename(%s): %s",
+				fileName, err)
+			return errors.New(errStr)
+		}
+	}
+
 	if withBackup {
 		err = backupFile(fileName)
 		if err != nil {
@@ -32,6 +32,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cmd/nim"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/nodeagent"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/pbuf"
+	"github.com/lf-edge/eve/pkg/pillar/cmd/timesync"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/tpmmgr"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/upgradeconverter"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/vaultmgr"
@@ -103,6 +104,7 @@ var (
 		"upgradeconverter": {f: upgradeconverter.Run, inline: inlineAlways},
 		"watcher":          {f: watcher.Run},
 		"zfsmanager":       {f: zfsmanager.Run},
+		"timesync":         {f: timesync.Run},
 	}
 	logger *logrus.Logger
 	log    *base.LogObject
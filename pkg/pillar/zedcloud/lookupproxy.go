@@ -4,12 +4,16 @@
 package zedcloud
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/lf-edge/eve/libs/proxyauth"
 	"github.com/lf-edge/eve/libs/zedUpload"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -47,8 +51,7 @@ func LookupProxy(log *base.LogObject, status *types.DeviceNetworkStatus, ifname
 				log.Errorf(errStr)
 				return nil, errors.New(errStr)
 			}
-			proxyString, err := zedpac.Find_proxy_sync(
-				string(pacFile), rawUrl, u.Host)
+			proxyString, err := findProxyWithPAC(&port, pacFile, u, rawUrl)
 			if err != nil {
 				errStr := fmt.Sprintf("LookupProxy: PAC file could not find proxy for %s: %s",
 					rawUrl, err)
@@ -124,6 +127,105 @@ func LookupProxy(log *base.LogObject, status *types.DeviceNetworkStatus, ifname
 	return nil, nil
 }
 
+const (
+	// How long to cache results of PAC file evaluations.
+	pacCacheTTL = 5 * time.Minute
+	// Maximum number of cached results of PAC file evaluations.
+	pacCacheMaxEntries = 256
+)
+
+// PAC file is evaluated for every request sent through the port.
+// Because evaluation may involve DNS queries (dnsResolve, isInNet) and runs
+// an untrusted script, results are cached per port, PAC file and target
+// scheme + host.
+type pacCacheKey struct {
+	ifName  string
+	pacHash [sha256.Size]byte
+	target  string
+}
+
+type pacCacheEntry struct {
+	proxyString string
+	expiresAt   time.Time
+}
+
+var pacCache = struct {
+	sync.Mutex
+	entries map[pacCacheKey]pacCacheEntry
+}{entries: make(map[pacCacheKey]pacCacheEntry)}
+
+// findProxyWithPAC returns (possibly cached) result of the PAC file evaluation.
+func findProxyWithPAC(port *types.NetworkPortStatus, pacFile []byte,
+	u *url.URL, rawURL string) (string, error) {
+	key := pacCacheKey{
+		ifName:  port.IfName,
+		pacHash: sha256.Sum256(pacFile),
+		target:  u.Scheme + "://" + u.Host,
+	}
+	now := time.Now()
+	pacCache.Lock()
+	entry, found := pacCache.entries[key]
+	pacCache.Unlock()
+	if found && now.Before(entry.expiresAt) {
+		return entry.proxyString, nil
+	}
+	evaluator := &zedpac.Evaluator{}
+	for _, addr := range port.AddrInfoList {
+		if addr.Addr.To4() != nil && !addr.Addr.IsLinkLocalUnicast() {
+			evaluator.MyIPAddress = addr.Addr
+			break
+		}
+	}
+	proxyString, err := evaluator.FindProxy(string(pacFile), rawURL, u.Hostname())
+	if err != nil {
+		// Errors are not cached.
+		return "", err
+	}
+	pacCache.Lock()
+	defer pacCache.Unlock()
+	if len(pacCache.entries) >= pacCacheMaxEntries {
+		for k, e := range pacCache.entries {
+			if now.After(e.expiresAt) {
+				delete(pacCache.entries, k)
+			}
+		}
+		if len(pacCache.entries) >= pacCacheMaxEntries {
+			pacCache.entries = make(map[pacCacheKey]pacCacheEntry)
+		}
+	}
+	pacCache.entries[key] = pacCacheEntry{
+		proxyString: proxyString,
+		expiresAt:   now.Add(pacCacheTTL),
+	}
+	return proxyString, nil
+}
+
+// LookupProxyAuth returns the method and the (decrypted) credentials to use
+// for authentication with the proxy configured for the port.
+// Returns empty credentials if proxy authentication is not configured.
+func LookupProxyAuth(status *types.DeviceNetworkStatus,
+	ifname string) (proxyauth.Method, proxyauth.Credentials) {
+	port := status.GetPortByIfName(ifname)
+	if port == nil || port.ProxyConfig.Credentials == nil {
+		return proxyauth.MethodAuto, proxyauth.Credentials{}
+	}
+	var method proxyauth.Method
+	switch port.ProxyConfig.AuthMethod {
+	case types.ProxyAuthBasic:
+		method = proxyauth.MethodBasic
+	case types.ProxyAuthNTLM:
+		method = proxyauth.MethodNTLM
+	case types.ProxyAuthNegotiate:
+		method = proxyauth.MethodNegotiate
+	default:
+		method = proxyauth.MethodAuto
+	}
+	return method, proxyauth.Credentials{
+		Username: port.ProxyConfig.Credentials.Username,
+		Password: port.ProxyConfig.Credentials.Password,
+	}
+}
+
 // IntfLookupProxyCfg - check if the intf has proxy configured
 func IntfLookupProxyCfg(log *base.LogObject, status *types.DeviceNetworkStatus, ifname, downloadURL string,
 	trType zedUpload.SyncTransportType) string {
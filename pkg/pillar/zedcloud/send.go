@@ -25,7 +25,9 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/nettrace"
+	"github.com/lf-edge/eve/libs/proxyauth"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/encdns"
 	"github.com/lf-edge/eve/pkg/pillar/netdump"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
@@ -139,6 +141,9 @@ type SendRetval struct {
 	HTTPResp     *http.Response
 	RespContents []byte
 	TracedReqs   []netdump.TracedNetRequest
+	// DNSResolver used for the request (zero value if domain name
+	// resolution was not needed).
+	DNSResolver types.DNSResolverStatus
 }
 
 // VerifyRetval is returned from connectivity verification (VerifyAllIntf).
@@ -359,6 +364,9 @@ func VerifyAllIntf(ctx *ZedCloudContext, url string, requiredSuccessCount uint,
 		rv, err := SendOnIntf(ctxWork, ctx, url, intf, 0, nil,
 			allowProxy, useOnboard, withNetTracing, dryRun)
 		verifyRV.TracedReqs = append(verifyRV.TracedReqs, rv.TracedReqs...)
+		if !rv.DNSResolver.LastUsed.IsZero() {
+			verifyRV.IntfStatusMap.RecordDNSResolver(intf, rv.DNSResolver)
+		}
 		switch rv.Status {
 		case types.SenderStatusRefused, types.SenderStatusCertInvalid:
 			verifyRV.RemoteTempFailure = true
@@ -380,7 +388,11 @@ func VerifyAllIntf(ctx *ZedCloudContext, url string, requiredSuccessCount uint,
 					IfName: intf,
 				})
 			}
-			verifyRV.IntfStatusMap.RecordFailure(intf, err.Error())
+			if isProxyAuthFailure(err) {
+				verifyRV.IntfStatusMap.RecordProxyAuthFailure(intf, err.Error())
+			} else {
+				verifyRV.IntfStatusMap.RecordFailure(intf, err.Error())
+			}
 			continue
 		}
 		if dryRun {
@@ -416,7 +428,11 @@ func VerifyAllIntf(ctx *ZedCloudContext, url string, requiredSuccessCount uint,
 				Err:    err,
 				IfName: intf,
 			})
-			verifyRV.IntfStatusMap.RecordFailure(intf, err.Error())
+			if rv.HTTPResp.StatusCode == http.StatusProxyAuthRequired {
+				verifyRV.IntfStatusMap.RecordProxyAuthFailure(intf, err.Error())
+			} else {
+				verifyRV.IntfStatusMap.RecordFailure(intf, err.Error())
+			}
 			continue
 		}
 	}
@@ -548,6 +564,7 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 	// Get the transport header with proxy information filled
 	proxyURL, err := LookupProxy(ctx.log, ctx.DeviceNetworkStatus, intf, reqURL)
 	var usedProxy, usedProxyWithIP bool
+	var proxyTunnel *proxyauth.Tunnel
 	if err == nil && proxyURL != nil && allowProxy {
 		log.Tracef("sendOnIntf: For input URL %s, proxy found is %s",
 			reqURL, proxyURL.String())
@@ -555,7 +572,29 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		host := strings.Split(proxyURL.Host, ":")[0]
 		usedProxyWithIP = net.ParseIP(host) != nil
 		clientConfig.TLSClientConfig = ctx.TlsConfig
-		clientConfig.Proxy = http.ProxyURL(proxyURL)
+		method, creds := LookupProxyAuth(ctx.DeviceNetworkStatus, intf)
+		switch {
+		case !creds.IsSet():
+			clientConfig.Proxy = http.ProxyURL(proxyURL)
+		case method == proxyauth.MethodBasic:
+			proxyURL.User = url.UserPassword(creds.Username, creds.Password)
+			clientConfig.Proxy = http.ProxyURL(proxyURL)
+		default:
+			// NTLM and Negotiate need multiple round-trips over the same
+			// connection, which http.Transport does not support.
+			// Instead, the CONNECT tunnel is established by proxyauth.Tunnel
+			// and the transport uses it as if it was a direct connection.
+			proxyTunnel = &proxyauth.Tunnel{
+				Proxy:       proxyURL,
+				Method:      method,
+				Credentials: creds,
+			}
+			clientConfig.DialTunnel = func(ctx context.Context, dial nettrace.DialFunc,
+				network, address string) (net.Conn, error) {
+				return proxyTunnel.WithDial(proxyauth.DialFunc(dial)).DialContext(
+					ctx, network, address)
+			}
+		}
 	} else {
 		clientConfig.TLSClientConfig = ctx.TlsConfig
 	}
@@ -712,6 +751,8 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 		apiCallStartTime := time.Now()
 
 		// Prepare the HTTP client.
+		// Domain name is resolved using encrypted DNS if enabled.
+		dnsDialer := encdns.NewDialer(ctx.DeviceNetworkStatus.EncryptedDNS)
 		var (
 			client         *http.Client
 			tracedClient   *nettrace.HTTPClient
@@ -723,6 +764,7 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 			fromDNSCache bool
 		)
 		if withNetTracing {
+			clientConfig.DialNameserver = dnsDialer.DialNameserver
 			tracedClient, err = nettrace.NewHTTPClient(clientConfig, ctx.NetTraceOpts...)
 			if err != nil {
 				log.Errorf("SendOnIntf: nettrace.NewHTTPClient failed: %v\n", err)
@@ -756,9 +798,16 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 					return nil, fmt.Errorf("skipped nameserver %v: %s", dnsIP, reason)
 				}
 				dnsIsAvail = true
-				// XXX can we fallback to TCP? Would get a mismatched address if we do
-				d := net.Dialer{LocalAddr: &localUDPAddr}
-				return d.Dial(network, address)
+				dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+					d := net.Dialer{LocalAddr: &localUDPAddr}
+					if strings.HasPrefix(network, "tcp") {
+						// Used to connect to encrypted DNS resolver.
+						d.LocalAddr = &localTCPAddr
+					}
+					return d.DialContext(ctx, network, address)
+				}
+				conn, _, _, err := dnsDialer.DialNameserver(ctx, dial, network, address)
+				return conn, err
 			}
 			r := net.Resolver{Dial: resolverDial, PreferGo: true, StrictErrors: false}
 			d := net.Dialer{
@@ -767,11 +816,17 @@ func SendOnIntf(workContext context.Context, ctx *ZedCloudContext, destURL strin
 				Timeout:   clientConfig.TCPHandshakeTimeout,
 			}
 			transport.DialContext = d.DialContext
+			if proxyTunnel != nil {
+				transport.DialContext = proxyTunnel.WithDial(d.DialContext).DialContext
+			}
 			client = &http.Client{Transport: transport, Timeout: clientConfig.ReqTimeout}
 		}
 
 		// Execute the HTTP request.
 		resp, err := client.Do(req)
+		if dnsStatus := dnsDialer.Status(); !dnsStatus.LastUsed.IsZero() {
+			rv.DNSResolver = dnsStatus
+		}
 
 		// Handle failed HTTP request (have not received HTTP response).
 		if err != nil {
@@ -1220,6 +1275,29 @@ func isCertUnknownAuthority(err error) bool {
 	return true
 }
 
+// isProxyAuthFailure returns true if the request (or any of its attempts)
+// failed because the proxy rejected the credentials or required them
+// while none were configured.
+func isProxyAuthFailure(err error) bool {
+	if proxyauth.IsAuthError(err) {
+		return true
+	}
+	// With Basic authentication (or without any) the CONNECT request
+	// is sent by http.Transport, which only returns the response status.
+	if strings.Contains(err.Error(), http.StatusText(http.StatusProxyAuthRequired)) {
+		return true
+	}
+	var sendErr *SendError
+	if errors.As(err, &sendErr) {
+		for _, attempt := range sendErr.Attempts {
+			if attempt.Err != nil && isProxyAuthFailure(attempt.Err) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isECONNREFUSED(err error) bool {
 	e0, ok := err.(*url.Error)
 	if !ok {
@@ -1,19 +1,369 @@
 // Copyright (c) 2018 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
+// Package zedpac evaluates proxy auto-configuration (PAC) files.
+// PAC file is a JavaScript program received from the controller or downloaded
+// using WPAD, i.e. it should not be trusted. It is therefore executed inside
+// a sandboxed JavaScript runtime, which only exposes the PAC helper functions
+// (implemented in Go), limits the stack depth and interrupts evaluation
+// which takes too long.
 package zedpac
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/jackwakefield/gopac"
+	"github.com/robertkrimen/otto"
 )
 
+const (
+	// DefaultTimeout : default limit for a single PAC evaluation.
+	DefaultTimeout = 5 * time.Second
+	// DefaultDNSTimeout : default limit for a single name resolution
+	// requested by the PAC file.
+	DefaultDNSTimeout = 2 * time.Second
+	// Maximum depth of the JavaScript call stack.
+	maxStackDepth = 256
+)
+
+// ErrTimeout is returned when PAC evaluation did not complete in time.
+var ErrTimeout = errors.New("PAC evaluation timed out")
+
+// Evaluator evaluates PAC files inside a sandboxed JavaScript runtime.
+// Zero value is ready to use, with default timeouts and the default resolver.
+type Evaluator struct {
+	// Timeout limits the time of a single PAC evaluation (including parsing).
+	// Zero value means DefaultTimeout.
+	Timeout time.Duration
+	// Resolver is used by dnsResolve, isResolvable and isInNet.
+	// If nil, net.DefaultResolver is used.
+	Resolver *net.Resolver
+	// DNSTimeout limits a single name resolution.
+	// Zero value means DefaultDNSTimeout.
+	DNSTimeout time.Duration
+	// MyIPAddress is returned by myIpAddress().
+	// If nil, the loopback address is returned.
+	MyIPAddress net.IP
+	// Now returns the current time used by weekdayRange, dateRange and timeRange.
+	// If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// Find_proxy_sync evaluates the PAC file with the default Evaluator
+// and returns the result of FindProxyForURL(url, host).
 func Find_proxy_sync(pac, url, host string) (string, error) {
-	parser := new(gopac.Parser)
-	if err := parser.ParseBytes([]byte(pac)); err != nil {
-		return "", fmt.Errorf("invalid proxy auto-configuration file: %v", err)
+	return (&Evaluator{}).FindProxy(pac, url, host)
+}
+
+// FindProxy evaluates the PAC file and returns the result
+// of FindProxyForURL(url, host).
+func (e *Evaluator) FindProxy(pac, url, host string) (proxy string, err error) {
+	timeout := e.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	vm := e.newRuntime()
+	vm.Interrupt = make(chan func(), 1)
+	timer := time.AfterFunc(timeout, func() {
+		vm.Interrupt <- func() {
+			panic(ErrTimeout)
+		}
+	})
+	defer timer.Stop()
+	defer func() {
+		if r := recover(); r != nil {
+			if r == ErrTimeout {
+				err = ErrTimeout
+				return
+			}
+			err = fmt.Errorf("PAC evaluation failed: %v", r)
+		}
+	}()
+	if _, err = vm.Run(pac); err != nil {
+		return "", fmt.Errorf("invalid proxy auto-configuration file: %w", err)
+	}
+	value, err := vm.Call("FindProxyForURL", nil, url, host)
+	if err != nil {
+		return "", err
+	}
+	return value.ToString()
+}
+
+// newRuntime prepares JavaScript runtime with PAC helper functions.
+func (e *Evaluator) newRuntime() *otto.Otto {
+	vm := otto.New()
+	vm.SetStackDepthLimit(maxStackDepth)
+	// Do not let PAC file write into our logs.
+	vm.Set("console", otto.UndefinedValue())
+	helpers := map[string]func(args []string) interface{}{
+		"isPlainHostName": func(args []string) interface{} {
+			return !strings.Contains(arg(args, 0), ".")
+		},
+		"dnsDomainIs": func(args []string) interface{} {
+			return strings.HasSuffix(arg(args, 0), arg(args, 1))
+		},
+		"localHostOrDomainIs": func(args []string) interface{} {
+			host, hostdom := arg(args, 0), arg(args, 1)
+			return host == hostdom || strings.HasPrefix(hostdom, host+".")
+		},
+		"isResolvable": func(args []string) interface{} {
+			return e.resolve(arg(args, 0)) != nil
+		},
+		"isInNet": func(args []string) interface{} {
+			return e.isInNet(arg(args, 0), arg(args, 1), arg(args, 2))
+		},
+		"dnsResolve": func(args []string) interface{} {
+			if ip := e.resolve(arg(args, 0)); ip != nil {
+				return ip.String()
+			}
+			return ""
+		},
+		"myIpAddress": func(args []string) interface{} {
+			if e.MyIPAddress != nil {
+				return e.MyIPAddress.String()
+			}
+			return "127.0.0.1"
+		},
+		"dnsDomainLevels": func(args []string) interface{} {
+			return strings.Count(arg(args, 0), ".")
+		},
+		"shExpMatch": func(args []string) interface{} {
+			return shExpMatch(arg(args, 0), arg(args, 1))
+		},
+		"weekdayRange": func(args []string) interface{} {
+			return weekdayRange(e.now(), args)
+		},
+		"dateRange": func(args []string) interface{} {
+			return dateRange(e.now(), args)
+		},
+		"timeRange": func(args []string) interface{} {
+			return timeRange(e.now(), args)
+		},
+	}
+	for name, helper := range helpers {
+		helper := helper
+		vm.Set(name, func(call otto.FunctionCall) otto.Value {
+			args := make([]string, 0, len(call.ArgumentList))
+			for _, a := range call.ArgumentList {
+				args = append(args, a.String())
+			}
+			value, err := call.Otto.ToValue(helper(args))
+			if err != nil {
+				return otto.UndefinedValue()
+			}
+			return value
+		})
+	}
+	return vm
+}
+
+func (e *Evaluator) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
+
+// resolve returns the first IPv4 address of the host, or nil if the host
+// cannot be resolved.
+func (e *Evaluator) resolve(host string) net.IP {
+	if host == "" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.To4()
+	}
+	resolver := e.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	timeout := e.DNSTimeout
+	if timeout == 0 {
+		timeout = DefaultDNSTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ips, err := resolver.LookupIP(ctx, "ip4", host)
+	if err != nil || len(ips) == 0 {
+		return nil
+	}
+	return ips[0].To4()
+}
+
+// isInNet returns true if the IP address of the host matches the pattern
+// for the bits selected by the mask.
+func (e *Evaluator) isInNet(host, pattern, mask string) bool {
+	ip := e.resolve(host)
+	patternIP := net.ParseIP(pattern).To4()
+	maskIP := net.ParseIP(mask).To4()
+	if ip == nil || patternIP == nil || maskIP == nil {
+		return false
+	}
+	ipMask := net.IPMask(maskIP)
+	return ip.Mask(ipMask).Equal(patternIP.Mask(ipMask))
+}
+
+func arg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
+
+// shExpMatch returns true if the string matches the shell expression.
+// Other regexp meta-characters are intentionally left unescaped,
+// some PAC files in the wild use alternation, e.g. "(*.a.com|a.com)".
+func shExpMatch(str, shexp string) bool {
+	expr := strings.ReplaceAll(shexp, ".", `\.`)
+	expr = strings.ReplaceAll(expr, "*", ".*")
+	expr = strings.ReplaceAll(expr, "?", ".")
+	matched, err := regexp.MatchString("^"+expr+"$", str)
+	return err == nil && matched
+}
+
+// splitGMT removes the optional trailing "GMT" argument and converts
+// the time to UTC if it was present.
+func splitGMT(now time.Time, args []string) (time.Time, []string) {
+	if len(args) > 0 && args[len(args)-1] == "GMT" {
+		return now.UTC(), args[:len(args)-1]
+	}
+	return now, args
+}
+
+// inRange returns true if value is in the inclusive range from..to,
+// which wraps around if from is greater than to.
+func inRange(value, from, to int) bool {
+	if from <= to {
+		return from <= value && value <= to
+	}
+	return value >= from || value <= to
+}
+
+var weekdays = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// weekdayRange(wd1 [, wd2] [, "GMT"])
+func weekdayRange(now time.Time, args []string) bool {
+	now, args = splitGMT(now, args)
+	if len(args) < 1 || len(args) > 2 {
+		return false
+	}
+	wd1, ok := weekdays[args[0]]
+	if !ok {
+		return false
 	}
+	wd2 := wd1
+	if len(args) == 2 {
+		if wd2, ok = weekdays[args[1]]; !ok {
+			return false
+		}
+	}
+	return inRange(int(now.Weekday()), wd1, wd2)
+}
+
+var months = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
 
-	return parser.FindProxy(url, host)
+// datePoint is a (partial) date given to dateRange.
+// Zero value of a field means that it was not specified.
+type datePoint struct {
+	year, month, day int
+}
+
+func parseDatePoint(args []string) (p datePoint, ok bool) {
+	for _, a := range args {
+		if month, isMonth := months[a]; isMonth {
+			p.month = month
+			continue
+		}
+		n, err := strconv.Atoi(a)
+		switch {
+		case err != nil || n <= 0:
+			return p, false
+		case n <= 31:
+			p.day = n
+		default:
+			p.year = n
+		}
+	}
+	return p, true
+}
+
+// key returns a number which can be used to compare date points
+// with the same set of specified fields.
+func (p datePoint) key(mask datePoint) int {
+	var key int
+	if mask.year != 0 {
+		key += p.year * 10000
+	}
+	if mask.month != 0 {
+		key += p.month * 100
+	}
+	if mask.day != 0 {
+		key += p.day
+	}
+	return key
+}
+
+// dateRange(day | month | year [, "GMT"])
+// dateRange(day1, day2 | month1, month2 | year1, year2 [, "GMT"])
+// dateRange(day1, month1, day2, month2 | month1, year1, month2, year2 [, "GMT"])
+// dateRange(day1, month1, year1, day2, month2, year2 [, "GMT"])
+func dateRange(now time.Time, args []string) bool {
+	now, args = splitGMT(now, args)
+	current := datePoint{year: now.Year(), month: int(now.Month()), day: now.Day()}
+	switch len(args) {
+	case 1:
+		p, ok := parseDatePoint(args)
+		return ok && current.key(p) == p.key(p)
+	case 2, 4, 6:
+		half := len(args) / 2
+		from, ok1 := parseDatePoint(args[:half])
+		to, ok2 := parseDatePoint(args[half:])
+		if !ok1 || !ok2 {
+			return false
+		}
+		return inRange(current.key(from), from.key(from), to.key(from))
+	}
+	return false
+}
+
+// timeRange(hour [, "GMT"])
+// timeRange(hour1, hour2 [, "GMT"])
+// timeRange(hour1, min1, hour2, min2 [, "GMT"])
+// timeRange(hour1, min1, sec1, hour2, min2, sec2 [, "GMT"])
+func timeRange(now time.Time, args []string) bool {
+	now, args = splitGMT(now, args)
+	values := make([]int, len(args))
+	for i, a := range args {
+		n, err := strconv.Atoi(a)
+		if err != nil || n < 0 {
+			return false
+		}
+		values[i] = n
+	}
+	seconds := func(h, m, s int) int { return h*3600 + m*60 + s }
+	current := seconds(now.Hour(), now.Minute(), now.Second())
+	switch len(values) {
+	case 1:
+		return now.Hour() == values[0]
+	case 2:
+		return inRange(now.Hour(), values[0], values[1])
+	case 4:
+		return inRange(current, seconds(values[0], values[1], 0),
+			seconds(values[2], values[3], 59))
+	case 6:
+		return inRange(current, seconds(values[0], values[1], values[2]),
+			seconds(values[3], values[4], values[5]))
+	}
+	return false
 }
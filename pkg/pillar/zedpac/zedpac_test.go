@@ -3,7 +3,12 @@
 
 package zedpac
 
-import "testing"
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
 
 type case_table struct {
 	url    string
@@ -1759,3 +1764,75 @@ return false;
 		}
 	}
 }
+
+func TestPacTimeout(t *testing.T) {
+	evaluator := &Evaluator{Timeout: 100 * time.Millisecond}
+	_, err := evaluator.FindProxy(`function FindProxyForURL(url, host) {
+    while (true) {}
+}`, "http://example.com/", "example.com")
+	if err != ErrTimeout {
+		t.Errorf("Expected timeout error, got: %v", err)
+	}
+	_, err = evaluator.FindProxy(`while (true) {}`, "http://example.com/", "example.com")
+	if err != ErrTimeout {
+		t.Errorf("Expected timeout error, got: %v", err)
+	}
+}
+
+func TestPacSandbox(t *testing.T) {
+	evaluator := &Evaluator{MyIPAddress: net.ParseIP("10.10.5.20")}
+	pac := `function FindProxyForURL(url, host) {
+    if (typeof console !== "undefined")
+        return "PROXY console:80";
+    if (isInNet(myIpAddress(), "10.10.5.0", "255.255.255.0"))
+        return "PROXY 1.2.3.4:8080";
+    return "DIRECT";
+}`
+	result, err := evaluator.FindProxy(pac, "http://example.com/", "example.com")
+	if err != nil || result != "PROXY 1.2.3.4:8080" {
+		t.Errorf("Got '%s' (err: %v), expected 'PROXY 1.2.3.4:8080'", result, err)
+	}
+	_, err = evaluator.FindProxy(`function f() { return f(); }
+function FindProxyForURL(url, host) { return f(); }`, "http://example.com/", "example.com")
+	if err == nil {
+		t.Errorf("Expected error for unbounded recursion")
+	}
+}
+
+func TestPacTimeFunctions(t *testing.T) {
+	// Wednesday, 15th of March 2023, 14:30:15 UTC
+	now := time.Date(2023, time.March, 15, 14, 30, 15, 0, time.UTC)
+	evaluator := &Evaluator{Now: func() time.Time { return now }}
+	tests := map[string]bool{
+		`weekdayRange("WED")`:                               true,
+		`weekdayRange("MON", "FRI")`:                        true,
+		`weekdayRange("SAT", "TUE")`:                        false,
+		`weekdayRange("FRI", "WED", "GMT")`:                 true,
+		`dateRange(15)`:                                     true,
+		`dateRange("MAR")`:                                  true,
+		`dateRange(2022)`:                                   false,
+		`dateRange("JAN", "MAR")`:                           true,
+		`dateRange("NOV", "FEB")`:                           false,
+		`dateRange(1, "MAR", 14, "MAR")`:                    false,
+		`dateRange(1, "MAR", 2023, 31, "MAR", 2023, "GMT")`: true,
+		`dateRange("DEC", 2022, "JAN", 2024)`:               true,
+		`timeRange(14)`:                                     true,
+		`timeRange(9, 17)`:                                  true,
+		`timeRange(22, 6)`:                                  false,
+		`timeRange(14, 0, 14, 29)`:                          false,
+		`timeRange(14, 30, 0, 14, 30, 30, "GMT")`:           true,
+	}
+	for expr, expected := range tests {
+		pac := fmt.Sprintf(`function FindProxyForURL(url, host) {
+    return %s ? "PROXY yes:80" : "DIRECT";
+}`, expr)
+		result, err := evaluator.FindProxy(pac, "http://example.com/", "example.com")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", expr, err)
+			continue
+		}
+		if (result == "PROXY yes:80") != expected {
+			t.Errorf("%s: got '%s', expected %t", expr, result, expected)
+		}
+	}
+}
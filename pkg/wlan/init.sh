@@ -8,11 +8,158 @@
 ip link set wlan0 up
 filetime=0
 wpaproc="wpa_supplicant"
+wlanif="wlan0"
 configdir=/run/wlan
 configfile=/run/wlan/wpa_supplicant.conf
+statusfile=/run/wlan/status.json
+metricsfile=/run/wlan/metrics.json
+# Written into wpa_supplicant.conf by NIM when the adapter operates as an access
+# point. In that case hostapd is started by zedrouter instead of wpa_supplicant.
+apmodemarker="# WiFi adapter operates in the access point mode"
+# Scan for available networks every 6 iterations (i.e. once per minute).
+scaninterval=6
+# Maximum value of int32 represents unavailable metric.
+unavailable=2147483647
+
+iteration=0
+lastscan="0001-01-01T00:00:00Z"
+scanresults="[]"
+authfailures=0
+lastauthfailure=""
+tempdisabled=""
+
+# Atomically replace the published file, but only if the content has changed.
+publish() {
+  local FILE="$1"
+  mkdir -p "$configdir"
+  cat > "${FILE}.tmp"
+  if cmp -s "${FILE}.tmp" "$FILE"; then
+    rm -f "${FILE}.tmp"
+  else
+    mv "${FILE}.tmp" "$FILE"
+  fi
+}
+
+wifi_state() {
+  case "$1" in
+    DISCONNECTED) echo "disconnected" ;;
+    INACTIVE) echo "inactive" ;;
+    SCANNING) echo "scanning" ;;
+    AUTHENTICATING) echo "authenticating" ;;
+    ASSOCIATING|ASSOCIATED) echo "associating" ;;
+    4WAY_HANDSHAKE|GROUP_HANDSHAKE) echo "handshake" ;;
+    COMPLETED) echo "connected" ;;
+    INTERFACE_DISABLED) echo "disabled" ;;
+    *) echo "" ;;
+  esac
+}
+
+# Parse "key=value" lines into a JSON object.
+kv_to_json() {
+  jq -R -s -c 'split("\n") | map(select(test("^[^=]+="))
+    | capture("^(?<key>[^=]+)=(?<value>.*)$")) | from_entries'
+}
+
+update_scan() {
+  if [ $((iteration % scaninterval)) -eq 0 ]; then
+    wpa_cli -i "$wlanif" scan >/dev/null 2>&1
+  elif [ $((iteration % scaninterval)) -eq 1 ]; then
+    # Columns: bssid / frequency / signal level / flags / ssid
+    local RESULTS
+    RESULTS="$(wpa_cli -i "$wlanif" scan_results 2>/dev/null | tail -n +2 |\
+      jq -R -s -c 'split("\n") | map(select(length > 0) | split("\t")
+        | {"ssid": (.[4] // ""), "bssid": .[0], "frequency": (.[1] | tonumber),
+           "rssi": (.[2] | tonumber), "flags": (.[3] // "")})')"
+    if [ -n "$RESULTS" ]; then
+      scanresults="$RESULTS"
+      lastscan="$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+    fi
+  fi
+}
+
+update_auth_failures() {
+  # Network is temporarily disabled by wpa_supplicant after
+  # an authentication failure (typically caused by a wrong password).
+  local DISABLED
+  DISABLED="$(wpa_cli -i "$wlanif" list_networks 2>/dev/null |\
+    awk -F'\t' '/TEMP-DISABLED/ {print $2}')"
+  if [ -n "$DISABLED" ] && [ "$DISABLED" != "$tempdisabled" ]; then
+    authfailures=$((authfailures + 1))
+    lastauthfailure="$(date -u +%Y-%m-%dT%H:%M:%SZ): authentication failed for SSID ${DISABLED}"
+  fi
+  tempdisabled="$DISABLED"
+}
+
+publish_status() {
+  local STATUS STATE
+  STATUS="$(wpa_cli -i "$wlanif" status 2>/dev/null | kv_to_json)"
+  [ -z "$STATUS" ] && STATUS="{}"
+  STATE="$(wifi_state "$(echo "$STATUS" | jq -r '.wpa_state // ""')")"
+  if [ -z "$(pgrep -x "$wpaproc")" ]; then
+    STATE="inactive"
+  fi
+  echo "$STATUS" | jq -c \
+    --arg iface "$wlanif" \
+    --arg state "$STATE" \
+    --argjson authfailures "$authfailures" \
+    --arg lastauthfailure "$lastauthfailure" \
+    --argjson scanresults "$scanresults" \
+    --arg lastscan "$lastscan" \
+    '{"networks": [{"interface": $iface, "state": $state,
+       "ssid": (.ssid // ""), "bssid": (.bssid // ""),
+       "frequency": ((.freq // "0") | tonumber), "key-mgmt": (.key_mgmt // ""),
+       "auth-failures": $authfailures, "last-auth-failure": $lastauthfailure,
+       "scan-results": $scanresults, "last-scan": $lastscan}]}' |\
+    publish "$statusfile"
+}
+
+publish_metrics() {
+  local IWCONFIG RSSI NOISE QUALITY BITRATE
+  IWCONFIG="$(iwconfig "$wlanif" 2>/dev/null)"
+  RSSI="$(echo "$IWCONFIG" | sed -n 's/.*Signal level=\(-\?[0-9]\+\) dBm.*/\1/p')"
+  NOISE="$(echo "$IWCONFIG" | sed -n 's/.*Noise level=\(-\?[0-9]\+\) dBm.*/\1/p')"
+  QUALITY="$(echo "$IWCONFIG" |\
+    sed -n 's/.*Link Quality=\([0-9]\+\)\/\([0-9]\+\).*/\1 \2/p' |\
+    awk '$2 > 0 {printf "%d", $1 * 100 / $2}')"
+  # Bit Rate is reported in Mb/s, convert to kbps.
+  BITRATE="$(echo "$IWCONFIG" |\
+    sed -n 's/.*Bit Rate[=:]\([0-9.]\+\) Mb\/s.*/\1/p' |\
+    awk '{printf "%d", $1 * 1000}')"
+  jq -n -c \
+    --arg iface "$wlanif" \
+    --argjson rssi "${RSSI:-$unavailable}" \
+    --argjson noise "${NOISE:-$unavailable}" \
+    --argjson quality "${QUALITY:-$unavailable}" \
+    --argjson bitrate "${BITRATE:-0}" \
+    '{"networks": [{"interface": $iface,
+       "signal-info": {"rssi": $rssi, "noise": $noise, "link-quality": $quality},
+       "tx-bitrate": $bitrate}]}' |\
+    publish "$metricsfile"
+}
+
+publish_wlan_info() {
+  if [ ! -d "/sys/class/net/$wlanif" ]; then
+    # No WiFi adapter present.
+    echo '{"networks": []}' | publish "$statusfile"
+    echo '{"networks": []}' | publish "$metricsfile"
+    return
+  fi
+  update_scan
+  update_auth_failures
+  publish_status
+  publish_metrics
+  iteration=$((iteration + 1))
+}
+
+ap_mode() {
+  grep -qxF "$apmodemarker" "$configfile"
+}
+
 if [ -d "$configdir" ] && [ -f "$configfile" ]; then
   filetime=$(stat -c %Y "$configfile")
-  wpa_supplicant -Dwext -iwlan0 -c "$configfile" -d -B
+  if ! ap_mode; then
+    wpa_supplicant -Dwext -iwlan0 -c "$configfile" -d -B
+  fi
 fi
 while true ; do
   sleep 10
@@ -20,11 +167,17 @@ while true ; do
     newfiletime=$(stat -c %Y "$configfile")
     if [ "${newfiletime}" -ne "${filetime}" ]; then
       filetime=$newfiletime
-      if [ -z "$(pgrep -x "wpa_supplicant")" ]; then
+      if ap_mode; then
+        # Leave the adapter to hostapd.
+        if [ -n "$(pgrep -x "wpa_supplicant")" ]; then
+          killall $wpaproc
+        fi
+      elif [ -z "$(pgrep -x "wpa_supplicant")" ]; then
         wpa_supplicant -Dwext -iwlan0 -c "$configfile" -d -B
       else
         killall -s HUP $wpaproc
       fi
     fi
   fi
+  publish_wlan_info
 done
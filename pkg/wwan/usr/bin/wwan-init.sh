@@ -28,6 +28,9 @@ UNAVAIL_SIGNAL_METRIC=$(printf "%d" 0x7FFFFFFF) # max int32
 
 DEFAULT_APN="internet"
 DEFAULT_PROBE_ADDR="8.8.8.8"
+# Number of consecutive failed connection attempts (incl. failed probing after
+# reconnect) after which SIM failover moves to the next access point.
+FAILOVER_THRESHOLD=2
 
 IPV4_REGEXP='^[0-9]\+\.[0-9]\+\.[0-9]\+\.[0-9]\+$'
 
@@ -219,17 +222,28 @@ bringup_iface() {
     echo "Failed to get IP config for interface $IFACE"
     return 1
   fi
-  ifconfig "$IFACE" "$IP" netmask "$SUBNET" pointopoint "$GW"
+  if [ -n "$IP" ]; then
+    ifconfig "$IFACE" "$IP" netmask "$SUBNET" pointopoint "$GW"
+  fi
   if [ -n "$MTU" ]; then
     ip link set mtu "$MTU" dev "$IFACE"
   fi
-  # NOTE we may want to disable /proc/sys/net/ipv4/conf/default/rp_filter instead
-  #      Verify it by cat /proc/net/netstat | awk '{print $80}'
-  ip route add default via "$GW" dev "$IFACE" metric 65000
+  if [ -n "$IP" ]; then
+    # NOTE we may want to disable /proc/sys/net/ipv4/conf/default/rp_filter instead
+    #      Verify it by cat /proc/net/netstat | awk '{print $80}'
+    ip route add default via "$GW" dev "$IFACE" metric 65000
+  fi
+  if [ -n "$IPV6" ]; then
+    echo 0 > "/proc/sys/net/ipv6/conf/${IFACE}/disable_ipv6"
+    ip -6 addr add "$IPV6" dev "$IFACE"
+    if [ -n "$GW6" ]; then
+      ip -6 route add default via "$GW6" dev "$IFACE" metric 65000
+    fi
+  fi
   mkdir -p "$BBS/resolv.conf"
   local RESOLV_CONF="$BBS/resolv.conf/${IFACE}.dhcp"
   : > "$RESOLV_CONF"
-  for DNS in "$DNS1" "$DNS2"; do
+  for DNS in "$DNS1" "$DNS2" "$DNS6_1" "$DNS6_2"; do
     if [ -n "$DNS" ]; then
       # The sole purpose of this route is to make sure that DNS probing,
       # done by probe_connectivity() using nslookup, uses interface wwan0
@@ -239,7 +253,11 @@ bringup_iface() {
       # into eve-alpine with all its dependencies.
       # The route should not cause any harm since EVE uses separate per-interface
       # routing tables instead of the main table.
-      ip route add "$DNS" via "$GW" dev "$IFACE"
+      if [ "$DNS" = "$DNS6_1" ] || [ "$DNS" = "$DNS6_2" ]; then
+        ip -6 route add "$DNS" via "$GW6" dev "$IFACE"
+      else
+        ip route add "$DNS" via "$GW" dev "$IFACE"
+      fi
       echo "nameserver $DNS" >> "$RESOLV_CONF"
     fi
   done
@@ -270,10 +288,14 @@ probe_connectivity() {
     # probing disabled, skip it
     return 0
   fi
+  local ICMP_PROBE_ERROR
   if [ -n "$PROBE_ADDR" ]; then
     # User-configured ICMP probe address.
-    add_probe_error "$(icmp_probe "$PROBE_ADDR")"
-    return
+    if ICMP_PROBE_ERROR="$(icmp_probe "$PROBE_ADDR")"; then
+      return 0
+    fi
+    add_probe_error "$ICMP_PROBE_ERROR"
+    return 1
   fi
   # Default probing behaviour (not configured by user).
   # First try endpoints from inside the LTE network:
@@ -286,7 +308,7 @@ probe_connectivity() {
       [ -z "$PROXY" ] && continue
       local SERVER="$(parse_json_attr "$PROXY" "server")"
       local PORT="$(parse_json_attr "$PROXY" "port")"
-      if echo "$SERVER" | grep -q "$IPV4_REGEXP"; then
+      if [ -n "$IP" ] && echo "$SERVER" | grep -q "$IPV4_REGEXP"; then
         if nc -w 5 -s "$IP" -z -n "$SERVER" "$PORT" >/dev/null 2>&1; then
           return 0
         fi
@@ -296,7 +318,7 @@ probe_connectivity() {
 $(echo "$PROXIES" | jq -c '.[]' 2>/dev/null)
 __EOT__
     # Try DNS query (for the root domain to get only small-sized response).
-    for DNS in "$DNS1" "$DNS2"; do
+    for DNS in "$DNS1" "$DNS2" "$DNS6_1" "$DNS6_2"; do
       if [ -n "$DNS" ]; then
         if nslookup -retry=1 -timeout=5 -type=a . "$DNS" >/dev/null 2>&1; then
           return 0
@@ -309,7 +331,11 @@ __EOT__
   # This is a last-resort probing option.
   # In a private LTE network ICMP requests headed towards public DNS servers
   # may be blocked by the firewall and thus produce probing false negatives.
-  add_probe_error "$(icmp_probe "$DEFAULT_PROBE_ADDR")"
+  if ICMP_PROBE_ERROR="$(icmp_probe "$DEFAULT_PROBE_ADDR")"; then
+    return 0
+  fi
+  add_probe_error "$ICMP_PROBE_ERROR"
+  return 1
 }
 
 add_probe_error() {
@@ -362,7 +388,8 @@ collect_network_status() {
     "$(json_attr     sim-cards        "$("${PROTOCOL}_get_sim_cards")")" \
     "$(json_str_attr config-error     "$CONFIG_ERROR")" \
     "$(json_str_attr probe-error      "$PROBE_ERROR")" \
-    "$(json_attr     providers        "$PROVIDERS")")"
+    "$(json_attr     providers        "$PROVIDERS")" \
+    "$(json_str_attr current-apn      "$APN")")"
   STATUS="${STATUS}${NETWORK_STATUS}\n"
 }
 
@@ -375,6 +402,84 @@ collect_network_metrics() {
   METRICS="${METRICS}${NETWORK_METRICS}\n"
 }
 
+# select_access_point sets connection parameters (APN, IP_TYPE, AUTH_PROTOCOL,
+# USERNAME, PASSWORD, SIM_PIN, SIM_SLOT, FORBID_ROAMING, PREFERRED_RATS)
+# based on the access point selected for the modem.
+# Without SIM failover, the access point configured for the activated SIM slot
+# is selected. With SIM failover, access points are tried in the configured
+# order (the index of the selected one is persisted in the AP_INDEX_FILE).
+select_access_point() {
+  unset SIM_SLOT IP_TYPE AUTH_PROTOCOL USERNAME PASSWORD SIM_PIN FORBID_ROAMING PREFERRED_RATS
+  AP_INDEX_FILE="${BBS}/ap-index_${CDC_DEV}"
+  AP_FAILURES_FILE="${BBS}/ap-failures_${CDC_DEV}"
+  AP_COUNT="$(echo "$ACCESS_POINTS" | jq length 2>/dev/null)"
+  AP_COUNT="${AP_COUNT:-0}"
+  if [ "$AP_COUNT" -eq 0 ]; then
+    # Legacy config with APN only.
+    AP_INDEX=0
+    APN="$(parse_json_attr "$NETWORK" "apns[0]")" # FIXME XXX limited to a single APN for now
+    APN="${APN:-$DEFAULT_APN}"
+    SIM_SLOT="$ACTIVATED_SIM_SLOT"
+    IP_TYPE="ipv4"
+    return
+  fi
+  if [ "$SIM_FAILOVER" = "true" ]; then
+    AP_INDEX="$(cat "$AP_INDEX_FILE" 2>/dev/null)"
+    if [ -z "$AP_INDEX" ] || [ "$AP_INDEX" -ge "$AP_COUNT" ]; then
+      AP_INDEX=0
+    fi
+  else
+    # Select access point for the activated SIM slot, or for unspecified slot,
+    # or just the first one.
+    AP_INDEX="$(echo "$ACCESS_POINTS" | jq --argjson SLOT "${ACTIVATED_SIM_SLOT:-0}" \
+      '[to_entries[] | select(.value."sim-slot" == $SLOT or .value."sim-slot" == 0)][0].key // 0')"
+  fi
+  local AP="$(echo "$ACCESS_POINTS" | jq -c ".[$AP_INDEX]")"
+  APN="$(parse_json_attr "$AP" "apn")"
+  APN="${APN:-$DEFAULT_APN}"
+  SIM_SLOT="$(parse_json_attr "$AP" "\"sim-slot\"")"
+  if [ "${SIM_SLOT:-0}" -eq 0 ] && [ "$SIM_FAILOVER" != "true" ]; then
+    SIM_SLOT="$ACTIVATED_SIM_SLOT"
+  fi
+  IP_TYPE="$(parse_json_attr "$AP" "\"ip-type\"")"
+  IP_TYPE="${IP_TYPE:-ipv4}"
+  AUTH_PROTOCOL="$(parse_json_attr "$AP" "\"auth-protocol\"")"
+  USERNAME="$(parse_json_attr "$AP" "credentials.username")"
+  PASSWORD="$(parse_json_attr "$AP" "credentials.password")"
+  SIM_PIN="$(parse_json_attr "$AP" "credentials.\"sim-pin\"")"
+  FORBID_ROAMING="$(parse_json_attr "$AP" "\"forbid-roaming\"")"
+  PREFERRED_RATS="$(echo "$AP" | jq -r '."preferred-rats" // [] | join(" ")')"
+}
+
+# reset_access_point_selection makes select_access_point to start again
+# from the first access point.
+reset_access_point_selection() {
+  rm -f "${BBS}/ap-index_${CDC_DEV}" "${BBS}/ap-failures_${CDC_DEV}"
+}
+
+# record_connection_result updates the counter of consecutive connection failures
+# for the selected access point. With SIM failover enabled, the next access point
+# is selected once the counter reaches FAILOVER_THRESHOLD.
+record_connection_result() {
+  local FAILED="$1"
+  if [ "$FAILED" != "y" ]; then
+    rm -f "$AP_FAILURES_FILE"
+    return
+  fi
+  local FAILURES="$(cat "$AP_FAILURES_FILE" 2>/dev/null)"
+  FAILURES="$(( ${FAILURES:-0} + 1 ))"
+  if [ "$SIM_FAILOVER" != "true" ] || [ "$AP_COUNT" -le 1 ] ||\
+     [ "$FAILURES" -lt "$FAILOVER_THRESHOLD" ]; then
+    echo "$FAILURES" > "$AP_FAILURES_FILE"
+    return
+  fi
+  rm -f "$AP_FAILURES_FILE"
+  local NEXT_INDEX="$(( (AP_INDEX + 1) % AP_COUNT ))"
+  echo "[$CDC_DEV] Failing over from access point #${AP_INDEX} (APN=${APN})"\
+       "to access point #${NEXT_INDEX}"
+  echo "$NEXT_INDEX" > "$AP_INDEX_FILE"
+}
+
 switch_to_preferred_proto() {
   local MODEL="$("${PROTOCOL}_get_modem_model")"
   case "$MODEL" in
@@ -480,8 +585,9 @@ event_stream | while read -r EVENT; do
     PROBE_DISABLED="$(parse_json_attr "$PROBE" "disable")"
     PROBE_ADDR="$(parse_json_attr "$PROBE" "address")"
     PROXIES="$(parse_json_attr "$NETWORK" "proxies")"
-    APN="$(parse_json_attr "$NETWORK" "apns[0]")" # FIXME XXX limited to a single APN for now
-    APN="${APN:-$DEFAULT_APN}"
+    ACCESS_POINTS="$(parse_json_attr "$NETWORK" "\"access-points\"")"
+    ACTIVATED_SIM_SLOT="$(parse_json_attr "$NETWORK" "\"activated-sim-slot\"")"
+    SIM_FAILOVER="$(parse_json_attr "$NETWORK" "\"sim-failover\"")"
     LOC_TRACKING="$(parse_json_attr "$NETWORK" "\"location-tracking\"")"
 
     if ! lookup_modem "${IFACE}" "${USB_ADDR}" "${PCI_ADDR}" 2>/tmp/wwan.stderr; then
@@ -508,6 +614,11 @@ event_stream | while read -r EVENT; do
       "$(json_str_attr usb       "$USB_ADDR")" \
       "$(json_str_attr pci       "$PCI_ADDR")")"
 
+    if [ "$CONFIG_CHANGE" = "y" ]; then
+      reset_access_point_selection
+    fi
+    select_access_point
+
     if [ "$EVENT" = "METRICS" ]; then
       collect_network_metrics 2>/dev/null
       continue
@@ -522,17 +633,22 @@ event_stream | while read -r EVENT; do
     # reflect updated config or just probe the current status
     if [ "$RADIO_SILENCE" != "true" ]; then
       if [ "$CONFIG_CHANGE" = "y" ] || ! check_connectivity; then
-        echo "[$CDC_DEV] Restarting connection (APN=${APN}, interface=${IFACE})"
+        echo "[$CDC_DEV] Restarting connection (APN=${APN}, SIM slot=${SIM_SLOT:-<current>},"\
+             "IP type=${IP_TYPE}, interface=${IFACE})"
         {
-          bringdown_iface                 &&\
-          "${PROTOCOL}_stop_network"      &&\
-          "${PROTOCOL}_toggle_rf" on      &&\
-          "${PROTOCOL}_wait_for_sim"      &&\
-          "${PROTOCOL}_wait_for_register" &&\
-          "${PROTOCOL}_start_network"     &&\
-          "${PROTOCOL}_wait_for_wds"      &&\
-          "${PROTOCOL}_wait_for_settings" &&\
-          bringup_iface                   &&\
+          bringdown_iface                   &&\
+          "${PROTOCOL}_stop_network"        &&\
+          "${PROTOCOL}_toggle_rf" on        &&\
+          "${PROTOCOL}_switch_sim_slot"     &&\
+          "${PROTOCOL}_unlock_sim"          &&\
+          "${PROTOCOL}_wait_for_sim"        &&\
+          "${PROTOCOL}_set_rat_preference"  &&\
+          "${PROTOCOL}_wait_for_register"   &&\
+          "${PROTOCOL}_check_roaming"       &&\
+          "${PROTOCOL}_start_network"       &&\
+          "${PROTOCOL}_wait_for_wds"        &&\
+          "${PROTOCOL}_wait_for_settings"   &&\
+          bringup_iface                     &&\
           echo "[$CDC_DEV] Connection successfully restarted"
         } 2>/tmp/wwan.stderr
         RV=$?
@@ -542,7 +658,11 @@ event_stream | while read -r EVENT; do
         fi
         # retry probe to update PROBE_ERROR
         sleep 3
-        probe_connectivity
+        if probe_connectivity && [ $RV -eq 0 ]; then
+          record_connection_result n
+        else
+          record_connection_result y
+        fi
       fi
     else # Radio-silence is ON
       if [ "$("${PROTOCOL}_get_op_mode")" != "radio-off" ]; then
@@ -118,19 +118,34 @@ mbim_get_sim_cards() {
   ICCID="$(echo "$ICCID" | tr -d "F")"
   local IMSI="$(parse_modem_attr "$SUBSCRIBER" "Subscriber ID")"
   local STATUS="$(parse_modem_attr "$SUBSCRIBER" "Ready state")"
-  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" "$(json_str_attr "status" "$STATUS")")\n"
+  local SLOT="$(qmi_get_active_sim_slot)"
+  SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" "$(json_str_attr "status" "$STATUS")" \
+    "$(json_attr "slot-number" "${SLOT:-0}")" "$(json_attr "slot-activated" "true")")\n"
   printf "%b" "$SIM" | json_array
 }
 
 mbim_get_ip_settings() {
+  unset IP SUBNET GW DNS1 DNS2 MTU IPV6 GW6 DNS6_1 DNS6_2
   if ! SETTINGS="$(mbim --query-ip-configuration)"; then
     return 1
   fi
-  IP="$(echo "$SETTINGS" | jq -r .ipv4.ip)"
-  SUBNET="$(echo "$SETTINGS" | jq -r .ipv4.subnet)"
-  GW="$(echo "$SETTINGS" | jq -r .ipv4.gateway)"
-  DNS1="$(echo "$SETTINGS" | jq -r .ipv4.dns0)"
-  DNS2="$(echo "$SETTINGS" | jq -r .ipv4.dns1)"
+  if [ "$IP_TYPE" != "ipv6" ]; then
+    IP="$(echo "$SETTINGS" | jq -r .ipv4.ip)"
+    SUBNET="$(echo "$SETTINGS" | jq -r .ipv4.subnet)"
+    GW="$(echo "$SETTINGS" | jq -r .ipv4.gateway)"
+    DNS1="$(echo "$SETTINGS" | jq -r .ipv4.dns0)"
+    DNS2="$(echo "$SETTINGS" | jq -r .ipv4.dns1)"
+  fi
+  if [ "$IP_TYPE" = "ipv6" ] || [ "$IP_TYPE" = "ipv4v6" ]; then
+    IPV6="$(echo "$SETTINGS" | jq -r '.ipv6.ip // empty')"
+    if [ -n "$IPV6" ]; then
+      # MBIM reports on-link prefix, which is typically /64 for cellular networks.
+      IPV6="${IPV6}/64"
+    fi
+    GW6="$(echo "$SETTINGS" | jq -r '.ipv6.gateway // empty')"
+    DNS6_1="$(echo "$SETTINGS" | jq -r '.ipv6.dns0 // empty')"
+    DNS6_2="$(echo "$SETTINGS" | jq -r '.ipv6.dns1 // empty')"
+  fi
   MTU="$(echo "$SETTINGS" | jq -r .mtu)"
 }
 
@@ -142,7 +157,48 @@ mbim_start_network() {
   # may be useful to check --query-packet-service-state just in case.
   mbim --attach-packet-service
   sleep 10
-  mbim --connect="apn='${APN}'"
+  local IP_TYPE_ARG="ipv4"
+  case "$IP_TYPE" in
+    "ipv6") IP_TYPE_ARG="ipv6"
+    ;;
+    "ipv4v6") IP_TYPE_ARG="ipv4v6"
+    ;;
+  esac
+  local ARGS="apn='${APN}',ip-type='${IP_TYPE_ARG}'"
+  if [ -n "$AUTH_PROTOCOL" ]; then
+    # MBIM does not allow to enable both PAP and CHAP, prefer the more secure CHAP.
+    local AUTH="CHAP"
+    if [ "$AUTH_PROTOCOL" = "pap" ]; then
+      AUTH="PAP"
+    fi
+    ARGS="${ARGS},auth='${AUTH}',username='${USERNAME}',password='${PASSWORD}'"
+  fi
+  mbim --connect="${ARGS}"
+}
+
+mbim_unlock_sim() {
+  if [ -z "$SIM_PIN" ]; then
+    return 0
+  fi
+  if ! mbim --query-subscriber-ready-status | grep -q "Ready state: .device-locked."; then
+    return 0
+  fi
+  echo "[$CDC_DEV] Unlocking SIM card"
+  if ! mbim --enter-pin="${SIM_PIN}" >/dev/null; then
+    echo "Failed to unlock SIM card with the configured PIN" >&2
+    return 1
+  fi
+}
+
+# SIM slot switching is not supported by the libmbim version that we use,
+# therefore it is done over QMUX.
+mbim_switch_sim_slot() {
+  qmi_switch_sim_slot
+}
+
+# Similarly to profile settings, RAT preference is applied over QMUX.
+mbim_set_rat_preference() {
+  qmi_set_rat_preference
 }
 
 mbim_wait_for_sim() {
@@ -174,7 +230,7 @@ mbim_wait_for_register() {
   # On the other hand, mbimcli does not yet provide command to manipulate with profiles.
   local PROFILE="$(qmi --wds-get-default-profile-num=3gpp)"
   local PROFILE_NUM="$(parse_modem_attr "$PROFILE" "Default profile number")"
-  qmi --wds-modify-profile="3gpp,${PROFILE_NUM},apn=${APN}"
+  qmi --wds-modify-profile="3gpp,${PROFILE_NUM},$(qmi_profile_settings)"
 
   echo "[$CDC_DEV] Waiting for the device to register on the network"
   local CMD="mbim --query-registration-state | grep -qE 'Register state:.*(home|roaming|partner)' && echo registered"
@@ -185,13 +241,30 @@ mbim_wait_for_register() {
   fi
 }
 
+mbim_check_roaming() {
+  if [ "$FORBID_ROAMING" != "true" ]; then
+    return 0
+  fi
+  if mbim --query-registration-state | grep -qE "Register state:.*(roaming|partner)"; then
+    echo "Registered to a roaming network, which is forbidden by the config" >&2
+    return 1
+  fi
+}
+
 mbim_get_ip_address() {
   mbim --query-ip-configuration | jq -r .ipv4.ip
 }
 
+mbim_get_ipv6_address() {
+  mbim --query-ip-configuration | jq -r '.ipv6.ip // empty'
+}
+
 mbim_wait_for_settings() {
   echo "[$CDC_DEV] Waiting for IP configuration for the $IFACE interface"
   local CMD="mbim_get_ip_address | grep -q \"$IPV4_REGEXP\" && echo connected"
+  if [ "$IP_TYPE" = "ipv6" ]; then
+    CMD="mbim_get_ipv6_address | grep -q \":\" && echo connected"
+  fi
 
   if ! wait_for connected "$CMD"; then
     echo "Timeout waiting for IP configuration for the $IFACE interface" >&2
@@ -210,20 +210,41 @@ qmi_get_sim_cards() {
   fi
   # Don't error out if this is empty
   local STATUS="$(qmi_get_sim_status)"
-  local SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" "$(json_str_attr "status" "$STATUS")")\n"
+  local SLOT="$(qmi_get_active_sim_slot)"
+  local SIM="$(json_struct "$(json_str_attr "iccid" "$ICCID")" "$(json_str_attr "imsi" "$IMSI")" "$(json_str_attr "status" "$STATUS")" \
+    "$(json_attr "slot-number" "${SLOT:-0}")" "$(json_attr "slot-activated" "true")")\n"
   printf "%b" "$SIM" | json_array
 }
 
 qmi_get_ip_settings() {
-  if ! SETTINGS="$(qmi --wds-get-current-settings)"; then
-    return 1
+  unset IP SUBNET GW DNS1 DNS2 MTU IPV6 GW6 DNS6_1 DNS6_2
+  if [ "$IP_TYPE" != "ipv6" ]; then
+    if ! SETTINGS="$(qmi --wds-get-current-settings)"; then
+      return 1
+    fi
+    IP=$(parse_modem_attr "$SETTINGS" "IPv4 address")
+    SUBNET=$(parse_modem_attr "$SETTINGS" "IPv4 subnet mask")
+    GW=$(parse_modem_attr "$SETTINGS" "IPv4 gateway address")
+    DNS1=$(parse_modem_attr "$SETTINGS" "IPv4 primary DNS")
+    DNS2=$(parse_modem_attr "$SETTINGS" "IPv4 secondary DNS")
+    MTU=$(parse_modem_attr "$SETTINGS" "MTU")
+  fi
+  if [ "$IP_TYPE" = "ipv6" ] || [ "$IP_TYPE" = "ipv4v6" ]; then
+    # IPv6 settings are available only from the client that started the IPv6 session.
+    local CID6="$(cat "${BBS}/cid6_${IFACE}.json" 2>/dev/null)"
+    if ! SETTINGS="$(qmi --wds-get-current-settings --client-cid="$CID6" --client-no-release-cid)"; then
+      # With dual-stack, IPv4 connectivity is enough.
+      [ "$IP_TYPE" = "ipv4v6" ]
+      return
+    fi
+    # Address is printed with the prefix length.
+    IPV6=$(parse_modem_attr "$SETTINGS" "IPv6 address")
+    GW6=$(parse_modem_attr "$SETTINGS" "IPv6 gateway address")
+    GW6="${GW6%/*}"
+    DNS6_1=$(parse_modem_attr "$SETTINGS" "IPv6 primary DNS")
+    DNS6_2=$(parse_modem_attr "$SETTINGS" "IPv6 secondary DNS")
+    MTU="${MTU:-$(parse_modem_attr "$SETTINGS" "MTU")}"
   fi
-  IP=$(parse_modem_attr "$SETTINGS" "IPv4 address")
-  SUBNET=$(parse_modem_attr "$SETTINGS" "IPv4 subnet mask")
-  GW=$(parse_modem_attr "$SETTINGS" "IPv4 gateway address")
-  DNS1=$(parse_modem_attr "$SETTINGS" "IPv4 primary DNS")
-  DNS2=$(parse_modem_attr "$SETTINGS" "IPv4 secondary DNS")
-  MTU=$(parse_modem_attr "$SETTINGS" "MTU")
 }
 
 qmi_start_network() {
@@ -233,12 +254,29 @@ qmi_start_network() {
   ip link set "$IFACE" up
 
   qmi --wds-reset
-  if ! OUTPUT="$(qmi --wds-start-network="ip-type=4,apn=${APN}" --client-no-release-cid)"; then
-    return 1
+  local ARGS="apn=${APN}"
+  if [ -n "$AUTH_PROTOCOL" ]; then
+    ARGS="${ARGS},auth=$(qmi_auth_protocol),username=${USERNAME},password=${PASSWORD}"
+  fi
+  # With QMI, a separate data session is started for each IP family.
+  if [ "$IP_TYPE" != "ipv6" ]; then
+    if ! OUTPUT="$(qmi --wds-start-network="ip-type=4,${ARGS}" --client-no-release-cid)"; then
+      return 1
+    fi
+    parse_modem_attr "$OUTPUT" "Packet data handle" | mbus_publish "pdh_$IFACE"
+    parse_modem_attr "$OUTPUT" "CID" | mbus_publish "cid_$IFACE"
+  fi
+  if [ "$IP_TYPE" = "ipv6" ] || [ "$IP_TYPE" = "ipv4v6" ]; then
+    if ! OUTPUT="$(qmi --wds-start-network="ip-type=6,${ARGS}" --client-no-release-cid)"; then
+      if [ "$IP_TYPE" = "ipv6" ]; then
+        return 1
+      fi
+      echo "[$CDC_DEV] Failed to start IPv6 data session, continuing with IPv4 only"
+      return 0
+    fi
+    parse_modem_attr "$OUTPUT" "Packet data handle" | mbus_publish "pdh6_$IFACE"
+    parse_modem_attr "$OUTPUT" "CID" | mbus_publish "cid6_$IFACE"
   fi
-
-  parse_modem_attr "$OUTPUT" "Packet data handle" | mbus_publish "pdh_$IFACE"
-  parse_modem_attr "$OUTPUT" "CID" | mbus_publish "cid_$IFACE"
 }
 
 qmi_get_sim_status() {
@@ -269,6 +307,20 @@ qmi_get_sim_status() {
   parse_modem_attr "$(echo "$STATE" | sed -n "/Slot \[$SLOT\]/,/Slot \[/p" | sed -n "/Application \[$APP\]/,/Application \[/p")" "Application state"
 }
 
+qmi_unlock_sim() {
+  if [ -z "$SIM_PIN" ]; then
+    return 0
+  fi
+  if [ "$(qmi_get_sim_status)" = "ready" ]; then
+    return 0
+  fi
+  echo "[$CDC_DEV] Unlocking SIM card"
+  if ! qmi --uim-verify-pin="PIN1,${SIM_PIN}" >/dev/null; then
+    echo "Failed to unlock SIM card with the configured PIN" >&2
+    return 1
+  fi
+}
+
 qmi_wait_for_sim() {
   echo "[$CDC_DEV] Waiting for SIM card to initialize"
   local CMD="qmi_get_sim_status"
@@ -299,7 +351,7 @@ qmi_wait_for_register() {
   # procedure (for the initial EPS bearer activation).
   local PROFILE="$(qmi --wds-get-default-profile-num=3gpp)"
   local PROFILE_NUM="$(parse_modem_attr "$PROFILE" "Default profile number")"
-  qmi --wds-modify-profile="3gpp,${PROFILE_NUM},apn=${APN}"
+  qmi --wds-modify-profile="3gpp,${PROFILE_NUM},$(qmi_profile_settings)"
 
   echo "[$CDC_DEV] Waiting for the device to register on the network"
   local CMD="qmi_get_registration_status"
@@ -310,13 +362,32 @@ qmi_wait_for_register() {
   fi
 }
 
+qmi_check_roaming() {
+  if [ "$FORBID_ROAMING" != "true" ]; then
+    return 0
+  fi
+  if [ "$(parse_modem_attr "$(qmi --nas-get-serving-system)" "Roaming status")" = "on" ]; then
+    echo "Registered to a roaming network, which is forbidden by the config" >&2
+    return 1
+  fi
+}
+
 qmi_get_ip_address() {
   parse_modem_attr "$(qmi --wds-get-current-settings)" "IPv4 address"
 }
 
+qmi_get_ipv6_address() {
+  local CID6="$(cat "${BBS}/cid6_${IFACE}.json" 2>/dev/null)"
+  parse_modem_attr "$(qmi --wds-get-current-settings --client-cid="$CID6" --client-no-release-cid)" \
+    "IPv6 address"
+}
+
 qmi_wait_for_settings() {
   echo "[$CDC_DEV] Waiting for IP configuration for the $IFACE interface"
   local CMD="qmi_get_ip_address | grep -q \"$IPV4_REGEXP\" && echo connected"
+  if [ "$IP_TYPE" = "ipv6" ]; then
+    CMD="qmi_get_ipv6_address | grep -q \":\" && echo connected"
+  fi
 
   if ! wait_for connected "$CMD"; then
     echo "Timeout waiting for IP configuration for the $IFACE interface" >&2
@@ -325,6 +396,12 @@ qmi_wait_for_settings() {
 }
 
 qmi_stop_network() {
+  local PDH6="$(cat "${BBS}/pdh6_${IFACE}.json" 2>/dev/null)"
+  local CID6="$(cat "${BBS}/cid6_${IFACE}.json" 2>/dev/null)"
+  if [ -n "$CID6" ]; then
+    qmi --wds-stop-network="$PDH6" --client-cid="$CID6" || true
+    rm -f "${BBS}/pdh6_${IFACE}.json" "${BBS}/cid6_${IFACE}.json"
+  fi
   local PDH="$(cat "${BBS}/pdh_${IFACE}.json" 2>/dev/null)"
   local CID="$(cat "${BBS}/cid_${IFACE}.json" 2>/dev/null)"
 
@@ -345,3 +422,93 @@ qmi_toggle_rf() {
     qmi --dms-set-operating-mode=online
   fi
 }
+
+# Print PDP type for the selected IP type as expected by qmicli profile settings.
+qmi_pdp_type() {
+  case "$IP_TYPE" in
+    "ipv6") echo "IPV6"
+    ;;
+    "ipv4v6") echo "IPV4V6"
+    ;;
+    *) echo "IP"
+    ;;
+  esac
+}
+
+# Print authentication protocol as expected by qmicli.
+qmi_auth_protocol() {
+  case "$AUTH_PROTOCOL" in
+    "pap") echo "PAP"
+    ;;
+    "chap") echo "CHAP"
+    ;;
+    "pap-and-chap") echo "BOTH"
+    ;;
+    *) echo "NONE"
+    ;;
+  esac
+}
+
+# Print settings for the default 3GPP profile (used for the initial attach).
+# Also used by MBIM (which uses qmicli to modify profiles).
+qmi_profile_settings() {
+  local SETTINGS="apn=${APN},pdp-type=$(qmi_pdp_type)"
+  if [ -n "$AUTH_PROTOCOL" ]; then
+    SETTINGS="${SETTINGS},auth=$(qmi_auth_protocol),username=${USERNAME},password=${PASSWORD}"
+  fi
+  echo "$SETTINGS"
+}
+
+# Apply preferred radio access technologies (if configured).
+# Also used by MBIM (over QMUX).
+# Note that qmicli only allows to restrict the set of RATs, the order of preference
+# is not enforced.
+qmi_set_rat_preference() {
+  if [ -z "$PREFERRED_RATS" ]; then
+    # Leave RAT selection to the modem.
+    return 0
+  fi
+  local RATS=""
+  for RAT in $PREFERRED_RATS; do
+    if [ "$RAT" = "5gnr" ]; then
+      # Not supported by the libqmi version that we use.
+      echo "[$CDC_DEV] Skipping unsupported RAT preference: 5gnr"
+      continue
+    fi
+    RATS="${RATS:+${RATS}|}${RAT}"
+  done
+  if [ -z "$RATS" ]; then
+    return 0
+  fi
+  echo "[$CDC_DEV] Setting RAT preference to: ${RATS}"
+  if ! qmi --nas-set-system-selection-preference="${RATS}" >/dev/null; then
+    echo "Failed to set preferred RATs to ${RATS}" >&2
+    return 1
+  fi
+}
+
+# Print the number of the active SIM slot (empty if not known).
+# Also used by MBIM (over QMUX).
+qmi_get_active_sim_slot() {
+  qmi --uim-get-slot-status 2>/dev/null | awk '
+    /Physical slot [0-9]+:/ {slot=$3; sub(/:/, "", slot)}
+    /Slot status: active/ {print slot; exit}'
+}
+
+# Activate SIM slot selected by select_access_point (if any).
+# Also used by MBIM (over QMUX).
+qmi_switch_sim_slot() {
+  if [ "${SIM_SLOT:-0}" -eq 0 ]; then
+    return 0
+  fi
+  if [ "$(qmi_get_active_sim_slot)" = "$SIM_SLOT" ]; then
+    return 0
+  fi
+  echo "[$CDC_DEV] Switching to SIM slot ${SIM_SLOT}"
+  if ! qmi --uim-switch-slot="$SIM_SLOT" >/dev/null; then
+    echo "Failed to switch to SIM slot ${SIM_SLOT}" >&2
+    return 1
+  fi
+  # Give modem some time to power up the card.
+  sleep 5
+}